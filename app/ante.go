@@ -12,6 +12,7 @@ import (
 
 	govkeeper "github.com/cosmos/cosmos-sdk/x/gov/keeper"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
+	cronkeeper "github.com/scrtlabs/SecretNetwork/x/cron/keeper"
 )
 
 // HandlerOptions extend the SDK's AnteHandler options by requiring the IBC
@@ -23,6 +24,7 @@ type HandlerOptions struct {
 	appCodec              codec.Codec
 	govkeeper             govkeeper.Keeper // You'll need the keeper to access stored mrenclave hash
 	IBCKeeper             *keeper.Keeper
+	CronKeeper            *cronkeeper.Keeper
 	WasmConfig            *compute.WasmConfig
 	TXCounterStoreService store.KVStoreService
 }
@@ -40,14 +42,24 @@ func NewAnteHandler(options HandlerOptions) (sdk.AnteHandler, error) {
 		return nil, sdkerrors.ErrLogic.Wrap("sign mode handler is required for ante builder")
 	}
 
+	if options.CronKeeper == nil {
+		return nil, sdkerrors.ErrLogic.Wrap("cron keeper is required for ante builder")
+	}
+
 	sigGasConsumer := options.HandlerOptions.SigGasConsumer
 	if sigGasConsumer == nil {
 		sigGasConsumer = ante.DefaultSigVerificationGasConsumer
 	}
 
+	// scheduled txs are unsigned and fee free, they were already authenticated by the ScheduledTxDecorator
+	scheduledTxHandler := sdk.ChainAnteDecorators(
+		ante.NewIncrementSequenceDecorator(options.HandlerOptions.AccountKeeper),
+	)
+
 	anteDecorators := []sdk.AnteDecorator{
 		compute.NewCountTXDecorator(options.appCodec, options.govkeeper, options.TXCounterStoreService),
 		ante.NewSetUpContextDecorator(), // outermost AnteDecorator. SetUpContext must be called first
+		cronkeeper.NewScheduledTxDecorator(options.CronKeeper, scheduledTxHandler),
 		circuitante.NewCircuitBreakerDecorator(options.CircuitKeeper),
 		ante.NewExtensionOptionsDecorator(nil),
 		ante.NewValidateBasicDecorator(),
@@ -12,7 +12,9 @@ import (
 
 	packetforwardtypes "github.com/cosmos/ibc-apps/middleware/packet-forward-middleware/v8/packetforward/types"
 	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	cronkeeper "github.com/scrtlabs/SecretNetwork/x/cron/keeper"
 	ibcswitchtypes "github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+	ibchookstypes "github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
 
 	cosmwasm_api "github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
 
@@ -404,6 +406,7 @@ func NewSecretNetworkApp(
 		appCodec:              app.appCodec,
 		govkeeper:             *app.AppKeepers.GovKeeper,
 		IBCKeeper:             app.AppKeepers.IbcKeeper,
+		CronKeeper:            app.AppKeepers.CronKeeper,
 		WasmConfig:            computeConfig,
 		TXCounterStoreService: app.AppKeepers.ComputeKeeper.GetStoreService(),
 	})
@@ -413,6 +416,12 @@ func NewSecretNetworkApp(
 
 	// The AnteHandler handles signature verification and transaction pre-processing
 	app.SetAnteHandler(anteHandler)
+	// Validators only accept the unsigned scheduled txs they built themselves, the enclave relies on it to
+	// authenticate them
+	app.SetProcessProposal(cronkeeper.NewScheduledTxProposalHandler(
+		app.AppKeepers.CronKeeper,
+		baseapp.NewDefaultProposalHandler(app.Mempool(), app).ProcessProposalHandler(),
+	).ProcessProposal)
 	// The initChainer handles translating the genesis.json file into initial state for the network
 	app.SetInitChainer(app.InitChainer)
 	app.SetBeginBlocker(app.BeginBlocker)
@@ -675,6 +684,7 @@ func SetOrderBeginBlockers(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
+		ibchookstypes.ModuleName,
 		crontypes.ModuleName,
 		circuittypes.ModuleName,
 	)
@@ -696,6 +706,7 @@ func SetOrderInitGenesis(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
+		ibchookstypes.ModuleName,
 
 		icatypes.ModuleName,
 
@@ -739,6 +750,7 @@ func SetOrderEndBlockers(app *SecretNetworkApp) {
 		compute.ModuleName,
 		reg.ModuleName,
 		ibcswitchtypes.ModuleName,
+		ibchookstypes.ModuleName,
 		crontypes.ModuleName,
 		circuittypes.ModuleName,
 	)
@@ -35,6 +35,7 @@ import (
 	ibctm "github.com/cosmos/ibc-go/v8/modules/light-clients/07-tendermint"
 	"github.com/scrtlabs/SecretNetwork/x/cron"
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	ibchooks "github.com/scrtlabs/SecretNetwork/x/ibc-hooks"
 
 	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
 	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
@@ -91,6 +92,7 @@ func customModuleBasics() []module.AppModuleBasic {
 		compute.AppModuleBasic{},
 		registration.AppModuleBasic{},
 		ibcswitch.AppModuleBasic{},
+		ibchooks.AppModuleBasic{},
 	}
 }
 
@@ -246,6 +246,7 @@ func (ak *SecretAppKeepers) InitSdkKeepers(
 		ak.keys[crontypes.StoreKey],
 		ak.memKeys[crontypes.StoreKey],
 		ak.AccountKeeper,
+		ak.BankKeeper,
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	ak.CronKeeper = cronKeeper
@@ -382,13 +383,14 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		homePath,
 		bootstrap,
 		app,
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 	ak.RegKeeper = &regKeeper
 	ak.CronKeeper.SetRegKeeper(&regKeeper)
 
 	// Assaf:
 	// Rules:
-	// 1. Everything should go through our IBC Switch middleware
+	// 1. Everything should go through our IBC Switch middleware, which also enforces the rate limits of transfers
 	// 2. Everything should go through the IBC Fee middleware
 	// 3. IBC Transfer should go through the IBC Packet Forward middleware
 	// 4. IBC Transfer should go through the IBC Hooks middleware
@@ -445,6 +447,7 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 
 	ibcSwitchKeeper := ibcswitch.NewKeeper(
 		ak.IbcFeeKeeper,
+		ak.BankKeeper,
 		appCodec,
 		ak.keys[ibcswitchtypes.StoreKey],
 		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
@@ -562,8 +565,13 @@ func (ak *SecretAppKeepers) InitCustomKeepers(
 		}
 		ak.ComputeKeeper.SetStoreKeys(sk)
 	}
+	ak.ComputeKeeper.SetExecutionHalter(ak.IbcSwitchKeeper)
 
 	wasmHooks.ContractKeeper = ak.ComputeKeeper
+	wasmHooks.Halter = ak.IbcSwitchKeeper
+	wasmHooks.TransferKeeper = ak.TransferKeeper
+	wasmHooks.BankKeeper = ak.BankKeeper
+	wasmHooks.ChannelKeeper = ak.IbcKeeper.ChannelKeeper
 
 	// Compute receive: Switch -> Fee -> Packet Forward -> WASM Hooks
 	var computeStack porttypes.IBCModule
@@ -42,6 +42,7 @@ import (
 	"github.com/scrtlabs/SecretNetwork/x/cron"
 	crontypes "github.com/scrtlabs/SecretNetwork/x/cron/types"
 	ibcswitch "github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	ibchooks "github.com/scrtlabs/SecretNetwork/x/ibc-hooks"
 	reg "github.com/scrtlabs/SecretNetwork/x/registration"
 )
 
@@ -91,6 +92,7 @@ func Modules(
 		packetforward.NewAppModule(app.AppKeepers.PacketForwardKeeper, app.AppKeepers.GetSubspace(packetforwardtypes.ModuleName)),
 		ibcfee.NewAppModule(app.AppKeepers.IbcFeeKeeper),
 		ibcswitch.NewAppModule(app.AppKeepers.IbcSwitchKeeper, app.AppKeepers.GetSubspace(ibcswitch.ModuleName)),
+		ibchooks.NewAppModule(app.AppKeepers.IbcHooksKeeper),
 		cron.NewAppModule(app.appCodec, *app.AppKeepers.CronKeeper),
 	}
 }
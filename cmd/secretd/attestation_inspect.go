@@ -0,0 +1,179 @@
+package main
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/spf13/cobra"
+
+	reg "github.com/scrtlabs/SecretNetwork/x/registration"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+const flagPolicy = "policy"
+
+// AttestationCmd groups the attestation commands that don't need an enclave
+func AttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:                        "attestation",
+		Short:                      "Offline attestation certificate tools",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		InspectAttestationCmd(),
+	)
+
+	return cmd
+}
+
+func InspectAttestationCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "inspect [cert file]",
+		Short: "Decode an attestation certificate and check it against a policy",
+		Long: `Decode a combined attestation certificate, a legacy EPID certificate, or the JSON of a registration tx or
+RaAuthenticate message, and print the fields of its attestation. This doesn't need SGX, and doesn't verify the quote,
+which only the enclave can do for DCAP attestations.
+
+With --policy, the attestation is also checked against a policy file in the format of the registration module params,
+as printed by 'secretd q register params -o json'. The command fails if the attestation doesn't comply with it.
+The maximum attestation age of the policy can't be checked offline, and is ignored.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cert, err := readAttestationCert(args[0])
+			if err != nil {
+				return err
+			}
+
+			info, err := ra.ParseCombinedCert(cert)
+			if err != nil {
+				var epidErr error
+				info, epidErr = ra.ParseRaCert(cert)
+				if epidErr != nil {
+					return fmt.Errorf("failed to parse certificate: %w", err)
+				}
+			}
+
+			printAttestationInfo(cmd.OutOrStdout(), info)
+
+			policyFile, _ := cmd.Flags().GetString(flagPolicy)
+			if policyFile == "" {
+				return nil
+			}
+
+			bz, err := os.ReadFile(policyFile)
+			if err != nil {
+				return err
+			}
+			var params reg.Params
+			if err := client.GetClientContextFromCmd(cmd).Codec.UnmarshalJSON(bz, &params); err != nil {
+				return fmt.Errorf("failed to parse policy file: %w", err)
+			}
+			if err := params.Validate(); err != nil {
+				return fmt.Errorf("invalid policy: %w", err)
+			}
+
+			violations := params.AttestationPolicy().Violations(info)
+			if len(violations) == 0 {
+				fmt.Fprintln(cmd.OutOrStdout(), "Policy:            compliant")
+				return nil
+			}
+			fmt.Fprintln(cmd.OutOrStdout(), "Policy violations:")
+			for _, violation := range violations {
+				fmt.Fprintf(cmd.OutOrStdout(), "  - %s\n", violation)
+			}
+			return errors.New("the attestation doesn't comply with the policy")
+		},
+	}
+	cmd.Flags().String(flagPolicy, "", "Policy file to check the attestation against")
+
+	return cmd
+}
+
+// readAttestationCert reads a raw certificate, or extracts it from the JSON of a tx or a message
+func readAttestationCert(path string) ([]byte, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	trimmed := bytes.TrimSpace(data)
+	if len(trimmed) == 0 || trimmed[0] != '{' || !json.Valid(trimmed) {
+		return data, nil
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(trimmed, &doc); err != nil {
+		return nil, err
+	}
+	encoded, found := findCertField(doc)
+	if !found {
+		return nil, errors.New("no certificate found in the JSON, expected a 'certificate' or 'ra_cert' field")
+	}
+	return base64.StdEncoding.DecodeString(encoded)
+}
+
+// findCertField looks for the certificate of a RaAuthenticate message, which is named certificate in the proto JSON
+// of a tx and ra_cert in its amino JSON
+func findCertField(doc interface{}) (string, bool) {
+	switch value := doc.(type) {
+	case map[string]interface{}:
+		for _, name := range []string{"ra_cert", "certificate"} {
+			if cert, ok := value[name].(string); ok {
+				return cert, true
+			}
+		}
+		for _, field := range value {
+			if cert, ok := findCertField(field); ok {
+				return cert, true
+			}
+		}
+	case []interface{}:
+		for _, item := range value {
+			if cert, ok := findCertField(item); ok {
+				return cert, true
+			}
+		}
+	}
+	return "", false
+}
+
+func printAttestationInfo(w io.Writer, info *ra.AttestationInfo) {
+	tcbStatus := info.TcbStatus
+	if info.Type == ra.AttestationTypeDCAP {
+		tcbStatus = "evaluated by the enclave against the collateral"
+	}
+
+	fmt.Fprintf(w, "Type:              %s\n", info.Type)
+	fmt.Fprintf(w, "Public key:        0x%s\n", hex.EncodeToString(info.PubKey))
+	fmt.Fprintf(w, "MRENCLAVE:         %s\n", info.MrEnclave)
+	fmt.Fprintf(w, "MRSIGNER:          %s\n", info.MrSigner)
+	fmt.Fprintf(w, "ISV SVN:           %d\n", info.IsvSvn)
+	fmt.Fprintf(w, "CPU SVN:           %s\n", hex.EncodeToString(info.CpuSvn))
+	fmt.Fprintf(w, "TCB status:        %s\n", tcbStatus)
+	if !info.ReportTime.IsZero() {
+		fmt.Fprintf(w, "Report time:       %s\n", info.ReportTime.Format(time.RFC3339))
+	}
+
+	if info.Type != ra.AttestationTypeEPID {
+		return
+	}
+	fmt.Fprintf(w, "Advisory IDs:      %s\n", strings.Join(info.AdvisoryIDs, ", "))
+	fmt.Fprintf(w, "Platform info:     %s\n", info.PlatformInfoBlob)
+	if info.ReportSignatureValid {
+		fmt.Fprintln(w, "IAS signature:     valid")
+	} else {
+		fmt.Fprintln(w, "IAS signature:     invalid")
+	}
+}
@@ -12,6 +12,7 @@ import (
 	eip191 "github.com/scrtlabs/SecretNetwork/eip191"
 	scrt "github.com/scrtlabs/SecretNetwork/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute"
+	computecli "github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
 	"github.com/spf13/viper"
 
 	txsigning "cosmossdk.io/x/tx/signing"
@@ -207,6 +208,8 @@ func initRootCmd(rootCmd *cobra.Command, encodingConfig app.EncodingConfig, basi
 		HealthCheck(),
 		ResetEnclave(),
 		AutoRegisterNode(),
+		AttestationCmd(),
+		computecli.IOKeyCmd(),
 		confixcmd.ConfigCommand(),
 		keys.Commands(),
 	)
@@ -275,6 +278,7 @@ func queryCommand() *cobra.Command {
 		server.QueryBlockResultsCmd(),
 		rpc.ValidatorCommand(),
 		S20GetQueryCmd(),
+		S721GetQueryCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
@@ -304,6 +308,7 @@ func txCommand() *cobra.Command {
 		authcmd.GetDecodeCommand(),
 		flags.LineBreak,
 		S20GetTxCmd(),
+		S721GetTxCmd(),
 	)
 
 	cmd.PersistentFlags().String(flags.FlagChainID, "", "The network chain ID")
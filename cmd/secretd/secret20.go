@@ -1,10 +1,15 @@
 package main
 
 import (
+	"bytes"
 	"crypto/rand"
+	"encoding/csv"
 	"encoding/hex"
 	"encoding/json"
 	"errors"
+	"fmt"
+	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -19,6 +24,7 @@ import (
 const (
 	MessageBlockSize = 256
 	flagAmount       = "amount"
+	flagExpiration   = "expiration"
 )
 
 // S20GetQueryCmd GetQueryCmd returns the cli query commands for this module
@@ -35,6 +41,12 @@ func S20GetQueryCmd() *cobra.Command {
 		S20BalanceCmd(),
 		S20TransferHistoryCmd(),
 		S20TransactionHistoryCmd(),
+		S20TokenInfoCmd(),
+		S20AllowanceCmd(),
+		S20SignPermitCmd(),
+		S20PermitBalanceCmd(),
+		S20PermitTransferHistoryCmd(),
+		S20PermitTransactionHistoryCmd(),
 	)
 
 	return s20QueryCmd
@@ -58,6 +70,12 @@ func S20GetTxCmd() *cobra.Command {
 		s20Redeem(),
 		s20SetViewingKey(),
 		s20BurnCmd(),
+		s20IncreaseAllowanceCmd(),
+		s20DecreaseAllowanceCmd(),
+		s20TransferFromCmd(),
+		s20SendFromCmd(),
+		s20BatchTransferCmd(),
+		s20BatchSendCmd(),
 	)
 
 	return s20TxCmd
@@ -247,6 +265,79 @@ key yet, use the "create-viewing-key" command. Otherwise, you can still see your
 	return cmd
 }
 
+func S20TokenInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "token-info [contract address or label]",
+		Short: "See the name, symbol, decimals and total supply of a token",
+		Long:  `See the name, symbol, decimals and total supply of a token. The total supply is only shown by tokens that made it public`,
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			queryData, err := queryTokenInfoMsg()
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	return cmd
+}
+
+func S20AllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "allowance [contract address or label] [owner] [spender] [viewing_key]",
+		Short: "See the amount a spender is allowed to spend from an owner's balance",
+		Long:  `See the amount a spender is allowed to spend from an owner's balance. The viewing key can be either the owner's or the spender's`,
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return err
+			}
+
+			key := args[3]
+			if key == "" {
+				return errors.New("viewing key must not be empty")
+			}
+
+			queryData, err := queryAllowanceMsg(owner, spender, key)
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	return cmd
+}
+
 func addressFromBechOrLabel(addressOrLabel string, cliCtx client.Context) (sdk.AccAddress, error) {
 	contractAddrBech32, err := cli.GetContractAddressByLabel(addressOrLabel, cliCtx)
 	var contractAddr sdk.AccAddress
@@ -538,6 +629,359 @@ WARNING! This action is irreversible and permanent! use at your own risk`,
 	return cmd
 }
 
+func s20IncreaseAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "increase-allowance [contract address or label] [spender] [amount]",
+		Short: "Allow another address to spend more of your tokens",
+		Long: `Allow another address to spend more of your tokens with transfer-from and send-from. The allowance expires at
+--expiration, in seconds since the epoch, if it's set`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s20ChangeAllowance(cmd, args, true)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagExpiration, 0, "Time the allowance expires at, in seconds since the epoch")
+
+	return cmd
+}
+
+func s20DecreaseAllowanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "decrease-allowance [contract address or label] [spender] [amount]",
+		Short: "Allow another address to spend less of your tokens",
+		Long: `Allow another address to spend less of your tokens with transfer-from and send-from. The allowance expires at
+--expiration, in seconds since the epoch, if it's set`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s20ChangeAllowance(cmd, args, false)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagExpiration, 0, "Time the allowance expires at, in seconds since the epoch")
+
+	return cmd
+}
+
+func s20ChangeAllowance(cmd *cobra.Command, args []string, increase bool) error {
+	cliCtx, err := client.GetClientTxContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+	if err != nil {
+		return err
+	}
+
+	spender, err := sdk.AccAddressFromBech32(args[1])
+	if err != nil {
+		return errors.New("invalid spender address")
+	}
+
+	amount := args[2]
+	_, err = strconv.ParseUint(amount, 10, 64)
+	if err != nil {
+		return errors.New("invalid amount format")
+	}
+
+	var expiration *uint64
+	if cmd.Flags().Changed(flagExpiration) {
+		value, _ := cmd.Flags().GetUint64(flagExpiration)
+		expiration = &value
+	}
+
+	var msg []byte
+	if increase {
+		msg, err = handleIncreaseAllowanceMsg(spender, amount, expiration)
+	} else {
+		msg, err = handleDecreaseAllowanceMsg(spender, amount, expiration)
+	}
+	if err != nil {
+		return err
+	}
+
+	return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+}
+
+func s20TransferFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer-from [contract address or label] [owner] [to account] [amount]",
+		Short: "Transfer tokens of another address that allowed you to spend them",
+		Long:  `Transfer tokens of another address that allowed you to spend them with increase-allowance`,
+		Args:  cobra.ExactArgs(4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid owner address")
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			amount := args[3]
+			_, err = strconv.ParseUint(amount, 10, 64)
+			if err != nil {
+				return errors.New("invalid amount format")
+			}
+
+			msg, err := handleTransferFromMsg(owner, toAddr, amount)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s20SendFromCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send-from [contract address or label] [owner] [to account] [amount] [optional: callback_message]",
+		Short: "Send tokens of another address that allowed you to spend them. Optionally add a callback message",
+		Long: `Send tokens of another address that allowed you to spend them with increase-allowance. If 'to_account' is a
+contract, you can optionally add a callback message to this contract.`,
+		Args: cobra.RangeArgs(4, 5),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid owner address")
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[2])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			amount := args[3]
+			_, err = strconv.ParseUint(amount, 10, 64)
+			if err != nil {
+				return errors.New("invalid amount format")
+			}
+
+			var callback string
+			if len(args) > 4 {
+				callback = args[4]
+			}
+			msg, err := handleSendFromMsg(owner, toAddr, amount, callback)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s20BatchTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-transfer [contract address or label] [file]",
+		Short: "Transfer tokens to many addresses in a single tx",
+		Long: `Transfer tokens to many addresses in a single tx. The file is either a JSON array of
+{"recipient": ..., "amount": ..., "memo": ...} objects, or a CSV file with the columns recipient,amount[,memo].
+The CSV file may start with a header row naming its columns`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			actions, err := readBatchActions(args[1], []string{"recipient", "amount", "memo"})
+			if err != nil {
+				return err
+			}
+
+			transfers := make([]TransferAction, len(actions))
+			for i, action := range actions {
+				transfers[i] = TransferAction{Recipient: action.Recipient, Amount: action.Amount, Memo: action.Memo}
+			}
+
+			msg, err := handleBatchTransferMsg(transfers)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s20BatchSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [contract address or label] [file]",
+		Short: "Send tokens to many addresses in a single tx",
+		Long: `Send tokens to many addresses in a single tx. The file is either a JSON array of
+{"recipient": ..., "amount": ..., "msg": ..., "memo": ...} objects, or a CSV file with the columns
+recipient,amount[,msg[,memo]]. The CSV file may start with a header row naming its columns`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			actions, err := readBatchActions(args[1], []string{"recipient", "amount", "msg", "memo"})
+			if err != nil {
+				return err
+			}
+
+			msg, err := handleBatchSendMsg(actions)
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readBatchActions reads the actions of a batch from a JSON or CSV file. The columns of a CSV file without a header
+// are the given ones, in order
+func readBatchActions(path string, columns []string) ([]SendAction, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var actions []SendAction
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &actions); err != nil {
+			return nil, fmt.Errorf("failed to parse batch file: %w", err)
+		}
+	} else {
+		actions, err = readBatchActionsCSV(data, columns)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	if len(actions) == 0 {
+		return nil, errors.New("batch file has no actions")
+	}
+	for i, action := range actions {
+		if action.Recipient.Empty() {
+			return nil, fmt.Errorf("action %d: missing recipient", i)
+		}
+		if _, err := strconv.ParseUint(action.Amount, 10, 64); err != nil {
+			return nil, fmt.Errorf("action %d: invalid amount format", i)
+		}
+		if action.Msg != "" && !slices.Contains(columns, "msg") {
+			return nil, fmt.Errorf("action %d: transfers can't have a callback message", i)
+		}
+	}
+
+	return actions, nil
+}
+
+func readBatchActionsCSV(data []byte, columns []string) ([]SendAction, error) {
+	rows, err := readCSVRows(data, columns)
+	if err != nil {
+		return nil, err
+	}
+
+	actions := make([]SendAction, len(rows))
+	for i, row := range rows {
+		if recipient := row["recipient"]; recipient != "" {
+			actions[i].Recipient, err = sdk.AccAddressFromBech32(recipient)
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid recipient address", i+1)
+			}
+		}
+		actions[i].Amount = row["amount"]
+		actions[i].Msg = row["msg"]
+		actions[i].Memo = row["memo"]
+	}
+
+	return actions, nil
+}
+
+// readCSVRows reads the rows of a CSV batch file as maps of column names to values. A file may start with a header
+// row, which starts with the first of the columns, and the columns of a file without one are the given ones, in order
+func readCSVRows(data []byte, columns []string) ([]map[string]string, error) {
+	reader := csv.NewReader(bytes.NewReader(data))
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+	records, err := reader.ReadAll()
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse batch file: %w", err)
+	}
+
+	if len(records) > 0 && strings.EqualFold(records[0][0], columns[0]) {
+		header := records[0]
+		for _, column := range header {
+			if !slices.Contains(columns, strings.ToLower(strings.TrimSpace(column))) {
+				return nil, fmt.Errorf("unknown column %s", column)
+			}
+		}
+		columns = header
+		records = records[1:]
+	}
+
+	rows := make([]map[string]string, len(records))
+	for i, record := range records {
+		if len(record) > len(columns) {
+			return nil, fmt.Errorf("row %d: expected at most %d columns, got %d", i+1, len(columns), len(record))
+		}
+		rows[i] = make(map[string]string, len(record))
+		for j, value := range record {
+			rows[i][strings.ToLower(strings.TrimSpace(columns[j]))] = value
+		}
+	}
+
+	return rows, nil
+}
+
 type TransferHistoryMsg struct {
 	TransferHistory TransferHistoryMsgInner `json:"transfer_history"`
 }
@@ -628,6 +1072,84 @@ type BurnMsgInner struct {
 	Amount string `json:"amount"`
 }
 
+type TokenInfoMsg struct {
+	TokenInfo struct{} `json:"token_info"`
+}
+
+type AllowanceMsg struct {
+	Allowance AllowanceMsgInner `json:"allowance"`
+}
+
+type AllowanceMsgInner struct {
+	Owner   sdk.AccAddress `json:"owner"`
+	Spender sdk.AccAddress `json:"spender"`
+	Key     string         `json:"key"`
+}
+
+type IncreaseAllowanceMsg struct {
+	IncreaseAllowance ChangeAllowanceMsgInner `json:"increase_allowance"`
+}
+
+type DecreaseAllowanceMsg struct {
+	DecreaseAllowance ChangeAllowanceMsgInner `json:"decrease_allowance"`
+}
+
+type ChangeAllowanceMsgInner struct {
+	Spender    sdk.AccAddress `json:"spender"`
+	Amount     string         `json:"amount"`
+	Expiration *uint64        `json:"expiration,omitempty"`
+}
+
+type TransferFromMsg struct {
+	TransferFrom TransferFromMsgInner `json:"transfer_from"`
+}
+
+type TransferFromMsgInner struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+}
+
+type SendFromMsg struct {
+	SendFrom SendFromMsgInner `json:"send_from"`
+}
+
+type SendFromMsgInner struct {
+	Owner     sdk.AccAddress `json:"owner"`
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+	Msg       string         `json:"msg,omitempty"`
+}
+
+type BatchTransferMsg struct {
+	BatchTransfer BatchTransferMsgInner `json:"batch_transfer"`
+}
+
+type BatchTransferMsgInner struct {
+	Actions []TransferAction `json:"actions"`
+}
+
+type TransferAction struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+	Memo      string         `json:"memo,omitempty"`
+}
+
+type BatchSendMsg struct {
+	BatchSend BatchSendMsgInner `json:"batch_send"`
+}
+
+type BatchSendMsgInner struct {
+	Actions []SendAction `json:"actions"`
+}
+
+type SendAction struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	Amount    string         `json:"amount"`
+	Msg       string         `json:"msg,omitempty"`
+	Memo      string         `json:"memo,omitempty"`
+}
+
 func spacePad(blockSize int, message string) string {
 	surplus := len(message) % blockSize
 	if surplus == 0 {
@@ -785,3 +1307,121 @@ func handleBurnMsg(amount string) ([]byte, error) {
 
 	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
 }
+
+func queryTokenInfoMsg() ([]byte, error) {
+	jsonMsg, err := json.Marshal(&TokenInfoMsg{})
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func queryAllowanceMsg(owner sdk.AccAddress, spender sdk.AccAddress, viewingKey string) ([]byte, error) {
+	msg := AllowanceMsg{
+		Allowance: AllowanceMsgInner{
+			Owner:   owner,
+			Spender: spender,
+			Key:     viewingKey,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleIncreaseAllowanceMsg(spender sdk.AccAddress, amount string, expiration *uint64) ([]byte, error) {
+	msg := IncreaseAllowanceMsg{
+		IncreaseAllowance: ChangeAllowanceMsgInner{
+			Spender:    spender,
+			Amount:     amount,
+			Expiration: expiration,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleDecreaseAllowanceMsg(spender sdk.AccAddress, amount string, expiration *uint64) ([]byte, error) {
+	msg := DecreaseAllowanceMsg{
+		DecreaseAllowance: ChangeAllowanceMsgInner{
+			Spender:    spender,
+			Amount:     amount,
+			Expiration: expiration,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleTransferFromMsg(owner sdk.AccAddress, toAddress sdk.AccAddress, amount string) ([]byte, error) {
+	msg := TransferFromMsg{
+		TransferFrom: TransferFromMsgInner{
+			Owner:     owner,
+			Recipient: toAddress,
+			Amount:    amount,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleSendFromMsg(owner sdk.AccAddress, toAddress sdk.AccAddress, amount string, message string) ([]byte, error) {
+	msg := SendFromMsg{
+		SendFrom: SendFromMsgInner{
+			Owner:     owner,
+			Recipient: toAddress,
+			Amount:    amount,
+			Msg:       message,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleBatchTransferMsg(actions []TransferAction) ([]byte, error) {
+	msg := BatchTransferMsg{
+		BatchTransfer: BatchTransferMsgInner{
+			Actions: actions,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
+
+func handleBatchSendMsg(actions []SendAction) ([]byte, error) {
+	msg := BatchSendMsg{
+		BatchSend: BatchSendMsgInner{
+			Actions: actions,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
@@ -0,0 +1,377 @@
+package main
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strconv"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagPermit      = "permit"
+	flagPermitName  = "permit-name"
+	flagPermissions = "permissions"
+
+	// defaultPermitName names the permits signed on the fly to run a single query
+	defaultPermitName = "secretd"
+)
+
+// QueryPermit is a SNIP-24 query permit, which authenticates queries with a signature instead of a viewing key
+type QueryPermit struct {
+	Params    PermitParams    `json:"params"`
+	Signature PermitSignature `json:"signature"`
+}
+
+type PermitParams struct {
+	PermitName    string   `json:"permit_name"`
+	AllowedTokens []string `json:"allowed_tokens"`
+	ChainID       string   `json:"chain_id"`
+	Permissions   []string `json:"permissions"`
+}
+
+type PermitSignature struct {
+	PubKey    PermitPubKey `json:"pub_key"`
+	Signature []byte       `json:"signature"`
+}
+
+type PermitPubKey struct {
+	Type  string `json:"type"`
+	Value []byte `json:"value"`
+}
+
+type WithPermitMsg struct {
+	WithPermit WithPermitMsgInner `json:"with_permit"`
+}
+
+type WithPermitMsgInner struct {
+	Permit QueryPermit `json:"permit"`
+	Query  interface{} `json:"query"`
+}
+
+type PermitBalanceQuery struct {
+	Balance struct{} `json:"balance"`
+}
+
+type PermitTransferHistoryQuery struct {
+	TransferHistory PermitHistoryQueryInner `json:"transfer_history"`
+}
+
+type PermitTransactionHistoryQuery struct {
+	TransactionHistory PermitHistoryQueryInner `json:"transaction_history"`
+}
+
+type PermitHistoryQueryInner struct {
+	Page               uint32 `json:"page"`
+	PageSize           uint32 `json:"page_size"`
+	ShouldFilterDecoys bool   `json:"should_filter_decoys"`
+}
+
+func S20SignPermitCmd() *cobra.Command {
+	return signPermitCmd([]string{"balance", "history"})
+}
+
+// signPermitCmd signs permits for the tokens of a standard, with the permissions the queries of its commands need
+func signPermitCmd(defaultPermissions []string) *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "sign-permit [permit name] [contract address or label]...",
+		Short: "Sign a query permit for tokens with a key of the keyring",
+		Long: `Sign a SNIP-24 query permit for tokens with the key of --from, and print it. The permit can be passed to
+the *-with-permit queries with --permit, or to any other client, to query without a viewing key. The signature never
+goes on chain, and the permit is revoked with a revoke_permit tx to each token.`,
+		Args: cobra.MinimumNArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			var tokens []sdk.AccAddress
+			for _, token := range args[1:] {
+				contractAddr, err := addressFromBechOrLabel(token, cliCtx)
+				if err != nil {
+					return err
+				}
+				tokens = append(tokens, contractAddr)
+			}
+
+			permissions, _ := cmd.Flags().GetStringSlice(flagPermissions)
+			permit, err := signPermit(cmd, cliCtx, args[0], tokens, permissions)
+			if err != nil {
+				return err
+			}
+
+			jsonBz, err := json.MarshalIndent(permit, "", "    ")
+			if err != nil {
+				return err
+			}
+			return cliCtx.PrintString(string(jsonBz) + "\n")
+		},
+	}
+
+	addPermitSigningFlags(cmd)
+	cmd.Flags().StringSlice(flagPermissions, defaultPermissions, "Permissions granted by the permit (allowance|balance|history|owner)")
+	_ = cmd.MarkFlagRequired(flags.FlagFrom)
+
+	return cmd
+}
+
+func S20PermitBalanceCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "balance-with-permit [contract address or label]",
+		Short: "See your current balance for a token, using a query permit",
+		Long: `See your current balance for a token, using the query permit of --permit, or one signed on the fly with the
+key of --from. No viewing key is needed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return queryWithPermit(cmd, args[0], "balance", PermitBalanceQuery{})
+		},
+	}
+
+	addPermitQueryFlags(cmd)
+
+	return cmd
+}
+
+func S20PermitTransferHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfers-with-permit [contract address or label] [optional: page, default: 0] [optional: page_size, default: 10] [optional: should_filter_decoys, default: false]",
+		Short: "View your transfer history, using a query permit",
+		Long: `Print out transfer you have been a part of - either as a sender or recipient, using the query permit of
+--permit, or one signed on the fly with the key of --from`,
+		Args: cobra.RangeArgs(1, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inner, err := parsePermitHistoryArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			return queryWithPermit(cmd, args[0], "history", PermitTransferHistoryQuery{TransferHistory: inner})
+		},
+	}
+
+	addPermitQueryFlags(cmd)
+
+	return cmd
+}
+
+func S20PermitTransactionHistoryCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "txs-with-permit [contract address or label] [optional: page, default: 0] [optional: page_size, default: 10] [optional: should_filter_decoys, default: false]",
+		Short: "View your full transaction history, using a query permit",
+		Long: `Print out transactions you have been a part of - either as a sender or recipient, using the query permit of
+--permit, or one signed on the fly with the key of --from`,
+		Args: cobra.RangeArgs(1, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			inner, err := parsePermitHistoryArgs(args[1:])
+			if err != nil {
+				return err
+			}
+			return queryWithPermit(cmd, args[0], "history", PermitTransactionHistoryQuery{TransactionHistory: inner})
+		},
+	}
+
+	addPermitQueryFlags(cmd)
+
+	return cmd
+}
+
+func addPermitSigningFlags(cmd *cobra.Command) {
+	cmd.Flags().String(flags.FlagFrom, "", "Name or address of the key to sign the permit with")
+	cmd.Flags().String(flagPermitName, defaultPermitName, "Name of the permit, which is what revoke_permit revokes")
+	flags.AddKeyringFlags(cmd.Flags())
+}
+
+func addPermitQueryFlags(cmd *cobra.Command) {
+	addPermitSigningFlags(cmd)
+	cmd.Flags().String(flagPermit, "", "File of a permit signed with sign-permit. If not set, a permit is signed with the key of --from")
+}
+
+func parsePermitHistoryArgs(args []string) (PermitHistoryQueryInner, error) {
+	inner := PermitHistoryQueryInner{PageSize: 10}
+
+	if len(args) >= 1 {
+		page, err := strconv.ParseUint(args[0], 10, 32)
+		if err != nil {
+			return inner, err
+		}
+		inner.Page = uint32(page)
+	}
+
+	if len(args) >= 2 {
+		pageSize, err := strconv.ParseUint(args[1], 10, 32)
+		if err != nil {
+			return inner, err
+		}
+		inner.PageSize = uint32(pageSize)
+	}
+
+	if len(args) >= 3 {
+		shouldFilterDecoys, err := strconv.ParseBool(args[2])
+		if err != nil {
+			return inner, err
+		}
+		inner.ShouldFilterDecoys = shouldFilterDecoys
+	}
+
+	return inner, nil
+}
+
+// queryWithPermit queries the contract with the permit of --permit, or with one signed for the query with the key of
+// --from, which needs the permission
+func queryWithPermit(cmd *cobra.Command, contract string, permission string, query interface{}) error {
+	cliCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	contractAddr, err := addressFromBechOrLabel(contract, cliCtx)
+	if err != nil {
+		return err
+	}
+
+	var permit QueryPermit
+	permitFile, _ := cmd.Flags().GetString(flagPermit)
+	if permitFile != "" {
+		permitBz, err := os.ReadFile(permitFile)
+		if err != nil {
+			return err
+		}
+		if err := json.Unmarshal(permitBz, &permit); err != nil {
+			return fmt.Errorf("failed to parse permit file: %w", err)
+		}
+	} else {
+		from, _ := cmd.Flags().GetString(flags.FlagFrom)
+		if from == "" {
+			return errors.New("either --permit or --from must be set")
+		}
+		permitName, _ := cmd.Flags().GetString(flagPermitName)
+		permit, err = signPermit(cmd, cliCtx, permitName, []sdk.AccAddress{contractAddr}, []string{permission})
+		if err != nil {
+			return err
+		}
+	}
+
+	queryData, err := queryWithPermitMsg(permit, query)
+	if err != nil {
+		return err
+	}
+
+	return cli.QueryWithData(contractAddr, queryData, cliCtx)
+}
+
+// signPermit signs a permit with the key of --from. Permits are signed as an amino sign doc, so they can be signed by
+// ledgers and browser wallets
+func signPermit(cmd *cobra.Command, cliCtx client.Context, permitName string, tokens []sdk.AccAddress, permissions []string) (QueryPermit, error) {
+	if cliCtx.Keyring == nil {
+		return QueryPermit{}, errors.New("no keyring is configured")
+	}
+	if cliCtx.ChainID == "" {
+		return QueryPermit{}, errors.New("--chain-id must be set")
+	}
+	if permitName == "" {
+		return QueryPermit{}, errors.New("permit name must not be empty")
+	}
+
+	from, _ := cmd.Flags().GetString(flags.FlagFrom)
+	_, fromName, _, err := client.GetFromFields(cliCtx, cliCtx.Keyring, from)
+	if err != nil {
+		return QueryPermit{}, err
+	}
+
+	params := PermitParams{
+		PermitName:  permitName,
+		ChainID:     cliCtx.ChainID,
+		Permissions: permissions,
+	}
+	for _, token := range tokens {
+		params.AllowedTokens = append(params.AllowedTokens, token.String())
+	}
+
+	signDoc, err := permitSignDoc(params)
+	if err != nil {
+		return QueryPermit{}, err
+	}
+
+	signature, pubKey, err := cliCtx.Keyring.Sign(fromName, signDoc, signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return QueryPermit{}, err
+	}
+
+	return QueryPermit{
+		Params: params,
+		Signature: PermitSignature{
+			PubKey:    PermitPubKey{Type: "tendermint/PubKeySecp256k1", Value: pubKey.Bytes()},
+			Signature: signature,
+		},
+	}, nil
+}
+
+// permitSignDoc is the amino sign doc of a permit, which contracts verify the signature of the permit against
+func permitSignDoc(params PermitParams) ([]byte, error) {
+	type coin struct {
+		Amount string `json:"amount"`
+		Denom  string `json:"denom"`
+	}
+	type fee struct {
+		Amount []coin `json:"amount"`
+		Gas    string `json:"gas"`
+	}
+	type permitMsgValue struct {
+		AllowedTokens []string `json:"allowed_tokens"`
+		PermitName    string   `json:"permit_name"`
+		Permissions   []string `json:"permissions"`
+	}
+	type permitMsg struct {
+		Type  string         `json:"type"`
+		Value permitMsgValue `json:"value"`
+	}
+	signDoc := struct {
+		AccountNumber string      `json:"account_number"`
+		ChainID       string      `json:"chain_id"`
+		Fee           fee         `json:"fee"`
+		Memo          string      `json:"memo"`
+		Msgs          []permitMsg `json:"msgs"`
+		Sequence      string      `json:"sequence"`
+	}{
+		AccountNumber: "0",
+		ChainID:       params.ChainID,
+		Fee:           fee{Amount: []coin{{Amount: "0", Denom: "uscrt"}}, Gas: "1"},
+		Msgs: []permitMsg{{
+			Type: "query_permit",
+			Value: permitMsgValue{
+				AllowedTokens: params.AllowedTokens,
+				PermitName:    params.PermitName,
+				Permissions:   params.Permissions,
+			},
+		}},
+		Sequence: "0",
+	}
+
+	jsonBz, err := json.Marshal(signDoc)
+	if err != nil {
+		return nil, err
+	}
+	return sdk.SortJSON(jsonBz)
+}
+
+func queryWithPermitMsg(permit QueryPermit, query interface{}) ([]byte, error) {
+	msg := WithPermitMsg{
+		WithPermit: WithPermitMsgInner{
+			Permit: permit,
+			Query:  query,
+		},
+	}
+	jsonMsg, err := json.Marshal(&msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
@@ -0,0 +1,860 @@
+package main
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/client/cli"
+	"github.com/spf13/cobra"
+)
+
+const (
+	flagViewer          = "viewer"
+	flagViewingKey      = "viewing-key"
+	flagIncludeExpired  = "include-expired"
+	flagStartAfter      = "start-after"
+	flagLimit           = "limit"
+	flagOwner           = "owner"
+	flagPublicMetadata  = "public-metadata"
+	flagPrivateMetadata = "private-metadata"
+	flagMemo            = "memo"
+	flagExpiresAtHeight = "expires-at-height"
+	flagExpiresAtTime   = "expires-at-time"
+)
+
+// S721GetQueryCmd returns the cli query commands for snip721 contracts
+func S721GetQueryCmd() *cobra.Command {
+	s721QueryCmd := &cobra.Command{
+		Use:                        "snip721",
+		Short:                      "Querying commands for the snip721 (NFT) contracts",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	s721QueryCmd.AddCommand(
+		S721OwnerOfCmd(),
+		S721NftInfoCmd(),
+		S721PrivateMetadataCmd(),
+		S721TokensCmd(),
+		S721SignPermitCmd(),
+	)
+
+	return s721QueryCmd
+}
+
+// S721GetTxCmd returns the transaction commands for snip721 contracts
+func S721GetTxCmd() *cobra.Command {
+	s721TxCmd := &cobra.Command{
+		Use:                        "snip721",
+		Short:                      "Snip721 (NFT) transactions subcommands",
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	s721TxCmd.AddCommand(
+		s721MintCmd(),
+		s721TransferCmd(),
+		s721SendCmd(),
+		s721ApproveCmd(),
+		s721RevokeCmd(),
+		s20CreatingViewingKey(),
+		s20SetViewingKey(),
+		s721BatchMintCmd(),
+		s721BatchTransferCmd(),
+		s721BatchSendCmd(),
+	)
+
+	return s721TxCmd
+}
+
+func S721SignPermitCmd() *cobra.Command {
+	return signPermitCmd([]string{"owner"})
+}
+
+func S721OwnerOfCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "owner-of [contract address or label] [token_id]",
+		Short: "See the owner of a token and the addresses approved to transfer it",
+		Long: `See the owner of a token and the addresses approved to transfer it. Tokens with private ownership need the
+owner's permission, given with --viewer and --viewing-key, or a query permit with --permit or --from`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			includeExpired, _ := cmd.Flags().GetBool(flagIncludeExpired)
+			inner := OwnerOfMsgInner{TokenID: args[1], IncludeExpired: includeExpired}
+
+			return s721QueryWithViewer(cmd, args[0], nil, func(viewer *Snip721Viewer) interface{} {
+				inner.Viewer = viewer
+				return OwnerOfMsg{OwnerOf: inner}
+			})
+		},
+	}
+
+	addS721ViewerFlags(cmd)
+	cmd.Flags().Bool(flagIncludeExpired, false, "Include expired approvals")
+
+	return cmd
+}
+
+func S721NftInfoCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "nft-info [contract address or label] [token_id]",
+		Short: "See the public metadata of a token",
+		Long:  `See the public metadata of a token`,
+		Args:  cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			queryData, err := snip721Msg(NftInfoMsg{NftInfo: NftInfoMsgInner{TokenID: args[1]}})
+			if err != nil {
+				return err
+			}
+
+			return cli.QueryWithData(contractAddr, queryData, cliCtx)
+		},
+	}
+
+	return cmd
+}
+
+func S721PrivateMetadataCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "private-metadata [contract address or label] [token_id]",
+		Short: "See the private metadata of a token",
+		Long: `See the private metadata of a token. This needs the owner's permission, given with --viewer and
+--viewing-key, or a query permit with --permit or --from`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			return s721QueryWithViewer(cmd, args[0], nil, func(viewer *Snip721Viewer) interface{} {
+				return PrivateMetadataMsg{PrivateMetadata: PrivateMetadataMsgInner{TokenID: args[1], Viewer: viewer}}
+			})
+		},
+	}
+
+	addS721ViewerFlags(cmd)
+
+	return cmd
+}
+
+func S721TokensCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "tokens [contract address or label] [owner]",
+		Short: "List the tokens of an owner",
+		Long: `List the tokens of an owner. Unless the owner made them public, this needs the owner's permission, given
+with --viewing-key, and --viewer if the key isn't the owner's, or a query permit with --permit or --from`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			owner, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return err
+			}
+
+			inner := TokensMsgInner{Owner: owner}
+			inner.StartAfter, _ = cmd.Flags().GetString(flagStartAfter)
+			if cmd.Flags().Changed(flagLimit) {
+				limit, _ := cmd.Flags().GetUint32(flagLimit)
+				inner.Limit = &limit
+			}
+
+			return s721QueryWithViewer(cmd, args[0], owner, func(viewer *Snip721Viewer) interface{} {
+				if viewer != nil {
+					inner.ViewingKey = viewer.ViewingKey
+					if !viewer.Address.Equals(owner) {
+						inner.Viewer = viewer.Address
+					}
+				}
+				return TokensMsg{Tokens: inner}
+			})
+		},
+	}
+
+	addS721ViewerFlags(cmd)
+	cmd.Flags().String(flagStartAfter, "", "List the tokens after this token id")
+	cmd.Flags().Uint32(flagLimit, 30, "Maximum number of tokens to list")
+
+	return cmd
+}
+
+func addS721ViewerFlags(cmd *cobra.Command) {
+	addPermitQueryFlags(cmd)
+	cmd.Flags().String(flagViewer, "", "Address of the viewing key, which defaults to the owner's for the tokens query")
+	cmd.Flags().String(flagViewingKey, "", "Viewing key to query with")
+}
+
+// s721QueryWithViewer queries the contract with the permit of --permit or --from if one is set, and otherwise with
+// the viewing key of --viewing-key, if set. The viewing key is of --viewer, or of defaultViewer if it's not set
+func s721QueryWithViewer(cmd *cobra.Command, contract string, defaultViewer sdk.AccAddress, query func(viewer *Snip721Viewer) interface{}) error {
+	permitFile, _ := cmd.Flags().GetString(flagPermit)
+	from, _ := cmd.Flags().GetString(flags.FlagFrom)
+	if permitFile != "" || from != "" {
+		return queryWithPermit(cmd, contract, "owner", query(nil))
+	}
+
+	cliCtx, err := client.GetClientQueryContext(cmd)
+	if err != nil {
+		return err
+	}
+
+	contractAddr, err := addressFromBechOrLabel(contract, cliCtx)
+	if err != nil {
+		return err
+	}
+
+	var viewer *Snip721Viewer
+	key, _ := cmd.Flags().GetString(flagViewingKey)
+	if key != "" {
+		viewer = &Snip721Viewer{ViewingKey: key}
+		viewerAddr, _ := cmd.Flags().GetString(flagViewer)
+		if viewerAddr != "" {
+			viewer.Address, err = sdk.AccAddressFromBech32(viewerAddr)
+			if err != nil {
+				return err
+			}
+		} else if !defaultViewer.Empty() {
+			viewer.Address = defaultViewer
+		} else {
+			return errors.New("--viewer must be set with --viewing-key")
+		}
+	}
+
+	queryData, err := snip721Msg(query(viewer))
+	if err != nil {
+		return err
+	}
+
+	return cli.QueryWithData(contractAddr, queryData, cliCtx)
+}
+
+func s721MintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "mint [contract address or label] [optional: token_id]",
+		Short: "Mint a new token",
+		Long: `Mint a new token. Only minters of the contract can mint. The token id is generated by the contract if it's
+not set, and the token is owned by the minter unless --owner is set. The metadata flags take the JSON of a snip721
+metadata, e.g. '{"token_uri":"https://..."}' or '{"extension":{"name":"..."}}'`,
+		Args: cobra.RangeArgs(1, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			mint := Snip721Mint{}
+			if len(args) > 1 {
+				mint.TokenID = args[1]
+			}
+
+			owner, _ := cmd.Flags().GetString(flagOwner)
+			if owner != "" {
+				mint.Owner, err = sdk.AccAddressFromBech32(owner)
+				if err != nil {
+					return errors.New("invalid owner address")
+				}
+			}
+
+			mint.PublicMetadata, err = metadataFlag(cmd, flagPublicMetadata)
+			if err != nil {
+				return err
+			}
+			mint.PrivateMetadata, err = metadataFlag(cmd, flagPrivateMetadata)
+			if err != nil {
+				return err
+			}
+			mint.Memo, _ = cmd.Flags().GetString(flagMemo)
+
+			msg, err := snip721Msg(MintNftMsg{MintNft: mint})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagOwner, "", "Owner of the new token")
+	cmd.Flags().String(flagPublicMetadata, "", "JSON of the public metadata of the token")
+	cmd.Flags().String(flagPrivateMetadata, "", "JSON of the private metadata of the token")
+	cmd.Flags().String(flagMemo, "", "Memo of the mint, visible to the owner")
+
+	return cmd
+}
+
+func s721TransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "transfer [contract address or label] [to account] [token_id]",
+		Short: "Transfer a token to another address",
+		Long:  `Transfer a token to another address. The sender must own the token or be approved to transfer it`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			memo, _ := cmd.Flags().GetString(flagMemo)
+			msg, err := snip721Msg(TransferNftMsg{TransferNft: TransferNftMsgInner{Recipient: toAddr, TokenID: args[2], Memo: memo}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagMemo, "", "Memo of the transfer, visible to the sender and the recipient")
+
+	return cmd
+}
+
+func s721SendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "send [contract address or label] [to contract] [token_id] [optional: callback_message]",
+		Short: "Send a token to a contract. Optionally add a callback message",
+		Long: `Send a token to a contract, which is notified of it if it registered to receive tokens. If 'to contract' is
+notified, you can optionally add a callback message to it`,
+		Args: cobra.RangeArgs(3, 4),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			toAddr, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid recipient address")
+			}
+
+			inner := SendNftMsgInner{Contract: toAddr, TokenID: args[2]}
+			if len(args) > 3 {
+				inner.Msg = args[3]
+			}
+			inner.Memo, _ = cmd.Flags().GetString(flagMemo)
+
+			msg, err := snip721Msg(SendNftMsg{SendNft: inner})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().String(flagMemo, "", "Memo of the transfer, visible to the sender and the recipient")
+
+	return cmd
+}
+
+func s721ApproveCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "approve [contract address or label] [spender] [token_id]",
+		Short: "Allow another address to transfer a token",
+		Long: `Allow another address to transfer a token. The approval never expires, unless --expires-at-height or
+--expires-at-time, in seconds since the epoch, is set`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid spender address")
+			}
+
+			expires, err := expirationFlags(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg, err := snip721Msg(ApproveMsg{Approve: ApproveMsgInner{Spender: spender, TokenID: args[2], Expires: expires}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	cmd.Flags().Uint64(flagExpiresAtHeight, 0, "Block height the approval expires at")
+	cmd.Flags().Uint64(flagExpiresAtTime, 0, "Time the approval expires at, in seconds since the epoch")
+	cmd.MarkFlagsMutuallyExclusive(flagExpiresAtHeight, flagExpiresAtTime)
+
+	return cmd
+}
+
+func s721RevokeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "revoke [contract address or label] [spender] [token_id]",
+		Short: "Revoke the approval of another address to transfer a token",
+		Long:  `Revoke the approval of another address to transfer a token`,
+		Args:  cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			spender, err := sdk.AccAddressFromBech32(args[1])
+			if err != nil {
+				return errors.New("invalid spender address")
+			}
+
+			msg, err := snip721Msg(RevokeMsg{Revoke: RevokeMsgInner{Spender: spender, TokenID: args[2]}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s721BatchMintCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-mint [contract address or label] [file]",
+		Short: "Mint many tokens in a single tx",
+		Long: `Mint many tokens in a single tx. The file is a JSON array of
+{"token_id": ..., "owner": ..., "public_metadata": {...}, "private_metadata": {...}, "memo": ...} objects, where
+every field is optional`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			data, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var mints []Snip721Mint
+			if err := json.Unmarshal(data, &mints); err != nil {
+				return fmt.Errorf("failed to parse batch file: %w", err)
+			}
+			if len(mints) == 0 {
+				return errors.New("batch file has no actions")
+			}
+
+			msg, err := snip721Msg(BatchMintNftMsg{BatchMintNft: BatchMintNftMsgInner{Mints: mints}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s721BatchTransferCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-transfer [contract address or label] [file]",
+		Short: "Transfer many tokens in a single tx",
+		Long: `Transfer many tokens in a single tx. The file is either a JSON array of
+{"recipient": ..., "token_ids": [...], "memo": ...} objects, or a CSV file with the columns recipient,token_id[,memo].
+The CSV file may start with a header row naming its columns`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			transfers, err := readNftTransfers(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := snip721Msg(BatchTransferNftMsg{BatchTransferNft: BatchTransferNftMsgInner{Transfers: transfers}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func s721BatchSendCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "batch-send [contract address or label] [file]",
+		Short: "Send many tokens to contracts in a single tx",
+		Long: `Send many tokens to contracts in a single tx. The file is either a JSON array of
+{"contract": ..., "token_ids": [...], "msg": ..., "memo": ...} objects, or a CSV file with the columns
+contract,token_id[,msg[,memo]]. The CSV file may start with a header row naming its columns`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			cliCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			contractAddr, err := addressFromBechOrLabel(args[0], cliCtx)
+			if err != nil {
+				return err
+			}
+
+			sends, err := readNftSends(args[1])
+			if err != nil {
+				return err
+			}
+
+			msg, err := snip721Msg(BatchSendNftMsg{BatchSendNft: BatchSendNftMsgInner{Sends: sends}})
+			if err != nil {
+				return err
+			}
+
+			return cli.ExecuteWithData(cmd, contractAddr, msg, "", false, "", "", cliCtx)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+// readNftTransfers reads the transfers of a batch from a JSON or CSV file, where each row of a CSV file transfers
+// one token
+func readNftTransfers(path string) ([]NftTransfer, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var transfers []NftTransfer
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &transfers); err != nil {
+			return nil, fmt.Errorf("failed to parse batch file: %w", err)
+		}
+	} else {
+		rows, err := readCSVRows(data, []string{"recipient", "token_id", "memo"})
+		if err != nil {
+			return nil, err
+		}
+		transfers = make([]NftTransfer, len(rows))
+		for i, row := range rows {
+			transfers[i].Recipient, err = nftBatchAddress(row["recipient"])
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid recipient address", i+1)
+			}
+			transfers[i].TokenIDs = nftBatchTokenIDs(row["token_id"])
+			transfers[i].Memo = row["memo"]
+		}
+	}
+
+	if len(transfers) == 0 {
+		return nil, errors.New("batch file has no actions")
+	}
+	for i, transfer := range transfers {
+		if transfer.Recipient.Empty() {
+			return nil, fmt.Errorf("action %d: missing recipient", i)
+		}
+		if len(transfer.TokenIDs) == 0 {
+			return nil, fmt.Errorf("action %d: missing token ids", i)
+		}
+	}
+
+	return transfers, nil
+}
+
+// readNftSends reads the sends of a batch from a JSON or CSV file, where each row of a CSV file sends one token
+func readNftSends(path string) ([]NftSend, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var sends []NftSend
+	if trimmed := bytes.TrimSpace(data); len(trimmed) > 0 && trimmed[0] == '[' {
+		if err := json.Unmarshal(trimmed, &sends); err != nil {
+			return nil, fmt.Errorf("failed to parse batch file: %w", err)
+		}
+	} else {
+		rows, err := readCSVRows(data, []string{"contract", "token_id", "msg", "memo"})
+		if err != nil {
+			return nil, err
+		}
+		sends = make([]NftSend, len(rows))
+		for i, row := range rows {
+			sends[i].Contract, err = nftBatchAddress(row["contract"])
+			if err != nil {
+				return nil, fmt.Errorf("row %d: invalid contract address", i+1)
+			}
+			sends[i].TokenIDs = nftBatchTokenIDs(row["token_id"])
+			sends[i].Msg = row["msg"]
+			sends[i].Memo = row["memo"]
+		}
+	}
+
+	if len(sends) == 0 {
+		return nil, errors.New("batch file has no actions")
+	}
+	for i, send := range sends {
+		if send.Contract.Empty() {
+			return nil, fmt.Errorf("action %d: missing contract", i)
+		}
+		if len(send.TokenIDs) == 0 {
+			return nil, fmt.Errorf("action %d: missing token ids", i)
+		}
+	}
+
+	return sends, nil
+}
+
+func nftBatchAddress(address string) (sdk.AccAddress, error) {
+	if address == "" {
+		return nil, nil
+	}
+	return sdk.AccAddressFromBech32(address)
+}
+
+func nftBatchTokenIDs(tokenID string) []string {
+	if tokenID == "" {
+		return nil
+	}
+	return []string{tokenID}
+}
+
+func metadataFlag(cmd *cobra.Command, flag string) (json.RawMessage, error) {
+	metadata, _ := cmd.Flags().GetString(flag)
+	if metadata == "" {
+		return nil, nil
+	}
+	if !json.Valid([]byte(metadata)) {
+		return nil, fmt.Errorf("--%s must be JSON", flag)
+	}
+	return json.RawMessage(metadata), nil
+}
+
+// expirationFlags returns the snip721 expiration of the expiration flags, or nil if neither is set
+func expirationFlags(cmd *cobra.Command) (*Snip721Expiration, error) {
+	switch {
+	case cmd.Flags().Changed(flagExpiresAtHeight):
+		height, err := cmd.Flags().GetUint64(flagExpiresAtHeight)
+		return &Snip721Expiration{AtHeight: &height}, err
+	case cmd.Flags().Changed(flagExpiresAtTime):
+		at, err := cmd.Flags().GetUint64(flagExpiresAtTime)
+		return &Snip721Expiration{AtTime: &at}, err
+	default:
+		return nil, nil
+	}
+}
+
+type Snip721Viewer struct {
+	Address    sdk.AccAddress `json:"address"`
+	ViewingKey string         `json:"viewing_key"`
+}
+
+type Snip721Expiration struct {
+	AtHeight *uint64 `json:"at_height,omitempty"`
+	AtTime   *uint64 `json:"at_time,omitempty"`
+}
+
+type OwnerOfMsg struct {
+	OwnerOf OwnerOfMsgInner `json:"owner_of"`
+}
+
+type OwnerOfMsgInner struct {
+	TokenID        string         `json:"token_id"`
+	Viewer         *Snip721Viewer `json:"viewer,omitempty"`
+	IncludeExpired bool           `json:"include_expired,omitempty"`
+}
+
+type NftInfoMsg struct {
+	NftInfo NftInfoMsgInner `json:"nft_info"`
+}
+
+type NftInfoMsgInner struct {
+	TokenID string `json:"token_id"`
+}
+
+type PrivateMetadataMsg struct {
+	PrivateMetadata PrivateMetadataMsgInner `json:"private_metadata"`
+}
+
+type PrivateMetadataMsgInner struct {
+	TokenID string         `json:"token_id"`
+	Viewer  *Snip721Viewer `json:"viewer,omitempty"`
+}
+
+type TokensMsg struct {
+	Tokens TokensMsgInner `json:"tokens"`
+}
+
+type TokensMsgInner struct {
+	Owner      sdk.AccAddress `json:"owner"`
+	Viewer     sdk.AccAddress `json:"viewer,omitempty"`
+	ViewingKey string         `json:"viewing_key,omitempty"`
+	StartAfter string         `json:"start_after,omitempty"`
+	Limit      *uint32        `json:"limit,omitempty"`
+}
+
+type MintNftMsg struct {
+	MintNft Snip721Mint `json:"mint_nft"`
+}
+
+type Snip721Mint struct {
+	TokenID         string          `json:"token_id,omitempty"`
+	Owner           sdk.AccAddress  `json:"owner,omitempty"`
+	PublicMetadata  json.RawMessage `json:"public_metadata,omitempty"`
+	PrivateMetadata json.RawMessage `json:"private_metadata,omitempty"`
+	Memo            string          `json:"memo,omitempty"`
+}
+
+type BatchMintNftMsg struct {
+	BatchMintNft BatchMintNftMsgInner `json:"batch_mint_nft"`
+}
+
+type BatchMintNftMsgInner struct {
+	Mints []Snip721Mint `json:"mints"`
+}
+
+type TransferNftMsg struct {
+	TransferNft TransferNftMsgInner `json:"transfer_nft"`
+}
+
+type TransferNftMsgInner struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	TokenID   string         `json:"token_id"`
+	Memo      string         `json:"memo,omitempty"`
+}
+
+type SendNftMsg struct {
+	SendNft SendNftMsgInner `json:"send_nft"`
+}
+
+type SendNftMsgInner struct {
+	Contract sdk.AccAddress `json:"contract"`
+	TokenID  string         `json:"token_id"`
+	Msg      string         `json:"msg,omitempty"`
+	Memo     string         `json:"memo,omitempty"`
+}
+
+type ApproveMsg struct {
+	Approve ApproveMsgInner `json:"approve"`
+}
+
+type ApproveMsgInner struct {
+	Spender sdk.AccAddress     `json:"spender"`
+	TokenID string             `json:"token_id"`
+	Expires *Snip721Expiration `json:"expires,omitempty"`
+}
+
+type RevokeMsg struct {
+	Revoke RevokeMsgInner `json:"revoke"`
+}
+
+type RevokeMsgInner struct {
+	Spender sdk.AccAddress `json:"spender"`
+	TokenID string         `json:"token_id"`
+}
+
+type BatchTransferNftMsg struct {
+	BatchTransferNft BatchTransferNftMsgInner `json:"batch_transfer_nft"`
+}
+
+type BatchTransferNftMsgInner struct {
+	Transfers []NftTransfer `json:"transfers"`
+}
+
+type NftTransfer struct {
+	Recipient sdk.AccAddress `json:"recipient"`
+	TokenIDs  []string       `json:"token_ids"`
+	Memo      string         `json:"memo,omitempty"`
+}
+
+type BatchSendNftMsg struct {
+	BatchSendNft BatchSendNftMsgInner `json:"batch_send_nft"`
+}
+
+type BatchSendNftMsgInner struct {
+	Sends []NftSend `json:"sends"`
+}
+
+type NftSend struct {
+	Contract sdk.AccAddress `json:"contract"`
+	TokenIDs []string       `json:"token_ids"`
+	Msg      string         `json:"msg,omitempty"`
+	Memo     string         `json:"memo,omitempty"`
+}
+
+// snip721Msg marshals a snip721 message, padded like the snip20 ones so its length doesn't reveal its content
+func snip721Msg(msg interface{}) ([]byte, error) {
+	jsonMsg, err := json.Marshal(msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return []byte(spacePad(MessageBlockSize, string(jsonMsg))), nil
+}
@@ -8,6 +8,10 @@ pub mod wasm_messages;
 
 pub use wasm_messages::VERIFIED_BLOCK_MESSAGES;
 
+pub mod scheduled_txs;
+
+pub use scheduled_txs::SCHEDULED_TXS;
+
 mod txs;
 
 #[cfg(any(feature = "verify-validator-whitelist", feature = "test"))]
@@ -52,6 +56,8 @@ pub mod tests {
             crate::wasm_messages::tests::check_parse_reg_from_tx();
             crate::wasm_messages::tests::test_wasm_msg_tracker();
             crate::wasm_messages::tests::test_mix_wasm_bank_msg_tracker_multiple_msgs();
+            crate::scheduled_txs::tests::test_consume_scheduled_txs();
+            crate::scheduled_txs::tests::test_is_scheduled_tx();
             crate::validator_whitelist::tests::test_parse_validators();
         });
 
@@ -0,0 +1,85 @@
+use cosmos_proto::tx::tx::Tx;
+use enclave_crypto::sha_256;
+use lazy_static::lazy_static;
+
+use std::sync::SgxMutex;
+
+const HASH_LEN: usize = 32;
+
+/// The hashes of the scheduled txs of the verified block, see ScheduledTxKey in x/cron.
+/// Scheduled txs are the only unsigned txs: every node builds them from its own state, and validators only
+/// accept proposals whose unsigned txs are the ones they built, see ScheduledTxProposalHandler in x/cron.
+/// So the unsigned txs of a block signed by the validator set are its scheduled txs, and these are the only
+/// unsigned txs that are accepted, each of them once.
+#[derive(Debug, Clone, Default)]
+pub struct ScheduledTxs {
+    hashes: Vec<[u8; HASH_LEN]>,
+}
+
+impl ScheduledTxs {
+    pub fn set(&mut self, hashes: Vec<[u8; HASH_LEN]>) {
+        self.hashes = hashes;
+    }
+
+    /// Removes tx_bytes from the scheduled txs. Returns whether it was one of them
+    pub fn consume(&mut self, tx_bytes: &[u8]) -> bool {
+        let hash = sha_256(tx_bytes);
+        match self.hashes.iter().position(|scheduled| scheduled == &hash) {
+            Some(index) => {
+                self.hashes.swap_remove(index);
+                true
+            }
+            None => false,
+        }
+    }
+
+    pub fn remaining(&self) -> usize {
+        self.hashes.len()
+    }
+}
+
+/// Returns whether a tx of the verified block is a scheduled tx, i.e. whether it's unsigned
+pub fn is_scheduled_tx(tx: &Tx) -> bool {
+    tx.signatures.is_empty() && tx.get_auth_info().signer_infos.is_empty()
+}
+
+lazy_static! {
+    pub static ref SCHEDULED_TXS: SgxMutex<ScheduledTxs> = SgxMutex::new(ScheduledTxs::default());
+}
+
+#[cfg(feature = "test")]
+pub mod tests {
+    use super::{is_scheduled_tx, ScheduledTxs};
+    use cosmos_proto::tx::tx::{AuthInfo, SignerInfo, Tx};
+    use enclave_crypto::sha_256;
+
+    pub fn test_consume_scheduled_txs() {
+        let mut scheduled = ScheduledTxs::default();
+        scheduled.set(vec![sha_256(b"tx1"), sha_256(b"tx2")]);
+
+        assert!(!scheduled.consume(b"tx3"));
+        assert!(scheduled.consume(b"tx1"));
+        // each scheduled tx is accepted once
+        assert!(!scheduled.consume(b"tx1"));
+        assert_eq!(scheduled.remaining(), 1);
+
+        // the scheduled txs of the previous block are replaced
+        scheduled.set(vec![sha_256(b"tx3")]);
+        assert!(!scheduled.consume(b"tx2"));
+        assert!(scheduled.consume(b"tx3"));
+    }
+
+    pub fn test_is_scheduled_tx() {
+        let mut tx = Tx::new();
+        assert!(is_scheduled_tx(&tx));
+
+        let mut signed = tx.clone();
+        signed.signatures.push(vec![1u8; 64]);
+        assert!(!is_scheduled_tx(&signed));
+
+        let mut auth_info = AuthInfo::new();
+        auth_info.signer_infos.push(SignerInfo::new());
+        tx.set_auth_info(auth_info);
+        assert!(!is_scheduled_tx(&tx));
+    }
+}
@@ -1,3 +1,4 @@
+use enclave_crypto::sha_256;
 use enclave_utils::{validate_const_ptr, validate_input_length, validate_mut_ptr, KEY_MANAGER};
 use log::debug;
 use log::error;
@@ -13,6 +14,7 @@ macro_rules! unwrap_or_return {
     };
 }
 
+use crate::scheduled_txs::{is_scheduled_tx, SCHEDULED_TXS};
 use crate::txs::tx_from_bytes;
 use crate::wasm_messages::VERIFIED_BLOCK_MESSAGES;
 
@@ -107,6 +109,7 @@ pub unsafe fn submit_block_signatures_impl(
         message_verifier.clear();
     }
 
+    let mut scheduled_txs = vec![];
     for tx in txs.iter() {
         // doing this a different way makes the code unreadable or requires creating a copy of
 
@@ -115,8 +118,13 @@ pub unsafe fn submit_block_signatures_impl(
             sgx_status_t::SGX_ERROR_INVALID_PARAMETER
         }));
 
+        if is_scheduled_tx(&parsed_tx) {
+            scheduled_txs.push(sha_256(tx.as_slice()));
+        }
         message_verifier.append_msg_from_tx(parsed_tx);
     }
+    debug!("Verified {} scheduled txs", scheduled_txs.len());
+    SCHEDULED_TXS.lock().unwrap().set(scheduled_txs);
 
     message_verifier.set_block_info(
         header.header.height.value(),
@@ -20,8 +20,8 @@ use crate::cosmwasm_config::ContractOperation;
 use crate::contract_validation::verify_block_info;
 
 use crate::contract_validation::{
-    generate_admin_proof, generate_contract_key_proof, validate_admin_proof, ReplyParams,
-    ValidatedMessage,
+    generate_admin_proof, generate_contract_key_proof, get_ibc_hooks_origin, validate_admin_proof,
+    ReplyParams, ValidatedMessage,
 };
 use crate::external::results::{
     HandleSuccess, InitSuccess, MigrateSuccess, QuerySuccess, UpdateAdminSuccess,
@@ -138,6 +138,10 @@ pub fn init(
             (secret_msg, decrypted_msg)
         };
 
+    // Contracts instantiated by ibc-hooks have the zero sender, like the contracts executed by ibc-hooks.
+    // There is no signature to verify, the input is verified against the memo of the received packet.
+    let is_ibc_wasm_hooks_init = is_zero_sender(&canonical_sender_address);
+
     //let start = Instant::now();
     verify_params(
         &parsed_sig_info,
@@ -145,7 +149,7 @@ pub fn init(
         &canonical_sender_address,
         contract_address,
         &secret_msg,
-        true,
+        !is_ibc_wasm_hooks_init,
         true,
         VerifyParamsType::Init,
         Some(&canonical_admin_address),
@@ -194,6 +198,13 @@ pub fn init(
 
     versioned_env.set_contract_hash(&contract_hash);
 
+    // The sender of an ibc-hooks packet cannot be verified, set it to null.
+    // The contract can still tell where the packet came from with the origin in env.
+    if is_ibc_wasm_hooks_init {
+        versioned_env.set_msg_sender("");
+        versioned_env.set_ibc_hooks_origin(get_ibc_hooks_origin(&parsed_sig_info)?);
+    }
+
     #[cfg(feature = "random")]
     set_random_in_env(
         block_height,
@@ -604,7 +615,7 @@ pub fn handle(
     };
 
     // There is no signature to verify when the input isn't signed.
-    // Receiving an unsigned messages is only possible in Handle (Init tx are always signed).
+    // Receiving an unsigned messages is possible in Handle, and in Init only through ibc-hooks.
     // All of these scenarios go through here but the data isn't signed:
     // - Plaintext replies (resulting from an IBC call)
     // - IBC WASM Hooks
@@ -666,6 +677,7 @@ pub fn handle(
         HandleType::HANDLE_TYPE_EXECUTE => {}
         // Reply & IBC stuff: no msg.sender, set it to null just in case
         // WASM Hooks: cannot verify sender, set it to null
+        // Sudo: called by the chain itself, there is no sender
         HandleType::HANDLE_TYPE_REPLY
         | HandleType::HANDLE_TYPE_IBC_CHANNEL_OPEN
         | HandleType::HANDLE_TYPE_IBC_CHANNEL_CONNECT
@@ -675,11 +687,17 @@ pub fn handle(
         | HandleType::HANDLE_TYPE_IBC_PACKET_TIMEOUT
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK
-        | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => {
+        | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT
+        | HandleType::HANDLE_TYPE_SUDO => {
             versioned_env.set_msg_sender("")
         }
     }
 
+    // WASM Hooks: the remote sender is passed separately from msg.sender, as read from the verified packet
+    if parsed_handle_type == HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER {
+        versioned_env.set_ibc_hooks_origin(get_ibc_hooks_origin(&parsed_sig_info)?);
+    }
+
     #[cfg(feature = "random")]
     {
         let contract_key_for_random = base_env.get_latest_contract_key()?;
@@ -904,3 +922,8 @@ fn extract_query_depth(env: &[u8]) -> Result<u32, EnclaveError> {
             env.query_depth
         })
 }
+
+/// The zero sender is the sender of the contract calls made by ibc-hooks, see x/ibc-hooks.
+fn is_zero_sender(sender: &CanonicalAddr) -> bool {
+    sender.as_slice() == [0u8; 20]
+}
@@ -1,5 +1,6 @@
 use cw_types_v1::ibc::IbcPacketReceiveMsg;
 use cw_types_v1::results::REPLY_ENCRYPTION_MAGIC_BYTES;
+use cw_types_v1::types::{Addr, IbcHooksOrigin};
 use log::*;
 
 use cw_types_generic::BaseEnv;
@@ -7,8 +8,8 @@ use cw_types_generic::BaseEnv;
 use cw_types_v010::types::{CanonicalAddr, Coin, HumanAddr};
 use enclave_cosmos_types::traits::CosmosAminoPubkey;
 use enclave_cosmos_types::types::{
-    ContractCode, CosmosPubKey, DirectSdkMsg, HandleType, SigInfo, SignDoc, StdSignDoc, TxBody,
-    VerifyParamsType,
+    ContractCode, CosmosPubKey, DirectSdkMsg, FungibleTokenPacketData, HandleType, Packet, SigInfo,
+    SignDoc, StdSignDoc, TxBody, VerifyParamsType,
 };
 use enclave_crypto::traits::VerifyingKey;
 use enclave_crypto::{sha_256, AESKey, Hmac, Kdf, HASH_SIZE};
@@ -17,6 +18,9 @@ use enclave_utils::{Keychain, KEY_MANAGER};
 use protobuf::Message;
 
 use crate::hardcoded_admins::is_code_hash_allowed;
+use crate::ibc_hooks_calls::{
+    complete_ibc_hooks_call, ibc_hooks_call_index, is_current_ibc_hooks_packet,
+};
 use crate::input_validation::contract_address_validation::verify_contract_address;
 use crate::input_validation::msg_validation::verify_and_get_sdk_msg;
 use crate::input_validation::send_funds_validations::verify_sent_funds;
@@ -26,7 +30,7 @@ use crate::message::is_ibc_msg;
 use crate::types::SecretMessage;
 
 #[cfg(feature = "light-client-validation")]
-use block_verifier::VERIFIED_BLOCK_MESSAGES;
+use block_verifier::{SCHEDULED_TXS, VERIFIED_BLOCK_MESSAGES};
 
 extern crate hex;
 
@@ -37,6 +41,15 @@ pub const CONTRACT_KEY_LENGTH: usize = HASH_SIZE + HASH_SIZE;
 const HEX_ENCODED_HASH_SIZE: usize = HASH_SIZE * 2;
 const SIZE_OF_U64: usize = 8;
 
+/// The name the address of the scheduled tx sender is derived from, like a module account address.
+/// See ScheduledTxSender in x/cron/types
+const SCHEDULED_TX_SENDER_NAME: &[u8] = b"cron_scheduler";
+const ADDRESS_LEN: usize = 20;
+
+/// The prefix the intermediate address of an ibc-hooks sender is derived with.
+/// See SenderPrefix and DeriveIntermediateSender in x/ibc-hooks
+const IBC_HOOKS_SENDER_PREFIX: &[u8] = b"ibc-wasm-hook-intermediary";
+
 #[cfg(feature = "light-client-validation")]
 fn is_subslice(larger: &[u8], smaller: &[u8]) -> bool {
     if smaller.is_empty() {
@@ -180,6 +193,28 @@ pub fn check_cert_in_current_block(cert: &[u8]) -> bool {
     false
 }
 
+#[cfg(feature = "light-client-validation")]
+/// WARNING: this function must be called at most once per message!
+/// Checks if tx_bytes is one of the scheduled txs of the verified block
+fn check_tx_is_scheduled(tx_bytes: &[u8]) -> bool {
+    #[cfg(feature = "go-tests")]
+    {
+        // allow skipping light client validation in go-tests
+        // if the env variable SKIP_LIGHT_CLIENT_VALIDATION is set to TRUE
+        let is_skip_light_client_validation = std::env::var("SKIP_LIGHT_CLIENT_VALIDATION");
+
+        if is_skip_light_client_validation
+            .unwrap_or_default()
+            .to_uppercase()
+            == "TRUE"
+        {
+            return true;
+        }
+    }
+
+    SCHEDULED_TXS.lock().unwrap().consume(tx_bytes)
+}
+
 /// contract_key is a unique key for each contract
 /// it's used in state encryption to prevent the same
 /// encryption keys from being used for different contracts
@@ -604,7 +639,19 @@ pub fn verify_params(
             return verify_callback_sig(callback_sig.as_slice(), sender, secret_msg, sent_funds);
         }
 
-        verify_signature(sig_info, sender)?;
+        if is_scheduled_tx_sender(sender) || is_unsigned_tx(sig_info) {
+            // Scheduled txs are built by the chain from its schedules and have no signature to verify.
+            // They're sent by the scheduled tx sender, or by a sender derived from the owner of the schedule.
+            // They're authenticated by their provenance instead: only the unsigned txs that are part of
+            // the verified block are accepted, which is checked when verifying the input below.
+            if !should_verify_input {
+                warn!("Scheduled txs must be verified against the block");
+                return Err(EnclaveError::FailedTxVerification);
+            }
+            verify_scheduled_tx(sig_info)?;
+        } else {
+            verify_signature(sig_info, sender)?;
+        }
     }
 
     if should_verify_input {
@@ -625,6 +672,110 @@ pub fn verify_params(
     Ok(())
 }
 
+/// Returns the origin of an ibc-hooks call, read from the packet of the MsgRecvPacket that triggered it.
+/// Must only be called once the input was verified by verify_params, which records the packet of the call.
+pub fn get_ibc_hooks_origin(sig_info: &SigInfo) -> Result<IbcHooksOrigin, EnclaveError> {
+    let sdk_messages = get_sdk_messages_from_sign_bytes(sig_info)?;
+
+    let packet = sdk_messages.iter().find_map(|m| match m {
+        DirectSdkMsg::MsgRecvPacket { packet, .. }
+            if is_current_ibc_hooks_packet(sig_info.tx_bytes.as_slice(), packet) =>
+        {
+            Some(packet)
+        }
+        _ => None,
+    });
+
+    match packet {
+        Some(packet) => ibc_hooks_origin(packet),
+        None => {
+            warn!("ibc-hooks origin: no matching MsgRecvPacket");
+            Err(EnclaveError::FailedTxVerification)
+        }
+    }
+}
+
+fn ibc_hooks_origin(packet: &Packet) -> Result<IbcHooksOrigin, EnclaveError> {
+    let data: FungibleTokenPacketData = serde_json::from_slice(&packet.data).map_err(|err| {
+        warn!(
+            "ibc-hooks origin: failed to parse the packet data: {:?}",
+            err
+        );
+        EnclaveError::FailedTxVerification
+    })?;
+    let sender = data.sender.as_str();
+
+    // Same as address.Hash(SenderPrefix, channel/sender) in the sdk
+    let mut key = sha_256(IBC_HOOKS_SENDER_PREFIX).to_vec();
+    key.extend_from_slice(format!("{}/{}", packet.destination_channel, sender).as_bytes());
+    let intermediate_sender = HumanAddr::from_canonical(&CanonicalAddr::from_vec(
+        sha_256(&key).to_vec(),
+    ))
+    .map_err(|err| {
+        warn!(
+            "ibc-hooks origin: failed to encode the intermediate sender: {:?}",
+            err
+        );
+        EnclaveError::FailedTxVerification
+    })?;
+
+    Ok(IbcHooksOrigin {
+        channel: packet.destination_channel.clone(),
+        port: packet.destination_port.clone(),
+        sender: sender.to_string(),
+        intermediate_sender: Addr::unchecked(intermediate_sender.0),
+    })
+}
+
+fn is_scheduled_tx_sender(sender: &CanonicalAddr) -> bool {
+    sender.as_slice() == &sha_256(SCHEDULED_TX_SENDER_NAME)[..ADDRESS_LEN]
+}
+
+/// Returns whether the tx carries no signatures. Only scheduled txs are unsigned, see verify_scheduled_tx
+fn is_unsigned_tx(sig_info: &SigInfo) -> bool {
+    cosmos_proto::tx::tx::TxRaw::parse_from_bytes(sig_info.tx_bytes.as_slice())
+        .map(|tx_raw| tx_raw.signatures.is_empty())
+        .unwrap_or(false)
+}
+
+/// Checks that a scheduled tx is an unsigned tx built by the chain.
+/// There's no key for the scheduled tx senders, so a tx that carries signatures wasn't built by the chain.
+/// The tx must also be one of the unsigned txs of the verified block, each of which is accepted once.
+fn verify_scheduled_tx(sig_info: &SigInfo) -> Result<(), EnclaveError> {
+    let tx_raw = cosmos_proto::tx::tx::TxRaw::parse_from_bytes(sig_info.tx_bytes.as_slice())
+        .map_err(|err| {
+            warn!("failed to parse TxRaw from tx_bytes: {:?}", err);
+            EnclaveError::FailedTxVerification
+        })?;
+    let auth_info = cosmos_proto::tx::tx::AuthInfo::parse_from_bytes(&tx_raw.auth_info_bytes)
+        .map_err(|err| {
+            warn!("failed to parse AuthInfo from tx_bytes: {:?}", err);
+            EnclaveError::FailedTxVerification
+        })?;
+
+    if !tx_raw.signatures.is_empty() || !auth_info.signer_infos.is_empty() {
+        warn!("Scheduled tx verification failed: scheduled txs must not be signed");
+        return Err(EnclaveError::FailedTxVerification);
+    }
+
+    // without the verified block there's nothing to authenticate scheduled txs with
+    #[cfg(not(feature = "light-client-validation"))]
+    {
+        warn!("Scheduled tx verification failed: scheduled txs require light client validation");
+        Err(EnclaveError::FailedTxVerification)
+    }
+
+    #[cfg(feature = "light-client-validation")]
+    {
+        if !check_tx_is_scheduled(sig_info.tx_bytes.as_slice()) {
+            warn!("Scheduled tx verification failed: the tx isn't a scheduled tx of the verified block");
+            return Err(EnclaveError::FailedTxVerification);
+        }
+
+        Ok(())
+    }
+}
+
 fn verify_signature(sig_info: &SigInfo, sender: &CanonicalAddr) -> Result<(), EnclaveError> {
     let sender_public_key = get_signer(sig_info, sender)?;
 
@@ -669,7 +820,6 @@ fn verify_input(
     verify_tx_bytes(sig_info, &sdk_messages)?;
 
     let is_verified = verify_input_params(
-        #[cfg(feature = "light-client-validation")]
         sig_info,
         &sdk_messages,
         sender,
@@ -902,7 +1052,7 @@ fn verify_callback_sig_impl(
 
 #[allow(clippy::too_many_arguments)]
 fn verify_input_params(
-    #[cfg(feature = "light-client-validation")] sig_info: &SigInfo,
+    sig_info: &SigInfo,
     sdk_messages: &[DirectSdkMsg],
     sender: &CanonicalAddr,
     sent_funds: &[Coin],
@@ -917,6 +1067,7 @@ fn verify_input_params(
     // since it didn't find a matching signed message
     let sdk_msg = verify_and_get_sdk_msg(
         sdk_messages,
+        sig_info.tx_bytes.as_slice(),
         sender,
         contract_address,
         sent_wasm_input,
@@ -939,8 +1090,15 @@ fn verify_input_params(
         }
     };
 
+    // Each call of an ibc-hooks memo is a separate invocation, bound to its index in the memo
+    let ibc_hooks_packet = get_ibc_hooks_packet(sdk_msg, verify_params_types);
+    let ibc_hooks_call_index = ibc_hooks_packet.map_or(0, |packet| {
+        ibc_hooks_call_index(sig_info.tx_bytes.as_slice(), packet)
+    });
+
+    // The later calls of an ibc-hooks memo belong to the tx that was checked with the first call
     #[cfg(feature = "light-client-validation")]
-    if !check_tx_in_current_block(sig_info.tx_bytes.as_slice()) {
+    if ibc_hooks_call_index == 0 && !check_tx_in_current_block(sig_info.tx_bytes.as_slice()) {
         return Err(EnclaveError::ValidationFailure);
     }
 
@@ -950,16 +1108,38 @@ fn verify_input_params(
     }
 
     info!("Verifying contract address...");
-    if !verify_contract_address(sdk_msg, contract_address) {
+    // The address of an instantiated contract is not yet known when sending the message,
+    // both in MsgInstantiateContract and in the memo of an ibc-hooks packet
+    let is_init = matches!(verify_params_types, VerifyParamsType::Init);
+    if !is_init && !verify_contract_address(sdk_msg, contract_address, ibc_hooks_call_index) {
         warn!("Contract address verification failed!");
         return Ok(false);
     }
 
     info!("Verifying sent funds...");
-    if !verify_sent_funds(sdk_msg, sent_funds) {
+    if !verify_sent_funds(sdk_msg, sent_funds, ibc_hooks_call_index) {
         warn!("Funds verification failed!");
         return Ok(false);
     }
 
+    if let Some(packet) = ibc_hooks_packet {
+        complete_ibc_hooks_call(sig_info.tx_bytes.as_slice(), packet);
+    }
+
     Ok(true)
 }
+
+/// Returns the packet of an incoming transfer whose memo is run by ibc-hooks.
+fn get_ibc_hooks_packet(
+    sdk_msg: &DirectSdkMsg,
+    verify_params_types: VerifyParamsType,
+) -> Option<&Packet> {
+    match (sdk_msg, verify_params_types) {
+        (DirectSdkMsg::MsgRecvPacket { packet, .. }, VerifyParamsType::Init)
+        | (
+            DirectSdkMsg::MsgRecvPacket { packet, .. },
+            VerifyParamsType::HandleType(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER),
+        ) => Some(packet),
+        _ => None,
+    }
+}
@@ -0,0 +1,73 @@
+use enclave_cosmos_types::types::Packet;
+use enclave_crypto::{sha_256, HASH_SIZE};
+use lazy_static::lazy_static;
+use log::trace;
+
+use std::sync::SgxMutex;
+
+/// The calls of an ibc-hooks memo are run by one invocation of the enclave each, all verified against the same
+/// MsgRecvPacket. IbcHooksCalls binds every invocation to the next call of the memo, so that the host can't
+/// replay, skip or reorder the calls.
+#[derive(Default, Clone, Copy, Debug)]
+pub struct IbcHooksCalls {
+    /// Identifies the packet and the tx that delivers it, see packet_id
+    pub packet_id: [u8; HASH_SIZE],
+    /// The index of the next call of the memo
+    pub next_call: usize,
+}
+
+lazy_static! {
+    pub static ref IBC_HOOKS_CALLS: SgxMutex<IbcHooksCalls> =
+        SgxMutex::new(IbcHooksCalls::default());
+}
+
+/// A packet is received once, but a tx that failed to receive it can be followed by another tx that delivers it
+/// again. The calls start over in that tx.
+fn packet_id(tx_bytes: &[u8], packet: &Packet) -> [u8; HASH_SIZE] {
+    let mut data = sha_256(tx_bytes).to_vec();
+    data.extend_from_slice(
+        format!(
+            "{}/{}/{}",
+            packet.destination_port, packet.destination_channel, packet.sequence
+        )
+        .as_bytes(),
+    );
+    sha_256(&data)
+}
+
+/// Returns the index of the call of the memo of the packet that the next invocation must run.
+pub fn ibc_hooks_call_index(tx_bytes: &[u8], packet: &Packet) -> usize {
+    let packet_id = packet_id(tx_bytes, packet);
+    let calls = IBC_HOOKS_CALLS.lock().unwrap();
+
+    if calls.packet_id == packet_id {
+        calls.next_call
+    } else {
+        0
+    }
+}
+
+/// Records that the expected call of the memo of the packet was verified, so that the next invocation must run
+/// the following call.
+pub fn complete_ibc_hooks_call(tx_bytes: &[u8], packet: &Packet) {
+    let packet_id = packet_id(tx_bytes, packet);
+    let mut calls = IBC_HOOKS_CALLS.lock().unwrap();
+
+    if calls.packet_id != packet_id {
+        *calls = IbcHooksCalls {
+            packet_id,
+            next_call: 0,
+        };
+    }
+    calls.next_call += 1;
+
+    trace!("ibc-hooks calls incremented to: {:?}", calls);
+}
+
+/// Whether a call of the memo of the packet was the last one verified.
+pub fn is_current_ibc_hooks_packet(tx_bytes: &[u8], packet: &Packet) -> bool {
+    let packet_id = packet_id(tx_bytes, packet);
+    let calls = IBC_HOOKS_CALLS.lock().unwrap();
+
+    calls.next_call > 0 && calls.packet_id == packet_id
+}
@@ -5,8 +5,15 @@ use enclave_cosmos_types::types::{
 };
 use log::*;
 
-/// Check that the contract listed in the cosmos sdk message matches the one in env
-pub fn verify_contract_address(msg: &DirectSdkMsg, contract_address: &HumanAddr) -> bool {
+const ZERO_SENDER: &str = "secret1qqqqqqqqqqqqqqqqqqqqqqqqqqqqqqqq3x5k6p";
+
+/// Check that the contract listed in the cosmos sdk message matches the one in env.
+/// ibc_hooks_call_index is the call of the ibc-hooks memo that is verified, if any.
+pub fn verify_contract_address(
+    msg: &DirectSdkMsg,
+    contract_address: &HumanAddr,
+    ibc_hooks_call_index: usize,
+) -> bool {
     // Contract address is relevant only to execute, since during sending an instantiate message the contract address is not yet known
     match msg {
         DirectSdkMsg::MsgExecuteContract { contract, .. }
@@ -26,7 +33,12 @@ pub fn verify_contract_address(msg: &DirectSdkMsg, contract_address: &HumanAddr)
                     ..
                 },
             ..
-        } => verify_contract_address_msg_recv_packet(destination_port, data, contract_address),
+        } => verify_contract_address_msg_recv_packet(
+            destination_port,
+            data,
+            contract_address,
+            ibc_hooks_call_index,
+        ),
         DirectSdkMsg::MsgAcknowledgement {
             packet: Packet {
                 source_port, data, ..
@@ -133,6 +145,7 @@ fn verify_contract_address_msg_recv_packet(
     destination_port: &String,
     data: &Vec<u8>,
     contract_address: &HumanAddr,
+    ibc_hooks_call_index: usize,
 ) -> bool {
     info!(
         "---destination_port: {:?}, contract_address: {:?}",
@@ -140,7 +153,11 @@ fn verify_contract_address_msg_recv_packet(
     );
     if destination_port == "transfer" {
         // Packet was routed here through ibc-hooks
-        verify_contract_address_ibc_wasm_hooks_incoming_transfer(data, contract_address)
+        verify_contract_address_ibc_wasm_hooks_incoming_transfer(
+            data,
+            contract_address,
+            ibc_hooks_call_index,
+        )
     } else if destination_port.starts_with("wasm.") {
         // Check if this is IBC hooks through ics20 contract
         if let Ok(packet_data) = serde_json::from_slice::<FungibleTokenPacketData>(data.as_slice())
@@ -152,9 +169,13 @@ fn verify_contract_address_msg_recv_packet(
                     // This is IBC hooks through ics20 - verify against memo contract
                     info!(
                         "contract_addreess: {:?}, wasm_msg: {:?}",
-                        contract_address, wasm_msg.wasm.contract
+                        contract_address, wasm_msg.wasm
                     );
-                    if *contract_address == wasm_msg.wasm.contract {
+                    if wasm_msg
+                        .wasm
+                        .call(ibc_hooks_call_index)
+                        .map_or(false, |call| call.executes_contract(contract_address))
+                    {
                         return true;
                     }
                 }
@@ -197,6 +218,7 @@ fn verify_contract_address_ibc_contract(port: &String, contract_address: &HumanA
 fn verify_contract_address_ibc_wasm_hooks_incoming_transfer(
     data: &Vec<u8>,
     contract_address: &HumanAddr,
+    ibc_hooks_call_index: usize,
 ) -> bool {
     // Parse data as FungibleTokenPacketData JSON
     let packet_data: FungibleTokenPacketData = match serde_json::from_slice(data.as_slice()) {
@@ -233,15 +255,24 @@ fn verify_contract_address_ibc_wasm_hooks_incoming_transfer(
         }
     };
 
-    // In ibc-hooks contract_address == packet_data.memo.wasm.contract == packet_data.receiver
-    let is_verified =
-        *contract_address == packet_data.receiver && *contract_address == wasm_msg.wasm.contract;
+    // In ibc-hooks contract_address is the contract executed by the call of packet_data.memo.wasm at
+    // ibc_hooks_call_index, and packet_data.receiver is the contract of its first call, or the zero sender when it
+    // instantiates a contract
+    let is_receiver_verified = match wasm_msg.wasm.receiver_contract() {
+        Some(receiver_contract) => packet_data.receiver == receiver_contract,
+        None => packet_data.receiver.as_str() == ZERO_SENDER,
+    };
+    let is_verified = is_receiver_verified
+        && wasm_msg
+            .wasm
+            .call(ibc_hooks_call_index)
+            .map_or(false, |call| call.executes_contract(contract_address));
     if !is_verified {
         trace!(
             "Contract address sent to enclave {:?} is not the same as in ibc-hooks packet receiver={:?} memo={:?}",
             contract_address,
             packet_data.receiver,
-            wasm_msg.wasm.contract
+            wasm_msg.wasm
         );
     }
     is_verified
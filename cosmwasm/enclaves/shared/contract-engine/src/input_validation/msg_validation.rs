@@ -11,11 +11,15 @@ use enclave_cosmos_types::types::{
 
 use log::*;
 
+use crate::ibc_hooks_calls::ibc_hooks_call_index;
 use crate::types::SecretMessage;
 
+const HEX_ENCODED_HASH_SIZE: usize = 64;
+
 /// Get the cosmwasm message that contains the encrypted message
 pub fn verify_and_get_sdk_msg<'sd>(
     sdk_messages: &'sd [DirectSdkMsg],
+    tx_bytes: &[u8],
     sent_sender: &CanonicalAddr,
     sent_contract_address: &HumanAddr,
     sent_wasm_input: &SecretMessage,
@@ -98,7 +102,19 @@ pub fn verify_and_get_sdk_msg<'sd>(
             }
             VerifyParamsType::HandleType(
                 HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER,
-            ) => verify_ibc_wasm_hooks_incoming_transfer(sent_wasm_input, packet),
+            ) => verify_ibc_wasm_hooks_incoming_transfer(
+                sent_wasm_input,
+                sent_contract_address,
+                packet,
+                ibc_hooks_call_index(tx_bytes, packet),
+            ),
+            // Contracts instantiated by ibc-hooks
+            VerifyParamsType::Init => verify_ibc_wasm_hooks_incoming_instantiate(
+                sent_wasm_input,
+                sent_current_admin,
+                packet,
+                ibc_hooks_call_index(tx_bytes, packet),
+            ),
             _ => false,
         },
         DirectSdkMsg::MsgAcknowledgement {
@@ -215,14 +231,10 @@ fn verify_ibc_packet_data_with_hooks(actual_data: &[u8], expected_data: &[u8]) -
 
         // Verify there's a valid wasm memo
         if let Some(memo_str) = &actual_packet.memo {
-            if let Ok(memo_json) = serde_json::from_str::<serde_json::Value>(memo_str) {
-                if let Some(contract_addr) = memo_json
-                    .get("wasm")
-                    .and_then(|w| w.get("contract"))
-                    .and_then(|c| c.as_str())
-                {
-                    // Verify original receiver matches contract in memo
-                    let valid = expected_packet.receiver.as_str() == contract_addr;
+            if let Ok(memo) = serde_json::from_str::<IbcHooksIncomingTransferMsg>(memo_str) {
+                if let Some(contract_addr) = memo.wasm.receiver_contract() {
+                    // Verify original receiver matches the contract of the first call in memo
+                    let valid = expected_packet.receiver == contract_addr;
                     info!(
                         "IBC hooks validation: original='{}', contract='{}', valid={}",
                         expected_packet.receiver, contract_addr, valid
@@ -242,7 +254,87 @@ fn verify_ibc_packet_data_with_hooks(actual_data: &[u8], expected_data: &[u8]) -
     false
 }
 
-pub fn verify_ibc_wasm_hooks_incoming_transfer(sent_msg: &SecretMessage, packet: &Packet) -> bool {
+/// Parses the ibc-hooks memo of an incoming transfer.
+fn parse_ibc_wasm_hooks_incoming_memo(packet: &Packet) -> Option<IbcHooksIncomingTransferMsg> {
+    let fungible_token_packet_data =
+        serde_json::from_slice::<FungibleTokenPacketData>(&packet.data).ok()?;
+    serde_json::from_slice::<IbcHooksIncomingTransferMsg>(
+        fungible_token_packet_data
+            .memo
+            .unwrap_or_default()
+            .as_bytes(),
+    )
+    .ok()
+}
+
+/// Verifies a contract instantiated by the call of the memo at call_index. The msg sent to the enclave is prefixed
+/// with the code hash of the contract, like any other init msg, and validate_msg checks that prefix against the
+/// code that runs. Checking it against the code hash of the memo binds the code_id of the memo.
+pub fn verify_ibc_wasm_hooks_incoming_instantiate(
+    sent_msg: &SecretMessage,
+    sent_current_admin: Option<&CanonicalAddr>,
+    packet: &Packet,
+    call_index: usize,
+) -> bool {
+    let memo = match parse_ibc_wasm_hooks_incoming_memo(packet) {
+        Some(memo) => memo,
+        None => {
+            trace!("get_verified_msg Init: packet memo cannot be parsed as IbcHooksIncomingTransferMsg");
+            return false;
+        }
+    };
+    let instantiate = match memo.wasm.call(call_index).and_then(|call| call.instantiate) {
+        Some(instantiate) => instantiate,
+        None => {
+            trace!(
+                "get_verified_msg Init: call {} of the memo is not an instantiate",
+                call_index
+            );
+            return false;
+        }
+    };
+
+    if sent_msg.msg.len() < HEX_ENCODED_HASH_SIZE {
+        trace!("get_verified_msg Init: sent_msg.msg is too short to hold a code hash");
+        return false;
+    }
+    let (sent_code_hash, sent_msg) = sent_msg.msg.split_at(HEX_ENCODED_HASH_SIZE);
+    if !sent_code_hash.eq_ignore_ascii_case(instantiate.code_hash.as_bytes()) {
+        trace!(
+            "get_verified_msg Init: code hash {:?} does not match the memo code hash {:?}",
+            String::from_utf8_lossy(sent_code_hash),
+            instantiate.code_hash
+        );
+        return false;
+    }
+
+    let sent_admin = sent_current_admin
+        .and_then(|admin| HumanAddr::from_canonical(admin).ok())
+        .unwrap_or_default();
+    if sent_admin != instantiate.admin.unwrap_or_default() {
+        trace!(
+            "get_verified_msg Init: admin {:?} does not match the memo admin",
+            sent_admin
+        );
+        return false;
+    }
+
+    match serde_json::from_slice::<serde_json::Value>(sent_msg) {
+        Ok(sent_msg_value) => sent_msg_value == instantiate.msg,
+        Err(err) => {
+            trace!("get_verified_msg Init: sent_msg.msg cannot be parsed as serde_json::Value: {:?} Error: {:?}", String::from_utf8_lossy(sent_msg), err);
+            false
+        }
+    }
+}
+
+/// Verifies the execution of the call of the memo at call_index.
+pub fn verify_ibc_wasm_hooks_incoming_transfer(
+    sent_msg: &SecretMessage,
+    sent_contract_address: &HumanAddr,
+    packet: &Packet,
+    call_index: usize,
+) -> bool {
     let Packet { data, .. } = packet;
 
     let fungible_token_packet_data = serde_json::from_slice::<FungibleTokenPacketData>(data);
@@ -270,7 +362,12 @@ pub fn verify_ibc_wasm_hooks_incoming_transfer(sent_msg: &SecretMessage, packet:
         return false;
     }
 
-    ibc_hooks_incoming_transfer_msg.wasm.msg == sent_msg_value.unwrap()
+    ibc_hooks_incoming_transfer_msg
+        .wasm
+        .call(call_index)
+        .map_or(false, |call| {
+            call.executes(sent_contract_address, &sent_msg_value.unwrap())
+        })
 }
 
 pub fn verify_ibc_packet_ack(
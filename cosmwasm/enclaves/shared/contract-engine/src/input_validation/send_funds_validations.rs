@@ -3,8 +3,13 @@ use cw_types_v010::types::Coin;
 use enclave_cosmos_types::types::{DirectSdkMsg, FungibleTokenPacketData, Packet};
 use log::*;
 
-/// Check that the funds listed in the cosmwasm message matches the ones in env
-pub fn verify_sent_funds(msg: &DirectSdkMsg, sent_funds_msg: &[Coin]) -> bool {
+/// Check that the funds listed in the cosmwasm message matches the ones in env.
+/// ibc_hooks_call_index is the call of the ibc-hooks memo that is verified, if any.
+pub fn verify_sent_funds(
+    msg: &DirectSdkMsg,
+    sent_funds_msg: &[Coin],
+    ibc_hooks_call_index: usize,
+) -> bool {
     match msg {
         DirectSdkMsg::MsgExecuteContract { sent_funds, .. }
         | DirectSdkMsg::MsgInstantiateContract {
@@ -24,7 +29,11 @@ pub fn verify_sent_funds(msg: &DirectSdkMsg, sent_funds_msg: &[Coin]) -> bool {
                 },
             ..
         } => {
-            if destination_port == "transfer" {
+            if destination_port == "transfer" && ibc_hooks_call_index > 0 {
+                // Packet was routed here through ibc-hooks
+                // Only the first call of the memo receives the funds
+                sent_funds_msg.is_empty()
+            } else if destination_port == "transfer" {
                 // Packet was routed here through ibc-hooks
                 verify_sent_funds_ibc_wasm_hooks_incoming_transfer(
                     sent_funds_msg,
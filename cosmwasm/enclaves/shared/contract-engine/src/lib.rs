@@ -17,6 +17,7 @@ mod execute_message;
 pub mod external;
 mod gas;
 mod ibc_denom_utils;
+mod ibc_hooks_calls;
 mod ibc_message;
 mod input_validation;
 mod io;
@@ -38,6 +38,16 @@ pub fn parse_message(
         | HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => {
             parse_plaintext_ibc_validated_message(message)
         }
+        // Sudo is only reachable through the compute keeper (governance or other modules),
+        // there is no tx msg to verify the input against
+        HandleType::HANDLE_TYPE_SUDO => {
+            trace!(
+                "parsing sudo msg (Should always be plaintext): {:?}",
+                base64::encode(message)
+            );
+
+            parse_plaintext_ibc_protocol_message(message)
+        }
     }
 }
 
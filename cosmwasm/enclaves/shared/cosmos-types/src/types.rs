@@ -162,6 +162,7 @@ pub enum HandleType {
     HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER = 8,
     HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK = 9,
     HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT = 10,
+    HANDLE_TYPE_SUDO = 11,
 }
 
 impl HandleType {
@@ -178,6 +179,7 @@ impl HandleType {
             8 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER),
             9 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK),
             10 => Ok(HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT),
+            11 => Ok(HandleType::HANDLE_TYPE_SUDO),
             _ => {
                 error!("unrecognized handle type: {}", value);
                 Err(EnclaveError::FailedToDeserialize)
@@ -198,6 +200,7 @@ impl HandleType {
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_INCOMING_TRANSFER => "execute",
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_ACK => "sudo",
             HandleType::HANDLE_TYPE_IBC_WASM_HOOKS_OUTGOING_TRANSFER_TIMEOUT => "sudo",
+            HandleType::HANDLE_TYPE_SUDO => "sudo",
         }
     }
 }
@@ -491,11 +494,81 @@ pub struct IbcHooksIncomingTransferMsg {
     pub wasm: IbcHooksIncomingTransferWasmMsg,
 }
 
+/// The `wasm` object of an ibc-hooks memo. It holds either a single call, as `contract` and `msg` or as
+/// `instantiate`, or an ordered list of `calls`. Other keys, like `forward`, are ignored by the enclave.
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
-
 pub struct IbcHooksIncomingTransferWasmMsg {
-    pub contract: HumanAddr,
+    #[serde(default)]
+    pub contract: Option<HumanAddr>,
+    #[serde(default)]
+    pub msg: Option<serde_json::Value>,
+    #[serde(default)]
+    pub instantiate: Option<IbcHooksInstantiateMsg>,
+    #[serde(default)]
+    pub calls: Vec<IbcHooksCall>,
+}
+
+/// A call of an ibc-hooks memo, either an execution of `contract` with `msg` or an `instantiate`.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct IbcHooksCall {
+    #[serde(default)]
+    pub contract: Option<HumanAddr>,
+    #[serde(default)]
+    pub msg: Option<serde_json::Value>,
+    #[serde(default)]
+    pub instantiate: Option<IbcHooksInstantiateMsg>,
+}
+
+/// The `instantiate` object of an ibc-hooks call. The label is only stored by the chain, the enclave never sees it.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct IbcHooksInstantiateMsg {
+    pub code_id: u64,
+    /// The hex encoded hash of the code of code_id, the enclave checks it against the code it runs.
+    pub code_hash: String,
     pub msg: serde_json::Value,
+    #[serde(default)]
+    pub admin: Option<HumanAddr>,
+}
+
+impl IbcHooksCall {
+    /// Whether the call executes the contract.
+    pub fn executes_contract(&self, contract: &HumanAddr) -> bool {
+        self.instantiate.is_none() && self.contract.as_ref() == Some(contract)
+    }
+
+    /// Whether the call executes the contract with the msg.
+    pub fn executes(&self, contract: &HumanAddr, msg: &serde_json::Value) -> bool {
+        self.executes_contract(contract) && self.msg.as_ref() == Some(msg)
+    }
+}
+
+impl IbcHooksIncomingTransferWasmMsg {
+    /// The calls of the memo in execution order.
+    pub fn calls(&self) -> Vec<IbcHooksCall> {
+        if !self.calls.is_empty() {
+            return self.calls.clone();
+        }
+        vec![IbcHooksCall {
+            contract: self.contract.clone(),
+            msg: self.msg.clone(),
+            instantiate: self.instantiate.clone(),
+        }]
+    }
+
+    /// The call of the memo at the index, in execution order.
+    pub fn call(&self, index: usize) -> Option<IbcHooksCall> {
+        self.calls().into_iter().nth(index)
+    }
+
+    /// The contract that receives the transferred funds, which is the receiver of the packet.
+    /// None when the first call instantiates a contract, the funds are then received by the zero sender.
+    pub fn receiver_contract(&self) -> Option<HumanAddr> {
+        let first = self.call(0)?;
+        if first.instantiate.is_some() {
+            return None;
+        }
+        first.contract
+    }
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
@@ -209,6 +209,7 @@ impl BaseEnv {
                     code_hash: self.0.contract_code_hash,
                 },
                 transaction: self.0.transaction,
+                ibc_hooks_origin: None,
             },
             msg_info: v1types::MessageInfo {
                 sender: v1types::Addr::unchecked(self.0.message.sender.0),
@@ -309,6 +310,16 @@ impl CwEnv {
         }
     }
 
+    /// Only v1 contracts can see the origin of an ibc-hooks call
+    pub fn set_ibc_hooks_origin(&mut self, origin: v1types::IbcHooksOrigin) {
+        match self {
+            CwEnv::V010Env { .. } => {}
+            CwEnv::V1Env { env, .. } => {
+                env.ibc_hooks_origin = Some(origin);
+            }
+        }
+    }
+
     pub fn set_msg_sender(&mut self, msg_sender: &str) {
         match self {
             CwEnv::V010Env { env } => {
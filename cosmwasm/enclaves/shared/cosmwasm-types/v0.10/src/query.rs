@@ -19,6 +19,9 @@ pub enum QueryRequest {
     Gov(GovQuery),
     Ibc(IbcQuery),
     Stargate { path: String, data: Binary },
+    /// Queries the chain using a gRPC query path and a protobuf encoded request.
+    /// Served from the same allowlist as `Stargate` and returns the protobuf encoded response.
+    Grpc { path: String, data: Binary },
 }
 
 /// These are queries to the various IBC modules to see the state of the contract's
@@ -102,6 +105,18 @@ pub enum DistQuery {
     /// Note that this may be much more expensive than Balance and should be avoided if possible.
     /// Return value is AllBalanceResponse.
     Rewards { delegator: HumanAddr },
+    /// Returns the address rewards of the given delegator are withdrawn to.
+    /// Return value is DelegatorWithdrawAddressResponse.
+    DelegatorWithdrawAddress { delegator_address: HumanAddr },
+    /// Returns the pending rewards of a single delegation.
+    /// Return value is DelegationRewardsResponse.
+    DelegationRewards {
+        delegator_address: HumanAddr,
+        validator_address: HumanAddr,
+    },
+    /// Returns the pending rewards of all the delegations of the given delegator.
+    /// Return value is DelegationTotalRewardsResponse.
+    DelegationTotalRewards { delegator_address: HumanAddr },
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
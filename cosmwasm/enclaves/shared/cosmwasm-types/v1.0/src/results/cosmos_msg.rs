@@ -4,6 +4,7 @@ use std::fmt;
 use crate::{coins::Coin, ibc::IbcTimeout};
 
 use cw_types_v010::encoding::Binary;
+use cw_types_v010::math::Decimal;
 
 use super::Empty;
 
@@ -29,6 +30,7 @@ where
     Ibc(IbcMsg),
     Wasm(WasmMsg),
     Gov(GovMsg),
+    Cron(CronMsg),
     FinalizeTx(Empty),
 }
 
@@ -131,6 +133,12 @@ pub enum DistributionMsg {
         /// The `validator_address`
         validator: String,
     },
+    /// This is translated to a [MsgFundCommunityPool](https://github.com/cosmos/cosmos-sdk/blob/v0.50.9/proto/cosmos/distribution/v1beta1/tx.proto#L110-L121).
+    /// `depositor` is automatically filled with the current contract's address.
+    FundCommunityPool {
+        /// The amount to spend
+        amount: Vec<Coin>,
+    },
 }
 
 /// The message types of the wasm module.
@@ -222,6 +230,56 @@ pub enum WasmMsg {
 pub enum GovMsg {
     /// This maps directly to [MsgVote](https://github.com/cosmos/cosmos-sdk/blob/v0.42.5/proto/cosmos/gov/v1beta1/tx.proto#L46-L56) in the Cosmos SDK with voter set to the contract address.
     Vote { proposal_id: u64, vote: VoteOption },
+    /// This maps directly to [MsgVoteWeighted](https://github.com/cosmos/cosmos-sdk/blob/v0.50.9/proto/cosmos/gov/v1/tx.proto#L117-L131) in the Cosmos SDK with voter set to the contract address.
+    VoteWeighted {
+        proposal_id: u64,
+        options: Vec<WeightedVoteOption>,
+    },
+}
+
+/// The message types of the cron module.
+///
+/// Schedules registered by a contract are owned by it, the owner is automatically filled with the current contract's address.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum CronMsg {
+    /// This is translated to a MsgRegisterSchedule. Exactly one of `period`, `interval_seconds` and
+    /// `cron_expression` must be set. The deposit pays the execution fees and the rest is refunded on removal.
+    RegisterSchedule {
+        name: String,
+        period: Option<u64>,
+        interval_seconds: Option<u64>,
+        cron_expression: Option<String>,
+        msgs: Vec<ScheduledMsg>,
+        failure_policy: Option<FailurePolicy>,
+        max_failures: Option<u32>,
+        deposit: Coin,
+    },
+    /// This is translated to a MsgRemoveSchedule, the schedule must be owned by the current contract.
+    RemoveSchedule { name: String },
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct ScheduledMsg {
+    pub contract: String,
+    /// msg is the json-encoded ExecuteMsg struct, it's encrypted before it's executed
+    pub msg: Binary,
+    pub gas_limit: Option<u64>,
+    pub funds: Vec<Coin>,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+#[serde(rename_all = "snake_case")]
+pub enum FailurePolicy {
+    Skip,
+    Retry,
+    Disable,
+}
+
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct WeightedVoteOption {
+    pub option: VoteOption,
+    pub weight: Decimal,
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
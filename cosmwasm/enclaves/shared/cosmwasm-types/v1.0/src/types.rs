@@ -11,6 +11,24 @@ pub struct Env {
     pub block: BlockInfo,
     pub contract: ContractInfo,
     pub transaction: Option<TransactionInfo>,
+    /// Set only when the contract is called by ibc-hooks for an incoming transfer
+    #[serde(default, skip_serializing_if = "Option::is_none")]
+    pub ibc_hooks_origin: Option<IbcHooksOrigin>,
+}
+
+/// The origin of an incoming transfer that called the contract through ibc-hooks.
+/// It is read by the enclave from the verified packet, so unlike the remote sender claimed in the memo
+/// it can be trusted, but it is never a local account: msg.sender stays empty.
+#[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
+pub struct IbcHooksOrigin {
+    /// The channel on Secret that received the packet
+    pub channel: String,
+    /// The port on Secret that received the packet
+    pub port: String,
+    /// The sender on the counterparty chain, as set in the packet
+    pub sender: String,
+    /// The intermediate address derived from the channel and the sender, see `secretd q ibchooks wasm-sender`
+    pub intermediate_sender: Addr,
 }
 
 #[derive(Serialize, Deserialize, Clone, Debug, PartialEq)]
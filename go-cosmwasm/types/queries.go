@@ -73,6 +73,7 @@ type QueryRequest struct {
 	Gov      *GovQuery       `json:"gov,omitempty"`
 	IBC      *IBCQuery       `json:"ibc,omitempty"`
 	Stargate *StargateQuery  `json:"stargate,omitempty"`
+	Grpc     *GrpcQuery      `json:"grpc,omitempty"`
 }
 
 type BankQuery struct {
@@ -265,7 +266,10 @@ type ContractInfoQuery struct {
 }
 
 type DistQuery struct {
-	Rewards *RewardsQuery `json:"rewards,omitempty"`
+	Rewards                  *RewardsQuery                  `json:"rewards,omitempty"`
+	DelegatorWithdrawAddress *DelegatorWithdrawAddressQuery `json:"delegator_withdraw_address,omitempty"`
+	DelegationRewards        *DelegationRewardsQuery        `json:"delegation_rewards,omitempty"`
+	DelegationTotalRewards   *DelegationTotalRewardsQuery   `json:"delegation_total_rewards,omitempty"`
 }
 
 type GovQuery struct {
@@ -284,6 +288,16 @@ type StargateQuery struct {
 	Data []byte `json:"data"`
 }
 
+// GrpcQuery queries the chain using a gRPC query path and protobuf encoded request data.
+// This is the counterpart of [GrpcQuery](https://github.com/CosmWasm/cosmwasm/blob/v1.5.0/packages/std/src/query/mod.rs#L90-L96).
+// Like StargateQuery, the response is the protobuf encoded response message.
+type GrpcQuery struct {
+	// The fully qualified gRPC method path, eg. /cosmos.bank.v1beta1.Query/Balance
+	Path string `json:"path"`
+	// The protobuf encoded request message
+	Data []byte `json:"data"`
+}
+
 // IBCQuery defines a query request from the contract into the chain.
 // This is the counterpart of [IbcQuery](https://github.com/CosmWasm/cosmwasm/blob/v0.14.0-beta1/packages/std/src/ibc.rs#L61-L83).
 type IBCQuery struct {
@@ -523,6 +537,37 @@ func (d *ProposalsResponse) UnmarshalJSON(data []byte) error {
 	return nil
 }
 
+type DelegatorWithdrawAddressQuery struct {
+	DelegatorAddress string `json:"delegator_address"`
+}
+
+// DelegatorWithdrawAddressResponse is the expected response to DelegatorWithdrawAddressQuery
+type DelegatorWithdrawAddressResponse struct {
+	WithdrawAddress string `json:"withdraw_address"`
+}
+
+type DelegationRewardsQuery struct {
+	DelegatorAddress string `json:"delegator_address"`
+	ValidatorAddress string `json:"validator_address"`
+}
+
+// DelegationRewardsResponse is the expected response to DelegationRewardsQuery.
+// Like the RewardsResponse, amounts are truncated to integers
+type DelegationRewardsResponse struct {
+	Rewards RewardCoins `json:"rewards"`
+}
+
+type DelegationTotalRewardsQuery struct {
+	DelegatorAddress string `json:"delegator_address"`
+}
+
+// DelegationTotalRewardsResponse is the expected response to DelegationTotalRewardsQuery.
+// Like the RewardsResponse, amounts are truncated to integers
+type DelegationTotalRewardsResponse struct {
+	Rewards []Rewards   `json:"rewards"`
+	Total   RewardCoins `json:"total"`
+}
+
 type ContractInfoResponse struct {
 	CodeID  uint64 `json:"code_id"`
 	Creator string `json:"creator"`
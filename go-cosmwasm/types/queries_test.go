@@ -62,3 +62,13 @@ func TestValidatorWithData(t *testing.T) {
 	require.NoError(t, err)
 	assert.Equal(t, reval, val)
 }
+
+func TestGrpcQueryDeserialization(t *testing.T) {
+	var req QueryRequest
+	err := json.Unmarshal([]byte(`{"grpc":{"path":"/cosmos.bank.v1beta1.Query/Balance","data":"CgNmb28="}}`), &req)
+	require.NoError(t, err)
+	require.Nil(t, req.Stargate)
+	require.NotNil(t, req.Grpc)
+	assert.Equal(t, "/cosmos.bank.v1beta1.Query/Balance", req.Grpc.Path)
+	assert.Equal(t, []byte{0x0a, 0x03, 'f', 'o', 'o'}, req.Grpc.Data)
+}
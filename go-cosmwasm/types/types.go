@@ -82,6 +82,7 @@ const (
 	HandleTypeIbcWasmHooksIncomingTransfer
 	HandleTypeIbcWasmHooksOutgoingTransferAck
 	HandleTypeIbcWasmHooksOutgoingTransferTimeout
+	HandleTypeSudo
 )
 
 type CosmosMsgVersion int
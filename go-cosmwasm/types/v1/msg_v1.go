@@ -80,6 +80,7 @@ type CosmosMsg struct {
 	Staking      *StakingMsg      `json:"staking,omitempty"`
 	Stargate     *StargateMsg     `json:"stargate,omitempty"`
 	Wasm         *WasmMsg         `json:"wasm,omitempty"`
+	Cron         *CronMsg         `json:"cron,omitempty"`
 	FinalizeTx   *Empty           `json:"finalize_tx,omitempty"`
 }
 
@@ -111,6 +112,8 @@ type IBCMsg struct {
 type GovMsg struct {
 	// This maps directly to [MsgVote](https://github.com/cosmos/cosmos-sdk/blob/v0.42.5/proto/cosmos/gov/v1beta1/tx.proto#L46-L56) in the Cosmos SDK with voter set to the contract address.
 	Vote *VoteMsg `json:"vote,omitempty"`
+	// This maps directly to [MsgVoteWeighted](https://github.com/cosmos/cosmos-sdk/blob/v0.50.9/proto/cosmos/gov/v1/tx.proto#L117-L131) in the Cosmos SDK with voter set to the contract address.
+	VoteWeighted *VoteWeightedMsg `json:"vote_weighted,omitempty"`
 }
 
 type VoteOption int
@@ -120,6 +123,17 @@ type VoteMsg struct {
 	Vote       VoteOption `json:"vote"`
 }
 
+type VoteWeightedMsg struct {
+	ProposalId uint64               `json:"proposal_id"`
+	Options    []WeightedVoteOption `json:"options"`
+}
+
+type WeightedVoteOption struct {
+	Option VoteOption `json:"option"`
+	// Weight is a decimal string, eg "0.5"
+	Weight string `json:"weight"`
+}
+
 const (
 	Yes VoteOption = iota
 	No
@@ -192,6 +206,7 @@ type StakingMsg struct {
 type DistributionMsg struct {
 	SetWithdrawAddress      *SetWithdrawAddressMsg      `json:"set_withdraw_address,omitempty"`
 	WithdrawDelegatorReward *WithdrawDelegatorRewardMsg `json:"withdraw_delegator_reward,omitempty"`
+	FundCommunityPool       *FundCommunityPoolMsg       `json:"fund_community_pool,omitempty"`
 }
 
 // SetWithdrawAddressMsg is translated to a [MsgSetWithdrawAddress](https://github.com/cosmos/cosmos-sdk/blob/v0.42.4/proto/cosmos/distribution/v1beta1/tx.proto#L29-L37).
@@ -208,6 +223,49 @@ type WithdrawDelegatorRewardMsg struct {
 	Validator string `json:"validator"`
 }
 
+// FundCommunityPoolMsg is translated to a [MsgFundCommunityPool](https://github.com/cosmos/cosmos-sdk/blob/v0.50.9/proto/cosmos/distribution/v1beta1/tx.proto#L110-L121).
+// `depositor` is automatically filled with the current contract's address.
+type FundCommunityPoolMsg struct {
+	// Amount is the list of coins to be send to the community pool
+	Amount types.Coins `json:"amount"`
+}
+
+// CronMsg registers and removes cron schedules owned by the contract
+type CronMsg struct {
+	RegisterSchedule *RegisterScheduleMsg `json:"register_schedule,omitempty"`
+	RemoveSchedule   *RemoveScheduleMsg   `json:"remove_schedule,omitempty"`
+}
+
+// RegisterScheduleMsg is translated to a MsgRegisterSchedule.
+// `owner` is automatically filled with the current contract's address.
+type RegisterScheduleMsg struct {
+	Name string `json:"name"`
+	// Exactly one of Period, IntervalSeconds and CronExpression must be set
+	Period          uint64         `json:"period,omitempty"`
+	IntervalSeconds uint64         `json:"interval_seconds,omitempty"`
+	CronExpression  string         `json:"cron_expression,omitempty"`
+	Msgs            []ScheduledMsg `json:"msgs"`
+	// FailurePolicy is one of "skip" (the default), "retry" and "disable"
+	FailurePolicy string     `json:"failure_policy,omitempty"`
+	MaxFailures   uint32     `json:"max_failures,omitempty"`
+	Deposit       types.Coin `json:"deposit"`
+}
+
+// ScheduledMsg is a contract execution of a schedule
+type ScheduledMsg struct {
+	Contract string `json:"contract"`
+	// Msg is the json-encoded ExecuteMsg struct
+	Msg      []byte      `json:"msg"`
+	GasLimit uint64      `json:"gas_limit,omitempty"`
+	Funds    types.Coins `json:"funds"`
+}
+
+// RemoveScheduleMsg is translated to a MsgRemoveSchedule.
+// The schedule must be owned by the current contract.
+type RemoveScheduleMsg struct {
+	Name string `json:"name"`
+}
+
 // StargateMsg is encoded the same way as a protobof [Any](https://github.com/protocolbuffers/protobuf/blob/master/src/google/protobuf/any.proto).
 // This is the same structure as messages in `TxBody` from [ADR-020](https://github.com/cosmos/cosmos-sdk/blob/master/docs/architecture/adr-020-protobuf-transaction-encoding.md)
 type StargateMsg struct {
@@ -23,7 +23,7 @@ import (
 
 var configOnce sync.Once
 
-func CronKeeper(t testing.TB, accountKeeper types.AccountKeeper) (*keeper.Keeper, sdk.Context) {
+func CronKeeper(t testing.TB, accountKeeper types.AccountKeeper, bankKeeper types.BankKeeper) (*keeper.Keeper, sdk.Context) {
 	configOnce.Do(func() {
 		config := sdk.GetConfig()
 		config.SetBech32PrefixForAccount("secret", "secretpub")
@@ -49,6 +49,7 @@ func CronKeeper(t testing.TB, accountKeeper types.AccountKeeper) (*keeper.Keeper
 		storeKey,
 		memStoreKey,
 		accountKeeper,
+		bankKeeper,
 		authtypes.NewModuleAddress(types.ModuleName).String(),
 	)
 
@@ -102,6 +102,7 @@ type (
 	MsgMigrateContract         = types.MsgMigrateContract
 	MsgUpdateAdmin             = types.MsgUpdateAdmin
 	MsgClearAdmin              = types.MsgClearAdmin
+	MsgSudoContract            = types.MsgSudoContract
 	Model                      = types.Model
 	CodeInfo                   = types.CodeInfo
 	ContractInfo               = types.ContractInfo
@@ -4,7 +4,6 @@ import (
 	"encoding/base64"
 	"encoding/hex"
 	"errors"
-	"fmt"
 
 	flag "github.com/spf13/pflag"
 )
@@ -50,15 +49,3 @@ func (a *argumentDecoder) DecodeString(s string) ([]byte, error) {
 func asciiDecodeString(s string) ([]byte, error) {
 	return []byte(s), nil
 }
-
-func parseEncryptedBlob(blob []byte) ([]byte, []byte, []byte, error) {
-	if len(blob) < 64 {
-		return nil, nil, nil, fmt.Errorf("input must be > 64 bytes. Got %d", len(blob))
-	}
-
-	nonce := blob[0:32]
-	originalTxSenderPubkey := blob[32:64]
-	ciphertextInput := blob[64:]
-
-	return nonce, originalTxSenderPubkey, ciphertextInput, nil
-}
@@ -0,0 +1,146 @@
+package cli
+
+import (
+	"encoding/hex"
+	"fmt"
+
+	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/spf13/cobra"
+
+	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+)
+
+// IOKeyCmd manages the key txs and queries to contracts are encrypted with
+func IOKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "io-key",
+		Short: "Manage the key contract txs and queries are encrypted with",
+		Long: `Manage the key contract txs and queries are encrypted with. By default it's kept in plaintext in
+id_tx_io.json in the home dir. It can instead be derived from a key generated in the keyring, or from any keyring
+key, which also works with ledger keys. Keys that were used before are kept, and tried when decrypting past txs.`,
+		DisableFlagParsing:         true,
+		SuggestionsMinimumDistance: 2,
+		RunE:                       client.ValidateCmd,
+	}
+
+	cmd.AddCommand(
+		ShowIOKeyCmd(),
+		UseDerivedIOKeyCmd(),
+		MigrateIOKeyCmd(),
+	)
+	flags.AddKeyringFlags(cmd.PersistentFlags())
+
+	return cmd
+}
+
+func ShowIOKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "show",
+		Short: "Show the current io key and the keys used before it",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			config, err := wasmUtils.LoadIOKeyConfig(clientCtx.HomeDir)
+			if err != nil {
+				return err
+			}
+
+			out := cmd.OutOrStdout()
+			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
+			for i, spec := range append([]wasmUtils.IOKeySpec{config.Current}, config.Previous...) {
+				status := "previous"
+				if i == 0 {
+					status = "current"
+				}
+
+				_, pubkey, err := wasmCtx.LoadIOKey(spec, false)
+				if err != nil {
+					fmt.Fprintf(out, "%s\t%s\tunavailable: %s\n", status, spec, err)
+					continue
+				}
+				fmt.Fprintf(out, "%s\t%s\t%s\n", status, spec, hex.EncodeToString(pubkey))
+			}
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func UseDerivedIOKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "use-derived [key-name]",
+		Short: "Derive the io key from a keyring key",
+		Long: `Derive the io key from the signature of a keyring key over a fixed message, so it never needs to be
+stored. With a ledger key, the ledger asks to sign the message whenever the io key is used.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			spec := wasmUtils.IOKeySpec{Source: wasmUtils.IOKeySourceDerived, KeyName: args[0]}
+			_, pubkey, err := wasmUtils.WASMContext{CLIContext: clientCtx}.LoadIOKey(spec, false)
+			if err != nil {
+				return fmt.Errorf("failed to derive the io key: %w", err)
+			}
+
+			if err := useIOKey(clientCtx.HomeDir, spec); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "io key derived from %s: %s\n", args[0], hex.EncodeToString(pubkey))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func MigrateIOKeyCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "migrate [key-name]",
+		Short: "Encrypt with an io key kept in the keyring instead of id_tx_io.json",
+		Long: `Generate a key in the keyring under the given name, and encrypt with the io key derived from it from now on.
+The keyring only holds signing keys, so the io key of id_tx_io.json isn't moved into it. The file is kept and still
+tried when decrypting past txs; it can be removed once they no longer need to be decrypted.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+			if clientCtx.Keyring == nil {
+				return fmt.Errorf("no keyring is configured")
+			}
+
+			_, pubkey, err := wasmUtils.NewKeyringIOKey(clientCtx.Keyring, args[0])
+			if err != nil {
+				return err
+			}
+
+			spec := wasmUtils.IOKeySpec{Source: wasmUtils.IOKeySourceKeyring, KeyName: args[0]}
+			if err := useIOKey(clientCtx.HomeDir, spec); err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "io key kept in the keyring as %s: %s\n", args[0], hex.EncodeToString(pubkey))
+			return nil
+		},
+	}
+
+	return cmd
+}
+
+func useIOKey(homeDir string, spec wasmUtils.IOKeySpec) error {
+	config, err := wasmUtils.LoadIOKeyConfig(homeDir)
+	if err != nil {
+		return err
+	}
+	config.Use(spec)
+	return wasmUtils.SaveIOKeyConfig(homeDir, config)
+}
@@ -1,7 +1,6 @@
 package cli
 
 import (
-	"bytes"
 	"context"
 	"encoding/base64"
 	"encoding/hex"
@@ -28,6 +27,7 @@ import (
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	wasmUtils "github.com/scrtlabs/SecretNetwork/x/compute/client/utils"
+	"github.com/scrtlabs/SecretNetwork/x/compute/encryption"
 )
 
 func GetQueryCmd() *cobra.Command {
@@ -54,6 +54,8 @@ func GetQueryCmd() *cobra.Command {
 		GetCmdGetContractHistory(),
 		GetCmdQueryAuthorizedMigration(),
 		GetCmdQueryAuthorizedAdminUpdate(),
+		GetCmdQueryContractStorageStats(),
+		GetCmdQueryLargestContractsByStorage(),
 	)
 	return queryCmd
 }
@@ -145,19 +147,14 @@ func GetCmdDecryptText() *cobra.Command {
 				return fmt.Errorf("error while trying to decode the encrypted output data from base64: %w", err)
 			}
 
-			nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(dataCipherBz)
+			nonce, originalTxSenderPubkey, ciphertextInput, err := encryption.ParseEncryptedBlob(dataCipherBz)
 			if err != nil {
 				return fmt.Errorf("error while parsing encrypted blob: %w", err)
 			}
 
-			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
-			_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
+			wasmCtx, err := wasmUtils.WASMContext{CLIContext: clientCtx}.WithTxSenderPubKey(originalTxSenderPubkey)
 			if err != nil {
-				return fmt.Errorf("error while getting tx sender key pair: %w", err)
-			}
-
-			if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
-				return fmt.Errorf("cannot decrypt, not original tx sender")
+				return err
 			}
 
 			dataPlaintextB64Bz, err := wasmCtx.Decrypt(ciphertextInput, nonce)
@@ -475,10 +472,6 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 			txInputs := result.GetTx().GetMsgs()
 
 			wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
-			_, myPubkey, err := wasmCtx.GetTxSenderKeyPair()
-			if err != nil {
-				return fmt.Errorf("error in GetTxSenderKeyPair: %w", err)
-			}
 
 			answers := types.DecryptedAnswers{
 				Answers:        make([]*types.DecryptedAnswer, len(txInputs)),
@@ -487,6 +480,9 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 				PlaintextError: "",
 			}
 			nonces := make([][]byte, len(txInputs))
+			// each message is decrypted with the key it was sent with
+			msgCtxs := make([]wasmUtils.WASMContext, len(txInputs))
+			var keyPairs []wasmUtils.IOKeyPair
 
 			for i, tx := range txInputs {
 				var encryptedInput []byte
@@ -506,18 +502,26 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 				}
 
 				if encryptedInput != nil {
-					nonce, originalTxSenderPubkey, ciphertextInput, err := parseEncryptedBlob(encryptedInput)
+					nonce, originalTxSenderPubkey, ciphertextInput, err := encryption.ParseEncryptedBlob(encryptedInput)
 					if err != nil {
 						return fmt.Errorf("can't parse encrypted blob: %w", err)
 					}
 
-					if !bytes.Equal(originalTxSenderPubkey, myPubkey) {
-						return fmt.Errorf("cannot decrypt, not original tx sender")
+					// the tx may have been sent with any of the known keys, which are only loaded once
+					if keyPairs == nil {
+						keyPairs, err = wasmCtx.TxSenderKeyPairs()
+						if err != nil {
+							return err
+						}
+					}
+					msgCtxs[i], err = wasmCtx.WithTxSenderKeyPairs(keyPairs, originalTxSenderPubkey)
+					if err != nil {
+						return err
 					}
 
 					var plaintextInput []byte
 					if len(ciphertextInput) > 0 {
-						plaintextInput, err = wasmCtx.Decrypt(ciphertextInput, nonce)
+						plaintextInput, err = msgCtxs[i].Decrypt(ciphertextInput, nonce)
 						if err != nil {
 							return fmt.Errorf("error while trying to decrypt the tx input: %w", err)
 						}
@@ -542,7 +546,7 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 				}
 
 				for i, msgData := range txData.MsgResponses {
-					if len(msgData.Value) != 0 {
+					if i < len(nonces) && nonces[i] != nil && len(msgData.Value) != 0 {
 						var dataField []byte
 						switch {
 						case msgData.TypeUrl == "/secret.compute.v1beta1.MsgInstantiateContractResponse":
@@ -565,7 +569,7 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 							continue
 						}
 
-						dataPlaintextB64Bz, err := wasmCtx.Decrypt(dataField, nonces[i])
+						dataPlaintextB64Bz, err := msgCtxs[i].Decrypt(dataField, nonces[i])
 						if err != nil {
 							continue
 						}
@@ -584,54 +588,30 @@ func GetQueryDecryptTxCmd() *cobra.Command {
 
 			// decrypt logs
 			answers.OutputLogs = []sdk.StringEvent{}
-			for _, e := range result.Events {
+			events := result.Events
+			for i, nonce := range nonces {
+				if nonce == nil {
+					continue
+				}
+				// the attributes that don't decrypt with this message's key are left for the next messages
+				encryptor, err := msgCtxs[i].Encryptor()
+				if err != nil {
+					return err
+				}
+				events = encryptor.DecryptEvents(events, [][]byte{nonce})
+			}
+			for _, e := range events {
 				if e.Type == "wasm" {
-					for i, a := range e.Attributes {
-						if a.Key != "contract_address" {
-							// key
-							if a.Key != "" {
-								// Try to decrypt the log key. If it doesn't look encrypted, leave it as-is
-								keyCiphertext, err := base64.StdEncoding.DecodeString(a.Key)
-								if err != nil {
-									continue
-								}
-
-								for _, nonce := range nonces {
-									keyPlaintext, err := wasmCtx.Decrypt(keyCiphertext, nonce)
-									if err != nil {
-										continue
-									}
-									a.Key = string(keyPlaintext)
-									break
-								}
-							}
-
-							// value
-							if a.Value != "" {
-								// Try to decrypt the log value. If it doesn't look encrypted, leave it as-is
-								valueCiphertext, err := base64.StdEncoding.DecodeString(a.Value)
-								if err != nil {
-									continue
-								}
-								for _, nonce := range nonces {
-									valuePlaintext, err := wasmCtx.Decrypt(valueCiphertext, nonce)
-									if err != nil {
-										continue
-									}
-									a.Value = string(valuePlaintext)
-									break
-								}
-							}
-							e.Attributes[i] = a
-						}
-					}
 					answers.OutputLogs = append(answers.OutputLogs, sdk.StringifyEvent(e))
 				}
 			}
 
 			if types.IsEncryptedErrorCode(result.Code) && types.ContainsEncryptedString(result.RawLog) {
 				for i, nonce := range nonces {
-					stdErr, err := wasmCtx.DecryptError(result.RawLog, nonce)
+					if nonce == nil {
+						continue
+					}
+					stdErr, err := msgCtxs[i].DecryptError(result.RawLog, nonce)
 					if err != nil {
 						continue
 					}
@@ -717,6 +697,71 @@ Examples:
 	return cmd
 }
 
+func GetCmdQueryContractStorageStats() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "storage-stats [contract-address]",
+		Short: "Query the key count and total bytes held by a contract",
+		Long: `Query the key count and total bytes held by a contract.
+
+Examples:
+  secretcli query compute storage-stats secret1sscrt123...`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.ContractStorageStats(context.Background(), &types.QueryByContractAddressRequest{
+				ContractAddress: args[0],
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+func GetCmdQueryLargestContractsByStorage() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "largest-contracts [limit]",
+		Short: "Query the contracts holding the most state, largest first",
+		Long: `Query the contracts holding the most state, largest first. Limit defaults to and is capped at 100.
+
+Examples:
+  secretcli query compute largest-contracts 10`,
+		Args: cobra.MaximumNArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+			queryClient := types.NewQueryClient(clientCtx)
+
+			var limit uint64
+			if len(args) == 1 {
+				var err error
+				limit, err = strconv.ParseUint(args[0], 10, 32)
+				if err != nil {
+					return err
+				}
+			}
+
+			res, err := queryClient.LargestContractsByStorage(context.Background(), &types.QueryLargestContractsByStorageRequest{
+				Limit: uint32(limit),
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
 func QueryWithData(contractAddress sdk.AccAddress, queryData []byte, clientCtx client.Context) error {
 	wasmCtx := wasmUtils.WASMContext{CLIContext: clientCtx}
 
@@ -734,7 +779,7 @@ func QueryWithData(contractAddress sdk.AccAddress, queryData []byte, clientCtx c
 	if err != nil {
 		return err
 	}
-	nonce, _, _, _ := parseEncryptedBlob(queryData)
+	nonce, _, _, _ := encryption.ParseEncryptedBlob(queryData)
 
 	queryClient := types.NewQueryClient(clientCtx)
 	res, err := queryClient.QuerySecretContract(
@@ -0,0 +1,366 @@
+package utils
+
+import (
+	"bytes"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+
+	"github.com/cosmos/cosmos-sdk/crypto/hd"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/secp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+)
+
+const (
+	// LegacyIOKeyFile holds the key pair in plaintext, which is what the CLI used before the key could be kept in
+	// the keyring
+	LegacyIOKeyFile = "id_tx_io.json"
+	// IOKeyConfigFile records where the tx io key is kept
+	IOKeyConfigFile = "io_key.json"
+
+	// IOKeySourceFile is a key pair stored in LegacyIOKeyFile
+	IOKeySourceFile = "file"
+	// IOKeySourceKeyring is a private key derived from a key that was generated for it in the keyring
+	IOKeySourceKeyring = "keyring"
+	// IOKeySourceDerived is a private key derived from the signature of a keyring key over a fixed message, so it
+	// never needs to be stored and works with ledger keys
+	IOKeySourceDerived = "derived"
+)
+
+// ioKeyDerivationData is the message signed to derive a tx io key. Changing it changes every derived key
+const ioKeyDerivationData = "Secret Network transaction io key v1"
+
+// keyringIOKeyInfo separates the tx io key from the keyring key it's derived from, so the keyring key can't be
+// used to decrypt, and signing with it doesn't reveal the io key
+const keyringIOKeyInfo = "Secret Network keyring transaction io key v1"
+
+// IOKeyPair is a tx io key pair
+type IOKeyPair struct {
+	PrivKey []byte
+	PubKey  []byte
+}
+
+// IOKeySpec is a tx io key
+type IOKeySpec struct {
+	Source  string `json:"source"`
+	KeyName string `json:"key_name,omitempty"`
+}
+
+func (s IOKeySpec) String() string {
+	if s.Source == IOKeySourceFile {
+		return s.Source
+	}
+	return fmt.Sprintf("%s:%s", s.Source, s.KeyName)
+}
+
+// IOKeyConfig is the tx io key used to encrypt, and the keys used before it, which are still tried when decrypting
+type IOKeyConfig struct {
+	Current  IOKeySpec   `json:"current"`
+	Previous []IOKeySpec `json:"previous,omitempty"`
+}
+
+// Use makes the key current, and keeps the current key to decrypt old txs
+func (c *IOKeyConfig) Use(spec IOKeySpec) {
+	if c.Current == spec {
+		return
+	}
+	previous := []IOKeySpec{c.Current}
+	for _, p := range c.Previous {
+		if p != spec && p != c.Current {
+			previous = append(previous, p)
+		}
+	}
+	c.Current = spec
+	c.Previous = previous
+}
+
+// LoadIOKeyConfig reads the io key config of the home dir, which defaults to the legacy key file
+func LoadIOKeyConfig(homeDir string) (IOKeyConfig, error) {
+	config := IOKeyConfig{Current: IOKeySpec{Source: IOKeySourceFile}}
+
+	bz, err := os.ReadFile(filepath.Join(homeDir, IOKeyConfigFile))
+	if os.IsNotExist(err) {
+		return config, nil
+	}
+	if err != nil {
+		return config, err
+	}
+
+	err = json.Unmarshal(bz, &config)
+	return config, err
+}
+
+// SaveIOKeyConfig writes the io key config of the home dir
+func SaveIOKeyConfig(homeDir string, config IOKeyConfig) error {
+	bz, err := json.MarshalIndent(config, "", "    ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(filepath.Join(homeDir, IOKeyConfigFile), bz, 0o600)
+}
+
+// LoadIOKey returns the key pair of the spec. The legacy key file is created if it doesn't exist and create is set
+func (ctx WASMContext) LoadIOKey(spec IOKeySpec, create bool) (privkey []byte, pubkey []byte, err error) {
+	switch spec.Source {
+	case IOKeySourceFile:
+		path := filepath.Join(ctx.CLIContext.HomeDir, LegacyIOKeyFile)
+		if _, err := os.Stat(path); os.IsNotExist(err) && !create {
+			return nil, nil, fmt.Errorf("%s doesn't exist", path)
+		}
+		return readOrCreateKeyPairFile(path)
+	case IOKeySourceKeyring:
+		if ctx.CLIContext.Keyring == nil {
+			return nil, nil, errors.New("no keyring is configured")
+		}
+		return ReadKeyringIOKey(ctx.CLIContext.Keyring, spec.KeyName)
+	case IOKeySourceDerived:
+		if ctx.CLIContext.Keyring == nil {
+			return nil, nil, errors.New("no keyring is configured")
+		}
+		return DeriveIOKey(ctx.CLIContext.Keyring, spec.KeyName)
+	default:
+		return nil, nil, fmt.Errorf("unknown io key source %s", spec.Source)
+	}
+}
+
+// TxSenderKeyPairs returns the current key pair followed by the others known, to decrypt txs sent before the current
+// key was set. Keys that can't be loaded, such as the keys of a disconnected ledger, are skipped
+func (ctx WASMContext) TxSenderKeyPairs() ([]IOKeyPair, error) {
+	if len(ctx.TestKeyPairPath) > 0 || ctx.txSenderKey != nil {
+		privkey, pubkey, err := ctx.GetTxSenderKeyPair()
+		if err != nil {
+			return nil, err
+		}
+		return []IOKeyPair{{PrivKey: privkey, PubKey: pubkey}}, nil
+	}
+
+	config, err := LoadIOKeyConfig(ctx.CLIContext.HomeDir)
+	if err != nil {
+		return nil, err
+	}
+	specs := append([]IOKeySpec{config.Current}, config.Previous...)
+	specs = append(specs, IOKeySpec{Source: IOKeySourceFile})
+
+	var keyPairs []IOKeyPair
+	var lastErr error
+	for _, spec := range specs {
+		privkey, pubkey, err := ctx.LoadIOKey(spec, false)
+		if err != nil {
+			lastErr = err
+			continue
+		}
+		duplicate := false
+		for _, pair := range keyPairs {
+			duplicate = duplicate || bytes.Equal(pair.PubKey, pubkey)
+		}
+		if !duplicate {
+			keyPairs = append(keyPairs, IOKeyPair{PrivKey: privkey, PubKey: pubkey})
+		}
+	}
+
+	if len(keyPairs) == 0 {
+		return nil, fmt.Errorf("no tx io key could be loaded: %w", lastErr)
+	}
+	return keyPairs, nil
+}
+
+// WithTxSenderPubKey returns a context that encrypts and decrypts with the known key of the public key, which is how
+// the txs of a previous key are decrypted
+func (ctx WASMContext) WithTxSenderPubKey(pubkey []byte) (WASMContext, error) {
+	keyPairs, err := ctx.TxSenderKeyPairs()
+	if err != nil {
+		return ctx, err
+	}
+	return ctx.WithTxSenderKeyPairs(keyPairs, pubkey)
+}
+
+// WithTxSenderKeyPairs is WithTxSenderPubKey with the key pairs returned by TxSenderKeyPairs, so they're only loaded
+// once when decrypting several messages
+func (ctx WASMContext) WithTxSenderKeyPairs(keyPairs []IOKeyPair, pubkey []byte) (WASMContext, error) {
+	for i := range keyPairs {
+		if bytes.Equal(keyPairs[i].PubKey, pubkey) {
+			ctx.txSenderKey = &keyPairs[i]
+			return ctx, nil
+		}
+	}
+	return ctx, fmt.Errorf("cannot decrypt, not original tx sender")
+}
+
+// DeriveIOKey derives a tx io key from the signature of a keyring key over a fixed sign doc. Keyring keys sign
+// deterministically, so the same key is derived every time, including from a ledger
+func DeriveIOKey(kr keyring.Keyring, name string) (privkey []byte, pubkey []byte, err error) {
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	addr, err := record.GetAddress()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	// ledgers only sign amino json sign docs
+	signature, _, err := kr.Sign(name, ioKeySignDoc(addr), signing.SignMode_SIGN_MODE_LEGACY_AMINO_JSON)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return ioKeyFromSecret(signature, ioKeyDerivationData)
+}
+
+// ioKeyFromSecret derives a tx io key pair from a secret with HKDF
+func ioKeyFromSecret(secret []byte, info string) (privkey []byte, pubkey []byte, err error) {
+	privkey = make([]byte, 32)
+	kdf := hkdf.New(sha256.New, secret, nil, []byte(info))
+	if _, err := io.ReadFull(kdf, privkey); err != nil {
+		return nil, nil, err
+	}
+
+	pubkey, err = curve25519.X25519(privkey, curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	return privkey, pubkey, nil
+}
+
+// ioKeySignDoc is an ADR-036 off-chain sign doc, which can't be mistaken for a tx
+func ioKeySignDoc(signer sdk.AccAddress) []byte {
+	type signData struct {
+		Data   string `json:"data"`
+		Signer string `json:"signer"`
+	}
+	type msg struct {
+		Type  string   `json:"type"`
+		Value signData `json:"value"`
+	}
+	type fee struct {
+		Amount []struct{} `json:"amount"`
+		Gas    string     `json:"gas"`
+	}
+	signDoc := struct {
+		AccountNumber string `json:"account_number"`
+		ChainID       string `json:"chain_id"`
+		Fee           fee    `json:"fee"`
+		Memo          string `json:"memo"`
+		Msgs          []msg  `json:"msgs"`
+		Sequence      string `json:"sequence"`
+	}{
+		AccountNumber: "0",
+		Fee:           fee{Amount: []struct{}{}, Gas: "0"},
+		Msgs: []msg{{
+			Type: "sign/MsgSignData",
+			Value: signData{
+				Data:   base64.StdEncoding.EncodeToString([]byte(ioKeyDerivationData)),
+				Signer: signer.String(),
+			},
+		}},
+		Sequence: "0",
+	}
+
+	bz, err := json.Marshal(signDoc)
+	if err != nil {
+		panic(err)
+	}
+	return sdk.MustSortJSON(bz)
+}
+
+// NewKeyringIOKey generates a secp256k1 key in the keyring and returns the tx io key derived from it. The keyring
+// only holds signing keys, so the io key isn't stored as is, which would make it a signing key too
+func NewKeyringIOKey(kr keyring.Keyring, name string) (privkey []byte, pubkey []byte, err error) {
+	if _, err := kr.Key(name); err == nil {
+		return nil, nil, fmt.Errorf("%s already exists in the keyring", name)
+	}
+
+	key := secp256k1.GenPrivKey()
+	if err := kr.ImportPrivKeyHex(name, hex.EncodeToString(key.Bytes()), string(hd.Secp256k1Type)); err != nil {
+		return nil, nil, err
+	}
+	return ReadKeyringIOKey(kr, name)
+}
+
+// ReadKeyringIOKey derives the tx io key of a keyring key created by NewKeyringIOKey
+func ReadKeyringIOKey(kr keyring.Keyring, name string) (privkey []byte, pubkey []byte, err error) {
+	record, err := kr.Key(name)
+	if err != nil {
+		return nil, nil, err
+	}
+	local := record.GetLocal()
+	if local == nil || local.PrivKey == nil {
+		return nil, nil, fmt.Errorf("%s is not a local key", name)
+	}
+	key, ok := local.PrivKey.GetCachedValue().(cryptotypes.PrivKey)
+	if !ok {
+		return nil, nil, fmt.Errorf("can't read the private key of %s", name)
+	}
+
+	return ioKeyFromSecret(key.Bytes(), keyringIOKeyInfo)
+}
+
+func readOrCreateKeyPairFile(keyPairFilePath string) (privkey []byte, pubkey []byte, er error) {
+	if _, err := os.Stat(keyPairFilePath); os.IsNotExist(err) {
+		var privkey [32]byte
+		rand.Read(privkey[:]) //nolint:errcheck
+
+		var pubkey [32]byte
+		curve25519.ScalarBaseMult(&pubkey, &privkey)
+
+		keyPair := keyPair{
+			Private: hex.EncodeToString(privkey[:]),
+			Public:  hex.EncodeToString(pubkey[:]),
+		}
+
+		keyPairJSONBytes, err := json.MarshalIndent(keyPair, "", "    ")
+		if err != nil {
+			return nil, nil, err
+		}
+
+		err = os.WriteFile(keyPairFilePath, keyPairJSONBytes, 0o600)
+		if err != nil {
+			return nil, nil, err
+		}
+
+		return privkey[:], pubkey[:], nil
+	}
+
+	keyPairJSONBytes, err := os.ReadFile(keyPairFilePath)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var keyPair keyPair
+
+	err = json.Unmarshal(keyPairJSONBytes, &keyPair)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	privkey, err = hex.DecodeString(keyPair.Private)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubkey, err = hex.DecodeString(keyPair.Public)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	derived, err := curve25519.X25519(privkey, curve25519.Basepoint)
+	if err != nil {
+		return nil, nil, err
+	}
+	if !bytes.Equal(derived, pubkey) {
+		return nil, nil, fmt.Errorf("the public key in %s doesn't match its private key", keyPairFilePath)
+	}
+
+	return privkey, pubkey, nil
+}
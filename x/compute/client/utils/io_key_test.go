@@ -0,0 +1,95 @@
+package utils
+
+import (
+	"testing"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	cryptocodec "github.com/cosmos/cosmos-sdk/crypto/codec"
+	"github.com/cosmos/cosmos-sdk/crypto/keyring"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	"github.com/stretchr/testify/require"
+)
+
+func TestIOKeyConfigUse(t *testing.T) {
+	file := IOKeySpec{Source: IOKeySourceFile}
+	keyring := IOKeySpec{Source: IOKeySourceKeyring, KeyName: "io"}
+	derived := IOKeySpec{Source: IOKeySourceDerived, KeyName: "ledger"}
+
+	config := IOKeyConfig{Current: file}
+	config.Use(keyring)
+	require.Equal(t, IOKeyConfig{Current: keyring, Previous: []IOKeySpec{file}}, config)
+
+	config.Use(derived)
+	require.Equal(t, IOKeyConfig{Current: derived, Previous: []IOKeySpec{keyring, file}}, config)
+
+	// going back to a previous key doesn't list it twice
+	config.Use(file)
+	require.Equal(t, IOKeyConfig{Current: file, Previous: []IOKeySpec{derived, keyring}}, config)
+}
+
+func TestIOKeyConfigSaveLoad(t *testing.T) {
+	home := t.TempDir()
+
+	config, err := LoadIOKeyConfig(home)
+	require.NoError(t, err)
+	require.Equal(t, IOKeyConfig{Current: IOKeySpec{Source: IOKeySourceFile}}, config)
+
+	config.Use(IOKeySpec{Source: IOKeySourceDerived, KeyName: "ledger"})
+	require.NoError(t, SaveIOKeyConfig(home, config))
+
+	loaded, err := LoadIOKeyConfig(home)
+	require.NoError(t, err)
+	require.Equal(t, config, loaded)
+}
+
+func TestTxSenderKeyPairs(t *testing.T) {
+	ctx := WASMContext{}
+	ctx.CLIContext.HomeDir = t.TempDir()
+
+	// the legacy file is only created when encrypting
+	_, err := ctx.TxSenderKeyPairs()
+	require.Error(t, err)
+
+	privkey, pubkey, err := ctx.GetTxSenderKeyPair()
+	require.NoError(t, err)
+
+	keyPairs, err := ctx.TxSenderKeyPairs()
+	require.NoError(t, err)
+	require.Equal(t, []IOKeyPair{{PrivKey: privkey, PubKey: pubkey}}, keyPairs)
+
+	pinned, err := ctx.WithTxSenderPubKey(pubkey)
+	require.NoError(t, err)
+	pinnedPriv, _, err := pinned.GetTxSenderKeyPair()
+	require.NoError(t, err)
+	require.Equal(t, privkey, pinnedPriv)
+
+	_, err = ctx.WithTxSenderPubKey(make([]byte, 32))
+	require.Error(t, err)
+}
+
+func TestKeyringIOKey(t *testing.T) {
+	registry := codectypes.NewInterfaceRegistry()
+	cryptocodec.RegisterInterfaces(registry)
+	kr := keyring.NewInMemory(codec.NewProtoCodec(registry))
+
+	privkey, pubkey, err := NewKeyringIOKey(kr, "io")
+	require.NoError(t, err)
+
+	readPriv, readPub, err := ReadKeyringIOKey(kr, "io")
+	require.NoError(t, err)
+	require.Equal(t, privkey, readPriv)
+	require.Equal(t, pubkey, readPub)
+
+	// the io key is derived from the keyring key, not the keyring key itself
+	record, err := kr.Key("io")
+	require.NoError(t, err)
+	require.NotEqual(t, record.GetLocal().PrivKey.GetCachedValue().(cryptotypes.PrivKey).Bytes(), privkey)
+
+	_, _, err = NewKeyringIOKey(kr, "io")
+	require.Error(t, err)
+
+	other, _, err := NewKeyringIOKey(kr, "other")
+	require.NoError(t, err)
+	require.NotEqual(t, privkey, other)
+}
@@ -4,25 +4,17 @@ import (
 	"bytes"
 	"compress/gzip"
 	"crypto/rand"
-	"crypto/sha256"
 	"encoding/base64"
-	"encoding/hex"
 	"encoding/json"
 	"fmt"
-	"io"
 	"log"
 	"os"
-	"path/filepath"
-	"regexp"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/scrtlabs/SecretNetwork/x/compute/encryption"
 	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
 	"google.golang.org/grpc/encoding"
 	"google.golang.org/grpc/encoding/proto"
-
-	"github.com/miscreant/miscreant.go"
-	"golang.org/x/crypto/curve25519"
-	"golang.org/x/crypto/hkdf"
 )
 
 var (
@@ -62,6 +54,8 @@ type WASMContext struct {
 	CLIContext      client.Context
 	TestKeyPairPath string
 	TestMasterIOKey regtypes.MasterKey
+	// txSenderKey is set by WithTxSenderPubKey
+	txSenderKey *IOKeyPair
 }
 
 type keyPair struct {
@@ -71,69 +65,18 @@ type keyPair struct {
 
 // GetTxSenderKeyPair get the local tx encryption id
 func (ctx WASMContext) GetTxSenderKeyPair() (privkey []byte, pubkey []byte, er error) {
-	var keyPairFilePath string
-	if len(ctx.TestKeyPairPath) > 0 {
-		keyPairFilePath = ctx.TestKeyPairPath
-	} else {
-		keyPairFilePath = filepath.Join(ctx.CLIContext.HomeDir, "id_tx_io.json")
-	}
-
-	if _, err := os.Stat(keyPairFilePath); os.IsNotExist(err) {
-		var privkey [32]byte
-		rand.Read(privkey[:]) //nolint:errcheck
-
-		var pubkey [32]byte
-		curve25519.ScalarBaseMult(&pubkey, &privkey)
-
-		keyPair := keyPair{
-			Private: hex.EncodeToString(privkey[:]),
-			Public:  hex.EncodeToString(pubkey[:]),
-		}
-
-		keyPairJSONBytes, err := json.MarshalIndent(keyPair, "", "    ")
-		if err != nil {
-			return nil, nil, err
-		}
-
-		err = os.WriteFile(keyPairFilePath, keyPairJSONBytes, 0o600)
-		if err != nil {
-			return nil, nil, err
-		}
-
-		return privkey[:], pubkey[:], nil
-	}
-
-	keyPairJSONBytes, err := os.ReadFile(keyPairFilePath)
-	if err != nil {
-		return nil, nil, err
+	if ctx.txSenderKey != nil {
+		return ctx.txSenderKey.PrivKey, ctx.txSenderKey.PubKey, nil
 	}
-
-	var keyPair keyPair
-
-	err = json.Unmarshal(keyPairJSONBytes, &keyPair)
-	if err != nil {
-		return nil, nil, err
+	if len(ctx.TestKeyPairPath) > 0 {
+		return readOrCreateKeyPairFile(ctx.TestKeyPairPath)
 	}
 
-	privkey, err = hex.DecodeString(keyPair.Private)
-	if err != nil {
-		return nil, nil, err
-	}
-	pubkey, err = hex.DecodeString(keyPair.Public)
+	config, err := LoadIOKeyConfig(ctx.CLIContext.HomeDir)
 	if err != nil {
 		return nil, nil, err
 	}
-
-	// TODO verify pubkey
-
-	return privkey, pubkey, nil
-}
-
-var hkdfSalt = []byte{
-	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
-	0x00, 0x02, 0x4b, 0xea, 0xd8, 0xdf, 0x69, 0x99,
-	0x08, 0x52, 0xc2, 0x02, 0xdb, 0x0e, 0x00, 0x97,
-	0xc1, 0xa1, 0x2e, 0xa6, 0x37, 0xd7, 0xe9, 0x6d,
+	return ctx.LoadIOKey(config.Current, true)
 }
 
 func (ctx WASMContext) getConsensusIoPubKey() ([]byte, error) {
@@ -162,20 +105,28 @@ func (ctx WASMContext) getTxEncryptionKey(txSenderPrivKey []byte, nonce []byte)
 		return nil, err
 	}
 
-	txEncryptionIkm, err := curve25519.X25519(txSenderPrivKey, consensusIoPubKeyBytes)
+	txEncryptionKey, err := encryption.TxEncryptionKey(consensusIoPubKeyBytes, txSenderPrivKey, nonce)
 	if err != nil {
 		fmt.Println("Failed to get tx encryption key")
 		return nil, err
 	}
 
-	kdfFunc := hkdf.New(sha256.New, append(txEncryptionIkm, nonce...), hkdfSalt, []byte{})
+	return txEncryptionKey, nil
+}
 
-	txEncryptionKey := make([]byte, 32)
-	if _, err := io.ReadFull(kdfFunc, txEncryptionKey); err != nil {
+// Encryptor returns an encryption.Encryptor of the tx sender key pair, for the consensus IO key of the chain
+func (ctx WASMContext) Encryptor() (*encryption.Encryptor, error) {
+	txSenderPrivKey, txSenderPubKey, err := ctx.GetTxSenderKeyPair()
+	if err != nil {
 		return nil, err
 	}
 
-	return txEncryptionKey, nil
+	consensusIoPubKeyBytes, err := ctx.getConsensusIoPubKey()
+	if err != nil {
+		return nil, err
+	}
+
+	return encryption.NewEncryptor(encryption.KeyPair{PrivKey: txSenderPrivKey, PubKey: txSenderPubKey}, consensusIoPubKeyBytes)
 }
 
 func (ctx WASMContext) OfflineEncrypt(plaintext []byte, pathToMasterIoKey string) ([]byte, error) {
@@ -208,7 +159,7 @@ func (ctx WASMContext) OfflineEncrypt(plaintext []byte, pathToMasterIoKey string
 		return nil, err
 	}
 
-	return encryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
+	return encryption.Seal(txEncryptionKey, txSenderPubKey, nonce, plaintext)
 }
 
 // Encrypt encrypts
@@ -232,7 +183,7 @@ func (ctx WASMContext) Encrypt(plaintext []byte) ([]byte, error) {
 		return nil, err
 	}
 
-	return encryptData(txEncryptionKey, txSenderPubKey, plaintext, nonce)
+	return encryption.Seal(txEncryptionKey, txSenderPubKey, nonce, plaintext)
 }
 
 // Decrypt decrypts
@@ -251,26 +202,13 @@ func (ctx WASMContext) Decrypt(ciphertext []byte, nonce []byte) ([]byte, error)
 		return nil, err
 	}
 
-	cipher, err := miscreant.NewAESCMACSIV(txEncryptionKey)
-	if err != nil {
-		return nil, err
-	}
-
-	return cipher.Open(nil, ciphertext, []byte{})
+	return encryption.Open(txEncryptionKey, ciphertext)
 }
 
-var re = regexp.MustCompile("encrypted: (.+?):")
-
 func (ctx WASMContext) DecryptError(errString string, nonce []byte) (json.RawMessage, error) {
-	regexMatch := re.FindStringSubmatch(errString)
-	if len(regexMatch) != 2 {
-		return nil, fmt.Errorf("got an error finding base64 of the error: regexMatch '%v' should have a length of 2. error: %v", regexMatch, errString)
-	}
-	errorCipherB64 := regexMatch[1]
-
-	errorCipherBz, err := base64.StdEncoding.DecodeString(errorCipherB64)
+	errorCipherBz, err := encryption.EncryptedErrorCiphertext(errString)
 	if err != nil {
-		return nil, fmt.Errorf("got an error decoding base64 of the error: %w", err)
+		return nil, err
 	}
 
 	errorPlainBz, err := ctx.Decrypt(errorCipherBz, nonce)
@@ -281,37 +219,7 @@ func (ctx WASMContext) DecryptError(errString string, nonce []byte) (json.RawMes
 	return errorPlainBz, nil
 }
 
-func encryptData(aesEncryptionKey []byte, txSenderPubKey []byte, plaintext []byte, nonce []byte) ([]byte, error) {
-	cipher, err := miscreant.NewAESCMACSIV(aesEncryptionKey)
-	if err != nil {
-		log.Println(err)
-		return nil, err
-	}
-
-	ciphertext, err := cipher.Seal(nil, plaintext, []byte{})
-	if err != nil {
-		log.Println(err)
-		return nil, err
-	}
-
-	// ciphertext = nonce(32) || wallet_pubkey(32) || ciphertext
-	ciphertext = append(nonce, append(txSenderPubKey, ciphertext...)...)
-
-	return ciphertext, nil
-}
-
+// Deprecated: use encryption.TxEncryptionKey
 func GetTxEncryptionKeyOffline(pubkey []byte, txSenderPrivKey []byte, nonce []byte) ([]byte, error) {
-	txEncryptionIkm, err := curve25519.X25519(txSenderPrivKey, pubkey)
-	if err != nil {
-		return nil, err
-	}
-
-	kdfFunc := hkdf.New(sha256.New, append(txEncryptionIkm, nonce...), hkdfSalt, []byte{})
-
-	txEncryptionKey := make([]byte, 32)
-	if _, err := io.ReadFull(kdfFunc, txEncryptionKey); err != nil {
-		return nil, err
-	}
-
-	return txEncryptionKey, nil
+	return encryption.TxEncryptionKey(pubkey, txSenderPrivKey, nonce)
 }
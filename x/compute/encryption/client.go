@@ -0,0 +1,135 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"sync"
+
+	"github.com/cosmos/gogoproto/grpc"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"google.golang.org/protobuf/types/known/emptypb"
+
+	cosmwasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// txKeyMethod is the registration query of the consensus IO key. It's invoked directly, so the package doesn't
+// depend on the registration module
+const txKeyMethod = "/secret.registration.v1beta1.Query/TxKey"
+
+// txKeyResponse is the wire format of the secret.registration.v1beta1.Key returned by txKeyMethod
+type txKeyResponse struct {
+	Key []byte `protobuf:"bytes,1,opt,name=key,proto3" json:"key"`
+}
+
+func (m *txKeyResponse) Reset()         { *m = txKeyResponse{} }
+func (m *txKeyResponse) String() string { return gogoproto.CompactTextString(m) }
+func (*txKeyResponse) ProtoMessage()    {}
+
+// Client does encrypted queries to secret contracts over gRPC
+type Client struct {
+	keyPair     KeyPair
+	conn        grpc.ClientConn
+	queryClient types.QueryClient
+
+	mu        sync.Mutex
+	encryptor *Encryptor
+}
+
+// NewClient returns a Client that encrypts queries with the key pair. The connection is usually a *grpc.ClientConn
+// to the gRPC endpoint of a node
+func NewClient(conn grpc.ClientConn, keyPair KeyPair) *Client {
+	return &Client{
+		keyPair:     keyPair,
+		conn:        conn,
+		queryClient: types.NewQueryClient(conn),
+	}
+}
+
+// Encryptor returns the Encryptor of the client's key pair, querying the consensus IO key the first time
+func (c *Client) Encryptor(ctx context.Context) (*Encryptor, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	if c.encryptor != nil {
+		return c.encryptor, nil
+	}
+
+	var txKey txKeyResponse
+	if err := c.conn.Invoke(ctx, txKeyMethod, &emptypb.Empty{}, &txKey); err != nil {
+		return nil, err
+	}
+	encryptor, err := NewEncryptor(c.keyPair, txKey.Key)
+	if err != nil {
+		return nil, err
+	}
+
+	c.encryptor = encryptor
+	return encryptor, nil
+}
+
+// CodeHash returns the hex encoded code hash of a contract, which messages to it are encrypted with
+func (c *Client) CodeHash(ctx context.Context, contractAddress string) (string, error) {
+	res, err := c.queryClient.CodeHashByContractAddress(ctx, &types.QueryByContractAddressRequest{
+		ContractAddress: contractAddress,
+	})
+	if err != nil {
+		return "", err
+	}
+	return res.CodeHash, nil
+}
+
+// QueryContract does a smart query to a contract and returns its decrypted json response.
+// The error a contract returns is decrypted into a *QueryError
+func (c *Client) QueryContract(ctx context.Context, contractAddress string, query []byte) ([]byte, error) {
+	encryptor, err := c.Encryptor(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	// the code hash can change when the contract is migrated, so it isn't cached
+	codeHash, err := c.CodeHash(ctx, contractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	encryptedQuery, err := encryptor.EncryptMsg(codeHash, query)
+	if err != nil {
+		return nil, err
+	}
+	nonce := encryptedQuery[:NonceLen]
+
+	res, err := c.queryClient.QuerySecretContract(ctx, &types.QuerySecretContractRequest{
+		ContractAddress: contractAddress,
+		Query:           encryptedQuery,
+	})
+	if err != nil {
+		if types.ErrContainsQueryError(err) {
+			errorPlainBz, decryptErr := encryptor.DecryptError(err.Error(), nonce)
+			if decryptErr == nil {
+				return nil, &QueryError{Plaintext: errorPlainBz}
+			}
+		}
+		return nil, err
+	}
+
+	resDecrypted, err := encryptor.Decrypt(res.Data, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return base64.StdEncoding.DecodeString(string(resDecrypted))
+}
+
+// QueryError is the decrypted error a contract returned for a query
+type QueryError struct {
+	Plaintext json.RawMessage
+}
+
+func (e *QueryError) Error() string {
+	var stdErr cosmwasmTypes.StdError
+	if err := json.Unmarshal(e.Plaintext, &stdErr); err != nil {
+		return "query result: " + string(e.Plaintext)
+	}
+	return "query result: " + stdErr.Error()
+}
@@ -0,0 +1,272 @@
+// Package encryption implements the encryption of the inputs and outputs of secret contracts, without depending on
+// the CLI. Contract messages are encrypted with a key shared by the sender's Curve25519 key pair and the consensus
+// IO key, and each message is bound to a random nonce that the outputs it produces are encrypted with.
+package encryption
+
+import (
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"regexp"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/miscreant/miscreant.go"
+	"golang.org/x/crypto/curve25519"
+	"golang.org/x/crypto/hkdf"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+const (
+	// NonceLen is the length of the nonce an encrypted message starts with
+	NonceLen = 32
+	// PubKeyLen is the length of the sender's public key, which follows the nonce in an encrypted message
+	PubKeyLen = 32
+)
+
+var hkdfSalt = []byte{
+	0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00, 0x00,
+	0x00, 0x02, 0x4b, 0xea, 0xd8, 0xdf, 0x69, 0x99,
+	0x08, 0x52, 0xc2, 0x02, 0xdb, 0x0e, 0x00, 0x97,
+	0xc1, 0xa1, 0x2e, 0xa6, 0x37, 0xd7, 0xe9, 0x6d,
+}
+
+var encryptedErrorRegex = regexp.MustCompile("encrypted: (.+?):")
+
+// KeyPair is the Curve25519 key pair of a tx sender
+type KeyPair struct {
+	PrivKey []byte
+	PubKey  []byte
+}
+
+// GenerateKeyPair returns a random key pair
+func GenerateKeyPair() (KeyPair, error) {
+	privKey := make([]byte, curve25519.ScalarSize)
+	if _, err := rand.Read(privKey); err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPairFromPrivKey(privKey)
+}
+
+// KeyPairFromPrivKey returns the key pair of a private key
+func KeyPairFromPrivKey(privKey []byte) (KeyPair, error) {
+	pubKey, err := curve25519.X25519(privKey, curve25519.Basepoint)
+	if err != nil {
+		return KeyPair{}, err
+	}
+	return KeyPair{PrivKey: privKey, PubKey: pubKey}, nil
+}
+
+// TxEncryptionKey derives the key that a message sent with the nonce, and the outputs it produces, are encrypted with
+func TxEncryptionKey(consensusIoPubKey []byte, txSenderPrivKey []byte, nonce []byte) ([]byte, error) {
+	txEncryptionIkm, err := curve25519.X25519(txSenderPrivKey, consensusIoPubKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ikm := append(append([]byte{}, txEncryptionIkm...), nonce...)
+	kdfFunc := hkdf.New(sha256.New, ikm, hkdfSalt, []byte{})
+
+	txEncryptionKey := make([]byte, 32)
+	if _, err := io.ReadFull(kdfFunc, txEncryptionKey); err != nil {
+		return nil, err
+	}
+
+	return txEncryptionKey, nil
+}
+
+// Seal encrypts a message with the tx encryption key of the nonce.
+// The output format is: nonce(32 bytes) || tx_sender_pubkey(32 bytes) || ciphertext
+func Seal(txEncryptionKey []byte, txSenderPubKey []byte, nonce []byte, plaintext []byte) ([]byte, error) {
+	cipher, err := miscreant.NewAESCMACSIV(txEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	ciphertext, err := cipher.Seal(nil, plaintext, []byte{})
+	if err != nil {
+		return nil, err
+	}
+
+	sealed := make([]byte, 0, len(nonce)+len(txSenderPubKey)+len(ciphertext))
+	sealed = append(sealed, nonce...)
+	sealed = append(sealed, txSenderPubKey...)
+	return append(sealed, ciphertext...), nil
+}
+
+// Open decrypts a ciphertext encrypted with the tx encryption key, such as an output of a contract
+func Open(txEncryptionKey []byte, ciphertext []byte) ([]byte, error) {
+	if len(ciphertext) == 0 {
+		return []byte{}, nil
+	}
+
+	cipher, err := miscreant.NewAESCMACSIV(txEncryptionKey)
+	if err != nil {
+		return nil, err
+	}
+
+	return cipher.Open(nil, ciphertext, []byte{})
+}
+
+// ParseEncryptedBlob splits a message encrypted by Seal into its nonce, the sender's public key and its ciphertext
+func ParseEncryptedBlob(blob []byte) (nonce []byte, txSenderPubKey []byte, ciphertext []byte, err error) {
+	if len(blob) < NonceLen+PubKeyLen {
+		return nil, nil, nil, fmt.Errorf("input must be > 64 bytes. Got %d", len(blob))
+	}
+
+	return blob[:NonceLen], blob[NonceLen : NonceLen+PubKeyLen], blob[NonceLen+PubKeyLen:], nil
+}
+
+// EncryptedErrorCiphertext extracts the ciphertext of the encrypted error of a contract from an error string
+func EncryptedErrorCiphertext(errString string) ([]byte, error) {
+	regexMatch := encryptedErrorRegex.FindStringSubmatch(errString)
+	if len(regexMatch) != 2 {
+		return nil, fmt.Errorf("got an error finding base64 of the error: regexMatch '%v' should have a length of 2. error: %v", regexMatch, errString)
+	}
+
+	errorCipherBz, err := base64.StdEncoding.DecodeString(regexMatch[1])
+	if err != nil {
+		return nil, fmt.Errorf("got an error decoding base64 of the error: %w", err)
+	}
+	return errorCipherBz, nil
+}
+
+// Encryptor encrypts messages to contracts with a key pair, and decrypts their outputs
+type Encryptor struct {
+	keyPair           KeyPair
+	consensusIoPubKey []byte
+}
+
+// NewEncryptor returns an Encryptor of the key pair, for the chain with the consensus IO public key
+func NewEncryptor(keyPair KeyPair, consensusIoPubKey []byte) (*Encryptor, error) {
+	if len(keyPair.PrivKey) != curve25519.ScalarSize || len(keyPair.PubKey) != PubKeyLen {
+		return nil, fmt.Errorf("invalid tx sender key pair")
+	}
+	if len(consensusIoPubKey) != curve25519.PointSize {
+		return nil, fmt.Errorf("invalid consensus io public key length %d", len(consensusIoPubKey))
+	}
+	return &Encryptor{keyPair: keyPair, consensusIoPubKey: consensusIoPubKey}, nil
+}
+
+// PubKey returns the public key the messages are encrypted for
+func (e *Encryptor) PubKey() []byte {
+	return e.keyPair.PubKey
+}
+
+// Encrypt encrypts a message with a random nonce
+func (e *Encryptor) Encrypt(plaintext []byte) ([]byte, error) {
+	nonce := make([]byte, NonceLen)
+	if _, err := rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	return e.EncryptWithNonce(plaintext, nonce)
+}
+
+// EncryptWithNonce encrypts a message with the given nonce, which must never be reused
+func (e *Encryptor) EncryptWithNonce(plaintext []byte, nonce []byte) ([]byte, error) {
+	if len(nonce) != NonceLen {
+		return nil, fmt.Errorf("invalid nonce length %d", len(nonce))
+	}
+
+	txEncryptionKey, err := TxEncryptionKey(e.consensusIoPubKey, e.keyPair.PrivKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return Seal(txEncryptionKey, e.keyPair.PubKey, nonce, plaintext)
+}
+
+// EncryptMsg encrypts the json message of an instantiate, execute, migrate or query to a contract with the code hash.
+// The code hash is hex encoded, like it's returned by the code hash queries
+func (e *Encryptor) EncryptMsg(codeHash string, msg []byte) ([]byte, error) {
+	return e.Encrypt(types.NewSecretMsg([]byte(codeHash), msg).Serialize())
+}
+
+// Decrypt decrypts an output of a message encrypted with the nonce
+func (e *Encryptor) Decrypt(ciphertext []byte, nonce []byte) ([]byte, error) {
+	if len(ciphertext) == 0 {
+		return []byte{}, nil
+	}
+
+	txEncryptionKey, err := TxEncryptionKey(e.consensusIoPubKey, e.keyPair.PrivKey, nonce)
+	if err != nil {
+		return nil, err
+	}
+	return Open(txEncryptionKey, ciphertext)
+}
+
+// DecryptInput decrypts a message encrypted with the key pair, such as the msg of a MsgExecuteContract.
+// It returns the nonce, which the outputs of the message are encrypted with
+func (e *Encryptor) DecryptInput(blob []byte) (plaintext []byte, nonce []byte, err error) {
+	nonce, txSenderPubKey, ciphertext, err := ParseEncryptedBlob(blob)
+	if err != nil {
+		return nil, nil, err
+	}
+	if string(txSenderPubKey) != string(e.keyPair.PubKey) {
+		return nil, nil, fmt.Errorf("cannot decrypt, not original tx sender")
+	}
+
+	plaintext, err = e.Decrypt(ciphertext, nonce)
+	return plaintext, nonce, err
+}
+
+// DecryptError decrypts the error a contract returned for a message encrypted with the nonce
+func (e *Encryptor) DecryptError(errString string, nonce []byte) (json.RawMessage, error) {
+	errorCipherBz, err := EncryptedErrorCiphertext(errString)
+	if err != nil {
+		return nil, err
+	}
+
+	errorPlainBz, err := e.Decrypt(errorCipherBz, nonce)
+	if err != nil {
+		return nil, fmt.Errorf("got an error decrypting the error: %w", err)
+	}
+
+	return errorPlainBz, nil
+}
+
+// DecryptEvents decrypts the attributes of the wasm events emitted by the messages encrypted with the nonces.
+// Attributes that weren't encrypted with any of the nonces are left as they are
+func (e *Encryptor) DecryptEvents(events []abci.Event, nonces [][]byte) []abci.Event {
+	decrypted := make([]abci.Event, len(events))
+	for i, event := range events {
+		decrypted[i] = event
+		if event.Type != "wasm" {
+			continue
+		}
+
+		attributes := make([]abci.EventAttribute, len(event.Attributes))
+		for j, attribute := range event.Attributes {
+			if attribute.Key != "contract_address" {
+				attribute.Key = e.tryDecryptB64(attribute.Key, nonces)
+				attribute.Value = e.tryDecryptB64(attribute.Value, nonces)
+			}
+			attributes[j] = attribute
+		}
+		decrypted[i].Attributes = attributes
+	}
+	return decrypted
+}
+
+func (e *Encryptor) tryDecryptB64(value string, nonces [][]byte) string {
+	if value == "" {
+		return value
+	}
+
+	ciphertext, err := base64.StdEncoding.DecodeString(value)
+	if err != nil {
+		return value
+	}
+	for _, nonce := range nonces {
+		if nonce == nil {
+			continue
+		}
+		plaintext, err := e.Decrypt(ciphertext, nonce)
+		if err == nil {
+			return string(plaintext)
+		}
+	}
+	return value
+}
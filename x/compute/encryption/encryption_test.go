@@ -0,0 +1,136 @@
+package encryption
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	gogoproto "github.com/cosmos/gogoproto/proto"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
+)
+
+func newTestEncryptor(t *testing.T) (*Encryptor, KeyPair) {
+	consensusIoKey, err := GenerateKeyPair()
+	require.NoError(t, err)
+	keyPair, err := GenerateKeyPair()
+	require.NoError(t, err)
+
+	encryptor, err := NewEncryptor(keyPair, consensusIoKey.PubKey)
+	require.NoError(t, err)
+	return encryptor, consensusIoKey
+}
+
+func TestEncryptDecrypt(t *testing.T) {
+	encryptor, consensusIoKey := newTestEncryptor(t)
+
+	blob, err := encryptor.EncryptMsg("a1b2", []byte(`{"balance":{}}`))
+	require.NoError(t, err)
+
+	nonce, pubKey, ciphertext, err := ParseEncryptedBlob(blob)
+	require.NoError(t, err)
+	require.Equal(t, encryptor.PubKey(), pubKey)
+
+	// the enclave derives the same key from the consensus io private key and the sender's public key
+	txEncryptionKey, err := TxEncryptionKey(pubKey, consensusIoKey.PrivKey, nonce)
+	require.NoError(t, err)
+	plaintext, err := Open(txEncryptionKey, ciphertext)
+	require.NoError(t, err)
+	require.Equal(t, `a1b2{"balance":{}}`, string(plaintext))
+
+	plaintext, inputNonce, err := encryptor.DecryptInput(blob)
+	require.NoError(t, err)
+	require.Equal(t, nonce, inputNonce)
+	require.Equal(t, `a1b2{"balance":{}}`, string(plaintext))
+
+	// outputs are encrypted with the key of the input's nonce
+	output, err := Seal(txEncryptionKey, nil, nil, []byte("output"))
+	require.NoError(t, err)
+	plaintext, err = encryptor.Decrypt(output, nonce)
+	require.NoError(t, err)
+	require.Equal(t, "output", string(plaintext))
+
+	otherEncryptor, _ := newTestEncryptor(t)
+	_, _, err = otherEncryptor.DecryptInput(blob)
+	require.Error(t, err)
+}
+
+func TestDecryptErrorAndEvents(t *testing.T) {
+	encryptor, consensusIoKey := newTestEncryptor(t)
+
+	blob, err := encryptor.Encrypt([]byte("msg"))
+	require.NoError(t, err)
+	nonce, pubKey, _, err := ParseEncryptedBlob(blob)
+	require.NoError(t, err)
+	txEncryptionKey, err := TxEncryptionKey(pubKey, consensusIoKey.PrivKey, nonce)
+	require.NoError(t, err)
+
+	seal := func(plaintext string) string {
+		ciphertext, err := Seal(txEncryptionKey, nil, nil, []byte(plaintext))
+		require.NoError(t, err)
+		return base64.StdEncoding.EncodeToString(ciphertext)
+	}
+
+	errString := fmt.Sprintf("execute contract failed: encrypted: %s: failed to execute message", seal(`{"generic_err":{"msg":"no"}}`))
+	stdErr, err := encryptor.DecryptError(errString, nonce)
+	require.NoError(t, err)
+	require.Equal(t, `{"generic_err":{"msg":"no"}}`, string(stdErr))
+
+	_, err = encryptor.DecryptError("execute contract failed", nonce)
+	require.Error(t, err)
+
+	events := []abci.Event{
+		{Type: "message", Attributes: []abci.EventAttribute{{Key: "action", Value: "execute"}}},
+		{Type: "wasm", Attributes: []abci.EventAttribute{
+			{Key: "contract_address", Value: "secret1contract"},
+			{Key: seal("action"), Value: seal("transfer")},
+			{Key: "plain", Value: "not encrypted"},
+		}},
+	}
+	decrypted := encryptor.DecryptEvents(events, [][]byte{nil, nonce})
+	require.Equal(t, events[0], decrypted[0])
+	require.Equal(t, []abci.EventAttribute{
+		{Key: "contract_address", Value: "secret1contract"},
+		{Key: "action", Value: "transfer"},
+		{Key: "plain", Value: "not encrypted"},
+	}, decrypted[1].Attributes)
+}
+
+// txKeyConn answers the TxKey query with the protobuf encoding of a registration key
+type txKeyConn struct {
+	key []byte
+}
+
+func (c txKeyConn) Invoke(_ context.Context, method string, _, reply interface{}, _ ...grpc.CallOption) error {
+	if method != txKeyMethod {
+		return fmt.Errorf("unexpected method %s", method)
+	}
+	bz, err := gogoproto.Marshal(&regtypes.Key{Key: c.key})
+	if err != nil {
+		return err
+	}
+	return gogoproto.Unmarshal(bz, reply.(gogoproto.Message))
+}
+
+func (txKeyConn) NewStream(context.Context, *grpc.StreamDesc, string, ...grpc.CallOption) (grpc.ClientStream, error) {
+	return nil, fmt.Errorf("no streams")
+}
+
+func TestClientEncryptor(t *testing.T) {
+	consensusIoKey, err := GenerateKeyPair()
+	require.NoError(t, err)
+	keyPair, err := GenerateKeyPair()
+	require.NoError(t, err)
+
+	client := NewClient(txKeyConn{key: consensusIoKey.PubKey}, keyPair)
+	encryptor, err := client.Encryptor(context.Background())
+	require.NoError(t, err)
+
+	expected, err := NewEncryptor(keyPair, consensusIoKey.PubKey)
+	require.NoError(t, err)
+	require.Equal(t, expected, encryptor)
+}
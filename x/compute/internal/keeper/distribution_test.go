@@ -3,10 +3,12 @@ package keeper
 import (
 	"encoding/binary"
 	"encoding/json"
+	"fmt"
 	"os"
 	"testing"
 
 	"cosmossdk.io/math"
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 
 	"github.com/stretchr/testify/assert"
@@ -106,3 +108,70 @@ func TestDistributionRewards(t *testing.T) {
 	// returns the rewards
 	require.Equal(t, uint64(0x59), binary.BigEndian.Uint64(res))
 }
+
+// TestDistQuerierDelegationRewards tests the distribution queries contracts can make, which format their amounts like
+// the rewards query
+func TestDistQuerierDelegationRewards(t *testing.T) {
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, nil, nil)
+	accKeeper, stakingKeeper, keeper, distKeeper := keepers.AccountKeeper, keepers.StakingKeeper, keepers.WasmKeeper, keepers.DistKeeper
+
+	valAddr := addValidator(ctx, stakingKeeper, accKeeper, keeper.bankKeeper, sdk.NewInt64Coin("stake", 100))
+	ctx = nextBlock(ctx, stakingKeeper, keeper)
+
+	delegator, _, _ := CreateFakeFundedAccount(ctx, accKeeper, keeper.bankKeeper, sdk.NewCoins(sdk.NewInt64Coin(sdk.DefaultBondDenom, 5_000_000_000)))
+	delTokens := sdk.TokensFromConsensusPower(1000, sdk.DefaultPowerReduction)
+	_, err := stakingkeeper.NewMsgServerImpl(&stakingKeeper).Delegate(ctx, stakingtypes.NewMsgDelegate(delegator.String(), valAddr.String(), sdk.NewCoin(sdk.DefaultBondDenom, delTokens)))
+	require.NoError(t, err)
+
+	v, err := stakingKeeper.GetValidator(ctx, valAddr)
+	require.NoError(t, err)
+	// a fraction of a token is allocated, which must not show in the responses
+	require.NoError(t, distKeeper.AllocateTokensToValidator(ctx, v, sdk.NewDecCoins(sdk.NewDecCoinFromDec("stake", math.LegacyNewDecWithPrec(1005, 1)))))
+
+	querier := DistQuerier(distKeeper)
+
+	res, err := querier(ctx, &wasmTypes.DistQuery{
+		DelegatorWithdrawAddress: &wasmTypes.DelegatorWithdrawAddressQuery{DelegatorAddress: delegator.String()},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, fmt.Sprintf(`{"withdraw_address":"%s"}`, delegator), string(res))
+
+	res, err = querier(ctx, &wasmTypes.DistQuery{
+		DelegationRewards: &wasmTypes.DelegationRewardsQuery{DelegatorAddress: delegator.String(), ValidatorAddress: valAddr.String()},
+	})
+	require.NoError(t, err)
+	var rewards wasmTypes.DelegationRewardsResponse
+	require.NoError(t, json.Unmarshal(res, &rewards))
+	require.Len(t, rewards.Rewards, 1)
+	require.Equal(t, "stake", rewards.Rewards[0].Denom)
+	require.NotContains(t, rewards.Rewards[0].Amount, ".")
+	require.NotEqual(t, "0", rewards.Rewards[0].Amount)
+
+	res, err = querier(ctx, &wasmTypes.DistQuery{
+		DelegationTotalRewards: &wasmTypes.DelegationTotalRewardsQuery{DelegatorAddress: delegator.String()},
+	})
+	require.NoError(t, err)
+	var totalRewards wasmTypes.DelegationTotalRewardsResponse
+	require.NoError(t, json.Unmarshal(res, &totalRewards))
+	require.Equal(t, []wasmTypes.Rewards{{Validator: valAddr.String(), Reward: rewards.Rewards}}, totalRewards.Rewards)
+	require.Equal(t, rewards.Rewards, totalRewards.Total)
+
+	// the same as the rewards query
+	res, err = querier(ctx, &wasmTypes.DistQuery{Rewards: &wasmTypes.RewardsQuery{Delegator: delegator.String()}})
+	require.NoError(t, err)
+	var legacyRewards wasmTypes.RewardsResponse
+	require.NoError(t, json.Unmarshal(res, &legacyRewards))
+	require.Equal(t, totalRewards.Total, legacyRewards.Total)
+
+	// the queries don't withdraw the rewards
+	res2, err := querier(ctx, &wasmTypes.DistQuery{
+		DelegationRewards: &wasmTypes.DelegationRewardsQuery{DelegatorAddress: delegator.String(), ValidatorAddress: valAddr.String()},
+	})
+	require.NoError(t, err)
+	require.JSONEq(t, `{"rewards":`+string(mustMarshal(t, rewards.Rewards))+`}`, string(res2))
+
+	_, err = querier(ctx, &wasmTypes.DistQuery{
+		DelegationRewards: &wasmTypes.DelegationRewardsQuery{DelegatorAddress: delegator.String(), ValidatorAddress: "invalid"},
+	})
+	require.Error(t, err)
+}
@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"errors"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
@@ -32,6 +33,7 @@ import (
 	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	crontypes "github.com/scrtlabs/SecretNetwork/x/cron/types"
 )
 
 // MessageHandlerChain defines a chain of handlers that are called one by one until it can be handled.
@@ -146,6 +148,7 @@ func (h IBCRawPacketHandler) DispatchMsg(ctx sdk.Context, _ sdk.AccAddress, cont
 
 type (
 	BankEncoder         func(sender sdk.AccAddress, msg *v1wasmTypes.BankMsg) ([]sdk.Msg, error)
+	CronEncoder         func(sender sdk.AccAddress, msg *v1wasmTypes.CronMsg) ([]sdk.Msg, error)
 	CustomEncoder       func(sender sdk.AccAddress, msg json.RawMessage) ([]sdk.Msg, error)
 	DistributionEncoder func(sender sdk.AccAddress, msg *v1wasmTypes.DistributionMsg) ([]sdk.Msg, error)
 	GovEncoder          func(sender sdk.AccAddress, msg *v1wasmTypes.GovMsg) ([]sdk.Msg, error)
@@ -157,6 +160,7 @@ type (
 
 type MessageEncoders struct {
 	Bank         BankEncoder
+	Cron         CronEncoder
 	Custom       CustomEncoder
 	Distribution DistributionEncoder
 	Gov          GovEncoder
@@ -169,6 +173,7 @@ type MessageEncoders struct {
 func DefaultEncoders(portSource types.ICS20TransferPortSource, unpacker codectypes.AnyUnpacker) MessageEncoders {
 	return MessageEncoders{
 		Bank:         EncodeBankMsg,
+		Cron:         EncodeCronMsg,
 		Custom:       NoCustomMsg,
 		Distribution: EncodeDistributionMsg,
 		Gov:          EncodeGovMsg,
@@ -186,6 +191,9 @@ func (e MessageEncoders) Merge(o *MessageEncoders) MessageEncoders {
 	if o.Bank != nil {
 		e.Bank = o.Bank
 	}
+	if o.Cron != nil {
+		e.Cron = o.Cron
+	}
 	if o.Custom != nil {
 		e.Custom = o.Custom
 	}
@@ -246,6 +254,8 @@ func (e MessageEncoders) Encode(ctx sdk.Context, contractAddr sdk.AccAddress, co
 	switch {
 	case msg.Bank != nil:
 		return e.Bank(contractAddr, msg.Bank)
+	case msg.Cron != nil:
+		return e.Cron(contractAddr, msg.Cron)
 	case msg.Custom != nil:
 		return e.Custom(contractAddr, msg.Custom)
 	case msg.Distribution != nil:
@@ -273,23 +283,44 @@ var VoteOptionMap = map[v1wasmTypes.VoteOption]string{
 }
 
 func EncodeGovMsg(sender sdk.AccAddress, msg *v1wasmTypes.GovMsg) ([]sdk.Msg, error) {
-	if msg.Vote == nil {
+	switch {
+	case msg.Vote != nil:
+		option, err := convertWasmVoteOption(msg.Vote.Vote)
+		if err != nil {
+			return nil, err
+		}
+
+		sdkMsg := govtypes.NewMsgVote(sender, msg.Vote.ProposalId, option, "")
+		return []sdk.Msg{sdkMsg}, nil
+	case msg.VoteWeighted != nil:
+		options := make(govtypes.WeightedVoteOptions, len(msg.VoteWeighted.Options))
+		for i, o := range msg.VoteWeighted.Options {
+			option, err := convertWasmVoteOption(o.Option)
+			if err != nil {
+				return nil, err
+			}
+			weight, err := math.LegacyNewDecFromStr(o.Weight)
+			if err != nil {
+				return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "vote weight %s: %s", o.Weight, err.Error())
+			}
+			options[i] = govtypes.NewWeightedVoteOption(option, weight)
+		}
+
+		sdkMsg := govtypes.NewMsgVoteWeighted(sender, msg.VoteWeighted.ProposalId, options, "")
+		return []sdk.Msg{sdkMsg}, nil
+	default:
 		return nil, errorsmod.Wrap(types.ErrInvalidMsg, "Unknown variant of Gov")
 	}
+}
 
-	opt, exists := VoteOptionMap[msg.Vote.Vote]
+func convertWasmVoteOption(vote v1wasmTypes.VoteOption) (govtypes.VoteOption, error) {
+	opt, exists := VoteOptionMap[vote]
 	if !exists {
 		// if it's not found, let the `VoteOptionFromString` below fail
 		opt = ""
 	}
 
-	option, err := govtypes.VoteOptionFromString(opt)
-	if err != nil {
-		return nil, err
-	}
-
-	sdkMsg := govtypes.NewMsgVote(sender, msg.Vote.ProposalId, option, "")
-	return []sdk.Msg{sdkMsg}, nil
+	return govtypes.VoteOptionFromString(opt)
 }
 
 func EncodeIBCMsg(portSource types.ICS20TransferPortSource) func(ctx sdk.Context, sender sdk.AccAddress, contractIBCPortID string, msg *v1wasmTypes.IBCMsg) ([]sdk.Msg, error) {
@@ -349,6 +380,65 @@ func EncodeBankMsg(sender sdk.AccAddress, msg *v1wasmTypes.BankMsg) ([]sdk.Msg,
 	return []sdk.Msg{&sdkMsg}, nil
 }
 
+var cronFailurePolicies = map[string]crontypes.FailurePolicy{
+	"":        crontypes.FailurePolicy_FAILURE_POLICY_SKIP,
+	"skip":    crontypes.FailurePolicy_FAILURE_POLICY_SKIP,
+	"retry":   crontypes.FailurePolicy_FAILURE_POLICY_RETRY,
+	"disable": crontypes.FailurePolicy_FAILURE_POLICY_DISABLE,
+}
+
+func EncodeCronMsg(sender sdk.AccAddress, msg *v1wasmTypes.CronMsg) ([]sdk.Msg, error) {
+	switch {
+	case msg.RegisterSchedule != nil:
+		register := msg.RegisterSchedule
+		failurePolicy, ok := cronFailurePolicies[register.FailurePolicy]
+		if !ok {
+			return nil, errorsmod.Wrapf(types.ErrInvalidMsg, "unknown failure policy %s", register.FailurePolicy)
+		}
+		deposit, err := convertWasmCoinToSdkCoin(register.Deposit)
+		if err != nil {
+			return nil, err
+		}
+		msgs := make([]crontypes.MsgExecuteContract, len(register.Msgs))
+		for i, scheduled := range register.Msgs {
+			funds, err := convertWasmCoinsToSdkCoins(scheduled.Funds)
+			if err != nil {
+				return nil, err
+			}
+			msgs[i] = crontypes.MsgExecuteContract{
+				Contract: scheduled.Contract,
+				Msg:      string(scheduled.Msg),
+				GasLimit: scheduled.GasLimit,
+				Funds:    funds,
+			}
+		}
+
+		registerMsg := crontypes.MsgRegisterSchedule{
+			Owner:          sender.String(),
+			Name:           register.Name,
+			Period:         register.Period,
+			Msgs:           msgs,
+			CronExpression: register.CronExpression,
+			FailurePolicy:  failurePolicy,
+			MaxFailures:    register.MaxFailures,
+			Deposit:        deposit,
+		}
+		if register.IntervalSeconds != 0 {
+			interval := time.Duration(register.IntervalSeconds) * time.Second //nolint:gosec
+			registerMsg.Interval = &interval
+		}
+		return []sdk.Msg{&registerMsg}, nil
+	case msg.RemoveSchedule != nil:
+		removeMsg := crontypes.MsgRemoveSchedule{
+			Authority: sender.String(),
+			Name:      msg.RemoveSchedule.Name,
+		}
+		return []sdk.Msg{&removeMsg}, nil
+	default:
+		return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of Cron")
+	}
+}
+
 func NoCustomMsg(_ sdk.AccAddress, _ json.RawMessage) ([]sdk.Msg, error) {
 	return nil, errorsmod.Wrap(types.ErrInvalidMsg, "Custom variant not supported")
 }
@@ -367,6 +457,16 @@ func EncodeDistributionMsg(sender sdk.AccAddress, msg *v1wasmTypes.DistributionM
 			ValidatorAddress: msg.WithdrawDelegatorReward.Validator,
 		}
 		return []sdk.Msg{&withdrawMsg}, nil
+	case msg.FundCommunityPool != nil:
+		amount, err := convertWasmCoinsToSdkCoins(msg.FundCommunityPool.Amount)
+		if err != nil {
+			return nil, err
+		}
+		fundMsg := distrtypes.MsgFundCommunityPool{
+			Amount:    amount,
+			Depositor: sender.String(),
+		}
+		return []sdk.Msg{&fundMsg}, nil
 	default:
 		return nil, errorsmod.Wrap(types.ErrUnknownMsg, "unknown variant of Distribution")
 	}
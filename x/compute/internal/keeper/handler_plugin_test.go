@@ -4,16 +4,19 @@ import (
 	"encoding/json"
 	"testing"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
 	"github.com/stretchr/testify/require"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	distributiontypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types/v1"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 
 	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
 	v010wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v010"
+	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
@@ -283,3 +286,113 @@ func TestEncoding(t *testing.T) {
 		})
 	}
 }
+
+func TestEncodingV1(t *testing.T) {
+	_, _, addr1 := keyPubAddr()
+
+	cases := map[string]struct {
+		sender sdk.AccAddress
+		input  v1wasmTypes.CosmosMsg
+		// set if valid
+		output []sdk.Msg
+		// set if invalid
+		isError bool
+	}{
+		"gov vote weighted": {
+			sender: addr1,
+			input: v1wasmTypes.CosmosMsg{
+				Gov: &v1wasmTypes.GovMsg{
+					VoteWeighted: &v1wasmTypes.VoteWeightedMsg{
+						ProposalId: 1,
+						Options: []v1wasmTypes.WeightedVoteOption{
+							{Option: v1wasmTypes.Yes, Weight: "0.7"},
+							{Option: v1wasmTypes.NoWithVeto, Weight: "0.3"},
+						},
+					},
+				},
+			},
+			output: []sdk.Msg{
+				govtypes.NewMsgVoteWeighted(addr1, 1, govtypes.WeightedVoteOptions{
+					govtypes.NewWeightedVoteOption(govtypes.OptionYes, math.LegacyNewDecWithPrec(7, 1)),
+					govtypes.NewWeightedVoteOption(govtypes.OptionNoWithVeto, math.LegacyNewDecWithPrec(3, 1)),
+				}, ""),
+			},
+		},
+		"gov vote weighted with invalid weight": {
+			sender: addr1,
+			input: v1wasmTypes.CosmosMsg{
+				Gov: &v1wasmTypes.GovMsg{
+					VoteWeighted: &v1wasmTypes.VoteWeightedMsg{
+						ProposalId: 1,
+						Options: []v1wasmTypes.WeightedVoteOption{
+							{Option: v1wasmTypes.Yes, Weight: "most"},
+						},
+					},
+				},
+			},
+			isError: true,
+		},
+		"gov vote weighted with invalid option": {
+			sender: addr1,
+			input: v1wasmTypes.CosmosMsg{
+				Gov: &v1wasmTypes.GovMsg{
+					VoteWeighted: &v1wasmTypes.VoteWeightedMsg{
+						ProposalId: 1,
+						Options: []v1wasmTypes.WeightedVoteOption{
+							{Option: v1wasmTypes.VoteOption(42), Weight: "1"},
+						},
+					},
+				},
+			},
+			isError: true,
+		},
+		"distribution fund community pool": {
+			sender: addr1,
+			input: v1wasmTypes.CosmosMsg{
+				Distribution: &v1wasmTypes.DistributionMsg{
+					FundCommunityPool: &v1wasmTypes.FundCommunityPoolMsg{
+						Amount: []wasmTypes.Coin{
+							{Denom: "uscrt", Amount: "12345"},
+						},
+					},
+				},
+			},
+			output: []sdk.Msg{
+				&distributiontypes.MsgFundCommunityPool{
+					Amount:    sdk.NewCoins(sdk.NewInt64Coin("uscrt", 12345)),
+					Depositor: addr1.String(),
+				},
+			},
+		},
+		"distribution fund community pool with invalid amount": {
+			sender: addr1,
+			input: v1wasmTypes.CosmosMsg{
+				Distribution: &v1wasmTypes.DistributionMsg{
+					FundCommunityPool: &v1wasmTypes.FundCommunityPoolMsg{
+						Amount: []wasmTypes.Coin{
+							{Denom: "uscrt", Amount: "123.45"},
+						},
+					},
+				},
+			},
+			isError: true,
+		},
+	}
+
+	encodingConfig := MakeEncodingConfig()
+
+	for name, tc := range cases {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			var ctx sdk.Context
+			encoder := DefaultEncoders(nil, encodingConfig.Codec)
+			res, err := encoder.Encode(ctx, tc.sender, "", tc.input)
+			if tc.isError {
+				require.Error(t, err)
+			} else {
+				require.NoError(t, err)
+				assert.Equal(t, tc.output, res)
+			}
+		})
+	}
+}
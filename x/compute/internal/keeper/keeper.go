@@ -48,7 +48,6 @@ import (
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
-	"github.com/cosmos/cosmos-sdk/types/tx/signing"
 	sdktxsigning "github.com/cosmos/cosmos-sdk/types/tx/signing"
 	wasm "github.com/scrtlabs/SecretNetwork/go-cosmwasm"
 
@@ -56,6 +55,7 @@ import (
 	v1wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types/v1"
 
 	cronkeeper "github.com/scrtlabs/SecretNetwork/x/cron/keeper"
+	crontypes "github.com/scrtlabs/SecretNetwork/x/cron/types"
 	"github.com/scrtlabs/SecretNetwork/x/registration"
 
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
@@ -89,10 +89,10 @@ type Keeper struct {
 	cronKeeper       cronkeeper.Keeper
 	wasmer           wasm.Wasmer
 	queryPlugins     QueryPlugins
+	queryRouter      GRPCQueryRouter
 	messenger        Messenger
 	// queryGasLimit is the max wasm gas that can be spent on executing a query with a contract
 	queryGasLimit uint64
-	maxCallDepth  uint32
 	HomeDir       string
 	// authZPolicy   AuthorizationPolicy
 	// paramSpace    subspace.Subspace
@@ -101,6 +101,8 @@ type Keeper struct {
 	// storeKeys maps store key names to the app's registered StoreKey
 	// instances so ApplyCrossModuleOps resolves correct pointers.
 	storeKeys map[string]storetypes.StoreKey
+	// executionHalter can halt all contract executions, it's optional
+	executionHalter types.ExecutionHalter
 }
 
 // SetStoreKeys provides the keeper with the app's registered store key
@@ -110,6 +112,20 @@ func (k *Keeper) SetStoreKeys(keys map[string]storetypes.StoreKey) {
 	k.storeKeys = keys
 }
 
+// SetExecutionHalter provides the keeper with the module that can halt all
+// contract executions during incidents.
+func (k *Keeper) SetExecutionHalter(halter types.ExecutionHalter) {
+	k.executionHalter = halter
+}
+
+// checkExecutionHalted returns an error if contract executions are halted by the execution halter
+func (k Keeper) checkExecutionHalted(ctx sdk.Context) error {
+	if k.executionHalter == nil {
+		return nil
+	}
+	return k.executionHalter.CheckContractExecutionHalted(ctx)
+}
+
 func moduleLogger(ctx sdk.Context) log.Logger {
 	return ctx.Logger().With("module", fmt.Sprintf("x/%s", types.ModuleName))
 }
@@ -162,6 +178,7 @@ func NewKeeper(
 		portKeeper:       portKeeper,
 		capabilityKeeper: capabilityKeeper,
 		RegKeeper:        regKeeper,
+		queryRouter:      queryRouter,
 		messenger: NewMessageHandler(
 			msgRouter,
 			customEncoders,
@@ -173,7 +190,6 @@ func NewKeeper(
 			cdc,
 		),
 		queryGasLimit:  wasmConfig.SmartQueryGasLimit,
-		maxCallDepth:   types.DefaultMaxCallDepth,
 		HomeDir:        homeDir,
 		LastMsgManager: lastMsgManager,
 		authority:      authority,
@@ -404,6 +420,10 @@ func (k Keeper) importCode(ctx sdk.Context, codeID uint64, codeInfo types.CodeIn
 }
 
 func (k Keeper) GetTxInfo(ctx sdk.Context, sender sdk.AccAddress) ([]byte, sdktxsigning.SignMode, []byte, []byte, []byte, error) {
+	if _, scheduled := k.scheduledExecution(ctx, sender); scheduled || sender.Equals(crontypes.ScheduledTxSender()) {
+		return k.scheduledTxInfo(ctx, sender)
+	}
+
 	var rawTx sdktx.TxRaw
 	var parsedTx sdktx.Tx
 	err := k.cdc.Unmarshal(ctx.TxBytes(), &parsedTx)
@@ -637,6 +657,18 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: init")
 
+	if err := k.checkExecutionHalted(ctx); err != nil {
+		return nil, nil, err
+	}
+
+	limits := k.getLimits(ctx)
+	if uint64(len(label)) > uint64(limits.MaxLabelSize) {
+		return nil, nil, errorsmod.Wrapf(types.ErrLimit, "label cannot be longer than %d characters", limits.MaxLabelSize)
+	}
+	if limits.MaxMsgSize > 0 && uint64(len(initMsg)) > limits.MaxMsgSize {
+		return nil, nil, errorsmod.Wrapf(types.ErrExceedMaxMsgSize, "%d > %d bytes", len(initMsg), limits.MaxMsgSize)
+	}
+
 	signBytes := []byte{}
 	signMode := sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED
 	modeInfoBytes := []byte{}
@@ -741,7 +773,9 @@ func (k Keeper) Instantiate(ctx sdk.Context, codeID uint64, creator, admin sdk.A
 		Caller:  contractAddress,
 	}
 
-	response, ogContractKey, adminProof, gasUsed, initError := k.wasmer.Instantiate(codeInfo.CodeHash, env, initMsg, storeForExecution, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), sigInfo, admin)
+	accountingStore := k.newStorageAccountingStore(ctx, contractAddress, storeForExecution)
+	response, ogContractKey, adminProof, gasUsed, initError := k.wasmer.Instantiate(codeInfo.CodeHash, env, initMsg, accountingStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), sigInfo, admin)
+	k.commitStorageAccounting(ctx, contractAddress, accountingStore)
 
 	// In replay mode, apply any cross-module ops stashed by replayExecution.
 	if recorder.IsReplayMode() {
@@ -861,6 +895,15 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading Compute module: execute")
 
+	if err := k.checkExecutionHalted(ctx); err != nil {
+		return nil, err
+	}
+
+	limits := k.getLimits(ctx)
+	if limits.MaxMsgSize > 0 && uint64(len(msg)) > limits.MaxMsgSize {
+		return nil, errorsmod.Wrapf(types.ErrExceedMaxMsgSize, "%d > %d bytes", len(msg), limits.MaxMsgSize)
+	}
+
 	signBytes := []byte{}
 	signMode := sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED
 	modeInfoBytes := []byte{}
@@ -869,7 +912,8 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	var err error
 
 	// If no callback signature - we should send the actual msg sender sign bytes and signature
-	if callbackSig == nil {
+	// Sudo is called by the chain itself (e.g. by governance in EndBlock), so there's no tx to sign it
+	if callbackSig == nil && handleType != wasmTypes.HandleTypeSudo {
 		signBytes, signMode, modeInfoBytes, pkBytes, signerSig, err = k.GetTxInfo(ctx, caller)
 		if err != nil {
 			return nil, err
@@ -948,7 +992,9 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		storeForExecution = prefixStore
 	}
 
-	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, msg, storeForExecution, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, handleType)
+	accountingStore := k.newStorageAccountingStore(ctx, contractAddress, storeForExecution)
+	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, msg, accountingStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, handleType)
+	k.commitStorageAccounting(ctx, contractAddress, accountingStore)
 
 	// In replay mode, apply any cross-module ops that were stashed by replayExecution.
 	// These are writes to other modules' stores (e.g., distribution, staking) that
@@ -1010,6 +1056,26 @@ func (k Keeper) Execute(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	}
 }
 
+// Sudo calls the sudo entry point of the contract instance with a plaintext msg.
+// The contract sees an empty msg.sender and no funds. There is no signature to verify
+// a sudo call against, so this must only be reachable from governance or from other
+// modules acting on behalf of the chain (e.g. cron schedules or ibc-hooks callbacks).
+func (k Keeper) Sudo(ctx sdk.Context, contractAddress sdk.AccAddress, msg []byte) ([]byte, error) {
+	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "sudo")
+
+	res, err := k.Execute(ctx, contractAddress, types.ZeroSender, msg, sdk.NewCoins(), nil, wasmTypes.HandleTypeSudo)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeSudo,
+		sdk.NewAttribute(types.AttributeKeyContractAddr, contractAddress.String()),
+	))
+
+	return res.Data, nil
+}
+
 // QuerySmart queries the smart contract itself.
 func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, useDefaultGasLimit bool) ([]byte, error) {
 	return k.querySmartImpl(ctx, contractAddr, req, useDefaultGasLimit, 1)
@@ -1017,6 +1083,9 @@ func (k Keeper) QuerySmart(ctx sdk.Context, contractAddr sdk.AccAddress, req []b
 
 // QuerySmartRecursive queries the smart contract itself. This should only be called when running inside another query recursively.
 func (k Keeper) querySmartRecursive(ctx sdk.Context, contractAddr sdk.AccAddress, req []byte, queryDepth uint32, useDefaultGasLimit bool) ([]byte, error) {
+	if maxQueryDepth := k.getLimits(ctx).MaxQueryDepth; queryDepth > maxQueryDepth {
+		return nil, errorsmod.Wrapf(types.ErrExceedMaxQueryDepth, "%d > %d", queryDepth, maxQueryDepth)
+	}
 	return k.querySmartImpl(ctx, contractAddr, req, useDefaultGasLimit, queryDepth)
 }
 
@@ -1241,39 +1310,38 @@ func (k Keeper) GetScheduledMsgs(ctx sdk.Context) ([][]byte, error) {
 	// schedule.LastExecuteHeight = uint64(ctx.BlockHeight()) //nolint:gosec
 	// k.storeSchedule(ctx, schedule)
 
-	// Get the module private key once.
 	var txBytesList [][]byte
-	privKey := cronkeeper.GetModulePrivateKey()
-	pubKey := privKey.PubKey()
-	senderAddr := sdk.AccAddress(pubKey.Address())
 
-	// Retrieve or create the account info using the derived address.
-	// The cron module account needs to exist for sequence tracking, but it doesn't need funds
+	// Governance schedules are sent by the scheduled tx sender, owned schedules by a sender
+	// derived from their owner, see crontypes.Schedule.TxSender.
+	// The accounts of the senders need to exist for sequence tracking, but they don't need funds
 	// since scheduled transactions are fee-free.
-	// Note: This address is derived from the hardcoded private key in GetModulePrivateKey(),
-	// so we only auto-create the account for that specific hardcoded key's address.
-	senderAcc := k.accountKeeper.GetAccount(ctx, senderAddr)
-	if senderAcc == nil {
-		// Create the account if it doesn't exist (first time running scheduled transactions).
-		// This only happens for the hardcoded cron module private key's address.
-		senderAcc = k.accountKeeper.NewAccountWithAddress(ctx, senderAddr)
-		k.accountKeeper.SetAccount(ctx, senderAcc)
+	// There are no private keys for the senders, scheduled txs are unsigned and authenticated by the
+	// cron ScheduledTxDecorator and the enclave instead.
+	sequences := make(map[string]uint64)
+	nextSequence := func(senderAddr sdk.AccAddress) uint64 {
+		sequence, found := sequences[senderAddr.String()]
+		if !found {
+			senderAcc := k.accountKeeper.GetAccount(ctx, senderAddr)
+			if senderAcc == nil {
+				// Create the account if it doesn't exist (first time running scheduled transactions).
+				senderAcc = k.accountKeeper.NewAccountWithAddress(ctx, senderAddr)
+				k.accountKeeper.SetAccount(ctx, senderAcc)
+			}
+			sequence = senderAcc.GetSequence()
+		}
+		// Each tx increments the sender's sequence when it's executed
+		sequences[senderAddr.String()] = sequence + 1
+		return sequence
 	}
-	accountNumber := senderAcc.GetAccountNumber()
-	sequence := senderAcc.GetSequence()
-	chainID := ctx.ChainID()
 
-	// Scheduled transactions are governance-permissioned and should be gas and fee free
+	// Scheduled transactions should be gas and fee free
 	// Fees are set to 0 (empty coins)
 	feeAmount := sdk.NewCoins()
-	// Gas limit is set to a high value to ensure scheduled transactions don't run out of gas.
-	// Since these are governance-permissioned, they should execute without gas constraints.
-	// Note: Gas limit must be > 0 to pass antehandler validation (ValidateBasicDecorator and ConsumeGasForTxSizeDecorator).
-	gasLimit := uint64(5_000_000) // 5 million gas units - effectively unlimited for scheduled txs
-
-	for i, msg := range cronScheduledMsgs {
-		// Increment sequence for each transaction (must be done BEFORE signing)
-		currentSequence := sequence + uint64(i)
+
+	for _, scheduled := range cronScheduledMsgs {
+		msg := scheduled.Msg
+		currentSequence := nextSequence(scheduled.Sender)
 		// Convert contract address from bech32.
 		contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
 		if err != nil {
@@ -1281,16 +1349,19 @@ func (k Keeper) GetScheduledMsgs(ctx sdk.Context) ([][]byte, error) {
 			return nil, err
 		}
 
-		encryptedMsg, err := cronkeeper.Encrypt(ctx, &k.cronKeeper, []byte(msg.Msg))
+		// The memo makes the bytes of every scheduled tx unique, as they're tracked by their hash
+		memo := fmt.Sprintf("cron/%s/%d", scheduled.ScheduleName, currentSequence)
+
+		encryptedMsg, err := cronkeeper.Encrypt(ctx, &k.cronKeeper, memo, []byte(msg.Msg))
 		if err != nil {
 			ctx.Logger().Debug("executeSchedule: failed to decode base64 msg", "err", err)
 			return nil, err
 		}
 		executeMsg := types.MsgExecuteContract{
-			Sender:           senderAddr,
+			Sender:           scheduled.Sender,
 			Contract:         contractAddr,
 			Msg:              encryptedMsg,
-			SentFunds:        sdk.NewCoins(),
+			SentFunds:        msg.Funds,
 			CallbackCodeHash: "",
 		}
 
@@ -1301,69 +1372,79 @@ func (k Keeper) GetScheduledMsgs(ctx sdk.Context) ([][]byte, error) {
 		}
 		// Set fee and gas (adjust as needed).
 		txBuilder.SetFeeAmount(feeAmount)
-		txBuilder.SetGasLimit(gasLimit)
-
-		// Prepare signer data using the correct account info.
-		signerData := authsigning.SignerData{
-			Address:       senderAddr.String(),
-			ChainID:       chainID,
-			AccountNumber: accountNumber,
-			Sequence:      currentSequence,
-			PubKey:        pubKey,
-		}
-
-		sigData := signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-			Signature: nil,
-		}
-
-		sig := signing.SignatureV2{
-			PubKey:   pubKey,
-			Data:     &sigData,
-			Sequence: currentSequence,
-		}
-
-		sigs := []signing.SignatureV2{sig}
-		if err := txBuilder.SetSignatures(sigs...); err != nil {
-			return nil, err
-		}
+		// Each schedule sets its own gas limit, it defaults to 5 million gas units when unset.
+		// Note: Gas limit must be > 0 to pass antehandler validation (ConsumeGasForTxSizeDecorator).
+		txBuilder.SetGasLimit(msg.GetGasLimitOrDefault())
+		txBuilder.SetMemo(memo)
 
-		bytesToSign, err := authsigning.GetSignBytesAdapter(ctx, k.cronKeeper.GetTxConfig().SignModeHandler(), signing.SignMode_SIGN_MODE_DIRECT, signerData, txBuilder.GetTx())
+		txBytes, err := k.cronKeeper.GetTxConfig().TxEncoder()(txBuilder.GetTx())
 		if err != nil {
 			return nil, err
 		}
 
-		// Sign those bytes
-		sigBytes, err := privKey.Sign(bytesToSign)
-		if err != nil {
-			return nil, err
-		}
+		// the tx reports its result back to the schedule when it's executed, see msgServer.ExecuteContract
+		k.cronKeeper.TrackScheduledExecution(ctx, txBytes, scheduled.ScheduleName)
+		k.cronKeeper.TrackScheduledTx(ctx, txBytes)
+		txBytesList = append(txBytesList, txBytes)
+	}
 
-		// Construct the SignatureV2 struct
-		sigData = signing.SingleSignatureData{
-			SignMode:  signing.SignMode_SIGN_MODE_DIRECT,
-			Signature: sigBytes,
-		}
-		sig = signing.SignatureV2{
-			PubKey:   pubKey,
-			Data:     &sigData,
-			Sequence: currentSequence,
-		}
+	return txBytesList, nil
+}
 
-		err = txBuilder.SetSignatures(sig)
-		if err != nil {
-			return nil, fmt.Errorf("unable to set signatures on payload: %w", err)
-		}
+// scheduledTxInfo returns the sign bytes of the scheduled tx that is being executed.
+// Scheduled txs aren't signed, the enclave authenticates them by their inclusion in the verified block,
+// but it still verifies the msg against the sign doc like it does for every other tx.
+func (k Keeper) scheduledTxInfo(ctx sdk.Context, sender sdk.AccAddress) ([]byte, sdktxsigning.SignMode, []byte, []byte, []byte, error) {
+	var rawTx sdktx.TxRaw
+	if err := k.cdc.Unmarshal(ctx.TxBytes(), &rawTx); err != nil {
+		return nil, 0, nil, nil, nil, errorsmod.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to decode raw transaction from bytes: %s", err.Error()))
+	}
 
-		txBytes, err := k.cronKeeper.GetTxConfig().TxEncoder()(txBuilder.GetTx())
-		if err != nil {
-			return nil, err
-		}
+	senderAcc := k.accountKeeper.GetAccount(ctx, sender)
+	if senderAcc == nil {
+		return nil, 0, nil, nil, nil, errorsmod.Wrap(types.ErrSigFailed, "scheduled tx sender account not found")
+	}
 
-		txBytesList = append(txBytesList, txBytes)
+	signDoc := sdktx.SignDoc{
+		BodyBytes:     rawTx.BodyBytes,
+		AuthInfoBytes: rawTx.AuthInfoBytes,
+		ChainId:       ctx.ChainID(),
+		AccountNumber: senderAcc.GetAccountNumber(),
+	}
+	signBytes, err := signDoc.Marshal()
+	if err != nil {
+		return nil, 0, nil, nil, nil, errorsmod.Wrap(types.ErrSigFailed, fmt.Sprintf("Unable to encode sign doc: %s", err.Error()))
 	}
 
-	return txBytesList, nil
+	return signBytes, sdktxsigning.SignMode_SIGN_MODE_DIRECT, []byte{}, []byte{}, []byte{}, nil
+}
+
+// scheduledExecution returns the schedule of the scheduled tx that is being executed,
+// or false if the msg wasn't sent by a cron schedule
+func (k Keeper) scheduledExecution(ctx sdk.Context, sender sdk.AccAddress) (*crontypes.Schedule, bool) {
+	scheduleName, found := k.cronKeeper.GetScheduledExecution(ctx, ctx.TxBytes())
+	if !found {
+		return nil, false
+	}
+	// msgs sent by contracts within the scheduled tx aren't part of the schedule
+	schedule, found := k.cronKeeper.GetSchedule(ctx, scheduleName)
+	if !found || !sender.Equals(schedule.TxSender()) {
+		return nil, false
+	}
+	return schedule, true
+}
+
+// fundScheduledExecution moves the funds of a scheduled msg from the schedule's account to the cron sender,
+// so that they're sent to the contract like any other funds
+func (k Keeper) fundScheduledExecution(ctx sdk.Context, schedule crontypes.Schedule, sender sdk.AccAddress, funds sdk.Coins) error {
+	if funds.IsZero() {
+		return nil
+	}
+	scheduleAccount := schedule.Account()
+	if err := k.bankKeeper.SendCoins(ctx, scheduleAccount, sender, funds); err != nil {
+		return errorsmod.Wrapf(err, "failed to fund scheduled execution from schedule account %s", scheduleAccount)
+	}
+	return nil
 }
 
 func (k Keeper) containsContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress) bool {
@@ -1430,6 +1511,7 @@ func (k Keeper) GetContractState(ctx sdk.Context, contractAddress sdk.AccAddress
 func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddress, models []types.Model) error {
 	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
 	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), prefixStoreKey)
+	var stats types.ContractStorageStats
 	for _, model := range models {
 		if model.Value == nil {
 			model.Value = []byte{}
@@ -1440,7 +1522,10 @@ func (k Keeper) importContractState(ctx sdk.Context, contractAddress sdk.AccAddr
 		}
 		prefixStore.Set(model.Key, model.Value)
 
+		stats.KeyCount++
+		stats.TotalBytes += uint64(len(model.Key) + len(model.Value))
 	}
+	k.setContractStorageStats(ctx, contractAddress, 0, stats)
 	return nil
 }
 
@@ -1529,13 +1614,19 @@ func (k *Keeper) handleContractResponse(
 
 	responseHandler := NewContractResponseHandler(NewMessageDispatcher(k.messenger, k))
 	// keep track of call depth
-	ctx, err := checkAndIncreaseCallDepth(ctx, k.maxCallDepth)
+	ctx, err := checkAndIncreaseCallDepth(ctx, k.getLimits(ctx).MaxCallDepth)
 	if err != nil {
 		return nil, err
 	}
 	return responseHandler.Handle(ctx, contractAddr, ibcPort, msgs, data, ogTx, ogSigInfo)
 }
 
+// getLimits returns the module params without charging gas, so that reading the
+// limits doesn't change the gas used by contract calls
+func (k Keeper) getLimits(ctx sdk.Context) types.Params {
+	return k.GetParams(ctx.WithGasMeter(storetypes.NewInfiniteGasMeter()))
+}
+
 func gasForContract(ctx sdk.Context) uint64 {
 	meter := ctx.GasMeter()
 	remaining := (meter.Limit() - meter.GasConsumed()) * types.GasMultiplier
@@ -1759,7 +1850,9 @@ func (k Keeper) reply(ctx sdk.Context, contractAddress sdk.AccAddress, reply v1w
 		replyStoreForExecution = prefixStore
 	}
 
-	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, marshaledReply, replyStoreForExecution, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), ogSigInfo, wasmTypes.HandleTypeReply)
+	accountingStore := k.newStorageAccountingStore(ctx, contractAddress, replyStoreForExecution)
+	response, gasUsed, execErr := k.wasmer.Execute(codeInfo.CodeHash, env, marshaledReply, accountingStore, cosmwasmAPI, querier, ctx.GasMeter(), gasForContract(ctx), ogSigInfo, wasmTypes.HandleTypeReply)
+	k.commitStorageAccounting(ctx, contractAddress, accountingStore)
 
 	// In replay mode, apply any cross-module ops stashed by replayExecution.
 	if recorder.IsReplayMode() {
@@ -1913,6 +2006,10 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 	defer telemetry.MeasureSince(time.Now(), "compute", "keeper", "migrate")
 	ctx.GasMeter().ConsumeGas(types.InstanceCost, "Loading CosmWasm module: migrate")
 
+	if err := k.checkExecutionHalted(ctx); err != nil {
+		return nil, err
+	}
+
 	signBytes := []byte{}
 	signMode := sdktxsigning.SignMode_SIGN_MODE_UNSPECIFIED
 	modeInfoBytes := []byte{}
@@ -2020,7 +2117,9 @@ func (k Keeper) Migrate(ctx sdk.Context, contractAddress sdk.AccAddress, caller
 		storeForExecution = prefixStore
 	}
 
-	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, storeForExecution, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, adminAddr, adminProof)
+	accountingStore := k.newStorageAccountingStore(ctx, contractAddress, storeForExecution)
+	response, newContractKey, newContractKeyProof, gasUsed, migrateErr := k.wasmer.Migrate(newCodeInfo.CodeHash, env, msg, accountingStore, cosmwasmAPI, querier, gasMeter(ctx), gasForContract(ctx), sigInfo, adminAddr, adminProof)
+	k.commitStorageAccounting(ctx, contractAddress, accountingStore)
 
 	// In replay mode, apply any cross-module ops stashed by replayExecution.
 	if recorder.IsReplayMode() {
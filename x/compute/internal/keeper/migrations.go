@@ -249,6 +249,16 @@ func (m Migrator) Migrate8to9(ctx sdk.Context) error {
 	return nil
 }
 
+// Migrate9to10 migrates from version 9 to 10. The migration moves the hardcoded stargate query
+// allowlist into the module params. The limits added in version 12 are still unset at this point
+// and would fail validation, so the params are written as-is and validated by Migrate11to12
+func (m Migrator) Migrate9to10(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	params.StargateQueryAllowlist = types.DefaultParams().StargateQueryAllowlist
+
+	return m.keeper.setParams(ctx, params)
+}
+
 const progressPartSize = 1000
 
 func logMigrationProgress(ctx sdk.Context, formatter *message.Printer, migratedContracts uint64, totalContracts uint64, previousTime int64) {
@@ -278,3 +288,41 @@ func logMigrationProgress(ctx sdk.Context, formatter *message.Printer, migratedC
 		}
 	}
 }
+
+// Migrate10to11 migrates from version 10 to 11. The migration computes the storage stats of every
+// existing contract, which are then kept up to date on every contract store write
+func (m Migrator) Migrate10to11(ctx sdk.Context) error {
+	store := prefix.NewStore(runtime.KVStoreAdapter(m.keeper.storeService.OpenKVStore(ctx)), types.ContractKeyPrefix)
+	iter := store.Iterator(nil, nil)
+	defer iter.Close()
+
+	formatter := message.NewPrinter(language.English)
+	migratedContracts := uint64(0)
+	totalContracts := m.keeper.peekAutoIncrementID(ctx, types.KeyLastInstanceID) - 1
+	previousTime := time.Now().UnixNano()
+	for ; iter.Valid(); iter.Next() {
+		var contractAddress sdk.AccAddress = iter.Key()
+
+		stats := m.keeper.computeContractStorageStats(ctx, contractAddress)
+		m.keeper.setContractStorageStats(ctx, contractAddress, 0, stats)
+
+		migratedContracts++
+		logMigrationProgress(ctx, formatter, migratedContracts, totalContracts, previousTime)
+		previousTime = time.Now().UnixNano()
+	}
+
+	return nil
+}
+
+// Migrate11to12 migrates from version 11 to 12. The migration moves the hardcoded call depth,
+// query depth and label size limits into the module params, keeping their current
+// values. The max message size is left unlimited, as it was before, until governance sets one
+func (m Migrator) Migrate11to12(ctx sdk.Context) error {
+	params := m.keeper.GetParams(ctx)
+	defaults := types.DefaultParams()
+	params.MaxCallDepth = defaults.MaxCallDepth
+	params.MaxQueryDepth = defaults.MaxQueryDepth
+	params.MaxLabelSize = defaults.MaxLabelSize
+
+	return m.keeper.SetParams(ctx, params)
+}
@@ -91,7 +91,23 @@ func (m msgServer) ExecuteContract(goCtx context.Context, msg *types.MsgExecuteC
 		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract.String()),
 	))
 
-	data, err := m.keeper.Execute(ctx, msg.Contract, msg.Sender, msg.Msg, msg.SentFunds, msg.CallbackSig, wasmtypes.HandleTypeExecute)
+	schedule, scheduled := m.keeper.scheduledExecution(ctx, msg.Sender)
+	if scheduled {
+		err = m.keeper.fundScheduledExecution(ctx, *schedule, msg.Sender, msg.SentFunds)
+	}
+
+	var data *sdk.Result
+	if err == nil {
+		data, err = m.keeper.Execute(ctx, msg.Contract, msg.Sender, msg.Msg, msg.SentFunds, msg.CallbackSig, wasmtypes.HandleTypeExecute)
+	}
+
+	if scheduled {
+		if err != nil {
+			m.keeper.cronKeeper.RecordScheduledExecutionError(ctx, ctx.TxBytes(), err)
+		} else {
+			m.keeper.cronKeeper.CompleteScheduledExecution(ctx, ctx.TxBytes())
+		}
+	}
 
 	if data == nil {
 		return &types.MsgExecuteContractResponse{
@@ -200,6 +216,9 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.ValidateStargateQueryRoutes(req.Params); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, err.Error())
+	}
 	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
@@ -234,7 +253,8 @@ func (m msgServer) ContractGovernanceProposal(goCtx context.Context, msg *types.
 		return nil, err
 	}
 
-	// Verify sender has authority (only governance module should call this)
+	// Verify sender has authority (only governance module should call this).
+	// The call is executed right away, so a failing call fails the proposal that carries it.
 	if m.keeper.authority != msg.Authority {
 		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, msg.Authority)
 	}
@@ -391,3 +411,37 @@ func (m msgServer) UpdateMachineWhitelist(goCtx context.Context, msg *types.MsgU
 
 	return &types.MsgUpdateMachineWhitelistResponse{}, nil
 }
+
+func (m msgServer) SudoContract(goCtx context.Context, msg *types.MsgSudoContract) (*types.MsgSudoContractResponse, error) {
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, err
+	}
+
+	// Verify sender has authority (only governance module should call this).
+	// The call is executed right away, so a failing call fails the proposal that carries it.
+	if m.keeper.authority != msg.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, msg.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	contractAddr, err := sdk.AccAddressFromBech32(msg.Contract)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "contract")
+	}
+
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		sdk.EventTypeMessage,
+		sdk.NewAttribute(sdk.AttributeKeyModule, types.ModuleName),
+		sdk.NewAttribute(sdk.AttributeKeySender, msg.Authority),
+		sdk.NewAttribute(types.AttributeKeyContractAddr, msg.Contract),
+	))
+
+	data, err := m.keeper.Sudo(ctx, contractAddr, msg.Msg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSudoContractResponse{
+		Data: data,
+	}, nil
+}
@@ -1,6 +1,8 @@
 package keeper
 
 import (
+	"fmt"
+
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
 )
@@ -10,6 +12,11 @@ func (k *Keeper) SetParams(ctx sdk.Context, p types.Params) error {
 		return err
 	}
 
+	return k.setParams(ctx, p)
+}
+
+// setParams stores the params without validating them
+func (k *Keeper) setParams(ctx sdk.Context, p types.Params) error {
 	store := k.storeService.OpenKVStore(ctx)
 	bz := k.cdc.MustMarshal(&p)
 	err := store.Set(types.ParamsKey, bz)
@@ -27,3 +34,17 @@ func (k *Keeper) GetParams(ctx sdk.Context) (params types.Params) {
 	k.cdc.MustUnmarshal(bz, &params)
 	return params
 }
+
+// ValidateStargateQueryRoutes makes sure every path in the stargate query allowlist
+// is served by a registered gRPC query route
+func (k *Keeper) ValidateStargateQueryRoutes(p types.Params) error {
+	if k.queryRouter == nil {
+		return nil
+	}
+	for _, path := range p.StargateQueryAllowlist {
+		if k.queryRouter.Route(path) == nil {
+			return fmt.Errorf("no registered query route for stargate query path '%s'", path)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,122 @@
+package keeper
+
+import (
+	"errors"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	wasmTypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestGovernableLimits(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	var transferPortSource types.ICS20TransferPortSource
+	transferPortSource = MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}
+	encoders := DefaultEncoders(transferPortSource, encodingConfig.Codec)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+	keeper := keepers.WasmKeeper
+
+	params := keeper.GetParams(ctx)
+	params.MaxLabelSize = 10
+	params.MaxMsgSize = 100
+	params.MaxQueryDepth = 2
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	_, _, creator := keyPubAddr()
+	_, _, contract := keyPubAddr()
+
+	_, _, err := keeper.Instantiate(ctx, 1, creator, nil, []byte("{}"), strings.Repeat("a", 11), nil, nil)
+	require.ErrorIs(t, err, types.ErrLimit)
+
+	_, _, err = keeper.Instantiate(ctx, 1, creator, nil, make([]byte, 101), "label", nil, nil)
+	require.ErrorIs(t, err, types.ErrExceedMaxMsgSize)
+
+	_, err = keeper.Execute(ctx, contract, creator, make([]byte, 101), nil, nil, wasmTypes.HandleTypeExecute)
+	require.ErrorIs(t, err, types.ErrExceedMaxMsgSize)
+
+	_, err = keeper.querySmartRecursive(ctx, contract, []byte("{}"), 3, true)
+	require.ErrorIs(t, err, types.ErrExceedMaxQueryDepth)
+
+	params.MaxCallDepth = 1
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	ctx, err = checkAndIncreaseCallDepth(ctx, keeper.getLimits(ctx).MaxCallDepth)
+	require.NoError(t, err)
+	_, err = checkAndIncreaseCallDepth(ctx, keeper.getLimits(ctx).MaxCallDepth)
+	require.ErrorIs(t, err, types.ErrExceedMaxCallDepth)
+}
+
+func TestParamsMigration9to12(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	encoders := DefaultEncoders(nil, encodingConfig.Codec)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+	keeper := keepers.WasmKeeper
+
+	// version 9 params only have the contract size and compile cost
+	require.NoError(t, keeper.setParams(ctx, types.Params{
+		MaxContractSize: types.DefaultMaxContractSize,
+		CompileCost:     types.DefaultCompileCost,
+	}))
+
+	migrator := NewMigrator(keeper)
+	require.NoError(t, migrator.Migrate9to10(ctx))
+	require.NoError(t, migrator.Migrate10to11(ctx))
+	require.NoError(t, migrator.Migrate11to12(ctx))
+
+	params := keeper.GetParams(ctx)
+	require.NoError(t, params.Validate())
+	defaults := types.DefaultParams()
+	require.True(t, defaults.CompileCost.Equal(params.CompileCost))
+	require.Equal(t, defaults.StargateQueryAllowlist, params.StargateQueryAllowlist)
+	require.Equal(t, defaults.MaxCallDepth, params.MaxCallDepth)
+	require.Equal(t, defaults.MaxQueryDepth, params.MaxQueryDepth)
+	require.Equal(t, defaults.MaxLabelSize, params.MaxLabelSize)
+	require.Zero(t, params.MaxMsgSize)
+
+	// messages stay unlimited in size after the upgrade
+	_, _, creator := keyPubAddr()
+	_, _, contract := keyPubAddr()
+	_, err := keeper.Execute(ctx, contract, creator, make([]byte, 2*types.DefaultMaxContractSize), nil, nil, wasmTypes.HandleTypeExecute)
+	require.Error(t, err)
+	require.NotErrorIs(t, err, types.ErrExceedMaxMsgSize)
+}
+
+type mockExecutionHalter struct {
+	err error
+}
+
+func (m mockExecutionHalter) CheckContractExecutionHalted(_ sdk.Context) error {
+	return m.err
+}
+
+func TestExecutionHalted(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	encoders := DefaultEncoders(nil, encodingConfig.Codec)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+	keeper := keepers.WasmKeeper
+
+	errHalted := errors.New("contract executions are halted")
+	keeper.SetExecutionHalter(mockExecutionHalter{err: errHalted})
+
+	_, _, creator := keyPubAddr()
+	_, _, contract := keyPubAddr()
+
+	_, _, err := keeper.Instantiate(ctx, 1, creator, nil, []byte("{}"), "label", nil, nil)
+	require.ErrorIs(t, err, errHalted)
+
+	_, err = keeper.Execute(ctx, contract, creator, []byte("{}"), nil, nil, wasmTypes.HandleTypeExecute)
+	require.ErrorIs(t, err, errHalted)
+
+	_, err = keeper.Migrate(ctx, contract, creator, 1, []byte("{}"), nil)
+	require.ErrorIs(t, err, errHalted)
+
+	_, err = keeper.Sudo(ctx, contract, []byte("{}"))
+	require.ErrorIs(t, err, errHalted)
+}
@@ -587,6 +587,52 @@ func (q GrpcQuerier) BlockCreateResults(c context.Context, req *types.QueryBlock
 	}, nil
 }
 
+func (q GrpcQuerier) ContractStorageStats(c context.Context, req *types.QueryByContractAddressRequest) (*types.QueryContractStorageStatsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	contractAddress, err := sdk.AccAddressFromBech32(req.ContractAddress)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewGasMeter(q.keeper.queryGasLimit))
+	if !q.keeper.containsContractInfo(ctx, contractAddress) {
+		return nil, types.ErrNotFound
+	}
+
+	return &types.QueryContractStorageStatsResponse{
+		Stats: q.keeper.GetContractStorageStats(ctx, contractAddress),
+	}, nil
+}
+
+func (q GrpcQuerier) LargestContractsByStorage(c context.Context, req *types.QueryLargestContractsByStorageRequest) (*types.QueryLargestContractsByStorageResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	limit := int(req.Limit)
+	if limit == 0 || limit > MaxLargestContractsByStorage {
+		limit = MaxLargestContractsByStorage
+	}
+
+	ctx := sdk.UnwrapSDKContext(c).WithGasMeter(storetypes.NewGasMeter(q.keeper.queryGasLimit))
+
+	contracts := make([]types.ContractStorageUsage, 0, limit)
+	q.keeper.IterateContractsByStorageSize(ctx, func(contractAddress sdk.AccAddress, stats types.ContractStorageStats) bool {
+		contracts = append(contracts, types.ContractStorageUsage{
+			ContractAddress: contractAddress.String(),
+			Stats:           stats,
+		})
+		return len(contracts) >= limit
+	})
+
+	return &types.QueryLargestContractsByStorageResponse{
+		Contracts: contracts,
+	}, nil
+}
+
 func queryContractInfo(ctx sdk.Context, contractAddress sdk.AccAddress, keeper Keeper) (*types.ContractInfoWithAddress, error) {
 	info := keeper.GetContractInfo(ctx, contractAddress)
 	if info == nil {
@@ -76,6 +76,9 @@ func (q QueryHandler) Query(request wasmTypes.QueryRequest, queryDepth uint32, g
 	if request.Stargate != nil {
 		return q.Plugins.Stargate(q.Ctx, request.Stargate)
 	}
+	if request.Grpc != nil {
+		return q.Plugins.Grpc(q.Ctx, request.Grpc)
+	}
 	return nil, wasmTypes.Unknown{}
 }
 
@@ -95,6 +98,7 @@ type QueryPlugins struct {
 	Gov      func(ctx sdk.Context, request *wasmTypes.GovQuery) ([]byte, error)
 	IBC      func(ctx sdk.Context, caller sdk.AccAddress, request *wasmTypes.IBCQuery) ([]byte, error)
 	Stargate func(ctx sdk.Context, request *wasmTypes.StargateQuery) ([]byte, error)
+	Grpc     func(ctx sdk.Context, request *wasmTypes.GrpcQuery) ([]byte, error)
 }
 
 func DefaultQueryPlugins(gov govkeeper.Keeper, dist distrkeeper.Keeper, mint mintkeeper.Keeper, bank bankkeeper.Keeper, staking stakingkeeper.Keeper, stargateQueryRouter GRPCQueryRouter, wasm *Keeper, channelKeeper types.ChannelKeeper) QueryPlugins {
@@ -106,7 +110,8 @@ func DefaultQueryPlugins(gov govkeeper.Keeper, dist distrkeeper.Keeper, mint min
 		Dist:     DistQuerier(dist),
 		Mint:     MintQuerier(mint),
 		Gov:      GovQuerier(gov),
-		Stargate: StargateQuerier(stargateQueryRouter),
+		Stargate: StargateQuerier(stargateQueryRouter, wasm),
+		Grpc:     GrpcStargateQuerier(stargateQueryRouter, wasm),
 		IBC:      IBCQuerier(wasm, channelKeeper),
 	}
 }
@@ -143,90 +148,44 @@ func (e QueryPlugins) Merge(o *QueryPlugins) QueryPlugins {
 	if o.Stargate != nil {
 		e.Stargate = o.Stargate
 	}
+	if o.Grpc != nil {
+		e.Grpc = o.Grpc
+	}
 	return e
 }
 
-// Assaf: stargateQueryAllowlist is a list of all safe and efficient queries
-//
-// excluded from this list (should be safe, but needs a clear use case):
-//   - /secret.registration.*
-//   - /ibc.core.*
-//   - /secret.intertx.*
-//   - /cosmos.evidence.*
-//   - /cosmos.upgrade.*
-//   - All "get all" queries - only O(1) queries should be served
-//
-// we reserve the right to add/remove queries in future chain upgrades
-//
-// used this to find all query paths:
-// find -name query.proto | sort | xargs grep -Poin 'package [a-z0-9.]+;|rpc [a-zA-Z]+\('
-var stargateQueryAllowlist = map[string]bool{
-	"/cosmos.auth.v1beta1.Query/Account": true,
-	"/cosmos.auth.v1beta1.Query/Params":  true,
-
-	"/cosmos.bank.v1beta1.Query/Balance":       true,
-	"/cosmos.bank.v1beta1.Query/DenomMetadata": true,
-	"/cosmos.bank.v1beta1.Query/SupplyOf":      true,
-	"/cosmos.bank.v1beta1.Query/Params":        true,
-
-	"/cosmos.distribution.v1beta1.Query/Params":                   true,
-	"/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress": true,
-	"/cosmos.distribution.v1beta1.Query/FoundationTax":            true,
-	"/cosmos.distribution.v1beta1.Query/ValidatorCommission":      true,
-
-	"/cosmos.feegrant.v1beta1.Query/Allowance": true,
-
-	"/cosmos.gov.v1beta1.Query/Deposit":  true,
-	"/cosmos.gov.v1beta1.Query/Params":   true,
-	"/cosmos.gov.v1beta1.Query/Proposal": true,
-	"/cosmos.gov.v1beta1.Query/Vote":     true,
-
-	"/cosmos.mint.v1beta1.Query/Params":           true,
-	"/cosmos.mint.v1beta1.Query/Inflation":        true,
-	"/cosmos.mint.v1beta1.Query/AnnualProvisions": true,
-
-	"/cosmos.params.v1beta1.Query/Params": true,
-
-	"/cosmos.slashing.v1beta1.Query/Params":      true,
-	"/cosmos.slashing.v1beta1.Query/SigningInfo": true,
-
-	"/cosmos.staking.v1beta1.Query/Validator":           true,
-	"/cosmos.staking.v1beta1.Query/Delegation":          true,
-	"/cosmos.staking.v1beta1.Query/UnbondingDelegation": true,
-	"/cosmos.staking.v1beta1.Query/Params":              true,
-
-	"/ibc.applications.transfer.v1.Query/DenomHash":  true,
-	"/ibc.applications.transfer.v1.Query/DenomTrace": true,
-	"/ibc.applications.transfer.v1.Query/Params":     true,
-
-	"/secret.compute.v1beta1.Query/ContractInfo":              true,
-	"/secret.compute.v1beta1.Query/CodeHashByContractAddress": true,
-	"/secret.compute.v1beta1.Query/CodeHashByCodeId":          true,
-	"/secret.compute.v1beta1.Query/LabelByAddress":            true,
-	"/secret.compute.v1beta1.Query/AddressByLabel":            true,
-	"/secret.compute.v1beta1.Query/ContractsByCodeId":         true,
+func StargateQuerier(queryRouter GRPCQueryRouter, wasm *Keeper) func(ctx sdk.Context, request *wasmTypes.StargateQuery) ([]byte, error) {
+	return func(ctx sdk.Context, msg *wasmTypes.StargateQuery) ([]byte, error) {
+		return routeAllowedQuery(ctx, queryRouter, wasm, msg.Path, msg.Data)
+	}
 }
 
-func StargateQuerier(queryRouter GRPCQueryRouter) func(ctx sdk.Context, request *wasmTypes.StargateQuery) ([]byte, error) {
-	return func(ctx sdk.Context, msg *wasmTypes.StargateQuery) ([]byte, error) {
-		if !stargateQueryAllowlist[msg.Path] {
-			return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("query path '%s' is not allowed from the contract", msg.Path)}
-		}
+// GrpcStargateQuerier serves the CosmWasm 1.x grpc query variant. It shares the stargate allowlist
+// and returns the protobuf encoded response as is.
+func GrpcStargateQuerier(queryRouter GRPCQueryRouter, wasm *Keeper) func(ctx sdk.Context, request *wasmTypes.GrpcQuery) ([]byte, error) {
+	return func(ctx sdk.Context, msg *wasmTypes.GrpcQuery) ([]byte, error) {
+		return routeAllowedQuery(ctx, queryRouter, wasm, msg.Path, msg.Data)
+	}
+}
 
-		route := queryRouter.Route(msg.Path)
-		if route == nil {
-			return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query path '%s'", msg.Path)}
-		}
-		req := abci.RequestQuery{
-			Data: msg.Data,
-			Path: msg.Path,
-		}
-		res, err := route(ctx, &req)
-		if err != nil {
-			return nil, err
-		}
-		return res.Value, nil
+func routeAllowedQuery(ctx sdk.Context, queryRouter GRPCQueryRouter, wasm *Keeper, path string, data []byte) ([]byte, error) {
+	if !wasm.GetParams(ctx).IsStargateQueryAllowed(path) {
+		return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("query path '%s' is not allowed from the contract", path)}
+	}
+
+	route := queryRouter.Route(path)
+	if route == nil {
+		return nil, wasmTypes.UnsupportedRequest{Kind: fmt.Sprintf("No route to query path '%s'", path)}
+	}
+	req := abci.RequestQuery{
+		Data: data,
+		Path: path,
+	}
+	res, err := route(ctx, &req)
+	if err != nil {
+		return nil, err
 	}
+	return res.Value, nil
 }
 
 func GovQuerier(keeper govkeeper.Keeper) func(ctx sdk.Context, request *wasmTypes.GovQuery) ([]byte, error) {
@@ -397,6 +356,76 @@ func DistQuerier(keeper distrkeeper.Keeper) func(ctx sdk.Context, request *wasmT
 
 			return ret, nil
 		}
+		if request.DelegatorWithdrawAddress != nil {
+			req := distrtypes.QueryDelegatorWithdrawAddressRequest{
+				DelegatorAddress: request.DelegatorWithdrawAddress.DelegatorAddress,
+			}
+
+			response, err := distrkeeper.NewQuerier(keeper).DelegatorWithdrawAddress(ctx, &req)
+			if err != nil {
+				return nil, sdkerrors.ErrUnknownRequest.Wrap(err.Error())
+			}
+
+			ret, err := json.Marshal(wasmTypes.DelegatorWithdrawAddressResponse{
+				WithdrawAddress: response.WithdrawAddress,
+			})
+			if err != nil {
+				return nil, sdkerrors.ErrJSONMarshal.Wrap(err.Error())
+			}
+
+			return ret, nil
+		}
+		if request.DelegationRewards != nil {
+			req := distrtypes.QueryDelegationRewardsRequest{
+				DelegatorAddress: request.DelegationRewards.DelegatorAddress,
+				ValidatorAddress: request.DelegationRewards.ValidatorAddress,
+			}
+
+			// the querier withdraws rewards into a cached context, so we don't want to persist its writes
+			cache, _ := ctx.CacheContext()
+			response, err := distrkeeper.NewQuerier(keeper).DelegationRewards(cache, &req)
+			if err != nil {
+				return nil, sdkerrors.ErrUnknownRequest.Wrap(err.Error())
+			}
+
+			ret, err := json.Marshal(wasmTypes.DelegationRewardsResponse{
+				Rewards: convertSdkDecCoinsToRewardCoins(response.Rewards),
+			})
+			if err != nil {
+				return nil, sdkerrors.ErrJSONMarshal.Wrap(err.Error())
+			}
+
+			return ret, nil
+		}
+		if request.DelegationTotalRewards != nil {
+			req := distrtypes.QueryDelegationTotalRewardsRequest{
+				DelegatorAddress: request.DelegationTotalRewards.DelegatorAddress,
+			}
+
+			cache, _ := ctx.CacheContext()
+			response, err := distrkeeper.NewQuerier(keeper).DelegationTotalRewards(cache, &req)
+			if err != nil {
+				return nil, sdkerrors.ErrUnknownRequest.Wrap(err.Error())
+			}
+
+			res := wasmTypes.DelegationTotalRewardsResponse{
+				Rewards: make([]wasmTypes.Rewards, len(response.Rewards)),
+				Total:   convertSdkDecCoinsToRewardCoins(response.Total),
+			}
+			for i, valRewards := range response.Rewards {
+				res.Rewards[i] = wasmTypes.Rewards{
+					Validator: valRewards.ValidatorAddress,
+					Reward:    convertSdkDecCoinsToRewardCoins(valRewards.Reward),
+				}
+			}
+
+			ret, err := json.Marshal(res)
+			if err != nil {
+				return nil, sdkerrors.ErrJSONMarshal.Wrap(err.Error())
+			}
+
+			return ret, nil
+		}
 		return nil, wasmTypes.UnsupportedRequest{Kind: "unknown DistQuery variant"}
 	}
 }
@@ -740,6 +769,18 @@ func convertSdkCoinsToWasmCoins(coins []sdk.Coin) wasmTypes.Coins {
 	return converted
 }
 
+// convertSdkDecCoinsToRewardCoins formats rewards like the Rewards query, without the fractions of the amounts
+func convertSdkDecCoinsToRewardCoins(coins sdk.DecCoins) wasmTypes.RewardCoins {
+	converted := make(wasmTypes.RewardCoins, len(coins))
+	for i, c := range coins {
+		converted[i] = wasmTypes.Coin{
+			Denom:  c.Denom,
+			Amount: c.Amount.TruncateInt().String(),
+		}
+	}
+	return converted
+}
+
 func convertSdkCoinToWasmCoin(coin sdk.Coin) wasmTypes.Coin {
 	return wasmTypes.Coin{
 		Denom:  coin.Denom,
@@ -80,7 +80,9 @@ func (k Keeper) ibcContractCall(ctx sdk.Context,
 		storeForExecution = prefixStore
 	}
 
-	res, gasUsed, err := k.wasmer.Execute(codeInfo.CodeHash, env, msgBz, storeForExecution, cosmwasmAPI, querier, ctx.GasMeter(), gas, sigInfo, callType)
+	accountingStore := k.newStorageAccountingStore(ctx, contractAddress, storeForExecution)
+	res, gasUsed, err := k.wasmer.Execute(codeInfo.CodeHash, env, msgBz, accountingStore, cosmwasmAPI, querier, ctx.GasMeter(), gas, sigInfo, callType)
+	k.commitStorageAccounting(ctx, contractAddress, accountingStore)
 
 	if api.GetRecorder().IsReplayMode() {
 		crossOps := api.GetRecorder().GetAndClearPendingCrossModuleOps()
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+// MaxLargestContractsByStorage is the max number of contracts returned by the
+// LargestContractsByStorage query
+const MaxLargestContractsByStorage = 100
+
+// storageAccountingStore wraps a contract's prefix store and keeps track of how
+// the contract's key count and total bytes change on every Set/Delete.
+// This also covers the replay path, as ReplayingKVStore.ApplyOps writes through
+// the store that's passed to the wasmer.
+type storageAccountingStore struct {
+	prefix.Store
+
+	// lookup is a gas-free view of the same store, used to read the previous
+	// value of a key so that accounting doesn't change the gas used by contracts
+	lookup    prefix.Store
+	keyDelta  int64
+	byteDelta int64
+}
+
+func (k Keeper) newStorageAccountingStore(ctx sdk.Context, contractAddress sdk.AccAddress, store prefix.Store) *storageAccountingStore {
+	lookupCtx := ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+	prefixStoreKey := types.GetContractStorePrefixKey(contractAddress)
+
+	return &storageAccountingStore{
+		Store:  store,
+		lookup: prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(lookupCtx)), prefixStoreKey),
+	}
+}
+
+// Set accounts for the new value and delegates to the inner store
+func (s *storageAccountingStore) Set(key, value []byte) {
+	if prev := s.lookup.Get(key); prev != nil {
+		s.byteDelta += int64(len(value)) - int64(len(prev))
+	} else {
+		s.keyDelta++
+		s.byteDelta += int64(len(key) + len(value))
+	}
+	s.Store.Set(key, value)
+}
+
+// Delete accounts for the removed value and delegates to the inner store
+func (s *storageAccountingStore) Delete(key []byte) {
+	if prev := s.lookup.Get(key); prev != nil {
+		s.keyDelta--
+		s.byteDelta -= int64(len(key) + len(prev))
+	}
+	s.Store.Delete(key)
+}
+
+// commitStorageAccounting applies the deltas collected by the store to the contract's storage stats
+func (k Keeper) commitStorageAccounting(ctx sdk.Context, contractAddress sdk.AccAddress, s *storageAccountingStore) {
+	if s.keyDelta == 0 && s.byteDelta == 0 {
+		return
+	}
+
+	// accounting is bookkeeping of the chain and is not charged to the caller
+	ctx = ctx.WithGasMeter(storetypes.NewInfiniteGasMeter())
+
+	stats := k.GetContractStorageStats(ctx, contractAddress)
+	oldTotalBytes := stats.TotalBytes
+	stats.KeyCount = applyDelta(stats.KeyCount, s.keyDelta)
+	stats.TotalBytes = applyDelta(stats.TotalBytes, s.byteDelta)
+	k.setContractStorageStats(ctx, contractAddress, oldTotalBytes, stats)
+
+	s.keyDelta = 0
+	s.byteDelta = 0
+}
+
+// applyDelta adds a signed delta to a counter without going below zero
+func applyDelta(value uint64, delta int64) uint64 {
+	if delta < 0 {
+		if uint64(-delta) > value {
+			return 0
+		}
+		return value - uint64(-delta)
+	}
+	return value + uint64(delta)
+}
+
+// GetContractStorageStats returns the key count and total bytes held by a contract
+func (k Keeper) GetContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageStats {
+	store := k.storeService.OpenKVStore(ctx)
+
+	var stats types.ContractStorageStats
+	bz, err := store.Get(types.GetContractStorageStatsKey(contractAddress))
+	if err != nil || bz == nil {
+		return stats
+	}
+	k.cdc.MustUnmarshal(bz, &stats)
+	return stats
+}
+
+// setContractStorageStats stores the stats of a contract and moves it in the by-size index
+func (k Keeper) setContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress, oldTotalBytes uint64, stats types.ContractStorageStats) {
+	store := k.storeService.OpenKVStore(ctx)
+
+	err := store.Delete(types.GetContractByStorageSizeSecondaryIndexKey(contractAddress, oldTotalBytes))
+	if err != nil {
+		ctx.Logger().Error("delete storage size index", "contract", contractAddress.String(), "error", err.Error())
+	}
+
+	if stats.KeyCount == 0 {
+		err = store.Delete(types.GetContractStorageStatsKey(contractAddress))
+		if err != nil {
+			ctx.Logger().Error("delete storage stats", "contract", contractAddress.String(), "error", err.Error())
+		}
+		return
+	}
+
+	err = store.Set(types.GetContractStorageStatsKey(contractAddress), k.cdc.MustMarshal(&stats))
+	if err != nil {
+		ctx.Logger().Error("set storage stats", "contract", contractAddress.String(), "error", err.Error())
+	}
+	err = store.Set(types.GetContractByStorageSizeSecondaryIndexKey(contractAddress, stats.TotalBytes), []byte{})
+	if err != nil {
+		ctx.Logger().Error("set storage size index", "contract", contractAddress.String(), "error", err.Error())
+	}
+}
+
+// computeContractStorageStats walks a contract's store and recomputes its storage stats from scratch
+func (k Keeper) computeContractStorageStats(ctx sdk.Context, contractAddress sdk.AccAddress) types.ContractStorageStats {
+	var stats types.ContractStorageStats
+
+	iter := k.GetContractState(ctx, contractAddress)
+	defer iter.Close()
+	for ; iter.Valid(); iter.Next() {
+		stats.KeyCount++
+		stats.TotalBytes += uint64(len(iter.Key()) + len(iter.Value()))
+	}
+	return stats
+}
+
+// IterateContractsByStorageSize iterates over contracts from the one holding the most bytes to the least.
+// cb returns true to stop early.
+func (k Keeper) IterateContractsByStorageSize(ctx sdk.Context, cb func(sdk.AccAddress, types.ContractStorageStats) bool) {
+	prefixStore := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.ContractByStorageSizeSecondaryIndexPrefix)
+	iter := prefixStore.ReverseIterator(nil, nil)
+	defer iter.Close()
+
+	for ; iter.Valid(); iter.Next() {
+		// key is `<totalBytes><contractAddr>`
+		contractAddress := sdk.AccAddress(iter.Key()[8:])
+		if cb(contractAddress, k.GetContractStorageStats(ctx, contractAddress)) {
+			break
+		}
+	}
+}
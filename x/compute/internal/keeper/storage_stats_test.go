@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+)
+
+func TestContractStorageAccounting(t *testing.T) {
+	encodingConfig := MakeEncodingConfig()
+	var transferPortSource types.ICS20TransferPortSource
+	transferPortSource = MockIBCTransferKeeper{GetPortFn: func(ctx sdk.Context) string {
+		return "myTransferPort"
+	}}
+	encoders := DefaultEncoders(transferPortSource, encodingConfig.Codec)
+	ctx, keepers := CreateTestInput(t, false, SupportedFeatures, &encoders, nil)
+	keeper := keepers.WasmKeeper
+
+	_, _, small := keyPubAddr()
+	_, _, big := keyPubAddr()
+
+	contractStore := func(addr sdk.AccAddress) *storageAccountingStore {
+		store := prefix.NewStore(runtime.KVStoreAdapter(keeper.storeService.OpenKVStore(ctx)), types.GetContractStorePrefixKey(addr))
+		return keeper.newStorageAccountingStore(ctx, addr, store)
+	}
+
+	// new keys
+	store := contractStore(small)
+	store.Set([]byte("a"), []byte("12345"))
+	store.Set([]byte("b"), []byte("1"))
+	keeper.commitStorageAccounting(ctx, small, store)
+	require.Equal(t, types.ContractStorageStats{KeyCount: 2, TotalBytes: 8}, keeper.GetContractStorageStats(ctx, small))
+
+	// overwrite, delete and delete of a missing key
+	store = contractStore(small)
+	store.Set([]byte("a"), []byte("12"))
+	store.Delete([]byte("b"))
+	store.Delete([]byte("missing"))
+	keeper.commitStorageAccounting(ctx, small, store)
+	require.Equal(t, types.ContractStorageStats{KeyCount: 1, TotalBytes: 3}, keeper.GetContractStorageStats(ctx, small))
+	require.Equal(t, keeper.computeContractStorageStats(ctx, small), keeper.GetContractStorageStats(ctx, small))
+
+	store = contractStore(big)
+	store.Set([]byte("key"), make([]byte, 100))
+	keeper.commitStorageAccounting(ctx, big, store)
+
+	var order []string
+	keeper.IterateContractsByStorageSize(ctx, func(addr sdk.AccAddress, _ types.ContractStorageStats) bool {
+		order = append(order, addr.String())
+		return false
+	})
+	require.Equal(t, []string{big.String(), small.String()}, order)
+
+	// removing all keys removes the contract from the index
+	store = contractStore(big)
+	store.Delete([]byte("key"))
+	keeper.commitStorageAccounting(ctx, big, store)
+	require.Equal(t, types.ContractStorageStats{}, keeper.GetContractStorageStats(ctx, big))
+
+	order = nil
+	keeper.IterateContractsByStorageSize(ctx, func(addr sdk.AccAddress, _ types.ContractStorageStats) bool {
+		order = append(order, addr.String())
+		return false
+	})
+	require.Equal(t, []string{small.String()}, order)
+}
@@ -0,0 +1,89 @@
+package keeper
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute/internal/types"
+	crontypes "github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+const sudoTimeoutMsg = `{"ibc_lifecycle_complete":{"ibc_timeout":{"channel":"channel-0","sequence":0}}}`
+
+func TestSudoContractAuthority(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	msgServer := NewMsgServerImpl(keeper)
+	for _, authority := range []string{walletA.String(), authtypes.NewModuleAddress(crontypes.ModuleName).String(), crontypes.ScheduledTxSender().String()} {
+		_, err := msgServer.SudoContract(ctx, &types.MsgSudoContract{
+			Authority: authority,
+			Contract:  contractAddress.String(),
+			Msg:       []byte(sudoTimeoutMsg),
+		})
+		require.ErrorIs(t, err, govtypes.ErrInvalidSigner)
+	}
+
+	_, err := msgServer.SudoContract(ctx, &types.MsgSudoContract{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Contract:  walletA.String(),
+		Msg:       []byte(sudoTimeoutMsg),
+	})
+	require.ErrorIs(t, err, types.ErrNotFound)
+}
+
+func TestSudoContract(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	// governance executes its msgs in EndBlock, outside of any tx
+	ctx = ctx.WithTxBytes(nil).WithEventManager(sdk.NewEventManager())
+	_, err := NewMsgServerImpl(keeper).SudoContract(ctx, &types.MsgSudoContract{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Contract:  contractAddress.String(),
+		Msg:       []byte(sudoTimeoutMsg),
+	})
+	require.NoError(t, err)
+
+	requireEvents(t,
+		[]ContractEvent{
+			{
+				{Key: "contract_address", Value: contractAddress.String()},
+				{Key: "ibc_lifecycle_complete.ibc_timeout.channel", Value: "channel-0"},
+				{Key: "ibc_lifecycle_complete.ibc_timeout.sequence", Value: "0"},
+			},
+		},
+		tryDecryptWasmEvents(ctx, nil),
+	)
+
+	// the call fails the proposal right away when the contract rejects it
+	_, err = NewMsgServerImpl(keeper).SudoContract(ctx, &types.MsgSudoContract{
+		Authority: authtypes.NewModuleAddress(govtypes.ModuleName).String(),
+		Contract:  contractAddress.String(),
+		Msg:       []byte(`{"unknown":{}}`),
+	})
+	require.Error(t, err)
+}
+
+func TestKeeperSudo(t *testing.T) {
+	ctx, keeper, codeID, _, walletA, privKeyA, _, _ := setupTest(t, TestContractPaths[v1Contract], sdk.NewCoins())
+
+	_, _, contractAddress, _, initErr := initHelper(t, keeper, ctx, codeID, walletA, nil, privKeyA, `{"nop":{}}`, true, true, defaultGasForTests)
+	require.Empty(t, initErr)
+
+	// other modules call sudo directly, e.g. from an ibc-hooks callback
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err := keeper.Sudo(ctx, contractAddress, []byte(sudoTimeoutMsg))
+	require.NoError(t, err)
+
+	_, err = keeper.Sudo(ctx, walletA, []byte(sudoTimeoutMsg))
+	require.Error(t, err)
+}
@@ -506,6 +506,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		keys[crontypes.StoreKey],
 		memKeys[crontypes.StoreKey],
 		authKeeper,
+		bankKeeper,
 		authtypes.NewModuleAddress(crontypes.ModuleName).String(),
 	)
 
@@ -589,6 +590,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, supportedFeatures string, enc
 		tempDir,
 		false,
 		&baseapp.BaseApp{},
+		authtypes.NewModuleAddress(govtypes.ModuleName).String(),
 	)
 
 	bappTxMngr := baseapp.LastMsgMarkerContainer{}
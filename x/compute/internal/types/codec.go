@@ -17,6 +17,7 @@ func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgClearAdmin{}, "wasm/MsgClearAdmin", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "wasm/MsgUpdateParams", nil)
 	cdc.RegisterConcrete(&MsgMigrateContractProposal{}, "wasm/MsgContractMigrateProposal", nil)
+	cdc.RegisterConcrete(&MsgSudoContract{}, "wasm/MsgSudoContract", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
@@ -30,6 +31,7 @@ func RegisterInterfaces(registry types.InterfaceRegistry) {
 		&MsgClearAdmin{},
 		&MsgUpdateParams{},
 		&MsgMigrateContractProposal{},
+		&MsgSudoContract{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -78,6 +78,12 @@ var (
 
 	// ErrExceedMaxContractSize error if max contract size is exceeded
 	ErrExceedMaxContractSize = errors.Register(DefaultCodespace, 31, "max contract size exceeded")
+
+	// ErrExceedMaxQueryDepth error if max smart query depth is exceeded
+	ErrExceedMaxQueryDepth = errors.Register(DefaultCodespace, 32, "max query depth exceeded")
+
+	// ErrExceedMaxMsgSize error if max message size is exceeded
+	ErrExceedMaxMsgSize = errors.Register(DefaultCodespace, 33, "max message size exceeded")
 )
 
 func IsEncryptedErrorCode(code uint32) bool {
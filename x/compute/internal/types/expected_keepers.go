@@ -26,5 +26,16 @@ type ChannelKeeper interface {
 }
 
 type CronKeeper interface {
-	GetScheduledMsgs(ctx sdk.Context) []types.MsgExecuteContract
+	GetScheduledMsgs(ctx sdk.Context) []types.ScheduledMsg
+	GetSchedule(ctx sdk.Context, name string) (*types.Schedule, bool)
+	TrackScheduledExecution(ctx sdk.Context, txBytes []byte, scheduleName string)
+	TrackScheduledTx(ctx sdk.Context, txBytes []byte)
+	GetScheduledExecution(ctx sdk.Context, txBytes []byte) (string, bool)
+	CompleteScheduledExecution(ctx sdk.Context, txBytes []byte)
+	RecordScheduledExecutionError(ctx sdk.Context, txBytes []byte, err error)
+}
+
+// ExecutionHalter can halt the execution of all contracts, see x/emergencybutton
+type ExecutionHalter interface {
+	CheckContractExecutionHalted(ctx sdk.Context) error
 }
@@ -13,6 +13,9 @@ func (s Sequence) ValidateBasic() error {
 }
 
 func (s GenesisState) ValidateBasic() error {
+	if err := s.Params.Validate(); err != nil {
+		return errors.Wrap(err, "params")
+	}
 	for i := range s.Codes {
 		if err := s.Codes[i].ValidateBasic(); err != nil {
 			return errors.Wrapf(err, "code: %d", i)
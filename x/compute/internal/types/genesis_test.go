@@ -15,14 +15,47 @@ func TestValidateGenesisState(t *testing.T) {
 		"all good": {
 			srcMutator: func(s *GenesisState) {},
 		},
-		/*
-			"params invalid": {
-				srcMutator: func(s *GenesisState) {
-					s.Params = Params{}
-				},
-				expError: true,
-			},
-		*/
+		"params invalid": {
+			srcMutator: func(s *GenesisState) {
+				s.Params = Params{}
+			},
+			expError: true,
+		},
+		"max call depth too high": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxCallDepth = MaxCallDepthLimit + 1
+			},
+			expError: true,
+		},
+		"max query depth above enclave limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxQueryDepth = DefaultMaxQueryDepth + 1
+			},
+			expError: true,
+		},
+		"max label size too high": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxLabelSize = MaxLabelSize + 1
+			},
+			expError: true,
+		},
+		"max msg size zero means no limit": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.MaxMsgSize = 0
+			},
+		},
+		"stargate query allowlist invalid path": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.StargateQueryAllowlist = []string{"cosmos.bank.v1beta1.Query/Balance"}
+			},
+			expError: true,
+		},
+		"stargate query allowlist duplicate path": {
+			srcMutator: func(s *GenesisState) {
+				s.Params.StargateQueryAllowlist = []string{"/cosmos.bank.v1beta1.Query/Balance", "/cosmos.bank.v1beta1.Query/Balance"}
+			},
+			expError: true,
+		},
 		"codeinfo invalid": {
 			srcMutator: func(s *GenesisState) {
 				s.Codes[0].CodeInfo.CodeHash = nil
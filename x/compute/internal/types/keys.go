@@ -36,6 +36,8 @@ var (
 	ParamsKey                                      = []byte{0x0B}
 	UpgradeAuthPrefix                              = []byte{0x0C}
 	UpdateAdminPrefix                              = []byte{0x0D}
+	ContractStorageStatsPrefix                     = []byte{0x0E}
+	ContractByStorageSizeSecondaryIndexPrefix      = []byte{0x0F}
 	RandomPrefix                                   = []byte{0xFF}
 	ValidatorSetEvidencePrefix                     = []byte{0xFE}
 	MachineIDEvidencePrefix                        = []byte{0xFD}
@@ -128,3 +130,19 @@ func GetContractCodeHistoryElementKey(contractAddr sdk.AccAddress, pos uint64) [
 	copy(r[prefixLen:], sdk.Uint64ToBigEndian(pos))
 	return r
 }
+
+// GetContractStorageStatsKey returns the key for the storage stats of a contract: `<prefix><contractAddr>`
+func GetContractStorageStatsKey(contractAddr sdk.AccAddress) []byte {
+	return append(ContractStorageStatsPrefix, contractAddr...)
+}
+
+// GetContractByStorageSizeSecondaryIndexKey returns the key for the secondary index:
+// `<prefix><totalBytes><contractAddr>`
+func GetContractByStorageSizeSecondaryIndexKey(contractAddr sdk.AccAddress, totalBytes uint64) []byte {
+	prefixLen := len(ContractByStorageSizeSecondaryIndexPrefix)
+	r := make([]byte, prefixLen+8+len(contractAddr))
+	copy(r[0:], ContractByStorageSizeSecondaryIndexPrefix)
+	copy(r[prefixLen:], sdk.Uint64ToBigEndian(totalBytes))
+	copy(r[prefixLen+8:], contractAddr)
+	return r
+}
@@ -2,6 +2,7 @@ package types
 
 import (
 	"encoding/hex"
+	"encoding/json"
 	fmt "fmt"
 	"strings"
 
@@ -415,3 +416,39 @@ func (msg MsgUpdateMachineWhitelist) GetSigners() []sdk.AccAddress {
 	addr, _ := sdk.AccAddressFromBech32(msg.Sender)
 	return []sdk.AccAddress{addr}
 }
+
+func (msg MsgSudoContract) Route() string {
+	return RouterKey
+}
+
+func (msg MsgSudoContract) Type() string {
+	return "sudo-contract"
+}
+
+func (msg MsgSudoContract) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errorsmod.Wrap(err, "authority")
+	}
+	if _, err := sdk.AccAddressFromBech32(msg.Contract); err != nil {
+		return errorsmod.Wrap(err, "contract")
+	}
+	if len(msg.Msg) == 0 {
+		return errorsmod.Wrap(ErrInvalid, "msg must not be empty")
+	}
+	if !json.Valid(msg.Msg) {
+		return errorsmod.Wrap(ErrInvalid, "msg must be valid json")
+	}
+	return nil
+}
+
+func (msg MsgSudoContract) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgSudoContract) GetSigners() []sdk.AccAddress {
+	addr, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{addr}
+}
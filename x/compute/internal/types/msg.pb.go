@@ -1336,6 +1336,96 @@ func (m *MsgUpdateMachineWhitelistResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateMachineWhitelistResponse proto.InternalMessageInfo
 
+// MsgSudoContract calls the sudo entry point of a contract with a plaintext
+// msg and an empty msg.sender. It can only be executed by governance.
+type MsgSudoContract struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// contract is the address of the contract to call
+	Contract string `protobuf:"bytes,2,opt,name=contract,proto3" json:"contract,omitempty"`
+	// msg is a plaintext json input to pass to the contract's sudo entry point
+	Msg []byte `protobuf:"bytes,3,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *MsgSudoContract) Reset()         { *m = MsgSudoContract{} }
+func (m *MsgSudoContract) String() string { return proto.CompactTextString(m) }
+func (*MsgSudoContract) ProtoMessage()    {}
+func (*MsgSudoContract) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6815433faf72a133, []int{28}
+}
+func (m *MsgSudoContract) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSudoContract) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSudoContract.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSudoContract) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSudoContract.Merge(m, src)
+}
+func (m *MsgSudoContract) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSudoContract) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSudoContract.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSudoContract proto.InternalMessageInfo
+
+// MsgSudoContractResponse returns sudo result data.
+type MsgSudoContractResponse struct {
+	// Data contains base64-encoded bytes to returned from the contract
+	Data []byte `protobuf:"bytes,1,opt,name=data,proto3" json:"data,omitempty"`
+}
+
+func (m *MsgSudoContractResponse) Reset()         { *m = MsgSudoContractResponse{} }
+func (m *MsgSudoContractResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSudoContractResponse) ProtoMessage()    {}
+func (*MsgSudoContractResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6815433faf72a133, []int{29}
+}
+func (m *MsgSudoContractResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSudoContractResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSudoContractResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSudoContractResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSudoContractResponse.Merge(m, src)
+}
+func (m *MsgSudoContractResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSudoContractResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSudoContractResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSudoContractResponse proto.InternalMessageInfo
+
+func (m *MsgSudoContractResponse) GetData() []byte {
+	if m != nil {
+		return m.Data
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*MsgStoreCode)(nil), "secret.compute.v1beta1.MsgStoreCode")
 	proto.RegisterType((*MsgStoreCodeResponse)(nil), "secret.compute.v1beta1.MsgStoreCodeResponse")
@@ -1365,111 +1455,116 @@ func init() {
 	proto.RegisterType((*MsgUpdateMachineWhitelistProposalResponse)(nil), "secret.compute.v1beta1.MsgUpdateMachineWhitelistProposalResponse")
 	proto.RegisterType((*MsgUpdateMachineWhitelist)(nil), "secret.compute.v1beta1.MsgUpdateMachineWhitelist")
 	proto.RegisterType((*MsgUpdateMachineWhitelistResponse)(nil), "secret.compute.v1beta1.MsgUpdateMachineWhitelistResponse")
+	proto.RegisterType((*MsgSudoContract)(nil), "secret.compute.v1beta1.MsgSudoContract")
+	proto.RegisterType((*MsgSudoContractResponse)(nil), "secret.compute.v1beta1.MsgSudoContractResponse")
 }
 
 func init() { proto.RegisterFile("secret/compute/v1beta1/msg.proto", fileDescriptor_6815433faf72a133) }
 
 var fileDescriptor_6815433faf72a133 = []byte{
-	// 1582 bytes of a gzipped FileDescriptorProto
+	// 1624 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x58, 0xcf, 0x6f, 0x1b, 0xc5,
-	0x17, 0xcf, 0xd6, 0x8e, 0x13, 0x3f, 0x3b, 0x4d, 0xba, 0x4d, 0x13, 0x67, 0xfb, 0xad, 0x9d, 0xef,
-	0xa6, 0x69, 0xd2, 0xb4, 0xb1, 0x9b, 0x20, 0x22, 0x6a, 0xe8, 0x21, 0x0e, 0x2d, 0xb5, 0x84, 0x4b,
-	0xb5, 0x01, 0x55, 0xe2, 0x62, 0x8d, 0x77, 0xa7, 0xeb, 0x55, 0xed, 0x5d, 0xb3, 0xb3, 0x4e, 0x9a,
-	0x03, 0x52, 0x05, 0x12, 0x42, 0x3d, 0x55, 0x1c, 0x41, 0x42, 0x1c, 0x90, 0x40, 0x9c, 0x72, 0xe0,
-	0xc4, 0x5f, 0x50, 0x6e, 0x55, 0x2f, 0x70, 0x0a, 0x28, 0x15, 0x8a, 0xc4, 0x9d, 0x0b, 0x27, 0x34,
-	0xbb, 0xb3, 0xeb, 0xf5, 0x66, 0x77, 0xe3, 0x44, 0x2d, 0x12, 0x97, 0x36, 0x33, 0xf3, 0x7e, 0x7e,
-	0xde, 0xe7, 0xbd, 0x99, 0x35, 0xcc, 0x12, 0x2c, 0x9b, 0xd8, 0x2a, 0xc9, 0x46, 0xbb, 0xd3, 0xb5,
-	0x70, 0x69, 0x6b, 0xa5, 0x81, 0x2d, 0xb4, 0x52, 0x6a, 0x13, 0xb5, 0xd8, 0x31, 0x0d, 0xcb, 0xe0,
-	0xa7, 0x1c, 0x89, 0x22, 0x93, 0x28, 0x32, 0x09, 0x61, 0x52, 0x35, 0x54, 0xc3, 0x16, 0x29, 0xd1,
-	0xbf, 0x1c, 0x69, 0x61, 0x5a, 0x36, 0x48, 0xdb, 0x20, 0x54, 0xbf, 0xb4, 0xe5, 0x33, 0x23, 0xcc,
-	0x38, 0x07, 0x75, 0x47, 0xc3, 0x59, 0xb0, 0xa3, 0x3c, 0xd3, 0x69, 0x20, 0xd2, 0x0b, 0x40, 0x36,
-	0x34, 0x9d, 0x9d, 0x9f, 0x41, 0x6d, 0x4d, 0x37, 0x4a, 0xf6, 0xbf, 0x6c, 0x6b, 0x2e, 0x22, 0xec,
-	0x0e, 0x32, 0x51, 0x9b, 0xd9, 0x15, 0xff, 0xe4, 0x20, 0x5b, 0x23, 0xea, 0xa6, 0x65, 0x98, 0x78,
-	0xc3, 0x50, 0x30, 0x5f, 0x85, 0x14, 0xc1, 0xba, 0x82, 0xcd, 0x1c, 0x37, 0xcb, 0x2d, 0x66, 0x2b,
-	0x2b, 0x7f, 0xef, 0x15, 0x96, 0x55, 0xcd, 0x6a, 0x76, 0x1b, 0x34, 0x3d, 0x16, 0x15, 0xfb, 0x6f,
-	0x99, 0x28, 0x0f, 0x4a, 0xd6, 0x4e, 0x07, 0x93, 0xe2, 0xba, 0x2c, 0xaf, 0x2b, 0x8a, 0x89, 0x09,
-	0x91, 0x98, 0x01, 0x7e, 0x0d, 0x4e, 0x6f, 0x23, 0xd2, 0xae, 0x37, 0x76, 0x2c, 0x5c, 0x97, 0x0d,
-	0x05, 0xe7, 0x4e, 0xd9, 0x26, 0x27, 0xf6, 0xf7, 0x0a, 0xd9, 0x7b, 0xeb, 0x9b, 0xb5, 0xca, 0x8e,
-	0x65, 0x3b, 0x95, 0xb2, 0x54, 0xce, 0x5d, 0xf1, 0x53, 0x90, 0x22, 0x46, 0xd7, 0x94, 0x71, 0x2e,
-	0x31, 0xcb, 0x2d, 0xa6, 0x25, 0xb6, 0xe2, 0x73, 0x30, 0xd2, 0xe8, 0x6a, 0x2d, 0x1a, 0x5b, 0xd2,
-	0x3e, 0x70, 0x97, 0xe5, 0xf9, 0xcf, 0xbf, 0x29, 0x0c, 0x7d, 0x72, 0xb0, 0xbb, 0xc4, 0x5c, 0x3f,
-	0x3e, 0xd8, 0x5d, 0x3a, 0x43, 0x6d, 0x96, 0xfc, 0xb9, 0x89, 0x6f, 0xc2, 0xa4, 0x7f, 0x2d, 0x61,
-	0xd2, 0x31, 0x74, 0x82, 0xf9, 0x39, 0x18, 0xa1, 0xe1, 0xd5, 0x35, 0xc5, 0x4e, 0x3a, 0x59, 0x81,
-	0xfd, 0xbd, 0x42, 0x8a, 0x8a, 0x54, 0xdf, 0x96, 0x52, 0xf4, 0xa8, 0xaa, 0x88, 0x7f, 0x24, 0x60,
-	0xaa, 0x46, 0xd4, 0xaa, 0x4e, 0x2c, 0xa4, 0x5b, 0x1a, 0xa2, 0xc1, 0xea, 0x96, 0x89, 0x64, 0xeb,
-	0x65, 0x62, 0x76, 0x15, 0x78, 0x19, 0xb5, 0x5a, 0x0d, 0x24, 0x3f, 0xb0, 0x21, 0xab, 0x37, 0x11,
-	0x69, 0xda, 0xb8, 0xa5, 0xa5, 0x09, 0xf7, 0x84, 0x46, 0x76, 0x1b, 0x91, 0xa6, 0x3f, 0xf0, 0x44,
-	0x54, 0xe0, 0xfc, 0x24, 0x0c, 0xb7, 0x50, 0x03, 0xb7, 0x18, 0x68, 0xce, 0x82, 0x9f, 0x81, 0x51,
-	0x4d, 0xd7, 0xac, 0x7a, 0x9b, 0xa8, 0xb9, 0x61, 0x1a, 0xb5, 0x34, 0x42, 0xd7, 0x35, 0xa2, 0xf2,
-	0x8f, 0x38, 0x00, 0xfb, 0xec, 0x7e, 0x57, 0x57, 0x48, 0x2e, 0x35, 0x9b, 0x58, 0xcc, 0xac, 0xce,
-	0x14, 0x19, 0x1f, 0x29, 0x03, 0x5d, 0x82, 0x17, 0x37, 0x0c, 0x4d, 0xaf, 0xdc, 0x7a, 0xba, 0x57,
-	0x18, 0xfa, 0xe1, 0xb7, 0xc2, 0xe2, 0x00, 0x29, 0x53, 0x05, 0xf2, 0xe5, 0xc1, 0xee, 0x52, 0xb6,
-	0x85, 0x55, 0x24, 0xef, 0xd4, 0x29, 0x87, 0xc9, 0xf7, 0x07, 0xbb, 0x4b, 0x9c, 0x94, 0xa6, 0x4e,
-	0x6f, 0x51, 0x9f, 0xfc, 0x2a, 0x64, 0x3d, 0x18, 0x88, 0xa6, 0xe6, 0x46, 0x6c, 0x5c, 0xc7, 0xf7,
-	0xf7, 0x0a, 0x99, 0x0d, 0xb6, 0xbf, 0xa9, 0xa9, 0x52, 0x46, 0xee, 0x2d, 0x68, 0x9e, 0x48, 0x69,
-	0x6b, 0x7a, 0x6e, 0xd4, 0xc9, 0xd3, 0x5e, 0x94, 0x4b, 0x21, 0xd4, 0x38, 0xef, 0x52, 0x23, 0xa4,
-	0x98, 0xe2, 0x1d, 0xc8, 0x87, 0x9f, 0x78, 0x74, 0xc9, 0xc1, 0x08, 0x72, 0xca, 0x66, 0xd7, 0x3b,
-	0x2d, 0xb9, 0x4b, 0x9e, 0x87, 0xa4, 0x82, 0x2c, 0xe4, 0xf0, 0x5c, 0xb2, 0xff, 0x16, 0x9f, 0x27,
-	0x80, 0xaf, 0x11, 0xf5, 0xe6, 0x43, 0x2c, 0x77, 0x5f, 0x0d, 0x67, 0x6a, 0x30, 0x2a, 0x33, 0xb3,
-	0xac, 0xc3, 0x4e, 0x60, 0xcc, 0x33, 0xc1, 0x4f, 0x40, 0x82, 0x92, 0x22, 0x61, 0xe7, 0x40, 0xff,
-	0x8c, 0x20, 0x65, 0x32, 0x82, 0x94, 0x94, 0x3e, 0x04, 0xeb, 0x2e, 0x7d, 0x86, 0xff, 0x35, 0xfa,
-	0x50, 0xa7, 0xe1, 0xf4, 0x49, 0x1d, 0x4d, 0x9f, 0xf2, 0x95, 0x10, 0xa2, 0x4c, 0xbb, 0x44, 0x09,
-	0x54, 0x4f, 0xbc, 0x06, 0xc2, 0xe1, 0x5d, 0x8f, 0x20, 0x2e, 0x0d, 0x38, 0x1f, 0x0d, 0x1e, 0x9f,
-	0xb2, 0x69, 0x50, 0xd3, 0x54, 0xd3, 0x3f, 0x3a, 0xa6, 0xfa, 0x68, 0x90, 0xf6, 0x6a, 0x2a, 0x04,
-	0x6a, 0x9a, 0xf6, 0x15, 0x68, 0xa0, 0xae, 0x67, 0x55, 0x4c, 0xf6, 0xaa, 0x78, 0x92, 0x9e, 0x0a,
-	0xaf, 0xfc, 0x68, 0x78, 0xe5, 0xcb, 0x0b, 0x51, 0xf0, 0x05, 0xb2, 0x66, 0xf0, 0x05, 0x76, 0x63,
-	0xe1, 0xfb, 0x89, 0x83, 0xd3, 0x35, 0xa2, 0x7e, 0xd0, 0x51, 0x90, 0x85, 0xd7, 0x69, 0x67, 0x47,
-	0x42, 0x77, 0x1e, 0xd2, 0x3a, 0xde, 0xae, 0x3b, 0xb3, 0x80, 0x61, 0xa7, 0xe3, 0x6d, 0x47, 0xc9,
-	0x8f, 0x6b, 0x22, 0x80, 0xeb, 0x09, 0x00, 0x2a, 0xcf, 0x05, 0x52, 0x3e, 0xeb, 0xa6, 0xec, 0x8b,
-	0x54, 0xcc, 0xd9, 0x37, 0x87, 0x6f, 0xc7, 0x4d, 0x55, 0xfc, 0x8a, 0x83, 0xb1, 0x1a, 0x51, 0x37,
-	0x5a, 0x18, 0x99, 0xf1, 0x59, 0xbd, 0xec, 0xc0, 0xc5, 0x40, 0xe0, 0xbc, 0x1b, 0x78, 0x2f, 0x16,
-	0x71, 0x1a, 0xce, 0xf5, 0x6d, 0x78, 0x61, 0xef, 0x72, 0x30, 0xee, 0x65, 0x74, 0xd7, 0x7e, 0x4f,
-	0xf0, 0x6b, 0x90, 0x46, 0x5d, 0xab, 0x69, 0x98, 0x9a, 0xb5, 0xe3, 0xc4, 0x5e, 0xc9, 0x3d, 0xff,
-	0x71, 0x79, 0x92, 0xf5, 0x3d, 0x9b, 0x33, 0x9b, 0x96, 0xa9, 0xe9, 0xaa, 0xd4, 0x13, 0xe5, 0xdf,
-	0x82, 0x94, 0xf3, 0x22, 0xb1, 0x6b, 0x95, 0x59, 0xcd, 0x17, 0xc3, 0x1f, 0x53, 0x45, 0xc7, 0x4f,
-	0x25, 0x49, 0xc7, 0x85, 0xc4, 0x74, 0x1c, 0xca, 0xf5, 0xac, 0xd1, 0x4c, 0x26, 0xfb, 0x4b, 0xe0,
-	0xa8, 0x89, 0x33, 0x30, 0x1d, 0xd8, 0xf2, 0xb2, 0xf9, 0x96, 0x83, 0x9c, 0x7d, 0xa6, 0x9a, 0x48,
-	0xc1, 0x77, 0x4d, 0xa3, 0x63, 0x10, 0xd4, 0xba, 0x8b, 0x08, 0xc1, 0x0a, 0x3f, 0x0f, 0xa7, 0x1d,
-	0x90, 0xea, 0xfd, 0x33, 0x7f, 0xcc, 0xd9, 0x65, 0x69, 0xf1, 0x97, 0x60, 0xbc, 0x6d, 0xd6, 0xb1,
-	0x2e, 0xb7, 0xd0, 0x96, 0xef, 0xd2, 0xce, 0x4a, 0x63, 0x6d, 0xf3, 0xa6, 0xb3, 0x6b, 0xb7, 0xc8,
-	0x75, 0x77, 0xca, 0x04, 0xac, 0xd2, 0xc0, 0x2f, 0xf4, 0x02, 0x0f, 0x89, 0x44, 0x14, 0x61, 0x36,
-	0xea, 0xcc, 0x4b, 0xe5, 0x3d, 0x38, 0x1b, 0xe8, 0xaa, 0xaa, 0x7e, 0xdf, 0x88, 0xb9, 0xb1, 0xf2,
-	0x90, 0xa1, 0xcd, 0xe2, 0xce, 0x13, 0x1a, 0x73, 0x52, 0xa2, 0xfd, 0xb3, 0xe1, 0xbc, 0x7a, 0x6e,
-	0xc3, 0xb8, 0x8f, 0xb7, 0x47, 0x18, 0x8b, 0xeb, 0x3c, 0xf1, 0xe7, 0x53, 0x70, 0x81, 0xb2, 0x89,
-	0xc5, 0xf5, 0x8e, 0xb1, 0x85, 0x4d, 0x1d, 0xe9, 0xb2, 0x97, 0x0a, 0xff, 0xbf, 0x43, 0x0c, 0xf2,
-	0xf3, 0x64, 0x12, 0x86, 0x2d, 0xcd, 0x6a, 0x61, 0x66, 0xd8, 0x59, 0xf0, 0xb3, 0x90, 0x51, 0x30,
-	0x91, 0x4d, 0xad, 0x63, 0x69, 0x86, 0xce, 0x3a, 0xc3, 0xbf, 0xc5, 0x57, 0x21, 0xed, 0x36, 0x0a,
-	0xc9, 0x25, 0xed, 0xcb, 0xe8, 0x4a, 0x14, 0xc5, 0x42, 0xb0, 0x93, 0x7a, 0xda, 0xfc, 0xbb, 0x30,
-	0x66, 0xe7, 0x56, 0xef, 0xda, 0x90, 0xb8, 0x77, 0xdb, 0x42, 0x94, 0xb9, 0x00, 0x72, 0x52, 0xd6,
-	0xd6, 0x76, 0x76, 0x49, 0x8f, 0x0a, 0xfd, 0xf4, 0x15, 0xbd, 0x46, 0x8c, 0x44, 0x4a, 0x5c, 0x80,
-	0xf9, 0x58, 0x01, 0x8f, 0x0f, 0x7f, 0x71, 0x61, 0x93, 0xf6, 0x3f, 0x83, 0x78, 0x79, 0x2d, 0x1c,
-	0xa3, 0x42, 0xc4, 0xc5, 0xe2, 0x01, 0x74, 0x11, 0xc4, 0xe8, 0x53, 0x0f, 0x9d, 0x27, 0x4e, 0xe3,
-	0x6f, 0x62, 0xeb, 0x30, 0x94, 0x91, 0x83, 0xf8, 0x32, 0x4c, 0xb8, 0xf1, 0x79, 0x23, 0xc1, 0x01,
-	0x68, 0xdc, 0xdd, 0x67, 0x43, 0xa1, 0xbc, 0x12, 0xf2, 0xa4, 0xf0, 0x9a, 0x3c, 0xd4, 0x2b, 0x6b,
-	0xf2, 0xd0, 0x33, 0x2f, 0xec, 0x5f, 0x38, 0xf8, 0xbf, 0x37, 0xcb, 0x6a, 0x48, 0x6e, 0x6a, 0x3a,
-	0xbe, 0xd7, 0xd4, 0x2c, 0xdc, 0xd2, 0xc8, 0xab, 0xae, 0xed, 0x05, 0x80, 0xb6, 0xe3, 0x91, 0x8e,
-	0x0b, 0xe7, 0x09, 0x98, 0x66, 0x3b, 0x55, 0xa5, 0x7c, 0x23, 0xbc, 0x5e, 0x97, 0xfa, 0x47, 0x72,
-	0x54, 0xcc, 0xe2, 0x15, 0xb8, 0x7c, 0xa4, 0x90, 0x07, 0xc3, 0x77, 0x1c, 0xcc, 0x44, 0x4a, 0x47,
-	0x96, 0xaf, 0x00, 0x99, 0x0e, 0xb3, 0xd4, 0x1b, 0x78, 0xe0, 0x6e, 0x55, 0x95, 0x40, 0x86, 0x89,
-	0x60, 0x86, 0xab, 0x21, 0x35, 0xcd, 0xc7, 0xa7, 0x27, 0xce, 0xc5, 0xd4, 0xcb, 0x4d, 0x67, 0xf5,
-	0x79, 0x06, 0x12, 0xf4, 0xeb, 0xab, 0x0e, 0xe9, 0xde, 0xd7, 0xf8, 0xc5, 0xc8, 0xbe, 0xf1, 0x7d,
-	0xc7, 0x0a, 0x57, 0x07, 0x91, 0xf2, 0x9e, 0x57, 0x1f, 0xc3, 0xd9, 0xb0, 0x8f, 0xd8, 0x62, 0x8c,
-	0x91, 0x10, 0x79, 0x61, 0xed, 0x78, 0xf2, 0x9e, 0xfb, 0x8f, 0x60, 0x3c, 0xf8, 0x2d, 0xb4, 0x14,
-	0x63, 0x2a, 0x20, 0x2b, 0xac, 0x0e, 0x2e, 0xeb, 0x77, 0x19, 0x7c, 0x77, 0xc7, 0xb9, 0x0c, 0xc8,
-	0xc6, 0xba, 0x8c, 0x7a, 0xc3, 0x62, 0xc8, 0xf8, 0xdf, 0xaa, 0x97, 0x62, 0x4c, 0xf8, 0xe4, 0x84,
-	0xe2, 0x60, 0x72, 0x9e, 0x9b, 0x06, 0x80, 0xef, 0xed, 0x38, 0x1f, 0xa3, 0xdd, 0x13, 0x13, 0x96,
-	0x07, 0x12, 0xf3, 0x7c, 0x34, 0x21, 0xdb, 0xf7, 0xd0, 0x5b, 0x38, 0x32, 0x46, 0x47, 0x50, 0x28,
-	0x0d, 0x28, 0xe8, 0x79, 0xfa, 0x94, 0x83, 0x73, 0xe1, 0xaf, 0xb0, 0x6b, 0xb1, 0xa6, 0x42, 0x34,
-	0x84, 0x37, 0x8e, 0xab, 0xe1, 0x45, 0xf1, 0x05, 0x07, 0x42, 0xcc, 0x2b, 0xe5, 0xf5, 0x38, 0xf4,
-	0x22, 0xd5, 0x84, 0x1b, 0x27, 0x52, 0xeb, 0x83, 0x26, 0xfc, 0x9e, 0x8a, 0x83, 0x26, 0x54, 0x23,
-	0x16, 0x9a, 0xd8, 0x9b, 0x87, 0xff, 0x9a, 0x83, 0xfc, 0x11, 0xd7, 0xce, 0xf5, 0x23, 0x8b, 0x1e,
-	0xa5, 0x2a, 0xac, 0x9f, 0x58, 0xd5, 0x0b, 0xf0, 0x33, 0x0e, 0xa6, 0x22, 0x2e, 0x84, 0x95, 0x63,
-	0x5b, 0x17, 0x8e, 0x9f, 0x8b, 0x1b, 0x88, 0x30, 0xfc, 0xe8, 0x60, 0x77, 0x89, 0xab, 0xbc, 0xff,
-	0x74, 0x3f, 0xcf, 0x3d, 0xdb, 0xcf, 0x73, 0xbf, 0xef, 0xe7, 0xb9, 0x27, 0x2f, 0xf2, 0x43, 0xcf,
-	0x5e, 0xe4, 0x87, 0x7e, 0x7d, 0x91, 0x1f, 0xfa, 0xb0, 0xec, 0xfb, 0xb5, 0x83, 0xc8, 0xa6, 0xd5,
-	0x42, 0x0d, 0x52, 0xda, 0xb4, 0xdd, 0xdd, 0xc1, 0xd6, 0xb6, 0x61, 0x3e, 0x28, 0x3d, 0xf4, 0x7e,
-	0xba, 0xd5, 0x74, 0x8b, 0x96, 0xa2, 0xe5, 0xfc, 0x0a, 0xd2, 0x48, 0xd9, 0xbf, 0xdd, 0xbe, 0xf6,
-	0x4f, 0x00, 0x00, 0x00, 0xff, 0xff, 0xa2, 0x16, 0x1b, 0xd2, 0x99, 0x16, 0x00, 0x00,
+	0x17, 0xcf, 0xc6, 0x8e, 0x13, 0x3f, 0x3b, 0x4d, 0xba, 0x4d, 0x13, 0x67, 0xfb, 0xad, 0x9d, 0xef,
+	0xa6, 0x69, 0xd2, 0xb4, 0xb1, 0x9b, 0x20, 0xa2, 0xd6, 0xd0, 0x43, 0x12, 0x5a, 0x6a, 0x09, 0x97,
+	0x6a, 0x03, 0xaa, 0xc4, 0xc5, 0x5a, 0xef, 0x4e, 0xd7, 0xab, 0xda, 0xbb, 0x66, 0x67, 0x9d, 0x34,
+	0x07, 0xa4, 0x0a, 0x24, 0x84, 0x7a, 0xaa, 0x38, 0x82, 0x84, 0x38, 0x20, 0x81, 0x38, 0xe5, 0xc0,
+	0x09, 0xf1, 0x07, 0x94, 0x5b, 0xd5, 0x0b, 0x9c, 0x02, 0x4a, 0x85, 0x22, 0x71, 0xe7, 0xc2, 0x09,
+	0xcd, 0xee, 0xec, 0x78, 0xbd, 0xd9, 0xdd, 0x38, 0x51, 0x8b, 0xc4, 0x25, 0xf1, 0xcc, 0xbc, 0xdf,
+	0xef, 0xf3, 0xde, 0xbc, 0x1d, 0x98, 0xc1, 0x48, 0xb1, 0x90, 0x5d, 0x52, 0xcc, 0x56, 0xbb, 0x63,
+	0xa3, 0xd2, 0xd6, 0x72, 0x1d, 0xd9, 0xf2, 0x72, 0xa9, 0x85, 0xb5, 0x62, 0xdb, 0x32, 0x6d, 0x93,
+	0x9f, 0x74, 0x29, 0x8a, 0x94, 0xa2, 0x48, 0x29, 0x84, 0x09, 0xcd, 0xd4, 0x4c, 0x87, 0xa4, 0x44,
+	0x7e, 0xb9, 0xd4, 0xc2, 0x94, 0x62, 0xe2, 0x96, 0x89, 0x09, 0x7f, 0x69, 0xcb, 0x27, 0x46, 0x98,
+	0x76, 0x0f, 0x6a, 0x2e, 0x87, 0xbb, 0xa0, 0x47, 0x79, 0xca, 0x53, 0x97, 0x71, 0xd7, 0x00, 0xc5,
+	0xd4, 0x0d, 0x7a, 0x7e, 0x5a, 0x6e, 0xe9, 0x86, 0x59, 0x72, 0xfe, 0xd2, 0xad, 0xd9, 0x08, 0xb3,
+	0xdb, 0xb2, 0x25, 0xb7, 0xa8, 0x5c, 0xf1, 0x4f, 0x0e, 0xb2, 0x55, 0xac, 0x6d, 0xda, 0xa6, 0x85,
+	0x36, 0x4c, 0x15, 0xf1, 0x15, 0x48, 0x61, 0x64, 0xa8, 0xc8, 0xca, 0x71, 0x33, 0xdc, 0x42, 0x76,
+	0x7d, 0xf9, 0xef, 0xbd, 0xc2, 0x92, 0xa6, 0xdb, 0x8d, 0x4e, 0x9d, 0xb8, 0x47, 0xad, 0xa2, 0xff,
+	0x96, 0xb0, 0xfa, 0xa0, 0x64, 0xef, 0xb4, 0x11, 0x2e, 0xae, 0x29, 0xca, 0x9a, 0xaa, 0x5a, 0x08,
+	0x63, 0x89, 0x0a, 0xe0, 0x57, 0xe1, 0xd4, 0xb6, 0x8c, 0x5b, 0xb5, 0xfa, 0x8e, 0x8d, 0x6a, 0x8a,
+	0xa9, 0xa2, 0xdc, 0xa0, 0x23, 0x72, 0x7c, 0x7f, 0xaf, 0x90, 0xbd, 0xb7, 0xb6, 0x59, 0x5d, 0xdf,
+	0xb1, 0x1d, 0xa5, 0x52, 0x96, 0xd0, 0x79, 0x2b, 0x7e, 0x12, 0x52, 0xd8, 0xec, 0x58, 0x0a, 0xca,
+	0x25, 0x66, 0xb8, 0x85, 0xb4, 0x44, 0x57, 0x7c, 0x0e, 0x86, 0xeb, 0x1d, 0xbd, 0x49, 0x6c, 0x4b,
+	0x3a, 0x07, 0xde, 0xb2, 0x3c, 0xf7, 0xd9, 0xd7, 0x85, 0x81, 0x8f, 0x0f, 0x76, 0x17, 0xa9, 0xea,
+	0xc7, 0x07, 0xbb, 0x8b, 0xa7, 0x89, 0xcc, 0x92, 0xdf, 0x37, 0xf1, 0x0d, 0x98, 0xf0, 0xaf, 0x25,
+	0x84, 0xdb, 0xa6, 0x81, 0x11, 0x3f, 0x0b, 0xc3, 0xc4, 0xbc, 0x9a, 0xae, 0x3a, 0x4e, 0x27, 0xd7,
+	0x61, 0x7f, 0xaf, 0x90, 0x22, 0x24, 0x95, 0xb7, 0xa4, 0x14, 0x39, 0xaa, 0xa8, 0xe2, 0x1f, 0x09,
+	0x98, 0xac, 0x62, 0xad, 0x62, 0x60, 0x5b, 0x36, 0x6c, 0x5d, 0x26, 0xc6, 0x1a, 0xb6, 0x25, 0x2b,
+	0xf6, 0xcb, 0x8c, 0xd9, 0x15, 0xe0, 0x15, 0xb9, 0xd9, 0xac, 0xcb, 0xca, 0x03, 0x27, 0x64, 0xb5,
+	0x86, 0x8c, 0x1b, 0x4e, 0xdc, 0xd2, 0xd2, 0xb8, 0x77, 0x42, 0x2c, 0xbb, 0x2d, 0xe3, 0x86, 0xdf,
+	0xf0, 0x44, 0x94, 0xe1, 0xfc, 0x04, 0x0c, 0x35, 0xe5, 0x3a, 0x6a, 0xd2, 0xa0, 0xb9, 0x0b, 0x7e,
+	0x1a, 0x46, 0x74, 0x43, 0xb7, 0x6b, 0x2d, 0xac, 0xe5, 0x86, 0x88, 0xd5, 0xd2, 0x30, 0x59, 0x57,
+	0xb1, 0xc6, 0x3f, 0xe2, 0x00, 0x9c, 0xb3, 0xfb, 0x1d, 0x43, 0xc5, 0xb9, 0xd4, 0x4c, 0x62, 0x21,
+	0xb3, 0x32, 0x5d, 0xa4, 0x78, 0x24, 0x08, 0xf4, 0x00, 0x5e, 0xdc, 0x30, 0x75, 0x63, 0xfd, 0xd6,
+	0xd3, 0xbd, 0xc2, 0xc0, 0xf7, 0xbf, 0x15, 0x16, 0xfa, 0x70, 0x99, 0x30, 0xe0, 0x2f, 0x0e, 0x76,
+	0x17, 0xb3, 0x4d, 0xa4, 0xc9, 0xca, 0x4e, 0x8d, 0x60, 0x18, 0x7f, 0x77, 0xb0, 0xbb, 0xc8, 0x49,
+	0x69, 0xa2, 0xf4, 0x16, 0xd1, 0xc9, 0xaf, 0x40, 0x96, 0x85, 0x01, 0xeb, 0x5a, 0x6e, 0xd8, 0x89,
+	0xeb, 0xd8, 0xfe, 0x5e, 0x21, 0xb3, 0x41, 0xf7, 0x37, 0x75, 0x4d, 0xca, 0x28, 0xdd, 0x05, 0xf1,
+	0x53, 0x56, 0x5b, 0xba, 0x91, 0x1b, 0x71, 0xfd, 0x74, 0x16, 0xe5, 0x52, 0x08, 0x34, 0xce, 0x79,
+	0xd0, 0x08, 0x49, 0xa6, 0x78, 0x07, 0xf2, 0xe1, 0x27, 0x0c, 0x2e, 0x39, 0x18, 0x96, 0xdd, 0xb4,
+	0x39, 0xf9, 0x4e, 0x4b, 0xde, 0x92, 0xe7, 0x21, 0xa9, 0xca, 0xb6, 0xec, 0xe2, 0x5c, 0x72, 0x7e,
+	0x8b, 0xcf, 0x13, 0xc0, 0x57, 0xb1, 0x76, 0xf3, 0x21, 0x52, 0x3a, 0xaf, 0x06, 0x33, 0x55, 0x18,
+	0x51, 0xa8, 0xd8, 0xdc, 0xe0, 0x49, 0x85, 0x31, 0x11, 0xfc, 0x38, 0x24, 0x08, 0x28, 0x12, 0x8e,
+	0x0f, 0xe4, 0x67, 0x04, 0x28, 0x93, 0x11, 0xa0, 0x24, 0xf0, 0xc1, 0xc8, 0xf0, 0xe0, 0x33, 0xf4,
+	0xaf, 0xc1, 0x87, 0x28, 0x0d, 0x87, 0x4f, 0xea, 0x68, 0xf8, 0x94, 0x2f, 0x87, 0x00, 0x65, 0xca,
+	0x03, 0x4a, 0x20, 0x7b, 0xe2, 0x55, 0x10, 0x0e, 0xef, 0x32, 0x80, 0x78, 0x30, 0xe0, 0x7c, 0x30,
+	0x78, 0x3c, 0xe8, 0xc0, 0xa0, 0xaa, 0x6b, 0x96, 0xbf, 0x75, 0x4c, 0xf6, 0xc0, 0x20, 0xcd, 0x72,
+	0x2a, 0x04, 0x72, 0x9a, 0xf6, 0x25, 0xa8, 0xaf, 0xaa, 0xa7, 0x59, 0x4c, 0x76, 0xb3, 0x78, 0x92,
+	0x9a, 0x0a, 0xcf, 0xfc, 0x48, 0x78, 0xe6, 0xcb, 0xf3, 0x51, 0xe1, 0x0b, 0x78, 0x4d, 0xc3, 0x17,
+	0xd8, 0x8d, 0x0d, 0xdf, 0x8f, 0x1c, 0x9c, 0xaa, 0x62, 0xed, 0xfd, 0xb6, 0x2a, 0xdb, 0x68, 0x8d,
+	0x54, 0x76, 0x64, 0xe8, 0xce, 0x41, 0xda, 0x40, 0xdb, 0x35, 0xb7, 0x17, 0xd0, 0xd8, 0x19, 0x68,
+	0xdb, 0x65, 0xf2, 0xc7, 0x35, 0x11, 0x88, 0xeb, 0x09, 0x02, 0x54, 0x9e, 0x0d, 0xb8, 0x7c, 0xc6,
+	0x73, 0xd9, 0x67, 0xa9, 0x98, 0x83, 0xc9, 0xde, 0x1d, 0xcf, 0x55, 0xf1, 0x4b, 0x0e, 0x46, 0xab,
+	0x58, 0xdb, 0x68, 0x22, 0xd9, 0x8a, 0xf7, 0xea, 0x65, 0x1b, 0x2e, 0x06, 0x0c, 0xe7, 0x3d, 0xc3,
+	0xbb, 0xb6, 0x88, 0x53, 0x70, 0xb6, 0x67, 0x83, 0x99, 0xbd, 0xcb, 0xc1, 0x18, 0xf3, 0xe8, 0xae,
+	0x33, 0x4f, 0xf0, 0xab, 0x90, 0x96, 0x3b, 0x76, 0xc3, 0xb4, 0x74, 0x7b, 0xc7, 0xb5, 0x7d, 0x3d,
+	0xf7, 0xfc, 0x87, 0xa5, 0x09, 0x5a, 0xf7, 0xb4, 0xcf, 0x6c, 0xda, 0x96, 0x6e, 0x68, 0x52, 0x97,
+	0x94, 0x7f, 0x13, 0x52, 0xee, 0x44, 0xe2, 0xe4, 0x2a, 0xb3, 0x92, 0x2f, 0x86, 0x0f, 0x53, 0x45,
+	0x57, 0xcf, 0x7a, 0x92, 0xb4, 0x0b, 0x89, 0xf2, 0xb8, 0x90, 0xeb, 0x4a, 0x23, 0x9e, 0x4c, 0xf4,
+	0xa6, 0xc0, 0x65, 0x13, 0xa7, 0x61, 0x2a, 0xb0, 0xc5, 0xbc, 0xf9, 0x86, 0x83, 0x9c, 0x73, 0xa6,
+	0x59, 0xb2, 0x8a, 0xee, 0x5a, 0x66, 0xdb, 0xc4, 0x72, 0xf3, 0xae, 0x8c, 0x31, 0x52, 0xf9, 0x39,
+	0x38, 0xe5, 0x06, 0xa9, 0xd6, 0xdb, 0xf3, 0x47, 0xdd, 0x5d, 0xea, 0x16, 0x7f, 0x11, 0xc6, 0x5a,
+	0x56, 0x0d, 0x19, 0x4a, 0x53, 0xde, 0xf2, 0x5d, 0xda, 0x59, 0x69, 0xb4, 0x65, 0xdd, 0x74, 0x77,
+	0x9d, 0x12, 0xb9, 0xee, 0x75, 0x99, 0x80, 0x54, 0x62, 0xf8, 0xf9, 0xae, 0xe1, 0x21, 0x96, 0x88,
+	0x22, 0xcc, 0x44, 0x9d, 0x31, 0x57, 0xde, 0x85, 0x33, 0x81, 0xaa, 0xaa, 0x18, 0xf7, 0xcd, 0x98,
+	0x1b, 0x2b, 0x0f, 0x19, 0x52, 0x2c, 0x5e, 0x3f, 0x21, 0x36, 0x27, 0x25, 0x52, 0x3f, 0x1b, 0xee,
+	0xd4, 0x73, 0x1b, 0xc6, 0x7c, 0xb8, 0x3d, 0x42, 0x58, 0x5c, 0xe5, 0x89, 0x3f, 0x0f, 0xc2, 0x79,
+	0x82, 0x26, 0x6a, 0xd7, 0xdb, 0xe6, 0x16, 0xb2, 0x0c, 0xd9, 0x50, 0x98, 0x2b, 0xfc, 0xff, 0x0e,
+	0x21, 0xc8, 0x8f, 0x93, 0x09, 0x18, 0xb2, 0x75, 0xbb, 0x89, 0xa8, 0x60, 0x77, 0xc1, 0xcf, 0x40,
+	0x46, 0x45, 0x58, 0xb1, 0xf4, 0xb6, 0xad, 0x9b, 0x06, 0xad, 0x0c, 0xff, 0x16, 0x5f, 0x81, 0xb4,
+	0x57, 0x28, 0x38, 0x97, 0x74, 0x2e, 0xa3, 0xcb, 0x51, 0x10, 0x0b, 0x89, 0x9d, 0xd4, 0xe5, 0xe6,
+	0xdf, 0x81, 0x51, 0xc7, 0xb7, 0x5a, 0xc7, 0x09, 0x89, 0x77, 0xb7, 0xcd, 0x47, 0x89, 0x0b, 0x44,
+	0x4e, 0xca, 0x3a, 0xdc, 0xee, 0x2e, 0xee, 0x42, 0xa1, 0x17, 0xbe, 0x22, 0x2b, 0xc4, 0xc8, 0x48,
+	0x89, 0xf3, 0x30, 0x17, 0x4b, 0xc0, 0xf0, 0xf0, 0x17, 0x17, 0xd6, 0x69, 0xff, 0x33, 0x11, 0x2f,
+	0xaf, 0x86, 0xc7, 0xa8, 0x10, 0x71, 0xb1, 0xb0, 0x00, 0x5d, 0x00, 0x31, 0xfa, 0x94, 0x45, 0xe7,
+	0x89, 0x5b, 0xf8, 0x9b, 0xc8, 0x3e, 0x1c, 0xca, 0xc8, 0x46, 0x7c, 0x09, 0xc6, 0x3d, 0xfb, 0x58,
+	0x4b, 0x70, 0x03, 0x34, 0xe6, 0xed, 0xd3, 0xa6, 0x50, 0x5e, 0x0e, 0x19, 0x29, 0x58, 0x91, 0x87,
+	0x6a, 0xa5, 0x45, 0x1e, 0x7a, 0xc6, 0xcc, 0xfe, 0x85, 0x83, 0xff, 0xb3, 0x5e, 0x56, 0x95, 0x95,
+	0x86, 0x6e, 0xa0, 0x7b, 0x0d, 0xdd, 0x46, 0x4d, 0x1d, 0xbf, 0xea, 0xdc, 0x9e, 0x07, 0x68, 0xb9,
+	0x1a, 0x49, 0xbb, 0x70, 0x47, 0xc0, 0x34, 0xdd, 0xa9, 0xa8, 0xe5, 0x1b, 0xe1, 0xf9, 0xba, 0xd8,
+	0xdb, 0x92, 0xa3, 0x6c, 0x16, 0x2f, 0xc3, 0xa5, 0x23, 0x89, 0x58, 0x18, 0xbe, 0xe5, 0x60, 0x3a,
+	0x92, 0x3a, 0x32, 0x7d, 0x05, 0xc8, 0xb4, 0xa9, 0xa4, 0x6e, 0xc3, 0x03, 0x6f, 0xab, 0xa2, 0x06,
+	0x3c, 0x4c, 0x04, 0x3d, 0x5c, 0x09, 0xc9, 0x69, 0x3e, 0xde, 0x3d, 0x71, 0x36, 0x26, 0x5f, 0xfe,
+	0x5b, 0x88, 0xdc, 0xa9, 0x9b, 0x1d, 0xd5, 0x64, 0xd3, 0xe1, 0x49, 0xef, 0xd4, 0xb8, 0xe9, 0xf1,
+	0xd0, 0x78, 0xdf, 0x9d, 0x7c, 0x23, 0xee, 0x51, 0xbf, 0x49, 0xe2, 0x12, 0x4c, 0x05, 0xb6, 0xe2,
+	0xe6, 0xb6, 0x95, 0x9f, 0xb2, 0x90, 0x20, 0xdf, 0x94, 0x35, 0x48, 0x77, 0xdf, 0x18, 0x2e, 0x44,
+	0x76, 0x03, 0xdf, 0xd7, 0xb9, 0x70, 0xa5, 0x1f, 0x2a, 0xa6, 0xfc, 0x23, 0x38, 0x13, 0xf6, 0x69,
+	0x5e, 0x8c, 0x11, 0x12, 0x42, 0x2f, 0xac, 0x1e, 0x8f, 0x9e, 0xa9, 0xff, 0x10, 0xc6, 0x82, 0x5f,
+	0x78, 0x8b, 0x31, 0xa2, 0x02, 0xb4, 0xc2, 0x4a, 0xff, 0xb4, 0x7e, 0x95, 0xc1, 0xaf, 0x89, 0x38,
+	0x95, 0x01, 0x5a, 0x61, 0xa5, 0x7f, 0x5a, 0xa6, 0x12, 0x41, 0xc6, 0x3f, 0x81, 0x5f, 0x8c, 0x11,
+	0xe1, 0xa3, 0x13, 0x8a, 0xfd, 0xd1, 0x31, 0x35, 0x75, 0x00, 0xdf, 0x44, 0x3c, 0x17, 0xc3, 0xdd,
+	0x25, 0x13, 0x96, 0xfa, 0x22, 0x63, 0x3a, 0x1a, 0x90, 0xed, 0x19, 0x5f, 0xe7, 0x8f, 0xb4, 0xd1,
+	0x25, 0x14, 0x4a, 0x7d, 0x12, 0x32, 0x4d, 0x9f, 0x70, 0x70, 0x36, 0x7c, 0xb6, 0xbc, 0x1a, 0x2b,
+	0x2a, 0x84, 0x43, 0xb8, 0x76, 0x5c, 0x0e, 0x66, 0xc5, 0xe7, 0x1c, 0x08, 0x31, 0xb3, 0xd7, 0xeb,
+	0x71, 0xd1, 0x8b, 0x64, 0x13, 0x6e, 0x9c, 0x88, 0xad, 0x27, 0x34, 0xe1, 0xb7, 0x6f, 0x5c, 0x68,
+	0x42, 0x39, 0x84, 0x6b, 0xc7, 0xe5, 0x60, 0x56, 0x7c, 0xc5, 0x41, 0xfe, 0x88, 0xcb, 0xf4, 0xfa,
+	0x91, 0x49, 0x8f, 0x62, 0x15, 0xd6, 0x4e, 0xcc, 0xca, 0x0c, 0xfc, 0x94, 0x83, 0xc9, 0x88, 0x6b,
+	0x6e, 0xf9, 0xd8, 0xd2, 0x85, 0xe3, 0xfb, 0xe2, 0x2f, 0x9a, 0x9e, 0xfb, 0x29, 0xae, 0x68, 0xfc,
+	0x84, 0x42, 0xa9, 0x4f, 0x42, 0x4f, 0x93, 0x30, 0xf4, 0x88, 0xbc, 0xe9, 0xac, 0xbf, 0xf7, 0x74,
+	0x3f, 0xcf, 0x3d, 0xdb, 0xcf, 0x73, 0xbf, 0xef, 0xe7, 0xb9, 0x27, 0x2f, 0xf2, 0x03, 0xcf, 0x5e,
+	0xe4, 0x07, 0x7e, 0x7d, 0x91, 0x1f, 0xf8, 0xa0, 0xec, 0x7b, 0x2d, 0xc2, 0x8a, 0x65, 0x37, 0xe5,
+	0x3a, 0x2e, 0x6d, 0x3a, 0x4a, 0xee, 0x20, 0x7b, 0xdb, 0xb4, 0x1e, 0x94, 0x1e, 0xb2, 0xa7, 0x6f,
+	0xdd, 0xb0, 0x49, 0xd2, 0x9b, 0xee, 0x2b, 0x52, 0x3d, 0xe5, 0xbc, 0x7d, 0xbf, 0xf6, 0xcf, 0x00,
+	0xcd, 0x29, 0x7b, 0x0a, 0xd9, 0x17, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -1503,6 +1598,8 @@ type MsgClient interface {
 	SetContractGovernance(ctx context.Context, in *MsgSetContractGovernance, opts ...grpc.CallOption) (*MsgSetContractGovernanceResponse, error)
 	UpdateMachineWhitelistProposal(ctx context.Context, in *MsgUpdateMachineWhitelistProposal, opts ...grpc.CallOption) (*MsgUpdateMachineWhitelistProposalResponse, error)
 	UpdateMachineWhitelist(ctx context.Context, in *MsgUpdateMachineWhitelist, opts ...grpc.CallOption) (*MsgUpdateMachineWhitelistResponse, error)
+	// SudoContract calls the sudo entry point of a contract, governance only
+	SudoContract(ctx context.Context, in *MsgSudoContract, opts ...grpc.CallOption) (*MsgSudoContractResponse, error)
 }
 
 type msgClient struct {
@@ -1621,6 +1718,15 @@ func (c *msgClient) UpdateMachineWhitelist(ctx context.Context, in *MsgUpdateMac
 	return out, nil
 }
 
+func (c *msgClient) SudoContract(ctx context.Context, in *MsgSudoContract, opts ...grpc.CallOption) (*MsgSudoContractResponse, error) {
+	out := new(MsgSudoContractResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Msg/SudoContract", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// StoreCode to submit Wasm code to the system
@@ -1642,6 +1748,8 @@ type MsgServer interface {
 	SetContractGovernance(context.Context, *MsgSetContractGovernance) (*MsgSetContractGovernanceResponse, error)
 	UpdateMachineWhitelistProposal(context.Context, *MsgUpdateMachineWhitelistProposal) (*MsgUpdateMachineWhitelistProposalResponse, error)
 	UpdateMachineWhitelist(context.Context, *MsgUpdateMachineWhitelist) (*MsgUpdateMachineWhitelistResponse, error)
+	// SudoContract calls the sudo entry point of a contract, governance only
+	SudoContract(context.Context, *MsgSudoContract) (*MsgSudoContractResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -1684,6 +1792,9 @@ func (*UnimplementedMsgServer) UpdateMachineWhitelistProposal(ctx context.Contex
 func (*UnimplementedMsgServer) UpdateMachineWhitelist(ctx context.Context, req *MsgUpdateMachineWhitelist) (*MsgUpdateMachineWhitelistResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateMachineWhitelist not implemented")
 }
+func (*UnimplementedMsgServer) SudoContract(ctx context.Context, req *MsgSudoContract) (*MsgSudoContractResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SudoContract not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -1905,6 +2016,24 @@ func _Msg_UpdateMachineWhitelist_Handler(srv interface{}, ctx context.Context, d
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SudoContract_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSudoContract)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SudoContract(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Msg/SudoContract",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SudoContract(ctx, req.(*MsgSudoContract))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.compute.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -1957,6 +2086,10 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateMachineWhitelist",
 			Handler:    _Msg_UpdateMachineWhitelist_Handler,
 		},
+		{
+			MethodName: "SudoContract",
+			Handler:    _Msg_SudoContract_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/compute/v1beta1/msg.proto",
@@ -3068,6 +3201,80 @@ func (m *MsgUpdateMachineWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (i
 	return len(dAtA) - i, nil
 }
 
+func (m *MsgSudoContract) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSudoContract) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSudoContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSudoContractResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSudoContractResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSudoContractResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Data) > 0 {
+		i -= len(m.Data)
+		copy(dAtA[i:], m.Data)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Data)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
 	offset -= sovMsg(v)
 	base := offset
@@ -3582,6 +3789,40 @@ func (m *MsgUpdateMachineWhitelistResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgSudoContract) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgSudoContractResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Data)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
 func sovMsg(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -6952,6 +7193,238 @@ func (m *MsgUpdateMachineWhitelistResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSudoContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSudoContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSudoContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSudoContractResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSudoContractResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSudoContractResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Data", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Data = append(m.Data[:0], dAtA[iNdEx:postIndex]...)
+			if m.Data == nil {
+				m.Data = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipMsg(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
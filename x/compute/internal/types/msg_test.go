@@ -346,3 +346,66 @@ func TestExecuteContractValidation(t *testing.T) {
 		})
 	}
 }
+
+func TestSudoContractValidation(t *testing.T) {
+	goodAddress := sdk.AccAddress(make([]byte, 20)).String()
+
+	cases := map[string]struct {
+		msg   MsgSudoContract
+		valid bool
+	}{
+		"empty": {
+			msg:   MsgSudoContract{},
+			valid: false,
+		},
+		"correct minimal": {
+			msg: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+				Msg:       []byte("{}"),
+			},
+			valid: true,
+		},
+		"bad authority": {
+			msg: MsgSudoContract{
+				Authority: "foo",
+				Contract:  goodAddress,
+				Msg:       []byte(`{"some": "data"}`),
+			},
+			valid: false,
+		},
+		"empty contract": {
+			msg: MsgSudoContract{
+				Authority: goodAddress,
+				Msg:       []byte(`{"some": "data"}`),
+			},
+			valid: false,
+		},
+		"non json msg": {
+			msg: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+				Msg:       []byte("invalid-json"),
+			},
+			valid: false,
+		},
+		"empty msg": {
+			msg: MsgSudoContract{
+				Authority: goodAddress,
+				Contract:  goodAddress,
+			},
+			valid: false,
+		},
+	}
+
+	for name, tc := range cases {
+		t.Run(name, func(t *testing.T) {
+			err := tc.msg.ValidateBasic()
+			if tc.valid {
+				assert.NoError(t, err)
+			} else {
+				assert.Error(t, err)
+			}
+		})
+	}
+}
@@ -1,26 +1,158 @@
 package types
 
 import (
+	"fmt"
+	"strings"
+
 	"cosmossdk.io/math"
 )
 
-const DefaultMaxContractSize = 2 * 1024 * 1024
+const (
+	DefaultMaxContractSize = 2 * 1024 * 1024
+
+	// DefaultMaxQueryDepth is the highest query depth the enclave allows
+	DefaultMaxQueryDepth = uint32(10)
+
+	// MaxCallDepthLimit is the highest max call depth governance can set. Every nested call
+	// recurses through the keeper, so this bounds the stack used by a single transaction
+	MaxCallDepthLimit = uint32(1000)
+)
 
 var DefaultCompileCost = math.LegacyNewDecWithPrec(8, 1)
 
-func NewParams(maxContractSize uint64, compileCost math.LegacyDec) Params {
+// DefaultStargateQueryAllowlist is a list of all safe and efficient queries
+//
+// excluded from this list (should be safe, but needs a clear use case):
+//   - /secret.registration.*
+//   - /ibc.core.*
+//   - /secret.intertx.*
+//   - /cosmos.evidence.*
+//   - /cosmos.upgrade.*
+//   - All "get all" queries - only O(1) queries should be served
+//
+// governance can add/remove queries via MsgUpdateParams
+//
+// used this to find all query paths:
+// find -name query.proto | sort | xargs grep -Poin 'package [a-z0-9.]+;|rpc [a-zA-Z]+\('
+var DefaultStargateQueryAllowlist = []string{
+	"/cosmos.auth.v1beta1.Query/Account",
+	"/cosmos.auth.v1beta1.Query/Params",
+
+	"/cosmos.bank.v1beta1.Query/Balance",
+	"/cosmos.bank.v1beta1.Query/DenomMetadata",
+	"/cosmos.bank.v1beta1.Query/SupplyOf",
+	"/cosmos.bank.v1beta1.Query/Params",
+
+	"/cosmos.distribution.v1beta1.Query/Params",
+	"/cosmos.distribution.v1beta1.Query/DelegatorWithdrawAddress",
+	"/cosmos.distribution.v1beta1.Query/FoundationTax",
+	"/cosmos.distribution.v1beta1.Query/ValidatorCommission",
+
+	"/cosmos.feegrant.v1beta1.Query/Allowance",
+
+	"/cosmos.gov.v1beta1.Query/Deposit",
+	"/cosmos.gov.v1beta1.Query/Params",
+	"/cosmos.gov.v1beta1.Query/Proposal",
+	"/cosmos.gov.v1beta1.Query/Vote",
+
+	"/cosmos.mint.v1beta1.Query/Params",
+	"/cosmos.mint.v1beta1.Query/Inflation",
+	"/cosmos.mint.v1beta1.Query/AnnualProvisions",
+
+	"/cosmos.params.v1beta1.Query/Params",
+
+	"/cosmos.slashing.v1beta1.Query/Params",
+	"/cosmos.slashing.v1beta1.Query/SigningInfo",
+
+	"/cosmos.staking.v1beta1.Query/Validator",
+	"/cosmos.staking.v1beta1.Query/Delegation",
+	"/cosmos.staking.v1beta1.Query/UnbondingDelegation",
+	"/cosmos.staking.v1beta1.Query/Params",
+
+	"/ibc.applications.transfer.v1.Query/DenomHash",
+	"/ibc.applications.transfer.v1.Query/DenomTrace",
+	"/ibc.applications.transfer.v1.Query/Params",
+
+	"/secret.compute.v1beta1.Query/ContractInfo",
+	"/secret.compute.v1beta1.Query/CodeHashByContractAddress",
+	"/secret.compute.v1beta1.Query/CodeHashByCodeId",
+	"/secret.compute.v1beta1.Query/LabelByAddress",
+	"/secret.compute.v1beta1.Query/AddressByLabel",
+	"/secret.compute.v1beta1.Query/ContractsByCodeId",
+}
+
+func NewParams(
+	maxContractSize uint64,
+	compileCost math.LegacyDec,
+	stargateQueryAllowlist []string,
+	maxCallDepth uint32,
+	maxQueryDepth uint32,
+	maxLabelSize uint32,
+	maxMsgSize uint64,
+) Params {
 	return Params{
-		MaxContractSize: maxContractSize,
-		CompileCost:     compileCost,
+		MaxContractSize:        maxContractSize,
+		CompileCost:            compileCost,
+		StargateQueryAllowlist: stargateQueryAllowlist,
+		MaxCallDepth:           maxCallDepth,
+		MaxQueryDepth:          maxQueryDepth,
+		MaxLabelSize:           maxLabelSize,
+		MaxMsgSize:             maxMsgSize,
 	}
 }
 
 // default module parameters.
 func DefaultParams() Params {
-	return NewParams(DefaultMaxContractSize, DefaultCompileCost)
+	allowlist := make([]string, len(DefaultStargateQueryAllowlist))
+	copy(allowlist, DefaultStargateQueryAllowlist)
+
+	return NewParams(
+		DefaultMaxContractSize,
+		DefaultCompileCost,
+		allowlist,
+		DefaultMaxCallDepth,
+		DefaultMaxQueryDepth,
+		DefaultMaxLabelSize,
+		0, // no msg size limit until governance sets one
+	)
 }
 
 // validate params.
 func (p Params) Validate() error {
+	if p.MaxCallDepth == 0 || p.MaxCallDepth > MaxCallDepthLimit {
+		return fmt.Errorf("max call depth must be between 1 and %d: %d", MaxCallDepthLimit, p.MaxCallDepth)
+	}
+	if p.MaxQueryDepth == 0 || p.MaxQueryDepth > DefaultMaxQueryDepth {
+		return fmt.Errorf("max query depth must be between 1 and %d: %d", DefaultMaxQueryDepth, p.MaxQueryDepth)
+	}
+	if p.MaxLabelSize == 0 || p.MaxLabelSize > MaxLabelSize {
+		return fmt.Errorf("max label size must be between 1 and %d: %d", MaxLabelSize, p.MaxLabelSize)
+	}
+	return validateStargateQueryAllowlist(p.StargateQueryAllowlist)
+}
+
+// IsStargateQueryAllowed returns true if contracts are allowed to call the given query path
+func (p Params) IsStargateQueryAllowed(path string) bool {
+	for _, allowed := range p.StargateQueryAllowlist {
+		if allowed == path {
+			return true
+		}
+	}
+	return false
+}
+
+func validateStargateQueryAllowlist(paths []string) error {
+	seen := make(map[string]bool, len(paths))
+	for _, path := range paths {
+		// query paths look like /cosmos.bank.v1beta1.Query/Balance
+		parts := strings.Split(path, "/")
+		if len(parts) != 3 || parts[0] != "" || parts[1] == "" || parts[2] == "" {
+			return fmt.Errorf("invalid stargate query path: '%s'", path)
+		}
+		if seen[path] {
+			return fmt.Errorf("duplicate stargate query path: '%s'", path)
+		}
+		seen[path] = true
+	}
 	return nil
 }
@@ -33,6 +33,21 @@ type Params struct {
 	CompileCost cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=compile_cost,json=compileCost,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"compile_cost"`
 	// MaxContractSize is the maximum size of contract to store in bytes.
 	MaxContractSize uint64 `protobuf:"varint,2,opt,name=max_contract_size,json=maxContractSize,proto3" json:"max_contract_size,omitempty"`
+	// StargateQueryAllowlist is the list of fully qualified gRPC query paths
+	// (e.g. /cosmos.bank.v1beta1.Query/Balance) that contracts may call via
+	// the stargate and grpc query variants.
+	StargateQueryAllowlist []string `protobuf:"bytes,3,rep,name=stargate_query_allowlist,json=stargateQueryAllowlist,proto3" json:"stargate_query_allowlist,omitempty"`
+	// MaxCallDepth is the maximum depth of nested contract calls (messages
+	// and submessages) in a single transaction.
+	MaxCallDepth uint32 `protobuf:"varint,4,opt,name=max_call_depth,json=maxCallDepth,proto3" json:"max_call_depth,omitempty"`
+	// MaxQueryDepth is the maximum depth of nested smart queries. Can't be
+	// higher than the limit enforced by the enclave.
+	MaxQueryDepth uint32 `protobuf:"varint,5,opt,name=max_query_depth,json=maxQueryDepth,proto3" json:"max_query_depth,omitempty"`
+	// MaxLabelSize is the maximum length in bytes of a contract label.
+	MaxLabelSize uint32 `protobuf:"varint,6,opt,name=max_label_size,json=maxLabelSize,proto3" json:"max_label_size,omitempty"`
+	// MaxMsgSize is the maximum size in bytes of an (encrypted) instantiate
+	// or execute message. Zero means no limit.
+	MaxMsgSize uint64 `protobuf:"varint,7,opt,name=max_msg_size,json=maxMsgSize,proto3" json:"max_msg_size,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -75,6 +90,41 @@ func (m *Params) GetMaxContractSize() uint64 {
 	return 0
 }
 
+func (m *Params) GetStargateQueryAllowlist() []string {
+	if m != nil {
+		return m.StargateQueryAllowlist
+	}
+	return nil
+}
+
+func (m *Params) GetMaxCallDepth() uint32 {
+	if m != nil {
+		return m.MaxCallDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxQueryDepth() uint32 {
+	if m != nil {
+		return m.MaxQueryDepth
+	}
+	return 0
+}
+
+func (m *Params) GetMaxLabelSize() uint32 {
+	if m != nil {
+		return m.MaxLabelSize
+	}
+	return 0
+}
+
+func (m *Params) GetMaxMsgSize() uint64 {
+	if m != nil {
+		return m.MaxMsgSize
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "secret.compute.v1beta1.Params")
 }
@@ -84,27 +134,34 @@ func init() {
 }
 
 var fileDescriptor_631b2d12372d9a02 = []byte{
-	// 313 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x3c, 0x90, 0xb1, 0x4a, 0x03, 0x41,
-	0x10, 0x86, 0x6f, 0x45, 0x03, 0x9e, 0x82, 0x24, 0x88, 0xc4, 0x08, 0x97, 0xa0, 0x4d, 0x10, 0xbc,
-	0x25, 0x08, 0x16, 0x96, 0x49, 0x4a, 0x11, 0x31, 0x36, 0xda, 0x1c, 0x7b, 0xeb, 0x70, 0x59, 0x92,
-	0xbd, 0x39, 0x76, 0x27, 0x9a, 0xe4, 0x29, 0xac, 0x7c, 0x06, 0x4b, 0x0b, 0x1f, 0x22, 0x65, 0xb0,
-	0x12, 0x8b, 0x20, 0x49, 0xe1, 0x6b, 0x48, 0x6e, 0x0f, 0x9b, 0x65, 0x67, 0xfe, 0x8f, 0xff, 0x67,
-	0x7e, 0xff, 0xc4, 0x82, 0x34, 0x40, 0x5c, 0xa2, 0xce, 0x46, 0x04, 0xfc, 0xa9, 0x15, 0x03, 0x89,
-	0x16, 0xcf, 0x84, 0x11, 0xda, 0x86, 0x99, 0x41, 0xc2, 0xca, 0x81, 0x83, 0xc2, 0x02, 0x0a, 0x0b,
-	0xa8, 0xb6, 0x9f, 0x60, 0x82, 0x39, 0xc2, 0xd7, 0x3f, 0x47, 0xd7, 0x0e, 0x25, 0x5a, 0x8d, 0x36,
-	0x72, 0x82, 0x1b, 0x0a, 0xa9, 0x2c, 0xb4, 0x4a, 0x91, 0xe7, 0xaf, 0x5b, 0x1d, 0xbf, 0x32, 0xbf,
-	0x74, 0x93, 0x87, 0x55, 0xee, 0xfd, 0xdd, 0x75, 0x82, 0x1a, 0x42, 0x24, 0xd1, 0x52, 0x95, 0x35,
-	0x58, 0x73, 0xbb, 0x7d, 0x31, 0x5b, 0xd4, 0xbd, 0xef, 0x45, 0xfd, 0xc8, 0x39, 0xd9, 0xc7, 0x41,
-	0xa8, 0x90, 0x6b, 0x41, 0xfd, 0xf0, 0x0a, 0x12, 0x21, 0x27, 0x5d, 0x90, 0x9f, 0x1f, 0x67, 0x7e,
-	0x11, 0xd4, 0x05, 0xf9, 0xf6, 0xfb, 0x7e, 0xca, 0x6e, 0x77, 0x0a, 0xaf, 0x0e, 0x5a, 0xaa, 0xb4,
-	0xfc, 0xb2, 0x16, 0xe3, 0x48, 0x62, 0x4a, 0x46, 0x48, 0x8a, 0xac, 0x9a, 0x42, 0x75, 0xa3, 0xc1,
-	0x9a, 0x9b, 0xed, 0x2d, 0x87, 0xef, 0x69, 0x31, 0xee, 0x14, 0x72, 0x4f, 0x4d, 0xa1, 0x7d, 0x37,
-	0x5b, 0x06, 0x6c, 0xbe, 0x0c, 0xd8, 0xcf, 0x32, 0x60, 0x2f, 0xab, 0xc0, 0x9b, 0xaf, 0x02, 0xef,
-	0x6b, 0x15, 0x78, 0x0f, 0x97, 0x89, 0xa2, 0xfe, 0x28, 0x5e, 0xd7, 0xc1, 0xad, 0x34, 0x34, 0x14,
-	0xb1, 0xe5, 0xbd, 0xbc, 0xa2, 0x6b, 0xa0, 0x67, 0x34, 0x03, 0x3e, 0xfe, 0x2f, 0x54, 0xa5, 0x04,
-	0x26, 0x15, 0x43, 0x4e, 0x93, 0x0c, 0x6c, 0x5c, 0xca, 0xaf, 0x3e, 0xff, 0x0b, 0x00, 0x00, 0xff,
-	0xff, 0x8c, 0x9d, 0xbb, 0x8e, 0x78, 0x01, 0x00, 0x00,
+	// 429 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x92, 0x41, 0x6b, 0x13, 0x41,
+	0x14, 0xc7, 0xb3, 0xa6, 0x8d, 0x74, 0x6c, 0x95, 0x2e, 0x52, 0xd6, 0x0a, 0xdb, 0xa0, 0x07, 0x83,
+	0xd2, 0x1d, 0x82, 0x20, 0xe2, 0xcd, 0x34, 0xc7, 0x2a, 0xda, 0x7a, 0xd1, 0xcb, 0xf2, 0x76, 0xfa,
+	0xd8, 0x2c, 0x9d, 0xc9, 0xac, 0x33, 0x2f, 0x76, 0xd3, 0xa3, 0x9f, 0xc0, 0x8f, 0xe1, 0xd1, 0x83,
+	0x1f, 0xa2, 0xc7, 0xe2, 0x49, 0x3c, 0x14, 0x49, 0x0e, 0x7e, 0x0d, 0x99, 0x9d, 0x89, 0x14, 0x2f,
+	0xcb, 0xce, 0xfc, 0x7f, 0xfc, 0x7f, 0x3c, 0xe6, 0xb1, 0x87, 0x16, 0x85, 0x41, 0xe2, 0x42, 0xab,
+	0x7a, 0x46, 0xc8, 0x3f, 0x0d, 0x0b, 0x24, 0x18, 0xf2, 0x1a, 0x0c, 0x28, 0x9b, 0xd5, 0x46, 0x93,
+	0x8e, 0x77, 0x3c, 0x94, 0x05, 0x28, 0x0b, 0xd0, 0xee, 0xdd, 0x52, 0x97, 0xba, 0x45, 0xb8, 0xfb,
+	0xf3, 0xf4, 0xee, 0x3d, 0xa1, 0xad, 0xd2, 0x36, 0xf7, 0x81, 0x3f, 0x84, 0x68, 0x1b, 0x54, 0x35,
+	0xd5, 0xbc, 0xfd, 0xfa, 0xab, 0x07, 0x9f, 0xbb, 0xac, 0xf7, 0xa6, 0x95, 0xc5, 0xef, 0xd9, 0xa6,
+	0x33, 0x54, 0x12, 0x73, 0xa1, 0x2d, 0x25, 0x51, 0x3f, 0x1a, 0x6c, 0x8c, 0x9e, 0x5d, 0x5c, 0xed,
+	0x75, 0x7e, 0x5d, 0xed, 0xdd, 0xf7, 0x4d, 0xf6, 0xe4, 0x34, 0xab, 0x34, 0x57, 0x40, 0x93, 0xec,
+	0x10, 0x4b, 0x10, 0xf3, 0x31, 0x8a, 0x1f, 0xdf, 0xf7, 0x59, 0x10, 0x8d, 0x51, 0x7c, 0xfd, 0xf3,
+	0xed, 0x71, 0x74, 0x74, 0x2b, 0x74, 0x1d, 0x68, 0x4b, 0xf1, 0x90, 0x6d, 0x2b, 0x68, 0x72, 0xa1,
+	0xa7, 0x64, 0x40, 0x50, 0x6e, 0xab, 0x73, 0x4c, 0x6e, 0xf4, 0xa3, 0xc1, 0xda, 0x68, 0xdd, 0xe3,
+	0x77, 0x14, 0x34, 0x07, 0x21, 0x3e, 0xae, 0xce, 0x31, 0x7e, 0xce, 0x12, 0x4b, 0x60, 0x4a, 0x20,
+	0xcc, 0x3f, 0xce, 0xd0, 0xcc, 0x73, 0x90, 0x52, 0x9f, 0xc9, 0xca, 0x52, 0xd2, 0xed, 0x77, 0x07,
+	0x1b, 0x47, 0x3b, 0xab, 0xfc, 0xad, 0x8b, 0x5f, 0xae, 0xd2, 0xf8, 0x09, 0xbb, 0xdd, 0xca, 0x40,
+	0xca, 0xfc, 0x04, 0x6b, 0x9a, 0x24, 0x6b, 0xfd, 0x68, 0xb0, 0xb5, 0x32, 0x6d, 0x3a, 0x13, 0x48,
+	0x39, 0x76, 0x51, 0xbc, 0xcf, 0x9c, 0x39, 0x18, 0x3c, 0xbd, 0x7e, 0x9d, 0xde, 0x52, 0xd0, 0xb4,
+	0xfd, 0x1e, 0x0f, 0xdd, 0x12, 0x0a, 0x94, 0x7e, 0x8a, 0xde, 0xff, 0xdd, 0x87, 0x2e, 0x6b, 0x47,
+	0x78, 0xc4, 0xdc, 0x39, 0x57, 0xb6, 0xf4, 0xe8, 0xcd, 0xeb, 0x03, 0x33, 0x05, 0xcd, 0x2b, 0x5b,
+	0x3a, 0x70, 0xf4, 0xee, 0x62, 0x91, 0x46, 0x97, 0x8b, 0x34, 0xfa, 0xbd, 0x48, 0xa3, 0x2f, 0xcb,
+	0xb4, 0x73, 0xb9, 0x4c, 0x3b, 0x3f, 0x97, 0x69, 0xe7, 0xc3, 0x8b, 0xb2, 0xa2, 0xc9, 0xac, 0x70,
+	0x4f, 0xcf, 0xad, 0x30, 0x24, 0xa1, 0xb0, 0xfc, 0xb8, 0x5d, 0x87, 0xd7, 0x48, 0x67, 0xda, 0x9c,
+	0xf2, 0xe6, 0xdf, 0xf2, 0x54, 0x53, 0x42, 0x33, 0x05, 0xc9, 0x69, 0x5e, 0xa3, 0x2d, 0x7a, 0xed,
+	0x0b, 0x3f, 0xfd, 0x3b, 0x00, 0x80, 0xb3, 0x0c, 0x66, 0x64, 0x02, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -127,6 +184,35 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxMsgSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxMsgSize))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.MaxLabelSize != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxLabelSize))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxQueryDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxQueryDepth))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MaxCallDepth != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxCallDepth))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.StargateQueryAllowlist) > 0 {
+		for iNdEx := len(m.StargateQueryAllowlist) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.StargateQueryAllowlist[iNdEx])
+			copy(dAtA[i:], m.StargateQueryAllowlist[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.StargateQueryAllowlist[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if m.MaxContractSize != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.MaxContractSize))
 		i--
@@ -167,6 +253,24 @@ func (m *Params) Size() (n int) {
 	if m.MaxContractSize != 0 {
 		n += 1 + sovParams(uint64(m.MaxContractSize))
 	}
+	if len(m.StargateQueryAllowlist) > 0 {
+		for _, s := range m.StargateQueryAllowlist {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MaxCallDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxCallDepth))
+	}
+	if m.MaxQueryDepth != 0 {
+		n += 1 + sovParams(uint64(m.MaxQueryDepth))
+	}
+	if m.MaxLabelSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxLabelSize))
+	}
+	if m.MaxMsgSize != 0 {
+		n += 1 + sovParams(uint64(m.MaxMsgSize))
+	}
 	return n
 }
 
@@ -258,6 +362,114 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StargateQueryAllowlist", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StargateQueryAllowlist = append(m.StargateQueryAllowlist, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxCallDepth", wireType)
+			}
+			m.MaxCallDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxCallDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxQueryDepth", wireType)
+			}
+			m.MaxQueryDepth = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxQueryDepth |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxLabelSize", wireType)
+			}
+			m.MaxLabelSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxLabelSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMsgSize", wireType)
+			}
+			m.MaxMsgSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxMsgSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -1801,6 +1801,160 @@ func (m *QueryBlockCreateResultsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryBlockCreateResultsResponse proto.InternalMessageInfo
 
+type QueryContractStorageStatsResponse struct {
+	Stats ContractStorageStats `protobuf:"bytes,1,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *QueryContractStorageStatsResponse) Reset()         { *m = QueryContractStorageStatsResponse{} }
+func (m *QueryContractStorageStatsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryContractStorageStatsResponse) ProtoMessage()    {}
+func (*QueryContractStorageStatsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{44}
+}
+func (m *QueryContractStorageStatsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryContractStorageStatsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryContractStorageStatsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryContractStorageStatsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryContractStorageStatsResponse.Merge(m, src)
+}
+func (m *QueryContractStorageStatsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryContractStorageStatsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryContractStorageStatsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryContractStorageStatsResponse proto.InternalMessageInfo
+
+type QueryLargestContractsByStorageRequest struct {
+	// limit is the max number of contracts to return, defaults to and is
+	// capped at 100
+	Limit uint32 `protobuf:"varint,1,opt,name=limit,proto3" json:"limit,omitempty"`
+}
+
+func (m *QueryLargestContractsByStorageRequest) Reset()         { *m = QueryLargestContractsByStorageRequest{} }
+func (m *QueryLargestContractsByStorageRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryLargestContractsByStorageRequest) ProtoMessage()    {}
+func (*QueryLargestContractsByStorageRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{45}
+}
+func (m *QueryLargestContractsByStorageRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLargestContractsByStorageRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLargestContractsByStorageRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLargestContractsByStorageRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLargestContractsByStorageRequest.Merge(m, src)
+}
+func (m *QueryLargestContractsByStorageRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLargestContractsByStorageRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLargestContractsByStorageRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLargestContractsByStorageRequest proto.InternalMessageInfo
+
+// ContractStorageUsage is the storage stats of a single contract
+type ContractStorageUsage struct {
+	ContractAddress string               `protobuf:"bytes,1,opt,name=contract_address,json=contractAddress,proto3" json:"contract_address,omitempty"`
+	Stats           ContractStorageStats `protobuf:"bytes,2,opt,name=stats,proto3" json:"stats"`
+}
+
+func (m *ContractStorageUsage) Reset()         { *m = ContractStorageUsage{} }
+func (m *ContractStorageUsage) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageUsage) ProtoMessage()    {}
+func (*ContractStorageUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{46}
+}
+func (m *ContractStorageUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStorageUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStorageUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageUsage.Merge(m, src)
+}
+func (m *ContractStorageUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStorageUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageUsage proto.InternalMessageInfo
+
+type QueryLargestContractsByStorageResponse struct {
+	Contracts []ContractStorageUsage `protobuf:"bytes,1,rep,name=contracts,proto3" json:"contracts"`
+}
+
+func (m *QueryLargestContractsByStorageResponse) Reset() {
+	*m = QueryLargestContractsByStorageResponse{}
+}
+func (m *QueryLargestContractsByStorageResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryLargestContractsByStorageResponse) ProtoMessage()    {}
+func (*QueryLargestContractsByStorageResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7735281c5fa969d4, []int{47}
+}
+func (m *QueryLargestContractsByStorageResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryLargestContractsByStorageResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryLargestContractsByStorageResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryLargestContractsByStorageResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryLargestContractsByStorageResponse.Merge(m, src)
+}
+func (m *QueryLargestContractsByStorageResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryLargestContractsByStorageResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryLargestContractsByStorageResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryLargestContractsByStorageResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "secret.compute.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "secret.compute.v1beta1.ParamsResponse")
@@ -1846,6 +2000,10 @@ func init() {
 	proto.RegisterType((*CreateResultData)(nil), "secret.compute.v1beta1.CreateResultData")
 	proto.RegisterType((*QueryBlockCreateResultsRequest)(nil), "secret.compute.v1beta1.QueryBlockCreateResultsRequest")
 	proto.RegisterType((*QueryBlockCreateResultsResponse)(nil), "secret.compute.v1beta1.QueryBlockCreateResultsResponse")
+	proto.RegisterType((*QueryContractStorageStatsResponse)(nil), "secret.compute.v1beta1.QueryContractStorageStatsResponse")
+	proto.RegisterType((*QueryLargestContractsByStorageRequest)(nil), "secret.compute.v1beta1.QueryLargestContractsByStorageRequest")
+	proto.RegisterType((*ContractStorageUsage)(nil), "secret.compute.v1beta1.ContractStorageUsage")
+	proto.RegisterType((*QueryLargestContractsByStorageResponse)(nil), "secret.compute.v1beta1.QueryLargestContractsByStorageResponse")
 }
 
 func init() {
@@ -1853,162 +2011,171 @@ func init() {
 }
 
 var fileDescriptor_7735281c5fa969d4 = []byte{
-	// 2472 bytes of a gzipped FileDescriptorProto
+	// 2610 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xc4, 0x5a, 0x4b, 0x6c, 0x1c, 0x49,
-	0x19, 0x76, 0xfb, 0x3d, 0xbf, 0x1f, 0x49, 0x6a, 0x1d, 0xc7, 0x99, 0x6c, 0xc6, 0x49, 0xb3, 0x49,
-	0x9c, 0x64, 0x33, 0x1d, 0xdb, 0x21, 0x2f, 0x16, 0x81, 0x9d, 0x18, 0xe2, 0x25, 0x0f, 0xef, 0x78,
-	0x57, 0x48, 0xab, 0xa0, 0x56, 0x4d, 0x77, 0x65, 0xa6, 0xe5, 0x99, 0xee, 0x49, 0x57, 0x8f, 0xed,
-	0xd9, 0xc8, 0x1c, 0x38, 0xf0, 0xb8, 0x21, 0xb1, 0x08, 0xad, 0xb8, 0xec, 0x09, 0x56, 0x20, 0x21,
-	0x71, 0x5d, 0x09, 0x71, 0x8d, 0x10, 0x87, 0x48, 0x7b, 0xe1, 0x14, 0x41, 0xc2, 0x01, 0x71, 0xe7,
-	0x8e, 0xaa, 0xea, 0xef, 0x9e, 0xee, 0x99, 0x9e, 0xe9, 0x71, 0x38, 0x70, 0x9b, 0xae, 0xfa, 0x1f,
-	0xdf, 0xff, 0xa8, 0xbf, 0xea, 0xff, 0x6d, 0xd0, 0x39, 0xb3, 0x7c, 0x16, 0x18, 0x96, 0x57, 0x6f,
-	0x34, 0x03, 0x66, 0xec, 0x2e, 0x97, 0x59, 0x40, 0x97, 0x8d, 0xa7, 0x4d, 0xe6, 0xb7, 0x8a, 0x0d,
-	0xdf, 0x0b, 0x3c, 0x32, 0xaf, 0x68, 0x8a, 0x48, 0x53, 0x44, 0x9a, 0xfc, 0x5c, 0xc5, 0xab, 0x78,
-	0x92, 0xc4, 0x10, 0xbf, 0x14, 0x75, 0xbe, 0x97, 0xc4, 0xa0, 0xd5, 0x60, 0x1c, 0x69, 0xbe, 0xd6,
-	0x83, 0xa6, 0x41, 0x7d, 0x5a, 0x0f, 0x89, 0x4e, 0x55, 0x3c, 0xaf, 0x52, 0x63, 0x86, 0xfc, 0x2a,
-	0x37, 0x9f, 0x18, 0xac, 0xde, 0x08, 0x10, 0x53, 0xfe, 0x6d, 0xdc, 0xa4, 0x0d, 0xc7, 0xa0, 0xae,
-	0xeb, 0x05, 0x34, 0x70, 0x3c, 0x37, 0x92, 0x6f, 0x79, 0xbc, 0xee, 0x71, 0xa3, 0x4c, 0x39, 0x33,
-	0x68, 0xd9, 0x72, 0x22, 0x0d, 0xe2, 0x03, 0x89, 0x2e, 0xc5, 0x89, 0xa4, 0xbd, 0x31, 0x1c, 0x15,
-	0xc7, 0x95, 0x12, 0x15, 0xad, 0x7e, 0x04, 0x66, 0xb6, 0x24, 0xb6, 0x12, 0x7b, 0xda, 0x64, 0x3c,
-	0xd0, 0x3f, 0x84, 0xd9, 0x70, 0x81, 0x37, 0x3c, 0x97, 0x33, 0xf2, 0x1e, 0x8c, 0x2b, 0xf8, 0x0b,
-	0xda, 0x19, 0x6d, 0x69, 0x6a, 0xa5, 0x50, 0x4c, 0x77, 0x5b, 0x51, 0xf1, 0xad, 0x8f, 0x3e, 0x7f,
-	0xb9, 0x38, 0x54, 0x42, 0x9e, 0xdb, 0xa3, 0xff, 0xfa, 0x7c, 0x71, 0x48, 0xff, 0x01, 0xe4, 0x3f,
-	0x10, 0x40, 0xb6, 0x25, 0xe7, 0x1d, 0xcf, 0x0d, 0x7c, 0x6a, 0x05, 0xa8, 0x93, 0x5c, 0x84, 0xa3,
-	0x16, 0x2e, 0x99, 0xd4, 0xb6, 0x7d, 0xc6, 0x95, 0xae, 0x5c, 0xe9, 0x48, 0xb8, 0xbe, 0xa6, 0x96,
-	0xc9, 0x1c, 0x8c, 0x49, 0x8b, 0x16, 0x86, 0xcf, 0x68, 0x4b, 0xd3, 0x25, 0xf5, 0xa1, 0x5f, 0x86,
-	0xb7, 0xa4, 0xf8, 0xf5, 0xd6, 0x7d, 0x5a, 0x66, 0xb5, 0x50, 0xee, 0x1c, 0x8c, 0xd5, 0xc4, 0x37,
-	0x0a, 0x53, 0x1f, 0xfa, 0xfb, 0x70, 0x1a, 0x89, 0xef, 0x24, 0x85, 0x1f, 0x1e, 0x8e, 0x6e, 0xc0,
-	0x5c, 0x24, 0xcb, 0x66, 0x9b, 0x76, 0x28, 0xe2, 0x04, 0x4c, 0x58, 0x9e, 0xcd, 0x4c, 0xc7, 0x96,
-	0x9c, 0xa3, 0xa5, 0x71, 0x4b, 0xee, 0xeb, 0xcb, 0x70, 0x2a, 0xd5, 0x11, 0xe8, 0x6b, 0x02, 0xa3,
-	0x36, 0x0d, 0xa8, 0x64, 0x9a, 0x2e, 0xc9, 0xdf, 0xfa, 0xaf, 0x35, 0x38, 0x29, 0x79, 0x42, 0xea,
-	0x4d, 0xf7, 0x89, 0x17, 0x71, 0x1c, 0xc2, 0x77, 0xdb, 0x30, 0x13, 0x91, 0x3a, 0xee, 0x13, 0x4f,
-	0xfa, 0x70, 0x6a, 0xe5, 0x9d, 0x5e, 0xf1, 0x8c, 0xeb, 0x5b, 0x9f, 0x7c, 0xf1, 0x72, 0x51, 0xfb,
-	0xb7, 0x88, 0xec, 0xb4, 0x15, 0x5b, 0xd7, 0x3f, 0xd3, 0xe0, 0x44, 0x9c, 0xf0, 0xfb, 0x4e, 0x50,
-	0x0d, 0x15, 0xfe, 0xbf, 0xb1, 0xfd, 0x10, 0x0a, 0x09, 0xc7, 0xf1, 0x76, 0x98, 0xd0, 0x7b, 0x8f,
-	0x61, 0x36, 0xa1, 0x56, 0xe0, 0x1b, 0x59, 0x9a, 0x5a, 0x31, 0x06, 0xd1, 0x1b, 0x33, 0x15, 0x93,
-	0x7e, 0x26, 0xae, 0x9e, 0xeb, 0x9f, 0x6a, 0x70, 0x54, 0x2a, 0x8c, 0x07, 0xac, 0x57, 0x6a, 0x90,
-	0x05, 0x98, 0xb0, 0x7c, 0x46, 0x03, 0xcf, 0x97, 0xc6, 0xe7, 0x4a, 0xe1, 0x27, 0x39, 0x05, 0x39,
-	0xc9, 0x52, 0xa5, 0xbc, 0xba, 0x30, 0x22, 0xf7, 0x26, 0xc5, 0xc2, 0x3d, 0xca, 0xab, 0x64, 0x1e,
-	0xc6, 0xb9, 0xd7, 0xf4, 0x2d, 0xb6, 0x30, 0x2a, 0x77, 0xf0, 0x4b, 0x88, 0x2b, 0x37, 0x9d, 0x9a,
-	0xcd, 0xfc, 0x85, 0x31, 0x25, 0x0e, 0x3f, 0xf5, 0x7d, 0x38, 0x86, 0x6e, 0xb1, 0x59, 0x04, 0xeb,
-	0x11, 0xea, 0x90, 0xce, 0x57, 0x07, 0x7d, 0xa9, 0xb7, 0x13, 0x92, 0x36, 0xc5, 0x02, 0x20, 0x71,
-	0x89, 0x3d, 0x91, 0xca, 0x7b, 0x94, 0xd7, 0xf1, 0xa0, 0xca, 0xdf, 0xba, 0x05, 0x24, 0xd2, 0xdc,
-	0x2e, 0x30, 0x0f, 0x00, 0x22, 0xd5, 0x61, 0x00, 0x06, 0xd7, 0xad, 0x3c, 0x9f, 0x0b, 0xf5, 0x72,
-	0x7d, 0x13, 0xde, 0x4e, 0x44, 0x3d, 0x3a, 0xdd, 0x87, 0x3e, 0x31, 0xfa, 0x0a, 0x96, 0xad, 0x50,
-	0x14, 0x56, 0x17, 0x14, 0x94, 0x5e, 0x5e, 0xae, 0xc1, 0xf1, 0xc8, 0x46, 0x11, 0xa0, 0x88, 0x3c,
-	0x11, 0x45, 0x2d, 0x19, 0x45, 0xfd, 0x97, 0x1a, 0x1c, 0xb9, 0xcb, 0x2c, 0xbf, 0xd5, 0x08, 0x98,
-	0xbd, 0xe6, 0xf2, 0x3d, 0xe6, 0x0b, 0x0f, 0x8a, 0xbb, 0x05, 0x69, 0xe5, 0x6f, 0xa1, 0xd3, 0x71,
-	0x1b, 0xcd, 0x00, 0x53, 0x44, 0x7d, 0x90, 0x45, 0x98, 0xf2, 0x9a, 0x41, 0xa3, 0x19, 0x98, 0xb2,
-	0x7a, 0xa8, 0x14, 0x01, 0xb5, 0x74, 0x97, 0x06, 0x94, 0x2c, 0xc3, 0xf1, 0x18, 0x81, 0x49, 0xb9,
-	0xc9, 0x03, 0xdf, 0x71, 0x2b, 0x98, 0x33, 0xa4, 0x4d, 0xba, 0xc6, 0xb7, 0xe5, 0x0e, 0x16, 0xee,
-	0xff, 0x68, 0x70, 0xb4, 0x03, 0x17, 0x27, 0x6b, 0x30, 0x41, 0xd5, 0x4f, 0x8c, 0xd6, 0x85, 0x5e,
-	0xd1, 0xea, 0x60, 0x2d, 0x85, 0x7c, 0xe4, 0x7e, 0x84, 0xb8, 0xe6, 0x55, 0xf8, 0xc2, 0xb0, 0x14,
-	0x73, 0xae, 0xa8, 0x6e, 0xae, 0xa2, 0xb8, 0xb9, 0x8a, 0xf2, 0x46, 0x0b, 0x05, 0x29, 0x50, 0x1b,
-	0xbb, 0xcc, 0x0d, 0x30, 0xe2, 0x68, 0xde, 0x7d, 0xaf, 0xc2, 0xc9, 0x59, 0x98, 0x46, 0x69, 0xcc,
-	0xf7, 0x3d, 0x1f, 0x1d, 0x80, 0x1a, 0x36, 0xc4, 0x12, 0xb9, 0x00, 0x47, 0x1a, 0x35, 0xea, 0xb8,
-	0x01, 0xdb, 0x0f, 0xa9, 0x94, 0xed, 0xb3, 0xd1, 0xb2, 0x24, 0x44, 0xbb, 0x1f, 0x62, 0x9d, 0x0e,
-	0x23, 0x7f, 0xcf, 0xe1, 0x81, 0xe7, 0xb7, 0x0e, 0x7f, 0x45, 0xa0, 0xbc, 0xdd, 0x8e, 0xa4, 0x8c,
-	0xe4, 0x61, 0x72, 0x6c, 0xc1, 0x04, 0x73, 0x03, 0xdf, 0x61, 0xa1, 0x4b, 0xaf, 0x66, 0x55, 0x20,
-	0x99, 0x5f, 0x4a, 0xca, 0x86, 0x1b, 0xf8, 0x2d, 0x74, 0x4b, 0x28, 0x06, 0xf5, 0xde, 0x87, 0x45,
-	0xa9, 0x77, 0xad, 0x19, 0x54, 0x3d, 0xdf, 0xf9, 0x84, 0xd9, 0x0f, 0x9c, 0x8a, 0x2f, 0x5f, 0x00,
-	0x6f, 0x70, 0xdd, 0x7d, 0x00, 0x67, 0x7a, 0x4b, 0x43, 0x4b, 0xae, 0xc0, 0x94, 0xcb, 0xf6, 0xcc,
-	0x44, 0x8d, 0x5b, 0x9f, 0x79, 0xf5, 0x72, 0x31, 0xf7, 0x90, 0xed, 0xc9, 0xd3, 0x7b, 0xb7, 0x94,
-	0x73, 0xf1, 0xa7, 0xad, 0x3f, 0x84, 0xb3, 0x1d, 0x22, 0xd7, 0xec, 0xba, 0xe3, 0x7e, 0xd4, 0xb0,
-	0x69, 0xc0, 0xde, 0x00, 0xe2, 0x1a, 0xe8, 0xfd, 0xe4, 0xb5, 0xcf, 0xa2, 0x00, 0x49, 0xc5, 0x56,
-	0x78, 0x16, 0x5d, 0xb6, 0x27, 0x49, 0xf5, 0x65, 0x38, 0x21, 0x45, 0x6c, 0x58, 0xb4, 0x56, 0x2b,
-	0x31, 0xcb, 0xf3, 0xa3, 0x7b, 0x7d, 0x1e, 0xc6, 0xab, 0xcc, 0xa9, 0x54, 0x03, 0xc9, 0x34, 0x52,
-	0xc2, 0x2f, 0xfd, 0x67, 0x1a, 0x2c, 0x74, 0xf3, 0xa0, 0xb2, 0x1e, 0x4c, 0xe2, 0xd4, 0xfa, 0xd4,
-	0xb5, 0xbd, 0xba, 0xc9, 0x19, 0xb3, 0xb1, 0x50, 0x82, 0x5a, 0xda, 0x66, 0xcc, 0x26, 0xd7, 0x60,
-	0x7e, 0x97, 0xd6, 0x1c, 0x5b, 0x5c, 0x02, 0x26, 0x67, 0x81, 0xc9, 0x76, 0x1d, 0x9b, 0xb9, 0x16,
-	0x93, 0x09, 0x3e, 0x5d, 0x9a, 0x8b, 0x76, 0xb7, 0x59, 0xb0, 0x81, 0x7b, 0xfa, 0xfb, 0xf8, 0x5c,
-	0x78, 0xc8, 0x82, 0x3d, 0xcf, 0xdf, 0xd9, 0x6a, 0x96, 0x77, 0x58, 0x2b, 0xc3, 0x00, 0x72, 0x1c,
-	0xc6, 0x9d, 0x36, 0x8c, 0x99, 0xd2, 0x98, 0x23, 0x10, 0xe8, 0x1f, 0x63, 0x01, 0xec, 0x90, 0x85,
-	0x86, 0x2d, 0xc2, 0x94, 0x2b, 0xc2, 0xdc, 0x90, 0xcb, 0xf8, 0x68, 0x01, 0xb1, 0xa4, 0x08, 0x85,
-	0x9b, 0x1d, 0x2f, 0xdc, 0x56, 0xf6, 0x4d, 0x3a, 0x9e, 0xda, 0xd4, 0x1f, 0x77, 0xbb, 0x2c, 0x7a,
-	0x82, 0x9d, 0x85, 0x69, 0x1e, 0x50, 0x3f, 0x30, 0x13, 0x60, 0xa7, 0xe4, 0xda, 0x3d, 0x85, 0xf8,
-	0x34, 0x00, 0x73, 0xed, 0x90, 0x60, 0x58, 0x12, 0xe4, 0x98, 0x6b, 0xab, 0x6d, 0xbd, 0x8e, 0x5e,
-	0x48, 0x4a, 0x6f, 0x9f, 0x36, 0x5f, 0x2d, 0x65, 0x9d, 0xb6, 0x5e, 0x41, 0x0d, 0x4f, 0x1b, 0x8a,
-	0xd1, 0xb7, 0x42, 0x75, 0x2e, 0x16, 0x3c, 0xe1, 0xbe, 0xd0, 0x1a, 0x51, 0xf9, 0x99, 0x30, 0x26,
-	0x5e, 0xf9, 0x99, 0x1f, 0x84, 0xf7, 0x77, 0xc2, 0x86, 0x30, 0xa5, 0x6a, 0xe8, 0xfa, 0x0e, 0x89,
-	0x68, 0xc1, 0x39, 0x98, 0x65, 0xe1, 0x86, 0x8a, 0x9b, 0xf2, 0xfe, 0x0c, 0x8b, 0x93, 0x8b, 0xaa,
-	0x57, 0xa7, 0x56, 0xd5, 0x71, 0x99, 0x59, 0x76, 0x5c, 0x5b, 0x54, 0x7c, 0x15, 0x86, 0x59, 0x5c,
-	0x5e, 0x57, 0xab, 0xfa, 0x16, 0xe4, 0xb6, 0x03, 0xcf, 0xa7, 0x15, 0xf6, 0xa8, 0x21, 0xc3, 0xc6,
-	0x4d, 0x9b, 0xd5, 0x58, 0xa0, 0x6e, 0x9f, 0xc9, 0xd2, 0xa4, 0xc3, 0xef, 0xca, 0x6f, 0x72, 0x14,
-	0x46, 0xda, 0xd1, 0x14, 0x3f, 0xc5, 0x9d, 0xb4, 0x4b, 0x6b, 0xcd, 0x30, 0x2b, 0xd5, 0x87, 0xfe,
-	0x14, 0x66, 0xee, 0xf8, 0x1e, 0xe7, 0x0f, 0x3c, 0xbb, 0x59, 0x43, 0xa9, 0xa2, 0x5a, 0x31, 0x33,
-	0xcc, 0x95, 0x5c, 0x69, 0x52, 0x2e, 0x7c, 0x8f, 0xb5, 0x06, 0x95, 0x9a, 0x84, 0x36, 0x9a, 0x84,
-	0xa6, 0xff, 0x79, 0x18, 0xc8, 0xc6, 0x3e, 0xb3, 0x9a, 0xa2, 0x20, 0x7d, 0xe8, 0x53, 0x8b, 0xc9,
-	0xcb, 0x4f, 0xde, 0x99, 0x36, 0xdb, 0xc7, 0x2c, 0x52, 0x1f, 0xe4, 0x16, 0x8c, 0x78, 0x8d, 0xf0,
-	0xe6, 0x39, 0xdb, 0x2b, 0xfe, 0x91, 0x53, 0x30, 0xe0, 0x82, 0x47, 0x84, 0xcc, 0x67, 0xbc, 0x59,
-	0x0b, 0x10, 0x1b, 0x7e, 0x91, 0x93, 0x30, 0x59, 0xa1, 0xdc, 0x6c, 0x72, 0x66, 0x4b, 0x6c, 0xa3,
-	0xa5, 0x89, 0x0a, 0xe5, 0x1f, 0x71, 0x66, 0x8b, 0x84, 0x16, 0x49, 0x54, 0xa6, 0xd6, 0x8e, 0x59,
-	0xa1, 0x7c, 0x61, 0x42, 0x6e, 0x4f, 0x85, 0x6b, 0xdf, 0xa5, 0x5c, 0x98, 0x56, 0xa5, 0x1c, 0xef,
-	0xa6, 0x31, 0x65, 0x5a, 0x95, 0x72, 0x75, 0x7d, 0x9d, 0x82, 0x9c, 0xdc, 0x30, 0xeb, 0xbc, 0xb2,
-	0x30, 0xae, 0x9c, 0x27, 0x17, 0x1e, 0xf0, 0x0a, 0xb9, 0x07, 0x39, 0x4b, 0xb8, 0xda, 0x14, 0x06,
-	0x4d, 0xe2, 0x55, 0xda, 0xeb, 0xfa, 0x88, 0xc7, 0x04, 0x8d, 0x9a, 0x94, 0xdc, 0x8f, 0x1a, 0x3c,
-	0x2a, 0x7d, 0xeb, 0x35, 0xcf, 0xda, 0x91, 0x1e, 0xe4, 0x59, 0xa5, 0xcf, 0xc6, 0x63, 0x9c, 0x60,
-	0xc1, 0x2c, 0xbd, 0x07, 0xe3, 0x81, 0x5c, 0xc1, 0x63, 0x76, 0xa9, 0x17, 0xaa, 0xee, 0xa8, 0x85,
-	0x6d, 0xa4, 0xe2, 0xd7, 0xb7, 0xf1, 0x34, 0x3c, 0x50, 0x69, 0xbb, 0x79, 0x77, 0xcb, 0xf7, 0xbc,
-	0x27, 0x59, 0x55, 0xed, 0x34, 0x40, 0x98, 0xfe, 0x8e, 0x8d, 0x4f, 0xa6, 0x1c, 0xae, 0x6c, 0xda,
-	0xfa, 0x2a, 0x5e, 0xf2, 0x9d, 0x42, 0xdb, 0xef, 0xbb, 0x86, 0x58, 0xc0, 0xa3, 0xa5, 0x3e, 0xf4,
-	0xeb, 0xe8, 0xa2, 0x35, 0x97, 0xd6, 0x5a, 0x9f, 0x30, 0xf5, 0x88, 0x6e, 0x9f, 0xf3, 0xc4, 0x0b,
-	0x6f, 0x3a, 0xf6, 0xc2, 0xdb, 0x47, 0x3f, 0x25, 0xf8, 0x50, 0x93, 0x01, 0x73, 0x22, 0xf4, 0x4e,
-	0xd9, 0x32, 0xc5, 0xf5, 0xdd, 0x32, 0x1b, 0x9e, 0xe3, 0x06, 0x1c, 0xcf, 0xde, 0xb1, 0x2a, 0xe5,
-	0x9b, 0x65, 0x4b, 0xde, 0xf2, 0x5b, 0x72, 0x83, 0x5c, 0x86, 0x63, 0x3e, 0x7b, 0xda, 0x74, 0x7c,
-	0x66, 0x9b, 0x4f, 0x18, 0x0d, 0x9a, 0x3e, 0xe3, 0x68, 0xdf, 0xd1, 0x70, 0xe3, 0x3b, 0xb8, 0xae,
-	0xff, 0x58, 0xb4, 0x21, 0xa2, 0x95, 0x10, 0x0a, 0x9b, 0x35, 0xf5, 0x22, 0x3c, 0x05, 0x39, 0xf1,
-	0x24, 0x4f, 0x60, 0x15, 0x0b, 0xb2, 0x26, 0x25, 0x0c, 0x19, 0x4e, 0x1a, 0x92, 0xcc, 0xd3, 0x91,
-	0x7e, 0x79, 0x3a, 0x9a, 0xcc, 0x53, 0xfd, 0x26, 0xf6, 0x63, 0x32, 0x55, 0xe2, 0x88, 0x32, 0x93,
-	0x6c, 0x07, 0x9f, 0x31, 0x69, 0x9c, 0x51, 0xae, 0x4d, 0xa8, 0x63, 0x98, 0xdd, 0x42, 0x74, 0xf8,
-	0xa2, 0x5d, 0xcb, 0x25, 0xfb, 0xca, 0x4f, 0xde, 0x86, 0x31, 0xa9, 0x8d, 0xfc, 0x4e, 0x83, 0xe9,
-	0x78, 0xc7, 0x47, 0xbe, 0xde, 0xf7, 0x9e, 0xe8, 0x35, 0x51, 0xc8, 0x2f, 0xf7, 0x65, 0x4b, 0xeb,
-	0xeb, 0xf5, 0xab, 0x3f, 0xfa, 0xea, 0x9f, 0xbf, 0x18, 0xbe, 0x44, 0x96, 0xba, 0x66, 0x49, 0xa2,
-	0x4d, 0x32, 0x9e, 0x75, 0xbe, 0x87, 0x0e, 0xc8, 0x6f, 0x35, 0x38, 0xd6, 0xd5, 0xe9, 0x92, 0x77,
-	0x33, 0x11, 0xc7, 0xe6, 0x16, 0xf9, 0xeb, 0x03, 0x01, 0xed, 0xea, 0xa3, 0xf5, 0x77, 0x25, 0xda,
-	0xf3, 0xe4, 0x9d, 0x2e, 0xb4, 0x21, 0x4e, 0x2e, 0x20, 0xcb, 0x27, 0xe1, 0x01, 0xf9, 0xa3, 0x86,
-	0xf3, 0x9a, 0xe4, 0x14, 0x84, 0xac, 0xf4, 0xd5, 0x9e, 0x3a, 0x3b, 0xca, 0xaf, 0x1e, 0x8a, 0x07,
-	0xe1, 0x2e, 0x4b, 0xb8, 0x97, 0xc9, 0xc5, 0xf4, 0xf1, 0x60, 0x9a, 0x77, 0x7f, 0xaa, 0xc1, 0xa8,
-	0x30, 0xfa, 0x90, 0x0e, 0xbd, 0x98, 0xe1, 0xd0, 0x76, 0x11, 0xd0, 0x2f, 0x48, 0x50, 0x67, 0xc9,
-	0x62, 0x8a, 0x0f, 0x6d, 0x16, 0x73, 0xdf, 0x0e, 0x8c, 0xc9, 0x06, 0x9a, 0xcc, 0x17, 0xd5, 0xb0,
-	0xb0, 0x18, 0x4e, 0x12, 0x8b, 0x1b, 0xf5, 0x46, 0xd0, 0xca, 0x5f, 0xca, 0x54, 0x1a, 0x1d, 0x1b,
-	0xbd, 0x20, 0xb5, 0x2e, 0x90, 0xf9, 0x54, 0xad, 0x9c, 0xfc, 0x55, 0x83, 0x93, 0x61, 0x2b, 0xdb,
-	0x95, 0xdf, 0x6f, 0x7a, 0x1e, 0xae, 0x64, 0x02, 0x8c, 0x77, 0xce, 0xfa, 0xa6, 0xc4, 0x78, 0x87,
-	0xac, 0xa5, 0x62, 0x94, 0x55, 0xca, 0x28, 0xb7, 0xcc, 0xce, 0xa0, 0xa5, 0x85, 0xf1, 0x0b, 0x1c,
-	0xc9, 0x84, 0xe6, 0xbc, 0xc1, 0x19, 0x39, 0x24, 0xf8, 0x1b, 0x12, 0xfc, 0x32, 0x31, 0xb2, 0xc0,
-	0xcb, 0xe8, 0xc6, 0xc2, 0xfc, 0x07, 0x0d, 0x66, 0xe5, 0xc0, 0x61, 0xbd, 0xf5, 0x3f, 0xba, 0x7b,
-	0x65, 0xa0, 0x53, 0x9d, 0x18, 0x6e, 0xf4, 0x39, 0x22, 0x72, 0xcc, 0x91, 0xe6, 0xdb, 0xdf, 0x68,
-	0x30, 0x1b, 0xce, 0xc3, 0xd4, 0x20, 0x96, 0x5c, 0xce, 0x00, 0x1c, 0x1f, 0xd7, 0xe6, 0xaf, 0x0d,
-	0x04, 0xb3, 0x63, 0x9c, 0xd3, 0x07, 0x68, 0x77, 0x3e, 0x48, 0xe8, 0x07, 0xe4, 0x4b, 0x0d, 0x8e,
-	0x74, 0x34, 0xe2, 0x64, 0x75, 0x20, 0xe5, 0xc9, 0x31, 0xc0, 0x80, 0x88, 0x3b, 0x7a, 0x7d, 0xfd,
-	0x3d, 0x89, 0xf8, 0x3a, 0xb9, 0xd6, 0x1b, 0x71, 0x55, 0xb1, 0xa4, 0x79, 0x79, 0x1f, 0xc6, 0xd5,
-	0xa0, 0x9d, 0x9c, 0xeb, 0x3f, 0x88, 0x0f, 0x41, 0x9e, 0xcf, 0x22, 0x43, 0x58, 0x8b, 0x12, 0xd6,
-	0x49, 0x72, 0xa2, 0xc7, 0x5f, 0x2f, 0xc8, 0x5f, 0x34, 0x78, 0x2b, 0xa5, 0xf3, 0x27, 0x37, 0xfa,
-	0x7a, 0xa1, 0xf7, 0xe4, 0x21, 0x7f, 0xf3, 0xf0, 0x8c, 0x88, 0xf5, 0xdb, 0x12, 0xeb, 0x6d, 0x72,
-	0xb3, 0x0b, 0x2b, 0x8d, 0xb8, 0xcc, 0x7a, 0xc8, 0x96, 0xe6, 0xc6, 0xaf, 0x34, 0x38, 0x9e, 0x3a,
-	0x23, 0x20, 0xb7, 0x06, 0x44, 0xd5, 0x3d, 0xa7, 0xc8, 0xdf, 0x7e, 0x13, 0x56, 0x34, 0xe9, 0x8e,
-	0x34, 0xe9, 0x9b, 0xe4, 0x1b, 0xfd, 0x4c, 0x92, 0x03, 0x0b, 0xb3, 0x29, 0x39, 0xd3, 0xac, 0xfa,
-	0x4c, 0x83, 0xa9, 0x58, 0xb7, 0x4a, 0x8c, 0xc1, 0xfb, 0x5a, 0x65, 0xc1, 0xa1, 0x1b, 0xe1, 0x3e,
-	0xd7, 0x16, 0x13, 0xd4, 0xc6, 0x33, 0xf5, 0x84, 0x3b, 0x20, 0x9f, 0x6a, 0x30, 0x1d, 0xef, 0xc6,
-	0xc9, 0xc0, 0xba, 0x06, 0x7c, 0x47, 0xa5, 0xb5, 0xfa, 0x7d, 0xb2, 0x5a, 0xc2, 0xe3, 0xe2, 0x31,
-	0x32, 0x93, 0x18, 0x6f, 0x90, 0xfe, 0x5a, 0xd2, 0xc6, 0x2a, 0x19, 0x15, 0x36, 0x75, 0x7a, 0xa2,
-	0xdf, 0x92, 0xc8, 0x56, 0xc9, 0x72, 0x17, 0x32, 0x57, 0xd1, 0xe3, 0xe0, 0x24, 0xf2, 0xa0, 0xf1,
-	0x4c, 0x8d, 0x68, 0x0e, 0xc8, 0xef, 0x35, 0x98, 0x49, 0xcc, 0x05, 0x32, 0x30, 0xa7, 0x4d, 0x25,
-	0x32, 0x30, 0xa7, 0x8e, 0x1d, 0xf4, 0x55, 0x89, 0xf9, 0x0a, 0xb9, 0xdc, 0xed, 0xcd, 0xc4, 0x34,
-	0xc2, 0x78, 0x16, 0x0d, 0x3c, 0x0e, 0xc8, 0xe7, 0x1a, 0x4c, 0xc5, 0xba, 0xc3, 0x8c, 0xa4, 0xec,
-	0x6e, 0x3d, 0x33, 0x92, 0x32, 0xa5, 0xf1, 0xd4, 0x8b, 0x12, 0xe7, 0x12, 0x39, 0xdf, 0x85, 0xb3,
-	0x2c, 0xa8, 0x4d, 0xd5, 0x55, 0xb6, 0x73, 0xf3, 0x4b, 0x0d, 0x66, 0x93, 0x5d, 0x60, 0xc6, 0x63,
-	0x34, 0xb5, 0x0f, 0xcd, 0x78, 0x8c, 0xa6, 0xb7, 0x99, 0xfa, 0xb7, 0x24, 0xd6, 0x5b, 0xe4, 0x46,
-	0x17, 0xd6, 0x76, 0xef, 0x6a, 0xca, 0xde, 0x33, 0x96, 0x09, 0xed, 0xad, 0x03, 0xf2, 0x2b, 0x0d,
-	0xa6, 0x62, 0x5d, 0x65, 0x86, 0x7f, 0xbb, 0xfb, 0xd6, 0x0c, 0xff, 0xa6, 0x34, 0xac, 0xfa, 0x39,
-	0x89, 0x79, 0x91, 0x9c, 0xee, 0x2e, 0x56, 0x8a, 0x5a, 0xbe, 0x67, 0xc8, 0x9f, 0x34, 0x20, 0xdd,
-	0x2d, 0x1b, 0xb9, 0x9e, 0x1d, 0xcf, 0xb4, 0xee, 0x30, 0x7f, 0xe3, 0xd0, 0x7c, 0x08, 0xf7, 0xba,
-	0x84, 0x7b, 0x95, 0x14, 0x7b, 0xa4, 0x83, 0xfc, 0x43, 0x1b, 0x33, 0xb1, 0x01, 0x8c, 0xdc, 0xbc,
-	0xfe, 0xf8, 0xf9, 0x3f, 0x0a, 0x43, 0x5f, 0xbc, 0x2a, 0x68, 0xcf, 0x5f, 0x15, 0xb4, 0x17, 0xaf,
-	0x0a, 0xda, 0xdf, 0x5f, 0x15, 0xb4, 0x9f, 0xbf, 0x2e, 0x0c, 0xbd, 0x78, 0x5d, 0x18, 0xfa, 0xdb,
-	0xeb, 0xc2, 0xd0, 0xc7, 0xb7, 0x2b, 0x4e, 0x50, 0x6d, 0x96, 0x05, 0x22, 0x83, 0x5b, 0x7e, 0x50,
-	0xa3, 0x65, 0x6e, 0xa8, 0xae, 0x03, 0x4f, 0xbd, 0xb1, 0x1f, 0x29, 0x75, 0xdc, 0x80, 0xf9, 0x2e,
-	0xad, 0xa9, 0x7f, 0x19, 0x28, 0x8f, 0xcb, 0x67, 0xfb, 0xea, 0x7f, 0x03, 0x00, 0x00, 0xff, 0xff,
-	0x31, 0x2f, 0xc0, 0x99, 0xab, 0x20, 0x00, 0x00,
+	0x19, 0x76, 0xfb, 0x3d, 0xbf, 0x1f, 0x49, 0x6a, 0x1d, 0x67, 0x3c, 0xde, 0xcc, 0x24, 0xcd, 0x26,
+	0xeb, 0xbc, 0x66, 0x62, 0x3b, 0xe4, 0xc5, 0x06, 0xb0, 0x13, 0x43, 0xbc, 0xe4, 0xe1, 0x1d, 0x6f,
+	0x84, 0xb4, 0x0a, 0x6a, 0xd5, 0x74, 0x57, 0x66, 0x5a, 0x9e, 0xe9, 0x9e, 0x74, 0xf5, 0xf8, 0x91,
+	0xc8, 0x1c, 0x38, 0x20, 0x10, 0x17, 0x04, 0x8b, 0xd0, 0x8a, 0xcb, 0x9e, 0x60, 0x05, 0x12, 0x12,
+	0xd7, 0x95, 0x10, 0x12, 0x5c, 0x22, 0xc4, 0x21, 0xd2, 0x5e, 0x38, 0x45, 0x90, 0x70, 0x40, 0xdc,
+	0xb9, 0xa3, 0xaa, 0xfa, 0xbb, 0xa7, 0xdb, 0xd3, 0xf3, 0xf2, 0x1e, 0xb8, 0x75, 0x55, 0xfd, 0x8f,
+	0xef, 0x7f, 0x54, 0xfd, 0x55, 0xff, 0x0c, 0xe8, 0x9c, 0x99, 0x1e, 0xf3, 0x0b, 0xa6, 0x5b, 0xab,
+	0x37, 0x7c, 0x56, 0xd8, 0x5e, 0x2c, 0x31, 0x9f, 0x2e, 0x16, 0x9e, 0x36, 0x98, 0xb7, 0x97, 0xaf,
+	0x7b, 0xae, 0xef, 0x92, 0x59, 0x45, 0x93, 0x47, 0x9a, 0x3c, 0xd2, 0x64, 0x66, 0xca, 0x6e, 0xd9,
+	0x95, 0x24, 0x05, 0xf1, 0xa5, 0xa8, 0x33, 0xed, 0x24, 0xfa, 0x7b, 0x75, 0xc6, 0x91, 0xe6, 0x2b,
+	0x6d, 0x68, 0xea, 0xd4, 0xa3, 0xb5, 0x80, 0x68, 0xbe, 0xec, 0xba, 0xe5, 0x2a, 0x2b, 0xc8, 0x51,
+	0xa9, 0xf1, 0xa4, 0xc0, 0x6a, 0x75, 0x1f, 0x31, 0x65, 0xde, 0xc6, 0x45, 0x5a, 0xb7, 0x0b, 0xd4,
+	0x71, 0x5c, 0x9f, 0xfa, 0xb6, 0xeb, 0x84, 0xf2, 0x4d, 0x97, 0xd7, 0x5c, 0x5e, 0x28, 0x51, 0xce,
+	0x0a, 0xb4, 0x64, 0xda, 0xa1, 0x06, 0x31, 0x40, 0xa2, 0xf3, 0x51, 0x22, 0x69, 0x6f, 0x04, 0x47,
+	0xd9, 0x76, 0xa4, 0x44, 0x45, 0xab, 0x1f, 0x81, 0xa9, 0x0d, 0x89, 0xad, 0xc8, 0x9e, 0x36, 0x18,
+	0xf7, 0xf5, 0x0f, 0x61, 0x3a, 0x98, 0xe0, 0x75, 0xd7, 0xe1, 0x8c, 0xbc, 0x07, 0xa3, 0x0a, 0x7e,
+	0x5a, 0x3b, 0xa5, 0x2d, 0x4c, 0x2c, 0x65, 0xf3, 0xc9, 0x6e, 0xcb, 0x2b, 0xbe, 0xd5, 0xe1, 0x17,
+	0xaf, 0x72, 0x03, 0x45, 0xe4, 0xb9, 0x39, 0xfc, 0xef, 0x4f, 0x73, 0x03, 0xfa, 0xf7, 0x20, 0xf3,
+	0x81, 0x00, 0xb2, 0x29, 0x39, 0x6f, 0xbb, 0x8e, 0xef, 0x51, 0xd3, 0x47, 0x9d, 0xe4, 0x1c, 0x1c,
+	0x35, 0x71, 0xca, 0xa0, 0x96, 0xe5, 0x31, 0xae, 0x74, 0xa5, 0x8a, 0x47, 0x82, 0xf9, 0x15, 0x35,
+	0x4d, 0x66, 0x60, 0x44, 0x5a, 0x94, 0x1e, 0x3c, 0xa5, 0x2d, 0x4c, 0x16, 0xd5, 0x40, 0xbf, 0x00,
+	0x6f, 0x49, 0xf1, 0xab, 0x7b, 0xf7, 0x68, 0x89, 0x55, 0x03, 0xb9, 0x33, 0x30, 0x52, 0x15, 0x63,
+	0x14, 0xa6, 0x06, 0xfa, 0xfb, 0x70, 0x12, 0x89, 0x6f, 0xc7, 0x85, 0xf7, 0x0f, 0x47, 0x2f, 0xc0,
+	0x4c, 0x28, 0xcb, 0x62, 0xeb, 0x56, 0x20, 0xe2, 0x04, 0x8c, 0x99, 0xae, 0xc5, 0x0c, 0xdb, 0x92,
+	0x9c, 0xc3, 0xc5, 0x51, 0x53, 0xae, 0xeb, 0x8b, 0x30, 0x9f, 0xe8, 0x08, 0xf4, 0x35, 0x81, 0x61,
+	0x8b, 0xfa, 0x54, 0x32, 0x4d, 0x16, 0xe5, 0xb7, 0xfe, 0x2b, 0x0d, 0xe6, 0x24, 0x4f, 0x40, 0xbd,
+	0xee, 0x3c, 0x71, 0x43, 0x8e, 0x3e, 0x7c, 0xb7, 0x09, 0x53, 0x21, 0xa9, 0xed, 0x3c, 0x71, 0xa5,
+	0x0f, 0x27, 0x96, 0xde, 0x69, 0x17, 0xcf, 0xa8, 0xbe, 0xd5, 0xf1, 0x97, 0xaf, 0x72, 0xda, 0x7f,
+	0x44, 0x64, 0x27, 0xcd, 0xc8, 0xbc, 0xfe, 0x89, 0x06, 0x27, 0xa2, 0x84, 0xdf, 0xb5, 0xfd, 0x4a,
+	0xa0, 0xf0, 0xff, 0x8d, 0xed, 0xfb, 0x90, 0x8d, 0x39, 0x8e, 0x37, 0xc3, 0x84, 0xde, 0x7b, 0x0c,
+	0xd3, 0x31, 0xb5, 0x02, 0xdf, 0xd0, 0xc2, 0xc4, 0x52, 0xa1, 0x17, 0xbd, 0x11, 0x53, 0x31, 0xe9,
+	0xa7, 0xa2, 0xea, 0xb9, 0xfe, 0xb1, 0x06, 0x47, 0xa5, 0xc2, 0x68, 0xc0, 0xda, 0xa5, 0x06, 0x49,
+	0xc3, 0x98, 0xe9, 0x31, 0xea, 0xbb, 0x9e, 0x34, 0x3e, 0x55, 0x0c, 0x86, 0x64, 0x1e, 0x52, 0x92,
+	0xa5, 0x42, 0x79, 0x25, 0x3d, 0x24, 0xd7, 0xc6, 0xc5, 0xc4, 0x5d, 0xca, 0x2b, 0x64, 0x16, 0x46,
+	0xb9, 0xdb, 0xf0, 0x4c, 0x96, 0x1e, 0x96, 0x2b, 0x38, 0x12, 0xe2, 0x4a, 0x0d, 0xbb, 0x6a, 0x31,
+	0x2f, 0x3d, 0xa2, 0xc4, 0xe1, 0x50, 0xdf, 0x85, 0x63, 0xe8, 0x16, 0x8b, 0x85, 0xb0, 0x1e, 0xa2,
+	0x0e, 0xe9, 0x7c, 0xb5, 0xd1, 0x17, 0xda, 0x3b, 0x21, 0x6e, 0x53, 0x24, 0x00, 0xe3, 0x26, 0xae,
+	0x89, 0x54, 0xde, 0xa1, 0xbc, 0x86, 0x1b, 0x55, 0x7e, 0xeb, 0x26, 0x90, 0x50, 0x73, 0xf3, 0x80,
+	0xb9, 0x0f, 0x10, 0xaa, 0x0e, 0x02, 0xd0, 0xbb, 0x6e, 0xe5, 0xf9, 0x54, 0xa0, 0x97, 0xeb, 0xeb,
+	0xf0, 0x76, 0x2c, 0xea, 0xe1, 0xee, 0xee, 0x7b, 0xc7, 0xe8, 0x4b, 0x90, 0x89, 0x89, 0xc2, 0xd3,
+	0x05, 0x05, 0x25, 0x1f, 0x2f, 0x57, 0xe0, 0x78, 0x68, 0xa3, 0x08, 0x50, 0x48, 0x1e, 0x8b, 0xa2,
+	0x16, 0x8f, 0xa2, 0xfe, 0x0b, 0x0d, 0x8e, 0xdc, 0x61, 0xa6, 0xb7, 0x57, 0xf7, 0x99, 0xb5, 0xe2,
+	0xf0, 0x1d, 0xe6, 0x09, 0x0f, 0x8a, 0xda, 0x82, 0xb4, 0xf2, 0x5b, 0xe8, 0xb4, 0x9d, 0x7a, 0xc3,
+	0xc7, 0x14, 0x51, 0x03, 0x92, 0x83, 0x09, 0xb7, 0xe1, 0xd7, 0x1b, 0xbe, 0x21, 0x4f, 0x0f, 0x95,
+	0x22, 0xa0, 0xa6, 0xee, 0x50, 0x9f, 0x92, 0x45, 0x38, 0x1e, 0x21, 0x30, 0x28, 0x37, 0xb8, 0xef,
+	0xd9, 0x4e, 0x19, 0x73, 0x86, 0x34, 0x49, 0x57, 0xf8, 0xa6, 0x5c, 0xc1, 0x83, 0xfb, 0xbf, 0x1a,
+	0x1c, 0x3d, 0x80, 0x8b, 0x93, 0x15, 0x18, 0xa3, 0xea, 0x13, 0xa3, 0xf5, 0x6e, 0xbb, 0x68, 0x1d,
+	0x60, 0x2d, 0x06, 0x7c, 0xe4, 0x5e, 0x88, 0xb8, 0xea, 0x96, 0x79, 0x7a, 0x50, 0x8a, 0x39, 0x93,
+	0x57, 0x95, 0x2b, 0x2f, 0x2a, 0x57, 0x5e, 0x56, 0xb4, 0x40, 0x90, 0x02, 0xb5, 0xb6, 0xcd, 0x1c,
+	0x1f, 0x23, 0x8e, 0xe6, 0xdd, 0x73, 0xcb, 0x9c, 0x9c, 0x86, 0x49, 0x94, 0xc6, 0x3c, 0xcf, 0xf5,
+	0xd0, 0x01, 0xa8, 0x61, 0x4d, 0x4c, 0x91, 0x77, 0xe1, 0x48, 0xbd, 0x4a, 0x6d, 0xc7, 0x67, 0xbb,
+	0x01, 0x95, 0xb2, 0x7d, 0x3a, 0x9c, 0x96, 0x84, 0x68, 0xf7, 0x03, 0x98, 0x8f, 0x45, 0xfe, 0xae,
+	0xcd, 0x7d, 0xd7, 0xdb, 0xeb, 0xbf, 0x44, 0xa0, 0xbc, 0x6d, 0x78, 0x3b, 0x59, 0x1e, 0x26, 0xc7,
+	0x06, 0x8c, 0x31, 0xc7, 0xf7, 0x6c, 0x16, 0xb8, 0xf4, 0x72, 0xb7, 0x13, 0x48, 0xe6, 0x97, 0x92,
+	0xb2, 0xe6, 0xf8, 0xde, 0x1e, 0xba, 0x25, 0x10, 0x83, 0x7a, 0xef, 0x41, 0x4e, 0xea, 0x5d, 0x69,
+	0xf8, 0x15, 0xd7, 0xb3, 0x9f, 0x31, 0xeb, 0xbe, 0x5d, 0xf6, 0xe4, 0x0d, 0xe0, 0x10, 0xe5, 0xee,
+	0x03, 0x38, 0xd5, 0x5e, 0x1a, 0x5a, 0x72, 0x09, 0x26, 0x1c, 0xb6, 0x63, 0xc4, 0xce, 0xb8, 0xd5,
+	0xa9, 0xd7, 0xaf, 0x72, 0xa9, 0x07, 0x6c, 0x47, 0xee, 0xde, 0x3b, 0xc5, 0x94, 0x83, 0x9f, 0x96,
+	0xfe, 0x00, 0x4e, 0x1f, 0x10, 0xb9, 0x62, 0xd5, 0x6c, 0xe7, 0x51, 0xdd, 0xa2, 0x3e, 0x3b, 0x04,
+	0xc4, 0x15, 0xd0, 0x3b, 0xc9, 0x6b, 0xee, 0x45, 0x01, 0x92, 0x8a, 0xa5, 0x60, 0x2f, 0x3a, 0x6c,
+	0x47, 0x92, 0xea, 0x8b, 0x70, 0x42, 0x8a, 0x58, 0x33, 0x69, 0xb5, 0x5a, 0x64, 0xa6, 0xeb, 0x85,
+	0x75, 0x7d, 0x16, 0x46, 0x2b, 0xcc, 0x2e, 0x57, 0x7c, 0xc9, 0x34, 0x54, 0xc4, 0x91, 0xfe, 0x63,
+	0x0d, 0xd2, 0xad, 0x3c, 0xa8, 0xac, 0x0d, 0x93, 0xd8, 0xb5, 0x1e, 0x75, 0x2c, 0xb7, 0x66, 0x70,
+	0xc6, 0x2c, 0x3c, 0x28, 0x41, 0x4d, 0x6d, 0x32, 0x66, 0x91, 0x2b, 0x30, 0xbb, 0x4d, 0xab, 0xb6,
+	0x25, 0x8a, 0x80, 0xc1, 0x99, 0x6f, 0xb0, 0x6d, 0xdb, 0x62, 0x8e, 0xc9, 0x64, 0x82, 0x4f, 0x16,
+	0x67, 0xc2, 0xd5, 0x4d, 0xe6, 0xaf, 0xe1, 0x9a, 0xfe, 0x3e, 0x5e, 0x17, 0x1e, 0x30, 0x7f, 0xc7,
+	0xf5, 0xb6, 0x36, 0x1a, 0xa5, 0x2d, 0xb6, 0xd7, 0xc5, 0x00, 0x72, 0x1c, 0x46, 0xed, 0x26, 0x8c,
+	0xa9, 0xe2, 0x88, 0x2d, 0x10, 0xe8, 0x1f, 0x41, 0x26, 0x49, 0x16, 0x1a, 0x96, 0x83, 0x09, 0x47,
+	0x84, 0xb9, 0x2e, 0xa7, 0xf1, 0xd2, 0x02, 0x62, 0x4a, 0x11, 0x0a, 0x37, 0xdb, 0x6e, 0xb0, 0xac,
+	0xec, 0x1b, 0xb7, 0x5d, 0xb5, 0xa8, 0x3f, 0x6e, 0x75, 0x59, 0x78, 0x05, 0x3b, 0x0d, 0x93, 0xdc,
+	0xa7, 0x9e, 0x6f, 0xc4, 0xc0, 0x4e, 0xc8, 0xb9, 0xbb, 0x0a, 0xf1, 0x49, 0x00, 0xe6, 0x58, 0x01,
+	0xc1, 0xa0, 0x24, 0x48, 0x31, 0xc7, 0x52, 0xcb, 0x7a, 0x0d, 0xe6, 0x12, 0xa4, 0x37, 0x77, 0x9b,
+	0xa7, 0xa6, 0xba, 0xed, 0xb6, 0x76, 0x41, 0x0d, 0x76, 0x1b, 0x8a, 0xd1, 0x37, 0x02, 0x75, 0x0e,
+	0x1e, 0x78, 0xc2, 0x7d, 0x81, 0x35, 0xe2, 0xe4, 0x67, 0x9e, 0x1f, 0x3f, 0xf9, 0x99, 0xe7, 0x07,
+	0xf5, 0x3b, 0x66, 0x43, 0x90, 0x52, 0x55, 0xc8, 0x24, 0x49, 0x44, 0x0b, 0xce, 0xc0, 0x34, 0x0b,
+	0x16, 0x54, 0xdc, 0x94, 0xf7, 0xa7, 0x58, 0x94, 0x5c, 0x9c, 0x7a, 0x35, 0x6a, 0x56, 0x6c, 0x87,
+	0x19, 0x25, 0xdb, 0xb1, 0xc4, 0x89, 0xaf, 0xc2, 0x30, 0x8d, 0xd3, 0xab, 0x6a, 0x56, 0xdf, 0x80,
+	0xd4, 0xa6, 0xef, 0x7a, 0xb4, 0xcc, 0x1e, 0xd6, 0x65, 0xd8, 0xb8, 0x61, 0xb1, 0x2a, 0xf3, 0x55,
+	0xf5, 0x19, 0x2f, 0x8e, 0xdb, 0xfc, 0x8e, 0x1c, 0x93, 0xa3, 0x30, 0xd4, 0x8c, 0xa6, 0xf8, 0x14,
+	0x35, 0x69, 0x9b, 0x56, 0x1b, 0x41, 0x56, 0xaa, 0x81, 0xfe, 0x14, 0xa6, 0x6e, 0x7b, 0x2e, 0xe7,
+	0xf7, 0x5d, 0xab, 0x51, 0x45, 0xa9, 0xdc, 0x77, 0x3d, 0x66, 0x04, 0xb9, 0x92, 0x2a, 0x8e, 0xcb,
+	0x89, 0xef, 0xb0, 0xbd, 0x5e, 0xa5, 0xc6, 0xa1, 0x0d, 0xc7, 0xa1, 0xe9, 0x7f, 0x1a, 0x04, 0xb2,
+	0xb6, 0xcb, 0xcc, 0x86, 0x38, 0x90, 0x3e, 0xf4, 0xa8, 0xc9, 0x64, 0xf1, 0x93, 0x35, 0xd3, 0x62,
+	0xbb, 0x98, 0x45, 0x6a, 0x40, 0x6e, 0xc0, 0x90, 0x5b, 0x0f, 0x2a, 0xcf, 0xe9, 0x76, 0xf1, 0x0f,
+	0x9d, 0x82, 0x01, 0x17, 0x3c, 0x22, 0x64, 0x1e, 0xe3, 0x8d, 0xaa, 0x8f, 0xd8, 0x70, 0x44, 0xe6,
+	0x60, 0xbc, 0x4c, 0xb9, 0xd1, 0xe0, 0xcc, 0x92, 0xd8, 0x86, 0x8b, 0x63, 0x65, 0xca, 0x1f, 0x71,
+	0x66, 0x89, 0x84, 0x16, 0x49, 0x54, 0xa2, 0xe6, 0x96, 0x51, 0xa6, 0x3c, 0x3d, 0x26, 0x97, 0x27,
+	0x82, 0xb9, 0x6f, 0x53, 0x2e, 0x4c, 0xab, 0x50, 0x8e, 0xb5, 0x69, 0x44, 0x99, 0x56, 0xa1, 0x5c,
+	0x95, 0xaf, 0x79, 0x48, 0xc9, 0x05, 0xa3, 0xc6, 0xcb, 0xe9, 0x51, 0xe5, 0x3c, 0x39, 0x71, 0x9f,
+	0x97, 0xc9, 0x5d, 0x48, 0x99, 0xc2, 0xd5, 0x86, 0x30, 0x68, 0x1c, 0x4b, 0x69, 0xbb, 0xf2, 0x11,
+	0x8d, 0x09, 0x1a, 0x35, 0x2e, 0xb9, 0x1f, 0xd6, 0x79, 0x78, 0xf4, 0xad, 0x56, 0x5d, 0x73, 0x4b,
+	0x7a, 0x90, 0x77, 0x3b, 0xfa, 0x2c, 0x48, 0xb7, 0xb2, 0x60, 0x96, 0xde, 0x85, 0x51, 0x5f, 0xce,
+	0xe0, 0x36, 0x3b, 0xdf, 0x0e, 0x55, 0x6b, 0xd4, 0x82, 0x67, 0xa4, 0xe2, 0xd7, 0x37, 0x71, 0x37,
+	0xdc, 0x57, 0x69, 0xbb, 0x7e, 0x67, 0xc3, 0x73, 0xdd, 0x27, 0xdd, 0x4e, 0xb5, 0x93, 0x00, 0x41,
+	0xfa, 0xdb, 0x16, 0x5e, 0x99, 0x52, 0x38, 0xb3, 0x6e, 0xe9, 0xcb, 0x30, 0x9f, 0x28, 0xb4, 0x79,
+	0xbf, 0xab, 0x8b, 0x09, 0xdc, 0x5a, 0x6a, 0xa0, 0x5f, 0x45, 0x17, 0xad, 0x38, 0xb4, 0xba, 0xf7,
+	0x8c, 0xa9, 0x4b, 0x74, 0x73, 0x9f, 0xc7, 0x6e, 0x78, 0x93, 0x91, 0x1b, 0xde, 0x2e, 0xa4, 0x5b,
+	0xf9, 0x50, 0x53, 0x01, 0x66, 0x44, 0xe8, 0xed, 0x92, 0x69, 0x30, 0x51, 0xcb, 0x8d, 0xba, 0x6b,
+	0x3b, 0x3e, 0xc7, 0xbd, 0x77, 0xac, 0x42, 0xf9, 0x7a, 0xc9, 0x94, 0x55, 0x7e, 0x43, 0x2e, 0x90,
+	0x0b, 0x70, 0xcc, 0x63, 0x4f, 0x1b, 0xb6, 0xc7, 0x2c, 0xe3, 0x09, 0xa3, 0x7e, 0xc3, 0x63, 0x1c,
+	0xed, 0x3b, 0x1a, 0x2c, 0x7c, 0x0b, 0xe7, 0xf5, 0x1f, 0x8a, 0x67, 0x88, 0xc7, 0x54, 0xfd, 0x6b,
+	0x54, 0xd5, 0x8d, 0x70, 0x1e, 0x52, 0xe2, 0x4a, 0x1e, 0xc3, 0x2a, 0x26, 0xe4, 0x99, 0x14, 0x33,
+	0x64, 0x30, 0x6e, 0x48, 0x3c, 0x4f, 0x87, 0x3a, 0xe5, 0xe9, 0x70, 0x3c, 0x4f, 0xf5, 0xeb, 0x90,
+	0x6d, 0xa6, 0x4a, 0x14, 0x51, 0xd7, 0x24, 0xdb, 0x82, 0x5c, 0x5b, 0xce, 0x30, 0xd7, 0xc6, 0xd4,
+	0x36, 0xec, 0xfe, 0x84, 0x38, 0xe0, 0x8b, 0xe6, 0x59, 0x2e, 0xd9, 0xf5, 0x1a, 0x5e, 0x49, 0x82,
+	0x9b, 0x16, 0x9e, 0x01, 0x9b, 0x3e, 0x8d, 0xa9, 0x1b, 0xe1, 0x62, 0x02, 0xdf, 0x4a, 0x17, 0xbb,
+	0x5d, 0xd7, 0xa2, 0x42, 0x50, 0xa1, 0x12, 0xa0, 0xdf, 0x82, 0x33, 0x52, 0xdd, 0x3d, 0xea, 0x95,
+	0x19, 0xf7, 0x23, 0x8f, 0x55, 0xe4, 0x89, 0xb6, 0x33, 0xec, 0x9a, 0xad, 0x7c, 0x33, 0x55, 0x54,
+	0x03, 0xfd, 0x27, 0x1a, 0xcc, 0x1c, 0x50, 0xf2, 0x88, 0xd3, 0x72, 0x5f, 0x9d, 0x81, 0xd0, 0x98,
+	0xc1, 0x2f, 0x6b, 0xcc, 0x33, 0x38, 0xdb, 0xcd, 0x98, 0xb0, 0x06, 0xa7, 0x02, 0x18, 0x41, 0xc4,
+	0x7a, 0xd5, 0x2b, 0xed, 0x6b, 0x3e, 0xfc, 0x50, 0xc8, 0xd2, 0xcf, 0x72, 0x30, 0x22, 0x95, 0x93,
+	0xdf, 0x6a, 0x30, 0x19, 0x7d, 0xa9, 0x93, 0xaf, 0x76, 0xac, 0xef, 0xed, 0x3a, 0x41, 0x99, 0xc5,
+	0x8e, 0x6c, 0x49, 0xfd, 0x18, 0xfd, 0xf2, 0x0f, 0xbe, 0xf8, 0xd7, 0xcf, 0x07, 0xcf, 0x93, 0x85,
+	0x96, 0x1e, 0xa0, 0x78, 0xde, 0x16, 0x9e, 0x1f, 0x0c, 0xc9, 0x3e, 0xf9, 0x8d, 0x06, 0xc7, 0x5a,
+	0x3a, 0x14, 0xe4, 0x62, 0x57, 0xc4, 0x91, 0x7e, 0x53, 0xe6, 0x6a, 0x4f, 0x40, 0x5b, 0xfa, 0x1f,
+	0xfa, 0x45, 0x89, 0xf6, 0x2c, 0x79, 0xa7, 0x05, 0x6d, 0xe8, 0xd6, 0xc2, 0x73, 0xbc, 0xca, 0xef,
+	0x93, 0x3f, 0x68, 0xf0, 0x56, 0x42, 0xf7, 0x8a, 0x2c, 0x75, 0xd4, 0x9e, 0xd8, 0xf3, 0xcb, 0x2c,
+	0xf7, 0xc5, 0x83, 0x70, 0x17, 0x25, 0xdc, 0x0b, 0xe4, 0x5c, 0x72, 0x5b, 0x37, 0xc9, 0xbb, 0x3f,
+	0xd2, 0x60, 0x58, 0x18, 0xdd, 0xa7, 0x43, 0xcf, 0x75, 0x71, 0x68, 0xf3, 0xf0, 0xd6, 0xdf, 0x95,
+	0xa0, 0x4e, 0x93, 0x5c, 0x82, 0x0f, 0x2d, 0x16, 0x71, 0xdf, 0x16, 0x8c, 0x08, 0x46, 0x4e, 0x66,
+	0xf3, 0xaa, 0xc9, 0x9b, 0x0f, 0x3a, 0xc0, 0xf9, 0x35, 0xd1, 0x01, 0xce, 0x9c, 0xef, 0xaa, 0x34,
+	0x3c, 0x7f, 0xf4, 0xac, 0xd4, 0x9a, 0x26, 0xb3, 0x89, 0x5a, 0x39, 0xf9, 0x9b, 0x06, 0x73, 0x41,
+	0x0b, 0xa2, 0x25, 0xbf, 0x0f, 0xbb, 0x1f, 0x2e, 0x75, 0x05, 0x18, 0xed, 0x78, 0xe8, 0xeb, 0x12,
+	0xe3, 0x6d, 0xb2, 0x92, 0x88, 0x51, 0x56, 0x97, 0x42, 0x69, 0xcf, 0x38, 0x18, 0xb4, 0xa4, 0x30,
+	0x7e, 0x86, 0xad, 0xb4, 0xc0, 0x9c, 0x43, 0xec, 0x91, 0x3e, 0xc1, 0x5f, 0x93, 0xe0, 0x17, 0x49,
+	0xa1, 0x1b, 0x78, 0x19, 0xdd, 0x48, 0x98, 0x7f, 0xaf, 0xc1, 0xb4, 0x6c, 0x14, 0xad, 0xee, 0x7d,
+	0x49, 0x77, 0x2f, 0xf5, 0xb4, 0xab, 0x63, 0x4d, 0xa9, 0x0e, 0x5b, 0x44, 0xb6, 0xa7, 0x92, 0x7c,
+	0xfb, 0x6b, 0x0d, 0xa6, 0x83, 0x3e, 0xa6, 0x6a, 0xa0, 0x93, 0x0b, 0x5d, 0x00, 0x47, 0xdb, 0xec,
+	0x99, 0x2b, 0x3d, 0xc1, 0x3c, 0xd0, 0x86, 0xeb, 0x00, 0xb4, 0x35, 0x1f, 0x24, 0xf4, 0x7d, 0xf2,
+	0xb9, 0x06, 0x47, 0x0e, 0x34, 0x50, 0xc8, 0x72, 0x4f, 0xca, 0xe3, 0xed, 0x9b, 0xcc, 0x95, 0xfe,
+	0x98, 0x10, 0xf1, 0x7b, 0x12, 0xf1, 0x55, 0x72, 0xa5, 0x3d, 0xe2, 0x8a, 0x62, 0x49, 0xf2, 0xf2,
+	0x2e, 0x8c, 0xaa, 0x1f, 0x48, 0xc8, 0x99, 0xce, 0x3f, 0xa0, 0x04, 0x20, 0xcf, 0x76, 0x23, 0x43,
+	0x58, 0x39, 0x09, 0x6b, 0x8e, 0x9c, 0x68, 0xf3, 0xab, 0x13, 0xf9, 0xab, 0x06, 0x6f, 0x25, 0x74,
+	0x6c, 0xc8, 0xb5, 0x8e, 0x5e, 0x68, 0xdf, 0x31, 0xca, 0x5c, 0xef, 0x9f, 0x11, 0xb1, 0x7e, 0x53,
+	0x62, 0xbd, 0x49, 0xae, 0xb7, 0x60, 0xa5, 0x21, 0x97, 0x51, 0x0b, 0xd8, 0x92, 0xdc, 0xf8, 0x85,
+	0x06, 0xc7, 0x13, 0x7b, 0x3b, 0xe4, 0x46, 0x8f, 0xa8, 0x5a, 0xfb, 0x4b, 0x99, 0x9b, 0x87, 0x61,
+	0x45, 0x93, 0x6e, 0x4b, 0x93, 0x6e, 0x91, 0xaf, 0x75, 0x32, 0x49, 0x36, 0x9a, 0x8c, 0x86, 0xe4,
+	0x4c, 0xb2, 0xea, 0x13, 0x0d, 0x26, 0x22, 0x5d, 0x06, 0x52, 0xe8, 0xbd, 0x1f, 0xa1, 0x2c, 0xe8,
+	0xbb, 0x81, 0xd1, 0xa1, 0x6c, 0x31, 0x41, 0x5d, 0x78, 0xae, 0xae, 0xde, 0xfb, 0xe4, 0x63, 0x0d,
+	0x26, 0x23, 0x02, 0x38, 0xe9, 0x59, 0x57, 0x8f, 0xf7, 0xa8, 0xa4, 0x16, 0x4d, 0x87, 0xac, 0x96,
+	0xf0, 0xb8, 0xb8, 0x8c, 0x4c, 0xc5, 0xda, 0x52, 0xa4, 0xb3, 0x96, 0xa4, 0x76, 0x58, 0x66, 0xa9,
+	0x1f, 0x16, 0x44, 0x76, 0x43, 0x22, 0x5b, 0x26, 0x8b, 0x2d, 0xc8, 0x1c, 0x45, 0x8f, 0x0d, 0xaf,
+	0xd0, 0x83, 0x85, 0xe7, 0xaa, 0xb5, 0xb6, 0x4f, 0x7e, 0xa7, 0xc1, 0x54, 0xac, 0x9f, 0xd3, 0x05,
+	0x73, 0x52, 0x37, 0x29, 0xb3, 0xd4, 0x0f, 0x0b, 0x62, 0x5e, 0x96, 0x98, 0x2f, 0x91, 0x0b, 0xad,
+	0xde, 0x8c, 0x75, 0x91, 0x0a, 0xcf, 0xc3, 0x46, 0xd5, 0x3e, 0xf9, 0x54, 0x83, 0x89, 0xc8, 0xab,
+	0xbe, 0x4b, 0x52, 0xb6, 0xb6, 0x0c, 0x32, 0x97, 0x7b, 0x67, 0x40, 0x9c, 0x79, 0x89, 0x73, 0x81,
+	0x9c, 0x6d, 0xc1, 0x59, 0x12, 0xd4, 0x86, 0xea, 0x06, 0x34, 0x73, 0xf3, 0x73, 0x0d, 0xa6, 0xe3,
+	0xaf, 0xf7, 0x2e, 0x97, 0xd1, 0xc4, 0xfe, 0x41, 0x66, 0xb9, 0x2f, 0x1e, 0xc4, 0xfa, 0x0d, 0x89,
+	0xf5, 0x06, 0xb9, 0xd6, 0x82, 0xb5, 0xd9, 0x73, 0x30, 0x64, 0xcf, 0x20, 0x92, 0x09, 0xcd, 0xa5,
+	0x7d, 0xf2, 0x4b, 0x0d, 0x26, 0x22, 0xdd, 0x80, 0x2e, 0xfe, 0x6d, 0xed, 0x37, 0x64, 0x2e, 0xf7,
+	0xce, 0x80, 0x98, 0xcf, 0x48, 0xcc, 0x39, 0x72, 0xb2, 0xf5, 0xb0, 0x52, 0xd4, 0xf2, 0x3e, 0x43,
+	0xfe, 0xa8, 0x01, 0x69, 0x7d, 0x6a, 0x93, 0xab, 0xdd, 0xe3, 0x99, 0xf4, 0xaa, 0xcf, 0x5c, 0xeb,
+	0x9b, 0x0f, 0xe1, 0x5e, 0x95, 0x70, 0x2f, 0x93, 0x7c, 0x9b, 0x74, 0x90, 0x3f, 0x90, 0x32, 0x03,
+	0x1f, 0xee, 0xcd, 0xb4, 0xf8, 0x4b, 0xeb, 0x9b, 0x58, 0xbe, 0x55, 0x0f, 0x7b, 0x11, 0xbb, 0xd1,
+	0xd3, 0x7d, 0x21, 0xa9, 0x4f, 0xd0, 0xe1, 0xb4, 0xe0, 0x8a, 0xdc, 0x90, 0x0f, 0xe7, 0xa4, 0xa2,
+	0xf0, 0x67, 0x0d, 0xe6, 0xda, 0xbe, 0xa3, 0xc9, 0xad, 0x8e, 0x98, 0xba, 0x35, 0x13, 0x32, 0x5f,
+	0x3f, 0x2c, 0x3b, 0xda, 0x75, 0x56, 0xda, 0x75, 0x8a, 0x64, 0x3b, 0xdb, 0xb5, 0xfa, 0xf8, 0xc5,
+	0x3f, 0xb3, 0x03, 0x9f, 0xbd, 0xce, 0x6a, 0x2f, 0x5e, 0x67, 0xb5, 0x97, 0xaf, 0xb3, 0xda, 0x3f,
+	0x5e, 0x67, 0xb5, 0x9f, 0xbe, 0xc9, 0x0e, 0xbc, 0x7c, 0x93, 0x1d, 0xf8, 0xfb, 0x9b, 0xec, 0xc0,
+	0x47, 0x37, 0xcb, 0xb6, 0x5f, 0x69, 0x94, 0x04, 0x90, 0x02, 0x37, 0x3d, 0xbf, 0x4a, 0x4b, 0xbc,
+	0xa0, 0x1e, 0x80, 0x78, 0x00, 0x17, 0x76, 0x43, 0x25, 0xb6, 0xe3, 0x33, 0xcf, 0xa1, 0x55, 0xf5,
+	0xaf, 0x9b, 0xd2, 0xa8, 0x7c, 0x41, 0x2d, 0xff, 0x6f, 0x00, 0x7d, 0x6b, 0x80, 0x35, 0xee, 0x23,
+	0x00, 0x00,
 }
 
 func (this *ParamsRequest) Equal(that interface{}) bool {
@@ -3075,6 +3242,110 @@ func (this *QueryBlockCreateResultsResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *QueryContractStorageStatsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryContractStorageStatsResponse)
+	if !ok {
+		that2, ok := that.(QueryContractStorageStatsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Stats.Equal(&that1.Stats) {
+		return false
+	}
+	return true
+}
+func (this *QueryLargestContractsByStorageRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryLargestContractsByStorageRequest)
+	if !ok {
+		that2, ok := that.(QueryLargestContractsByStorageRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Limit != that1.Limit {
+		return false
+	}
+	return true
+}
+func (this *ContractStorageUsage) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageUsage)
+	if !ok {
+		that2, ok := that.(ContractStorageUsage)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.ContractAddress != that1.ContractAddress {
+		return false
+	}
+	if !this.Stats.Equal(&that1.Stats) {
+		return false
+	}
+	return true
+}
+func (this *QueryLargestContractsByStorageResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryLargestContractsByStorageResponse)
+	if !ok {
+		that2, ok := that.(QueryLargestContractsByStorageResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Contracts) != len(that1.Contracts) {
+		return false
+	}
+	for i := range this.Contracts {
+		if !this.Contracts[i].Equal(&that1.Contracts[i]) {
+			return false
+		}
+	}
+	return true
+}
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ context.Context
@@ -3132,6 +3403,10 @@ type QueryClient interface {
 	AnalyzeCode(ctx context.Context, in *QueryAnalyzeCodeRequest, opts ...grpc.CallOption) (*QueryAnalyzeCodeResponse, error)
 	// Query all Create (MsgStoreCode) results for a block (for non-SGX node sync)
 	BlockCreateResults(ctx context.Context, in *QueryBlockCreateResultsRequest, opts ...grpc.CallOption) (*QueryBlockCreateResultsResponse, error)
+	// Query the key count and total bytes held by a contract
+	ContractStorageStats(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractStorageStatsResponse, error)
+	// Query the contracts holding the most state, largest first
+	LargestContractsByStorage(ctx context.Context, in *QueryLargestContractsByStorageRequest, opts ...grpc.CallOption) (*QueryLargestContractsByStorageResponse, error)
 }
 
 type queryClient struct {
@@ -3331,6 +3606,24 @@ func (c *queryClient) BlockCreateResults(ctx context.Context, in *QueryBlockCrea
 	return out, nil
 }
 
+func (c *queryClient) ContractStorageStats(ctx context.Context, in *QueryByContractAddressRequest, opts ...grpc.CallOption) (*QueryContractStorageStatsResponse, error) {
+	out := new(QueryContractStorageStatsResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/ContractStorageStats", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) LargestContractsByStorage(ctx context.Context, in *QueryLargestContractsByStorageRequest, opts ...grpc.CallOption) (*QueryLargestContractsByStorageResponse, error) {
+	out := new(QueryLargestContractsByStorageResponse)
+	err := c.cc.Invoke(ctx, "/secret.compute.v1beta1.Query/LargestContractsByStorage", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Query contract info by address
@@ -3377,6 +3670,10 @@ type QueryServer interface {
 	AnalyzeCode(context.Context, *QueryAnalyzeCodeRequest) (*QueryAnalyzeCodeResponse, error)
 	// Query all Create (MsgStoreCode) results for a block (for non-SGX node sync)
 	BlockCreateResults(context.Context, *QueryBlockCreateResultsRequest) (*QueryBlockCreateResultsResponse, error)
+	// Query the key count and total bytes held by a contract
+	ContractStorageStats(context.Context, *QueryByContractAddressRequest) (*QueryContractStorageStatsResponse, error)
+	// Query the contracts holding the most state, largest first
+	LargestContractsByStorage(context.Context, *QueryLargestContractsByStorageRequest) (*QueryLargestContractsByStorageResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -3446,6 +3743,12 @@ func (*UnimplementedQueryServer) AnalyzeCode(ctx context.Context, req *QueryAnal
 func (*UnimplementedQueryServer) BlockCreateResults(ctx context.Context, req *QueryBlockCreateResultsRequest) (*QueryBlockCreateResultsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method BlockCreateResults not implemented")
 }
+func (*UnimplementedQueryServer) ContractStorageStats(ctx context.Context, req *QueryByContractAddressRequest) (*QueryContractStorageStatsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ContractStorageStats not implemented")
+}
+func (*UnimplementedQueryServer) LargestContractsByStorage(ctx context.Context, req *QueryLargestContractsByStorageRequest) (*QueryLargestContractsByStorageResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method LargestContractsByStorage not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -3829,6 +4132,42 @@ func _Query_BlockCreateResults_Handler(srv interface{}, ctx context.Context, dec
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ContractStorageStats_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryByContractAddressRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ContractStorageStats(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/ContractStorageStats",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ContractStorageStats(ctx, req.(*QueryByContractAddressRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_LargestContractsByStorage_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryLargestContractsByStorageRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).LargestContractsByStorage(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.compute.v1beta1.Query/LargestContractsByStorage",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).LargestContractsByStorage(ctx, req.(*QueryLargestContractsByStorageRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.compute.v1beta1.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -3917,6 +4256,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "BlockCreateResults",
 			Handler:    _Query_BlockCreateResults_Handler,
 		},
+		{
+			MethodName: "ContractStorageStats",
+			Handler:    _Query_ContractStorageStats_Handler,
+		},
+		{
+			MethodName: "LargestContractsByStorage",
+			Handler:    _Query_LargestContractsByStorage_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/compute/v1beta1/query.proto",
@@ -5572,41 +5919,179 @@ func (m *QueryBlockCreateResultsResponse) MarshalToSizedBuffer(dAtA []byte) (int
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryContractStorageStatsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryContractStorageStatsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QuerySecretContractRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryContractStorageStatsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLargestContractsByStorageRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLargestContractsByStorageRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLargestContractsByStorageRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Limit))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ContractStorageUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Stats.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.ContractAddress) > 0 {
+		i -= len(m.ContractAddress)
+		copy(dAtA[i:], m.ContractAddress)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ContractAddress)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryLargestContractsByStorageResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryLargestContractsByStorageResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryLargestContractsByStorageResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for iNdEx := len(m.Contracts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Contracts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QuerySecretContractRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
 	var l int
 	_ = l
 	l = len(m.ContractAddress)
@@ -6300,6 +6785,59 @@ func (m *QueryBlockCreateResultsResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryContractStorageStatsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLargestContractsByStorageRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Limit != 0 {
+		n += 1 + sovQuery(uint64(m.Limit))
+	}
+	return n
+}
+
+func (m *ContractStorageUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ContractAddress)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = m.Stats.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryLargestContractsByStorageResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Contracts) > 0 {
+		for _, e := range m.Contracts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -10899,6 +11437,357 @@ func (m *QueryBlockCreateResultsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryContractStorageStatsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryContractStorageStatsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryContractStorageStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLargestContractsByStorageRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLargestContractsByStorageRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLargestContractsByStorageRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Limit", wireType)
+			}
+			m.Limit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Limit |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ContractStorageUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorageUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorageUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ContractAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryLargestContractsByStorageResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryLargestContractsByStorageResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryLargestContractsByStorageResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contracts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contracts = append(m.Contracts, ContractStorageUsage{})
+			if err := m.Contracts[len(m.Contracts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
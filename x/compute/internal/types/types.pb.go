@@ -421,6 +421,48 @@ func (m *ContractCodeHistoryEntry) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_ContractCodeHistoryEntry proto.InternalMessageInfo
 
+// ContractStorageStats tracks how much state a contract holds
+type ContractStorageStats struct {
+	// KeyCount is the number of keys in the contract's store
+	KeyCount uint64 `protobuf:"varint,1,opt,name=key_count,json=keyCount,proto3" json:"key_count,omitempty"`
+	// TotalBytes is the sum of the lengths of all keys and values in the
+	// contract's store
+	TotalBytes uint64 `protobuf:"varint,2,opt,name=total_bytes,json=totalBytes,proto3" json:"total_bytes,omitempty"`
+}
+
+func (m *ContractStorageStats) Reset()         { *m = ContractStorageStats{} }
+func (m *ContractStorageStats) String() string { return proto.CompactTextString(m) }
+func (*ContractStorageStats) ProtoMessage()    {}
+func (*ContractStorageStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_8ba7f40a6d1951b3, []int{8}
+}
+func (m *ContractStorageStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ContractStorageStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ContractStorageStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ContractStorageStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ContractStorageStats.Merge(m, src)
+}
+func (m *ContractStorageStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *ContractStorageStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_ContractStorageStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ContractStorageStats proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterEnum("secret.compute.v1beta1.AccessType", AccessType_name, AccessType_value)
 	proto.RegisterEnum("secret.compute.v1beta1.ContractCodeHistoryOperationType", ContractCodeHistoryOperationType_name, ContractCodeHistoryOperationType_value)
@@ -432,6 +474,7 @@ func init() {
 	proto.RegisterType((*AbsoluteTxPosition)(nil), "secret.compute.v1beta1.AbsoluteTxPosition")
 	proto.RegisterType((*Model)(nil), "secret.compute.v1beta1.Model")
 	proto.RegisterType((*ContractCodeHistoryEntry)(nil), "secret.compute.v1beta1.ContractCodeHistoryEntry")
+	proto.RegisterType((*ContractStorageStats)(nil), "secret.compute.v1beta1.ContractStorageStats")
 }
 
 func init() {
@@ -439,75 +482,77 @@ func init() {
 }
 
 var fileDescriptor_8ba7f40a6d1951b3 = []byte{
-	// 1077 bytes of a gzipped FileDescriptorProto
+	// 1120 bytes of a gzipped FileDescriptorProto
 	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0xcd, 0x6f, 0x1b, 0x45,
-	0x14, 0xf7, 0xc6, 0x8e, 0x3f, 0xc6, 0x6e, 0x6b, 0x86, 0xd0, 0xba, 0x46, 0xb2, 0xcd, 0x16, 0x95,
-	0xd0, 0x12, 0xbb, 0x29, 0x1c, 0x50, 0x38, 0xf9, 0x63, 0x93, 0x2c, 0x21, 0xb6, 0x35, 0x76, 0x82,
-	0x82, 0x40, 0xab, 0xfd, 0x98, 0xd8, 0xab, 0xac, 0x77, 0xcc, 0xcc, 0x38, 0x78, 0x6f, 0x1c, 0x91,
-	0x4f, 0x1c, 0xb9, 0x58, 0x42, 0xa2, 0xaa, 0xfa, 0x0f, 0xf0, 0x3f, 0xf4, 0xd8, 0x23, 0x27, 0x0b,
-	0x9c, 0x3f, 0x00, 0xc1, 0xb1, 0x27, 0xb4, 0xe3, 0xf5, 0x47, 0x69, 0xa3, 0x04, 0x89, 0x93, 0xdf,
-	0xe7, 0xef, 0xbd, 0x37, 0xef, 0xb7, 0x4f, 0x06, 0x32, 0xc3, 0x26, 0xc5, 0xbc, 0x64, 0x92, 0x5e,
-	0x7f, 0xc0, 0x71, 0xe9, 0x7c, 0xdb, 0xc0, 0x5c, 0xdf, 0x2e, 0x71, 0xaf, 0x8f, 0x59, 0xb1, 0x4f,
-	0x09, 0x27, 0xf0, 0xf6, 0x2c, 0xa6, 0x18, 0xc4, 0x14, 0x83, 0x98, 0xec, 0x46, 0x87, 0x74, 0x88,
-	0x08, 0x29, 0xf9, 0xd2, 0x2c, 0x5a, 0x36, 0xc1, 0xad, 0xb2, 0x69, 0x62, 0xc6, 0xda, 0x5e, 0x1f,
-	0x37, 0x75, 0xaa, 0xf7, 0xe0, 0xe7, 0x60, 0xfd, 0x5c, 0x77, 0x06, 0x38, 0x23, 0x15, 0xa4, 0xcd,
-	0x9b, 0x8f, 0xe5, 0xe2, 0x9b, 0x01, 0x8b, 0xcb, 0xbc, 0x4a, 0xfa, 0xef, 0x49, 0x3e, 0xe5, 0xe9,
-	0x3d, 0x67, 0x47, 0x16, 0xa9, 0x32, 0x9a, 0x41, 0xec, 0x44, 0x7e, 0xfa, 0x39, 0x2f, 0xc9, 0x4f,
-	0x25, 0x10, 0xaf, 0x12, 0x0b, 0xab, 0xee, 0x29, 0x81, 0xef, 0x82, 0x84, 0x49, 0x2c, 0xac, 0x75,
-	0x75, 0xd6, 0x15, 0x25, 0x52, 0x28, 0xee, 0x1b, 0xf6, 0x75, 0xd6, 0x85, 0x07, 0x20, 0x66, 0x52,
-	0xac, 0x73, 0x42, 0x33, 0x6b, 0xbe, 0xab, 0xb2, 0xfd, 0x72, 0x92, 0xdf, 0xea, 0xd8, 0xbc, 0x3b,
-	0x30, 0xfc, 0x06, 0x4a, 0x26, 0x61, 0x3d, 0xc2, 0x82, 0x9f, 0x2d, 0x66, 0x9d, 0x05, 0xb3, 0x97,
-	0x4d, 0xb3, 0x6c, 0x59, 0x14, 0x33, 0x86, 0xe6, 0x08, 0xf0, 0x36, 0x88, 0x32, 0x32, 0xa0, 0x26,
-	0xce, 0x84, 0x0b, 0xd2, 0x66, 0x02, 0x05, 0x1a, 0xcc, 0x80, 0x98, 0x31, 0xb0, 0x1d, 0x0b, 0xd3,
-	0x4c, 0x44, 0x38, 0xe6, 0xaa, 0xfc, 0x44, 0x02, 0xc9, 0x2a, 0x71, 0x39, 0xd5, 0x4d, 0x7e, 0x80,
-	0x3d, 0x78, 0x1f, 0xdc, 0x22, 0x1d, 0xcd, 0x0c, 0x2c, 0xda, 0x19, 0xf6, 0x82, 0x8e, 0x6f, 0x90,
-	0xce, 0x6a, 0xdc, 0x23, 0xb0, 0x61, 0x0e, 0x28, 0xc5, 0x2e, 0x7f, 0x35, 0x58, 0xcc, 0x80, 0x60,
-	0xe0, 0x5b, 0xcd, 0xf8, 0x0c, 0x64, 0xdf, 0x94, 0xa1, 0xf5, 0x29, 0x21, 0xa7, 0xa2, 0xdf, 0x14,
-	0xba, 0xf3, 0x7a, 0x5e, 0xd3, 0x77, 0xcb, 0xdf, 0x4b, 0x00, 0xce, 0x8d, 0xd5, 0x01, 0xe3, 0xa4,
-	0x27, 0x5e, 0xb6, 0x0d, 0x92, 0xd8, 0x35, 0x1d, 0xfd, 0x1c, 0x2f, 0x3a, 0x4d, 0x3e, 0xbe, 0x77,
-	0xd9, 0xfa, 0x56, 0x50, 0x2b, 0x37, 0xa7, 0x93, 0x3c, 0x50, 0x66, 0xb9, 0x07, 0xd8, 0x43, 0x00,
-	0x2f, 0x64, 0xb8, 0x01, 0xd6, 0x1d, 0xdd, 0xc0, 0x8e, 0x18, 0x26, 0x81, 0x66, 0x8a, 0xfc, 0xd7,
-	0x1a, 0x48, 0xcd, 0x11, 0x44, 0xf1, 0x7b, 0x20, 0x26, 0xd6, 0x6a, 0x5b, 0xa2, 0x70, 0xa4, 0x02,
-	0xa6, 0x93, 0x7c, 0x54, 0x6c, 0xbd, 0x86, 0xa2, 0xbe, 0x4b, 0xb5, 0xfe, 0xdf, 0xf5, 0x2e, 0x1a,
-	0x8b, 0xac, 0x34, 0x06, 0x6b, 0x41, 0x09, 0x6c, 0x65, 0xd6, 0xc5, 0x03, 0x3c, 0xb8, 0x94, 0xbf,
-	0x06, 0x23, 0xce, 0x80, 0xe3, 0xf6, 0xb0, 0x49, 0x98, 0xcd, 0x6d, 0xe2, 0xa2, 0x79, 0x2a, 0xdc,
-	0x02, 0x49, 0xdb, 0x30, 0xb5, 0x3e, 0xa1, 0xdc, 0x9f, 0x28, 0xea, 0x57, 0xa8, 0xdc, 0x98, 0x4e,
-	0xf2, 0x09, 0xb5, 0x52, 0x6d, 0x12, 0xca, 0xd5, 0x1a, 0x4a, 0xd8, 0x86, 0x29, 0x44, 0xcb, 0x6f,
-	0x45, 0xb7, 0x7a, 0xb6, 0x9b, 0x89, 0xcd, 0x5a, 0x11, 0x0a, 0xcc, 0x83, 0xa4, 0x10, 0x82, 0xa5,
-	0xc6, 0xc5, 0x52, 0x81, 0x30, 0x89, 0x3d, 0xc2, 0x2d, 0x00, 0x29, 0xfe, 0x76, 0x60, 0x53, 0xac,
-	0x75, 0xc8, 0x39, 0xa6, 0xae, 0xee, 0x9a, 0x38, 0x93, 0x28, 0x48, 0x9b, 0x71, 0xf4, 0x56, 0xe0,
-	0xd9, 0x5b, 0x38, 0x64, 0x04, 0xe0, 0xeb, 0x3d, 0xc3, 0xf7, 0x40, 0xca, 0x70, 0x88, 0x79, 0xa6,
-	0x75, 0xb1, 0xdd, 0xe9, 0x72, 0xf1, 0xfa, 0x61, 0x94, 0x14, 0xb6, 0x7d, 0x61, 0x82, 0x77, 0x41,
-	0x9c, 0x0f, 0x35, 0xdb, 0xb5, 0xf0, 0x50, 0xbc, 0x7b, 0x04, 0xc5, 0xf8, 0x50, 0xf5, 0x55, 0x19,
-	0x83, 0xf5, 0x43, 0x62, 0x61, 0x07, 0xee, 0x82, 0xf0, 0xc1, 0x9c, 0xde, 0x95, 0x4f, 0x5e, 0x4e,
-	0xf2, 0x8f, 0x5e, 0x59, 0x4b, 0x0f, 0x73, 0xe3, 0x94, 0x2f, 0x05, 0xc7, 0x36, 0x58, 0xc9, 0xf0,
-	0x38, 0x66, 0xc5, 0x7d, 0x3c, 0xac, 0xf8, 0x02, 0x0a, 0x07, 0x74, 0x39, 0x16, 0xd7, 0x63, 0xc6,
-	0xfd, 0x99, 0x22, 0xff, 0x29, 0x81, 0xcc, 0x82, 0xb1, 0xfe, 0xc7, 0x6e, 0x33, 0x4e, 0xa8, 0xa7,
-	0xb8, 0x9c, 0x7a, 0xf0, 0x18, 0x24, 0x48, 0x1f, 0x53, 0xdd, 0x1f, 0x27, 0x38, 0x3a, 0x9f, 0x5e,
-	0xc5, 0xda, 0x15, 0x90, 0xc6, 0x3c, 0xd7, 0x3f, 0x45, 0x68, 0x09, 0xb5, 0x4a, 0xc9, 0xb5, 0x4b,
-	0x29, 0x59, 0x03, 0xb1, 0x41, 0xdf, 0x12, 0x7c, 0x09, 0xff, 0x77, 0xbe, 0x04, 0xa9, 0x30, 0x0d,
-	0xc2, 0x3d, 0xd6, 0x11, 0x4c, 0x4c, 0x21, 0x5f, 0x7c, 0xf0, 0xab, 0x04, 0xc0, 0xf2, 0x42, 0xc2,
-	0xfb, 0x20, 0x71, 0x54, 0xaf, 0x29, 0xbb, 0x6a, 0x5d, 0xa9, 0xa5, 0x43, 0xd9, 0x3b, 0xa3, 0x71,
-	0xe1, 0xed, 0xa5, 0xfb, 0xc8, 0xb5, 0xf0, 0xa9, 0xed, 0x62, 0x0b, 0x16, 0x40, 0xb4, 0xde, 0xa8,
-	0x34, 0x6a, 0x27, 0x69, 0x29, 0xbb, 0x31, 0x1a, 0x17, 0xd2, 0xcb, 0xa0, 0x3a, 0x31, 0x88, 0xe5,
-	0xc1, 0x87, 0x20, 0xd5, 0xa8, 0x7f, 0x71, 0xa2, 0x95, 0x6b, 0x35, 0xa4, 0xb4, 0x5a, 0xe9, 0xb5,
-	0xec, 0xdd, 0xd1, 0xb8, 0xf0, 0xce, 0x32, 0xae, 0xe1, 0x3a, 0x5e, 0xf0, 0xb1, 0xf8, 0x65, 0x95,
-	0x63, 0x05, 0x9d, 0x08, 0xc4, 0xf0, 0xbf, 0xcb, 0x2a, 0xe7, 0x98, 0x7a, 0x3e, 0x68, 0x36, 0xfe,
-	0xc3, 0x2f, 0xb9, 0xd0, 0xb3, 0x27, 0xb9, 0xd0, 0x83, 0xa7, 0x61, 0x50, 0xb8, 0xea, 0x91, 0x21,
-	0x06, 0x8f, 0xaa, 0x8d, 0x7a, 0x1b, 0x95, 0xab, 0x6d, 0xad, 0xda, 0xa8, 0x29, 0xda, 0xbe, 0xda,
-	0x6a, 0x37, 0xd0, 0x89, 0xd6, 0x68, 0x2a, 0xa8, 0xdc, 0x56, 0x1b, 0x75, 0xad, 0x7d, 0xd2, 0x54,
-	0xb4, 0xa3, 0x7a, 0xab, 0xa9, 0x54, 0xd5, 0x5d, 0x55, 0x0c, 0x5d, 0x1a, 0x8d, 0x0b, 0x0f, 0xaf,
-	0xc2, 0x3e, 0x72, 0x59, 0x1f, 0x9b, 0xf6, 0xa9, 0x8d, 0x2d, 0xf8, 0x25, 0xf8, 0xf0, 0x5a, 0x65,
-	0xd4, 0xba, 0xda, 0x4e, 0x4b, 0xd9, 0xcd, 0xd1, 0xb8, 0xf0, 0xfe, 0x55, 0xf8, 0xaa, 0x6b, 0x73,
-	0xf8, 0x0d, 0xf8, 0xe8, 0x5a, 0xc0, 0x87, 0xea, 0x1e, 0x2a, 0xb7, 0x95, 0xf4, 0x5a, 0xf6, 0xe1,
-	0x68, 0x5c, 0xf8, 0xe0, 0x2a, 0xec, 0x43, 0xbb, 0x43, 0x75, 0x8e, 0xaf, 0x0d, 0xbf, 0xa7, 0xd4,
-	0x95, 0x96, 0xda, 0x4a, 0x87, 0xaf, 0x07, 0xbf, 0x87, 0x5d, 0xcc, 0x6c, 0x96, 0x8d, 0xf8, 0xcb,
-	0xaa, 0x7c, 0xfd, 0xfc, 0x8f, 0x5c, 0xe8, 0xd9, 0x34, 0x27, 0x3d, 0x9f, 0xe6, 0xa4, 0x17, 0xd3,
-	0x9c, 0xf4, 0xfb, 0x34, 0x27, 0xfd, 0x78, 0x91, 0x0b, 0xbd, 0xb8, 0xc8, 0x85, 0x7e, 0xbb, 0xc8,
-	0x85, 0xbe, 0xda, 0x59, 0xf9, 0x82, 0x99, 0x49, 0xb9, 0xa3, 0x1b, 0xac, 0xd4, 0x12, 0xe4, 0xae,
-	0x63, 0xfe, 0x1d, 0xa1, 0x67, 0xa5, 0xe1, 0xe2, 0xaf, 0x84, 0xed, 0x72, 0xff, 0xce, 0x38, 0xb3,
-	0x83, 0x6b, 0x44, 0xc5, 0xdf, 0x83, 0x8f, 0xff, 0x09, 0x00, 0x00, 0xff, 0xff, 0xce, 0xf4, 0xee,
-	0x1e, 0x72, 0x08, 0x00, 0x00,
+	0x14, 0xf7, 0xc6, 0x4e, 0x62, 0x4f, 0xdc, 0xd6, 0x0c, 0xa1, 0x75, 0x8d, 0x64, 0x9b, 0x2d, 0x2a,
+	0xa1, 0x25, 0x71, 0x5b, 0x38, 0xa0, 0x72, 0xf2, 0xc7, 0x36, 0x59, 0x42, 0x6d, 0x6b, 0xec, 0x14,
+	0x05, 0x81, 0x56, 0xfb, 0xf1, 0xe2, 0xac, 0xb2, 0xde, 0x31, 0x33, 0xe3, 0xe0, 0xbd, 0x71, 0x44,
+	0x3e, 0x71, 0xe4, 0x62, 0x09, 0x89, 0xaa, 0xea, 0x3f, 0xc0, 0xff, 0xd0, 0x63, 0x8f, 0x9c, 0x2c,
+	0x70, 0xff, 0x00, 0x04, 0xc7, 0x9e, 0xd0, 0x8e, 0xd7, 0x1f, 0xa5, 0x8d, 0x12, 0x24, 0x4e, 0xfb,
+	0x3e, 0x7f, 0xef, 0xcd, 0xbc, 0xdf, 0x3c, 0x2d, 0x52, 0x39, 0xd8, 0x0c, 0x44, 0xc9, 0xa6, 0xdd,
+	0x5e, 0x5f, 0x40, 0xe9, 0xf4, 0xae, 0x05, 0xc2, 0xbc, 0x5b, 0x12, 0x41, 0x0f, 0xf8, 0x4e, 0x8f,
+	0x51, 0x41, 0xf1, 0xd5, 0x69, 0xcc, 0x4e, 0x14, 0xb3, 0x13, 0xc5, 0xe4, 0x36, 0x3b, 0xb4, 0x43,
+	0x65, 0x48, 0x29, 0x94, 0xa6, 0xd1, 0xaa, 0x8d, 0xae, 0x94, 0x6d, 0x1b, 0x38, 0x6f, 0x07, 0x3d,
+	0x68, 0x9a, 0xcc, 0xec, 0xe2, 0xcf, 0xd1, 0xea, 0xa9, 0xe9, 0xf5, 0x21, 0xab, 0x14, 0x95, 0xad,
+	0xcb, 0xf7, 0xd4, 0x9d, 0x37, 0x03, 0xee, 0x2c, 0xf2, 0x2a, 0x99, 0xbf, 0xc7, 0x85, 0x74, 0x60,
+	0x76, 0xbd, 0xfb, 0xaa, 0x4c, 0x55, 0xc9, 0x14, 0xe2, 0x7e, 0xe2, 0xa7, 0x9f, 0x0b, 0x8a, 0xfa,
+	0x44, 0x41, 0xc9, 0x2a, 0x75, 0x40, 0xf7, 0x8f, 0x28, 0x7e, 0x17, 0xa5, 0x6c, 0xea, 0x80, 0x71,
+	0x6c, 0xf2, 0x63, 0x59, 0x22, 0x4d, 0x92, 0xa1, 0x61, 0xcf, 0xe4, 0xc7, 0x78, 0x1f, 0xad, 0xdb,
+	0x0c, 0x4c, 0x41, 0x59, 0x76, 0x25, 0x74, 0x55, 0xee, 0xbe, 0x1c, 0x17, 0xb6, 0x3b, 0xae, 0x38,
+	0xee, 0x5b, 0x61, 0x03, 0x25, 0x9b, 0xf2, 0x2e, 0xe5, 0xd1, 0x67, 0x9b, 0x3b, 0x27, 0xd1, 0xd9,
+	0xcb, 0xb6, 0x5d, 0x76, 0x1c, 0x06, 0x9c, 0x93, 0x19, 0x02, 0xbe, 0x8a, 0xd6, 0x38, 0xed, 0x33,
+	0x1b, 0xb2, 0xf1, 0xa2, 0xb2, 0x95, 0x22, 0x91, 0x86, 0xb3, 0x68, 0xdd, 0xea, 0xbb, 0x9e, 0x03,
+	0x2c, 0x9b, 0x90, 0x8e, 0x99, 0xaa, 0x3e, 0x56, 0xd0, 0x46, 0x95, 0xfa, 0x82, 0x99, 0xb6, 0xd8,
+	0x87, 0x00, 0xdf, 0x44, 0x57, 0x68, 0xc7, 0xb0, 0x23, 0x8b, 0x71, 0x02, 0x41, 0xd4, 0xf1, 0x25,
+	0xda, 0x59, 0x8e, 0xbb, 0x83, 0x36, 0xed, 0x3e, 0x63, 0xe0, 0x8b, 0x57, 0x83, 0xe5, 0x19, 0x08,
+	0x8e, 0x7c, 0xcb, 0x19, 0x9f, 0xa1, 0xdc, 0x9b, 0x32, 0x8c, 0x1e, 0xa3, 0xf4, 0x48, 0xf6, 0x9b,
+	0x26, 0xd7, 0x5e, 0xcf, 0x6b, 0x86, 0x6e, 0xf5, 0x7b, 0x05, 0xe1, 0x99, 0xb1, 0xda, 0xe7, 0x82,
+	0x76, 0xe5, 0xcd, 0xb6, 0xd1, 0x06, 0xf8, 0xb6, 0x67, 0x9e, 0xc2, 0xbc, 0xd3, 0x8d, 0x7b, 0x37,
+	0xce, 0x1a, 0xdf, 0x12, 0x6a, 0xe5, 0xf2, 0x64, 0x5c, 0x40, 0xda, 0x34, 0x77, 0x1f, 0x02, 0x82,
+	0x60, 0x2e, 0xe3, 0x4d, 0xb4, 0xea, 0x99, 0x16, 0x78, 0xf2, 0x30, 0x29, 0x32, 0x55, 0xd4, 0xbf,
+	0x56, 0x50, 0x7a, 0x86, 0x20, 0x8b, 0xdf, 0x40, 0xeb, 0x72, 0xac, 0xae, 0x23, 0x0b, 0x27, 0x2a,
+	0x68, 0x32, 0x2e, 0xac, 0xc9, 0xa9, 0xd7, 0xc8, 0x5a, 0xe8, 0xd2, 0x9d, 0xff, 0x77, 0xbc, 0xf3,
+	0xc6, 0x12, 0x4b, 0x8d, 0xe1, 0x5a, 0x54, 0x02, 0x9c, 0xec, 0xaa, 0xbc, 0x80, 0x5b, 0x67, 0xf2,
+	0xd7, 0xe2, 0xd4, 0xeb, 0x0b, 0x68, 0x0f, 0x9a, 0x94, 0xbb, 0xc2, 0xa5, 0x3e, 0x99, 0xa5, 0xe2,
+	0x6d, 0xb4, 0xe1, 0x5a, 0xb6, 0xd1, 0xa3, 0x4c, 0x84, 0x27, 0x5a, 0x0b, 0x2b, 0x54, 0x2e, 0x4d,
+	0xc6, 0x85, 0x94, 0x5e, 0xa9, 0x36, 0x29, 0x13, 0x7a, 0x8d, 0xa4, 0x5c, 0xcb, 0x96, 0xa2, 0x13,
+	0xb6, 0x62, 0x3a, 0x5d, 0xd7, 0xcf, 0xae, 0x4f, 0x5b, 0x91, 0x0a, 0x2e, 0xa0, 0x0d, 0x29, 0x44,
+	0x43, 0x4d, 0xca, 0xa1, 0x22, 0x69, 0x92, 0x73, 0xc4, 0xdb, 0x08, 0x33, 0xf8, 0xb6, 0xef, 0x32,
+	0x30, 0x3a, 0xf4, 0x14, 0x98, 0x6f, 0xfa, 0x36, 0x64, 0x53, 0x45, 0x65, 0x2b, 0x49, 0xde, 0x8a,
+	0x3c, 0xbb, 0x73, 0x87, 0x4a, 0x10, 0x7e, 0xbd, 0x67, 0xfc, 0x1e, 0x4a, 0x5b, 0x1e, 0xb5, 0x4f,
+	0x8c, 0x63, 0x70, 0x3b, 0xc7, 0x42, 0xde, 0x7e, 0x9c, 0x6c, 0x48, 0xdb, 0x9e, 0x34, 0xe1, 0xeb,
+	0x28, 0x29, 0x06, 0x86, 0xeb, 0x3b, 0x30, 0x90, 0xf7, 0x9e, 0x20, 0xeb, 0x62, 0xa0, 0x87, 0xaa,
+	0x0a, 0x68, 0xf5, 0x21, 0x75, 0xc0, 0xc3, 0x0f, 0x50, 0x7c, 0x7f, 0x46, 0xef, 0xca, 0x27, 0x2f,
+	0xc7, 0x85, 0x3b, 0xaf, 0x8c, 0xa5, 0x0b, 0xc2, 0x3a, 0x12, 0x0b, 0xc1, 0x73, 0x2d, 0x5e, 0xb2,
+	0x02, 0x01, 0x7c, 0x67, 0x0f, 0x06, 0x95, 0x50, 0x20, 0xf1, 0x88, 0x2e, 0x8f, 0xe4, 0xf6, 0x98,
+	0x72, 0x7f, 0xaa, 0xa8, 0x7f, 0x2a, 0x28, 0x3b, 0x67, 0x6c, 0xf8, 0xd8, 0x5d, 0x2e, 0x28, 0x0b,
+	0x34, 0x5f, 0xb0, 0x00, 0x3f, 0x42, 0x29, 0xda, 0x03, 0x66, 0x86, 0xc7, 0x89, 0x96, 0xce, 0xa7,
+	0xe7, 0xb1, 0x76, 0x09, 0xa4, 0x31, 0xcb, 0x0d, 0x57, 0x11, 0x59, 0x40, 0x2d, 0x53, 0x72, 0xe5,
+	0x4c, 0x4a, 0xd6, 0xd0, 0x7a, 0xbf, 0xe7, 0x48, 0xbe, 0xc4, 0xff, 0x3b, 0x5f, 0xa2, 0x54, 0x9c,
+	0x41, 0xf1, 0x2e, 0xef, 0x48, 0x26, 0xa6, 0x49, 0x28, 0xaa, 0x6d, 0xb4, 0x39, 0xeb, 0xb5, 0x25,
+	0x28, 0x33, 0x3b, 0xd0, 0x12, 0xa6, 0xe0, 0xe1, 0xfa, 0x0b, 0xdf, 0xb9, 0x4d, 0xfb, 0xfe, 0x74,
+	0x56, 0x09, 0x92, 0x3c, 0x81, 0xa0, 0x1a, 0xea, 0x21, 0x63, 0x04, 0x15, 0xa6, 0x67, 0xc8, 0x9b,
+	0x8d, 0x66, 0x85, 0xa4, 0x49, 0x5e, 0xf1, 0xad, 0x5f, 0x15, 0x84, 0x16, 0x7b, 0x17, 0xdf, 0x44,
+	0xa9, 0x83, 0x7a, 0x4d, 0x7b, 0xa0, 0xd7, 0xb5, 0x5a, 0x26, 0x96, 0xbb, 0x36, 0x1c, 0x15, 0xdf,
+	0x5e, 0xb8, 0x0f, 0x7c, 0x07, 0x8e, 0x5c, 0x1f, 0x1c, 0x5c, 0x44, 0x6b, 0xf5, 0x46, 0xa5, 0x51,
+	0x3b, 0xcc, 0x28, 0xb9, 0xcd, 0xe1, 0xa8, 0x98, 0x59, 0x04, 0xd5, 0xa9, 0x45, 0x9d, 0x00, 0xdf,
+	0x46, 0xe9, 0x46, 0xfd, 0x8b, 0x43, 0xa3, 0x5c, 0xab, 0x11, 0xad, 0xd5, 0xca, 0xac, 0xe4, 0xae,
+	0x0f, 0x47, 0xc5, 0x77, 0x16, 0x71, 0x0d, 0xdf, 0x0b, 0xa2, 0x27, 0x18, 0x96, 0xd5, 0x1e, 0x69,
+	0xe4, 0x50, 0x22, 0xc6, 0xff, 0x5d, 0x56, 0x3b, 0x05, 0x16, 0x84, 0xa0, 0xb9, 0xe4, 0x0f, 0xbf,
+	0xe4, 0x63, 0x4f, 0x1f, 0xe7, 0x63, 0xb7, 0x9e, 0xc4, 0x51, 0xf1, 0xbc, 0xd1, 0x61, 0x40, 0x77,
+	0xaa, 0x8d, 0x7a, 0x9b, 0x94, 0xab, 0x6d, 0xa3, 0xda, 0xa8, 0x69, 0xc6, 0x9e, 0xde, 0x6a, 0x37,
+	0xc8, 0xa1, 0xd1, 0x68, 0x6a, 0xa4, 0xdc, 0xd6, 0x1b, 0x75, 0xa3, 0x7d, 0xd8, 0xd4, 0x8c, 0x83,
+	0x7a, 0xab, 0xa9, 0x55, 0xf5, 0x07, 0xba, 0x3c, 0x74, 0x69, 0x38, 0x2a, 0xde, 0x3e, 0x0f, 0xfb,
+	0xc0, 0xe7, 0x3d, 0xb0, 0xdd, 0x23, 0x17, 0x1c, 0xfc, 0x25, 0xfa, 0xf0, 0x42, 0x65, 0xf4, 0xba,
+	0xde, 0xce, 0x28, 0xb9, 0xad, 0xe1, 0xa8, 0xf8, 0xfe, 0x79, 0xf8, 0xba, 0xef, 0x0a, 0xfc, 0x0d,
+	0xfa, 0xe8, 0x42, 0xc0, 0x0f, 0xf5, 0x5d, 0x52, 0x6e, 0x6b, 0x99, 0x95, 0xdc, 0xed, 0xe1, 0xa8,
+	0xf8, 0xc1, 0x79, 0xd8, 0x0f, 0xdd, 0x0e, 0x33, 0x05, 0x5c, 0x18, 0x7e, 0x57, 0xab, 0x6b, 0x2d,
+	0xbd, 0x95, 0x89, 0x5f, 0x0c, 0x7e, 0x17, 0x7c, 0xe0, 0x2e, 0xcf, 0x25, 0xc2, 0x61, 0x55, 0xbe,
+	0x7e, 0xf6, 0x47, 0x3e, 0xf6, 0x74, 0x92, 0x57, 0x9e, 0x4d, 0xf2, 0xca, 0xf3, 0x49, 0x5e, 0xf9,
+	0x7d, 0x92, 0x57, 0x7e, 0x7c, 0x91, 0x8f, 0x3d, 0x7f, 0x91, 0x8f, 0xfd, 0xf6, 0x22, 0x1f, 0xfb,
+	0xea, 0xfe, 0xd2, 0x5e, 0xe0, 0x36, 0x13, 0x9e, 0x69, 0xf1, 0x52, 0x4b, 0x3e, 0x99, 0x3a, 0x88,
+	0xef, 0x28, 0x3b, 0x29, 0x0d, 0xe6, 0x3f, 0x28, 0xae, 0x2f, 0xc2, 0xed, 0xe5, 0x4d, 0xd7, 0xb8,
+	0xb5, 0x26, 0x7f, 0x3a, 0x3e, 0xfe, 0x67, 0x00, 0xc2, 0xae, 0x89, 0x95, 0xc8, 0x08, 0x00, 0x00,
 }
 
 func (this *AccessTypeParam) Equal(that interface{}) bool {
@@ -756,6 +801,33 @@ func (this *ContractCodeHistoryEntry) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *ContractStorageStats) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*ContractStorageStats)
+	if !ok {
+		that2, ok := that.(ContractStorageStats)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.KeyCount != that1.KeyCount {
+		return false
+	}
+	if this.TotalBytes != that1.TotalBytes {
+		return false
+	}
+	return true
+}
 func (m *AccessTypeParam) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -1128,6 +1200,39 @@ func (m *ContractCodeHistoryEntry) MarshalToSizedBuffer(dAtA []byte) (int, error
 	return len(dAtA) - i, nil
 }
 
+func (m *ContractStorageStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ContractStorageStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ContractStorageStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.TotalBytes != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.TotalBytes))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.KeyCount != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.KeyCount))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintTypes(dAtA []byte, offset int, v uint64) int {
 	offset -= sovTypes(v)
 	base := offset
@@ -1308,6 +1413,21 @@ func (m *ContractCodeHistoryEntry) Size() (n int) {
 	return n
 }
 
+func (m *ContractStorageStats) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.KeyCount != 0 {
+		n += 1 + sovTypes(uint64(m.KeyCount))
+	}
+	if m.TotalBytes != 0 {
+		n += 1 + sovTypes(uint64(m.TotalBytes))
+	}
+	return n
+}
+
 func sovTypes(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2488,6 +2608,94 @@ func (m *ContractCodeHistoryEntry) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *ContractStorageStats) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTypes
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ContractStorageStats: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ContractStorageStats: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field KeyCount", wireType)
+			}
+			m.KeyCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.KeyCount |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalBytes", wireType)
+			}
+			m.TotalBytes = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.TotalBytes |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTypes(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTypes
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTypes(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -10,8 +10,12 @@ import (
 const (
 	MaxWasmSize = 2 * 1024 * 1024 // 2MB
 
-	// MaxLabelSize is the longest label that can be used when Instantiating a contract
-	MaxLabelSize = 512
+	// MaxLabelSize is the longest label that can ever be used when Instantiating a contract.
+	// The actual limit is the MaxLabelSize param, which governance can set up to this value
+	MaxLabelSize = 4096
+
+	// DefaultMaxLabelSize is the default value of the MaxLabelSize param
+	DefaultMaxLabelSize = uint32(512)
 
 	// BuildTagRegexp is a docker image regexp.
 	// We only support max 128 characters, with at least one organization name (subset of all legal names).
@@ -74,7 +78,7 @@ func validateLabel(label string) error {
 		return errors.Wrap(ErrEmpty, "is required")
 	}
 	if len(label) > MaxLabelSize {
-		return errors.Wrap(ErrLimit, "cannot be longer than 128 characters")
+		return errors.Wrapf(ErrLimit, "cannot be longer than %d characters", MaxLabelSize)
 	}
 	return nil
 }
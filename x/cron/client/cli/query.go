@@ -24,6 +24,8 @@ func GetQueryCmd(_ string) *cobra.Command {
 	cmd.AddCommand(CmdQueryParams())
 	cmd.AddCommand(CmdListSchedule())
 	cmd.AddCommand(CmdShowSchedule())
+	cmd.AddCommand(CmdListScheduleExecutions())
+	cmd.AddCommand(CmdShowBacklog())
 
 	return cmd
 }
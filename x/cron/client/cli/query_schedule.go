@@ -2,6 +2,7 @@ package cli
 
 import (
 	"context"
+	"fmt"
 
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
@@ -10,10 +11,35 @@ import (
 	"github.com/scrtlabs/SecretNetwork/x/cron/types"
 )
 
+const (
+	flagTrigger = "trigger"
+	flagOwner   = "owner"
+	flagStatus  = "status"
+)
+
+var scheduleTriggers = map[string]types.ScheduleTrigger{
+	"":                types.ScheduleTrigger_SCHEDULE_TRIGGER_UNSPECIFIED,
+	"block-period":    types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD,
+	"interval":        types.ScheduleTrigger_SCHEDULE_TRIGGER_INTERVAL,
+	"cron-expression": types.ScheduleTrigger_SCHEDULE_TRIGGER_CRON_EXPRESSION,
+}
+
+var pauseStatuses = map[string]types.PauseStatus{
+	"":       types.PauseStatus_PAUSE_STATUS_UNSPECIFIED,
+	"active": types.PauseStatus_PAUSE_STATUS_ACTIVE,
+	"paused": types.PauseStatus_PAUSE_STATUS_PAUSED,
+}
+
 func CmdListSchedule() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "list-schedule",
 		Short: "list all schedule",
+		Long: `list all schedule, optionally only the ones with the given trigger, owner or status.
+
+Examples:
+  secretcli query cron list-schedule --trigger cron-expression
+  secretcli query cron list-schedule --owner secret1...
+  secretcli query cron list-schedule --status paused`,
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx := client.GetClientContextFromCmd(cmd)
 
@@ -22,10 +48,36 @@ func CmdListSchedule() *cobra.Command {
 				return err
 			}
 
+			triggerArg, err := cmd.Flags().GetString(flagTrigger)
+			if err != nil {
+				return err
+			}
+			trigger, ok := scheduleTriggers[triggerArg]
+			if !ok {
+				return fmt.Errorf("invalid trigger '%s', expected one of: block-period, interval, cron-expression", triggerArg)
+			}
+
+			owner, err := cmd.Flags().GetString(flagOwner)
+			if err != nil {
+				return err
+			}
+
+			statusArg, err := cmd.Flags().GetString(flagStatus)
+			if err != nil {
+				return err
+			}
+			status, ok := pauseStatuses[statusArg]
+			if !ok {
+				return fmt.Errorf("invalid status '%s', expected one of: active, paused", statusArg)
+			}
+
 			queryClient := types.NewQueryClient(clientCtx)
 
 			params := &types.QuerySchedulesRequest{
-				Pagination: pageReq,
+				Pagination:  pageReq,
+				Trigger:     trigger,
+				Owner:       owner,
+				PauseStatus: status,
 			}
 
 			res, err := queryClient.Schedules(context.Background(), params)
@@ -37,6 +89,9 @@ func CmdListSchedule() *cobra.Command {
 		},
 	}
 
+	cmd.Flags().String(flagTrigger, "", "Only list schedules with this trigger: block-period, interval or cron-expression")
+	cmd.Flags().String(flagOwner, "", "Only list schedules owned by this address")
+	cmd.Flags().String(flagStatus, "", "Only list active or paused schedules")
 	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
 	flags.AddQueryFlagsToCmd(cmd)
 
@@ -72,3 +127,66 @@ func CmdShowSchedule() *cobra.Command {
 
 	return cmd
 }
+
+func CmdListScheduleExecutions() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "list-schedule-executions [name]",
+		Short: "list the recorded executions of a schedule",
+		Long: fmt.Sprintf(`list the recorded executions of a schedule, oldest first. The last %d executions are kept.
+
+Examples:
+  secretcli query cron list-schedule-executions my-schedule --reverse`, types.MaxScheduleExecutions),
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			params := &types.QueryScheduleExecutionsRequest{
+				Name:       args[0],
+				Pagination: pageReq,
+			}
+
+			res, err := queryClient.ScheduleExecutions(context.Background(), params)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddPaginationFlagsToCmd(cmd, cmd.Use)
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdShowBacklog() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "backlog",
+		Short: "shows the number of due schedules held back by the per block limit",
+		Args:  cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) (err error) {
+			clientCtx := client.GetClientContextFromCmd(cmd)
+
+			queryClient := types.NewQueryClient(clientCtx)
+
+			res, err := queryClient.Backlog(context.Background(), &types.QueryBacklogRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -1,15 +1,41 @@
 package cli
 
 import (
+	"encoding/json"
 	"fmt"
+	"os"
+	"time"
 
 	"github.com/spf13/cobra"
 
 	"github.com/cosmos/cosmos-sdk/client"
+	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/client/tx"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/scrtlabs/SecretNetwork/x/cron/types"
 )
 
+const (
+	flagPeriod         = "period"
+	flagInterval       = "interval"
+	flagCronExpression = "cron-expression"
+	flagFailurePolicy  = "failure-policy"
+	flagMaxFailures    = "max-failures"
+	flagPriority       = "priority"
+)
+
+var failurePolicies = map[string]types.FailurePolicy{
+	"skip":    types.FailurePolicy_FAILURE_POLICY_SKIP,
+	"retry":   types.FailurePolicy_FAILURE_POLICY_RETRY,
+	"disable": types.FailurePolicy_FAILURE_POLICY_DISABLE,
+}
+
+var schedulePriorities = map[string]types.SchedulePriority{
+	"normal": types.SchedulePriority_SCHEDULE_PRIORITY_NORMAL,
+	"low":    types.SchedulePriority_SCHEDULE_PRIORITY_LOW,
+}
+
 // GetTxCmd returns the transaction commands for this module
 func GetTxCmd() *cobra.Command {
 	cmd := &cobra.Command{
@@ -20,5 +46,267 @@ func GetTxCmd() *cobra.Command {
 		RunE:                       client.ValidateCmd,
 	}
 
+	cmd.AddCommand(CmdRegisterSchedule())
+	cmd.AddCommand(CmdRemoveSchedule())
+	cmd.AddCommand(CmdUpdateSchedule())
+	cmd.AddCommand(CmdPauseSchedule())
+	cmd.AddCommand(CmdResumeSchedule())
+
+	return cmd
+}
+
+func CmdRegisterSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "register-schedule [name] [msgs-json-file] [deposit]",
+		Short: "register a schedule owned by the sender",
+		Long: `register a schedule owned by the sender, bonding a deposit that pays the execution fees.
+The remaining deposit is refunded when the schedule is removed.
+The msgs file holds a JSON array of {"contract", "msg", "gas_limit", "funds"} objects.
+
+Examples:
+  secretcli tx cron register-schedule sweep msgs.json 10000000uscrt --interval 1h --from mykey
+  secretcli tx cron register-schedule daily msgs.json 10000000uscrt --cron-expression "0 0 * * *" --failure-policy retry --from mykey`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var msgs []types.MsgExecuteContract
+			if err := json.Unmarshal(bz, &msgs); err != nil {
+				return fmt.Errorf("failed to parse msgs: %w", err)
+			}
+
+			deposit, err := sdk.ParseCoinNormalized(args[2])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRegisterSchedule{
+				Owner:   clientCtx.GetFromAddress().String(),
+				Name:    args[0],
+				Msgs:    msgs,
+				Deposit: deposit,
+			}
+
+			if msg.Period, err = cmd.Flags().GetUint64(flagPeriod); err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration(flagInterval)
+			if err != nil {
+				return err
+			}
+			if interval != 0 {
+				msg.Interval = &interval
+			}
+			if msg.CronExpression, err = cmd.Flags().GetString(flagCronExpression); err != nil {
+				return err
+			}
+
+			policyArg, err := cmd.Flags().GetString(flagFailurePolicy)
+			if err != nil {
+				return err
+			}
+			policy, ok := failurePolicies[policyArg]
+			if !ok {
+				return fmt.Errorf("invalid failure policy '%s', expected one of: skip, retry, disable", policyArg)
+			}
+			msg.FailurePolicy = policy
+			if msg.MaxFailures, err = cmd.Flags().GetUint32(flagMaxFailures); err != nil {
+				return err
+			}
+
+			priorityArg, err := cmd.Flags().GetString(flagPriority)
+			if err != nil {
+				return err
+			}
+			priority, ok := schedulePriorities[priorityArg]
+			if !ok {
+				return fmt.Errorf("invalid priority '%s', expected one of: normal, low", priorityArg)
+			}
+			msg.Priority = priority
+
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPeriod, 0, "Period in blocks")
+	cmd.Flags().Duration(flagInterval, time.Duration(0), "Fixed duration of block time between executions")
+	cmd.Flags().String(flagCronExpression, "", "Standard 5 field cron expression, evaluated in UTC against block time")
+	cmd.Flags().String(flagFailurePolicy, "skip", "What happens when an execution fails: skip, retry or disable")
+	cmd.Flags().Uint32(flagMaxFailures, 0, "Consecutive failures after which the schedule is disabled, for the disable failure policy")
+	cmd.Flags().String(flagPriority, "normal", "Priority tier of the schedule when the per block limit is reached: normal or low")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdRemoveSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "remove-schedule [name]",
+		Short: "remove a schedule owned by the sender",
+		Long: `remove a schedule owned by the sender and refund its remaining deposit.
+The security address can remove any schedule.
+
+Examples:
+  secretcli tx cron remove-schedule sweep --from mykey`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgRemoveSchedule{
+				Authority: clientCtx.GetFromAddress().String(),
+				Name:      args[0],
+			}
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdUpdateSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "update-schedule [name] [msgs-json-file]",
+		Short: "update the trigger and msgs of a schedule owned by the sender",
+		Long: `update the trigger and msgs of a schedule owned by the sender, keeping its execution state.
+Exactly one of --period, --interval and --cron-expression must be set.
+The msgs file holds a JSON array of {"contract", "msg", "gas_limit", "funds"} objects.
+
+Examples:
+  secretcli tx cron update-schedule sweep msgs.json --interval 2h --from mykey`,
+		Args: cobra.ExactArgs(2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			bz, err := os.ReadFile(args[1])
+			if err != nil {
+				return err
+			}
+			var msgs []types.MsgExecuteContract
+			if err := json.Unmarshal(bz, &msgs); err != nil {
+				return fmt.Errorf("failed to parse msgs: %w", err)
+			}
+
+			msg := types.MsgUpdateSchedule{
+				Authority: clientCtx.GetFromAddress().String(),
+				Name:      args[0],
+				Msgs:      msgs,
+			}
+
+			if msg.Period, err = cmd.Flags().GetUint64(flagPeriod); err != nil {
+				return err
+			}
+			interval, err := cmd.Flags().GetDuration(flagInterval)
+			if err != nil {
+				return err
+			}
+			if interval != 0 {
+				msg.Interval = &interval
+			}
+			if msg.CronExpression, err = cmd.Flags().GetString(flagCronExpression); err != nil {
+				return err
+			}
+
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	cmd.Flags().Uint64(flagPeriod, 0, "Period in blocks")
+	cmd.Flags().Duration(flagInterval, time.Duration(0), "Fixed duration of block time between executions")
+	cmd.Flags().String(flagCronExpression, "", "Standard 5 field cron expression, evaluated in UTC against block time")
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdPauseSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pause-schedule [name]",
+		Short: "pause a schedule owned by the sender",
+		Long: `pause a schedule owned by the sender, it isn't executed until it's resumed.
+The security address can pause any schedule.
+
+Examples:
+  secretcli tx cron pause-schedule sweep --from mykey`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgPauseSchedule{
+				Authority: clientCtx.GetFromAddress().String(),
+				Name:      args[0],
+			}
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
+
+func CmdResumeSchedule() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "resume-schedule [name]",
+		Short: "resume a paused schedule owned by the sender",
+		Long: `resume a paused schedule owned by the sender. A schedule that was due while paused is executed in the next block.
+Schedules paused by governance or the security address can only be resumed by governance.
+
+Examples:
+  secretcli tx cron resume-schedule sweep --from mykey`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgResumeSchedule{
+				Authority: clientCtx.GetFromAddress().String(),
+				Name:      args[0],
+			}
+			if err := msg.Validate(); err != nil {
+				return err
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+
 	return cmd
 }
@@ -2,6 +2,7 @@ package cron_test
 
 import (
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 
@@ -12,7 +13,7 @@ import (
 )
 
 func TestGenesis(t *testing.T) {
-	k, ctx := keeper.CronKeeper(t, nil)
+	k, ctx := keeper.CronKeeper(t, nil, nil)
 
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
@@ -36,3 +37,46 @@ func TestGenesis(t *testing.T) {
 	require.Equal(t, genesisState.Params, got.Params)
 	require.ElementsMatch(t, genesisState.ScheduleList, got.ScheduleList)
 }
+
+func TestGenesisKeepsExecutionState(t *testing.T) {
+	k, ctx := keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(100).WithBlockTime(time.Date(2030, 1, 1, 0, 0, 0, 0, time.UTC))
+
+	interval := time.Hour
+	nextExecuteTime := time.Date(2030, 1, 1, 0, 20, 0, 0, time.UTC)
+	genesisState := types.GenesisState{
+		Params: types.DefaultParams(),
+		ScheduleList: []types.Schedule{
+			{
+				Name:              "block",
+				Period:            5,
+				LastExecuteHeight: 97,
+			},
+			{
+				Name:            "interval",
+				Interval:        &interval,
+				NextExecuteTime: &nextExecuteTime,
+			},
+			{
+				Name:     "new_interval",
+				Interval: &interval,
+			},
+		},
+	}
+
+	cron.InitGenesis(ctx, *k, genesisState)
+
+	// exported execution state is restored as-is
+	schedule, found := k.GetSchedule(ctx, "block")
+	require.True(t, found)
+	require.Equal(t, uint64(97), schedule.LastExecuteHeight)
+
+	schedule, found = k.GetSchedule(ctx, "interval")
+	require.True(t, found)
+	require.Equal(t, nextExecuteTime, *schedule.NextExecuteTime)
+
+	// time based schedules without a next execution time are due from the genesis block time
+	schedule, found = k.GetSchedule(ctx, "new_interval")
+	require.True(t, found)
+	require.Equal(t, ctx.BlockTime().Add(interval), *schedule.NextExecuteTime)
+}
@@ -0,0 +1,118 @@
+package keeper
+
+import (
+	"bytes"
+
+	errorsmod "cosmossdk.io/errors"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// ScheduledTxDecorator authenticates scheduled txs by their provenance. Scheduled txs are unsigned, so they
+// skip the rest of the ante handler and are handled by `scheduled` instead, as long as they are one of the txs
+// built for this block. Each scheduled tx is accepted once, as its sequence isn't checked. Any other tx from the
+// scheduled tx sender, or from the retired sender whose key was public, is rejected. The senders of owned
+// schedules have no key either, so their untracked txs fail signature verification.
+type ScheduledTxDecorator struct {
+	keeper    *Keeper
+	scheduled sdk.AnteHandler
+}
+
+// NewScheduledTxDecorator constructor
+func NewScheduledTxDecorator(keeper *Keeper, scheduled sdk.AnteHandler) *ScheduledTxDecorator {
+	return &ScheduledTxDecorator{
+		keeper:    keeper,
+		scheduled: scheduled,
+	}
+}
+
+func (d ScheduledTxDecorator) AnteHandle(ctx sdk.Context, tx sdk.Tx, simulate bool, next sdk.AnteHandler) (sdk.Context, error) {
+	sigTx, ok := tx.(authsigning.SigVerifiableTx)
+	if !ok {
+		return next(ctx, tx, simulate)
+	}
+	signers, err := sigTx.GetSigners()
+	if err != nil {
+		return ctx, err
+	}
+
+	for _, signer := range signers {
+		if bytes.Equal(signer, types.LegacyScheduledTxSender()) {
+			return ctx, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "the legacy scheduled tx sender was retired")
+		}
+	}
+
+	// only unsigned txs can be scheduled txs, signed txs don't need to be looked up
+	sigs, err := sigTx.GetSignaturesV2()
+	if err != nil {
+		return ctx, err
+	}
+	if len(sigs) == 0 && len(signers) == 1 {
+		isTracked := d.keeper.ConsumeScheduledTx
+		if simulate {
+			isTracked = d.keeper.IsScheduledTx
+		}
+		if isTracked(ctx, ctx.TxBytes()) {
+			return d.scheduled(ctx, tx, simulate)
+		}
+	}
+
+	for _, signer := range signers {
+		if bytes.Equal(signer, types.ScheduledTxSender()) {
+			return ctx, errorsmod.Wrapf(types.ErrUnscheduledTx, "only scheduled txs can be sent by %s", types.ScheduledTxSender())
+		}
+	}
+	return next(ctx, tx, simulate)
+}
+
+// ScheduledTxProposalHandler rejects proposals carrying unsigned txs that this node didn't build for the block.
+// The enclave takes the unsigned txs of a block signed by the validator set to be its scheduled txs, so
+// validators must not sign blocks with any other unsigned tx.
+type ScheduledTxProposalHandler struct {
+	keeper *Keeper
+	next   sdk.ProcessProposalHandler
+}
+
+// NewScheduledTxProposalHandler constructor
+func NewScheduledTxProposalHandler(keeper *Keeper, next sdk.ProcessProposalHandler) *ScheduledTxProposalHandler {
+	return &ScheduledTxProposalHandler{
+		keeper: keeper,
+		next:   next,
+	}
+}
+
+func (h ScheduledTxProposalHandler) ProcessProposal(ctx sdk.Context, req *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+	included := make(map[string]bool)
+	for _, txBytes := range req.Txs {
+		if !h.isUnsignedTx(txBytes) {
+			continue
+		}
+
+		key := string(types.GetScheduledTxKey(txBytes))
+		if included[key] || !h.keeper.IsScheduledTx(ctx, txBytes) {
+			h.keeper.Logger(ctx).Info("rejecting proposal with an unsigned tx that wasn't scheduled", "height", req.Height)
+			return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_REJECT}, nil
+		}
+		included[key] = true
+	}
+	return h.next(ctx, req)
+}
+
+// isUnsignedTx returns whether txBytes carries no signatures, like the scheduled txs do.
+// Txs that can't be decoded are left to the next handler.
+func (h ScheduledTxProposalHandler) isUnsignedTx(txBytes []byte) bool {
+	var rawTx sdktx.TxRaw
+	if err := h.keeper.cdc.Unmarshal(txBytes, &rawTx); err != nil {
+		return false
+	}
+	var authInfo sdktx.AuthInfo
+	if err := h.keeper.cdc.Unmarshal(rawTx.AuthInfoBytes, &authInfo); err != nil {
+		return false
+	}
+	return len(rawTx.Signatures) == 0 || len(authInfo.SignerInfos) == 0
+}
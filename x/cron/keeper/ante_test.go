@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdktx "github.com/cosmos/cosmos-sdk/types/tx"
+	"github.com/cosmos/cosmos-sdk/types/tx/signing"
+	authsigning "github.com/cosmos/cosmos-sdk/x/auth/signing"
+	"github.com/stretchr/testify/require"
+
+	testutil_keeper "github.com/scrtlabs/SecretNetwork/testutil/cron/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/cron/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// signersTx is a tx that only reports its signers and whether it's signed
+type signersTx struct {
+	authsigning.SigVerifiableTx
+	signers [][]byte
+	signed  bool
+}
+
+func (tx signersTx) GetSigners() ([][]byte, error) {
+	return tx.signers, nil
+}
+
+func (tx signersTx) GetSignaturesV2() ([]signing.SignatureV2, error) {
+	if !tx.signed {
+		return nil, nil
+	}
+	return []signing.SignatureV2{{Sequence: 1}}, nil
+}
+
+func TestScheduledTxDecorator(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+
+	scheduledCalls := 0
+	scheduled := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		scheduledCalls++
+		return ctx, nil
+	}
+	nextCalls := 0
+	next := func(ctx sdk.Context, _ sdk.Tx, _ bool) (sdk.Context, error) {
+		nextCalls++
+		return ctx, nil
+	}
+	decorator := keeper.NewScheduledTxDecorator(k, scheduled)
+
+	txBytes := []byte("scheduled tx")
+	k.TrackScheduledTx(ctx, txBytes)
+	ctx = ctx.WithBlockHeight(2).WithTxBytes(txBytes)
+	tx := signersTx{signers: [][]byte{types.ScheduledTxSender()}}
+
+	// simulations don't use up the scheduled tx
+	_, err := decorator.AnteHandle(ctx, tx, true, next)
+	require.NoError(t, err)
+	require.True(t, k.IsScheduledTx(ctx, txBytes))
+
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.NoError(t, err)
+	require.Equal(t, 2, scheduledCalls)
+
+	// the same scheduled tx can't be executed twice
+	_, err = decorator.AnteHandle(ctx, tx, false, next)
+	require.ErrorIs(t, err, types.ErrUnscheduledTx)
+	require.Equal(t, 2, scheduledCalls)
+
+	// other txs of the scheduled tx sender are rejected
+	k.TrackScheduledTx(ctx, txBytes)
+	_, err = decorator.AnteHandle(ctx.WithTxBytes([]byte("another tx")), tx, false, next)
+	require.ErrorIs(t, err, types.ErrUnscheduledTx)
+	_, err = decorator.AnteHandle(ctx, signersTx{signers: [][]byte{types.ScheduledTxSender(), sdk.AccAddress("other")}}, false, next)
+	require.ErrorIs(t, err, types.ErrUnscheduledTx)
+	_, err = decorator.AnteHandle(ctx, signersTx{signers: [][]byte{types.LegacyScheduledTxSender()}}, false, next)
+	require.Error(t, err)
+	require.Equal(t, 2, scheduledCalls)
+
+	// a signed tx isn't a scheduled tx, even if its bytes were tracked
+	_, err = decorator.AnteHandle(ctx, signersTx{signers: [][]byte{types.ScheduledTxSender()}, signed: true}, false, next)
+	require.ErrorIs(t, err, types.ErrUnscheduledTx)
+	require.Equal(t, 2, scheduledCalls)
+
+	// owned schedules are sent by a sender derived from their owner
+	ownerSender := types.OwnerScheduledTxSender(sdk.AccAddress("owner"))
+	require.NotEqual(t, types.ScheduledTxSender(), ownerSender)
+	_, err = decorator.AnteHandle(ctx, signersTx{signers: [][]byte{ownerSender}}, false, next)
+	require.NoError(t, err)
+	require.Equal(t, 3, scheduledCalls)
+
+	// txs of other senders are left to the rest of the ante handler, which verifies their signatures
+	_, err = decorator.AnteHandle(ctx, signersTx{signers: [][]byte{sdk.AccAddress("other")}, signed: true}, false, next)
+	require.NoError(t, err)
+	_, err = decorator.AnteHandle(ctx, signersTx{signers: [][]byte{ownerSender}}, false, next)
+	require.NoError(t, err)
+	require.Equal(t, 2, nextCalls)
+	require.Equal(t, 3, scheduledCalls)
+}
+
+// rawTx encodes a tx with the given body, signed or not
+func rawTx(t *testing.T, body string, signed bool) []byte {
+	authInfo := sdktx.AuthInfo{}
+	rawTx := sdktx.TxRaw{BodyBytes: []byte(body)}
+	if signed {
+		authInfo.SignerInfos = []*sdktx.SignerInfo{{Sequence: 1}}
+		rawTx.Signatures = [][]byte{[]byte("signature")}
+	}
+	authInfoBytes, err := authInfo.Marshal()
+	require.NoError(t, err)
+	rawTx.AuthInfoBytes = authInfoBytes
+	txBytes, err := rawTx.Marshal()
+	require.NoError(t, err)
+	return txBytes
+}
+
+func TestScheduledTxProposalHandler(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+
+	accepted := 0
+	next := func(sdk.Context, *abci.RequestProcessProposal) (*abci.ResponseProcessProposal, error) {
+		accepted++
+		return &abci.ResponseProcessProposal{Status: abci.ResponseProcessProposal_ACCEPT}, nil
+	}
+	handler := keeper.NewScheduledTxProposalHandler(k, next)
+
+	k.TrackScheduledTx(ctx, rawTx(t, "scheduled tx 1", false))
+	k.TrackScheduledTx(ctx, rawTx(t, "scheduled tx 2", false))
+	ctx = ctx.WithBlockHeight(2)
+
+	for _, tc := range []struct {
+		name     string
+		txs      []string
+		accepted bool
+	}{
+		{"no scheduled txs", []string{"tx"}, true},
+		{"scheduled txs", []string{"scheduled tx 2", "tx", "scheduled tx 1"}, true},
+		{"unscheduled tx", []string{"scheduled tx 1", "scheduled tx 3"}, false},
+		{"scheduled tx twice", []string{"scheduled tx 1", "scheduled tx 1"}, false},
+		{"undecodable tx", []string{"scheduled tx 1", "garbage"}, true},
+	} {
+		var txs [][]byte
+		for _, tx := range tc.txs {
+			switch {
+			case tx == "garbage":
+				txs = append(txs, []byte{0xff})
+			case strings.HasPrefix(tx, "scheduled"):
+				txs = append(txs, rawTx(t, tx, false))
+			default:
+				txs = append(txs, rawTx(t, tx, true))
+			}
+		}
+		before := accepted
+		res, err := handler.ProcessProposal(ctx, &abci.RequestProcessProposal{Txs: txs, Height: 2})
+		require.NoError(t, err, tc.name)
+		if tc.accepted {
+			require.Equal(t, abci.ResponseProcessProposal_ACCEPT, res.Status, tc.name)
+			require.Equal(t, before+1, accepted, tc.name)
+		} else {
+			require.Equal(t, abci.ResponseProcessProposal_REJECT, res.Status, tc.name)
+			require.Equal(t, before, accepted, tc.name)
+		}
+	}
+}
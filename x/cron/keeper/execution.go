@@ -0,0 +1,265 @@
+package keeper
+
+import (
+	"crypto/sha256"
+	"fmt"
+	"sort"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// defaultExecutionError is recorded when a scheduled tx failed without leaving an error behind,
+// e.g. because it wasn't included in the block
+const defaultExecutionError = "scheduled execution failed"
+
+// TrackScheduledExecution records that the scheduled tx txBytes executes a msg of `scheduleName`.
+// It's called by x/compute when building the scheduled txs for the next block.
+// Executions are tracked by tx rather than by the sender's sequence, so that a scheduled tx that is
+// left out of the block doesn't shift the results of the ones that follow it.
+func (k *Keeper) TrackScheduledExecution(ctx sdk.Context, txBytes []byte, scheduleName string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingExecutionKey)
+	store.Set(types.GetPendingExecutionKey(txBytes), []byte(scheduleName))
+}
+
+// TrackScheduledTx records a scheduled tx built for the next block. Scheduled txs aren't signed,
+// so only the tracked txs are accepted from the scheduled tx sender, see ScheduledTxDecorator and
+// ScheduledTxProposalHandler.
+func (k *Keeper) TrackScheduledTx(ctx sdk.Context, txBytes []byte) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledTxKey)
+	store.Set(types.GetScheduledTxKey(txBytes), []byte{})
+}
+
+// IsScheduledTx returns whether txBytes is one of the scheduled txs built in the last block
+func (k *Keeper) IsScheduledTx(ctx sdk.Context, txBytes []byte) bool {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledTxKey)
+	return store.Has(types.GetScheduledTxKey(txBytes))
+}
+
+// ConsumeScheduledTx removes txBytes from the scheduled txs, so that it can only be executed once.
+// It returns whether txBytes was one of the scheduled txs built in the last block.
+func (k *Keeper) ConsumeScheduledTx(ctx sdk.Context, txBytes []byte) bool {
+	if !k.IsScheduledTx(ctx, txBytes) {
+		return false
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledTxKey)
+	store.Delete(types.GetScheduledTxKey(txBytes))
+	return true
+}
+
+// GetScheduledExecution returns the name of the schedule executed by the scheduled tx txBytes
+func (k *Keeper) GetScheduledExecution(ctx sdk.Context, txBytes []byte) (string, bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingExecutionKey)
+	bz := store.Get(types.GetPendingExecutionKey(txBytes))
+	if bz == nil {
+		return "", false
+	}
+	return string(bz), true
+}
+
+// CompleteScheduledExecution marks the scheduled tx txBytes as successful.
+// It must be called from within the tx, so that it's reverted if the tx fails.
+func (k *Keeper) CompleteScheduledExecution(ctx sdk.Context, txBytes []byte) {
+	name, found := k.GetScheduledExecution(ctx, txBytes)
+	if !found {
+		return
+	}
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingExecutionKey)
+	store.Delete(types.GetPendingExecutionKey(txBytes))
+
+	k.recordScheduleExecution(ctx, name, types.GetPendingExecutionKey(txBytes), newScheduleExecution(ctx, nil))
+}
+
+// RecordScheduledExecutionError keeps the result of a failed scheduled tx until EndBlock.
+// Only errors from block execution are kept, so that simulations can't affect the recorded results.
+func (k *Keeper) RecordScheduledExecutionError(ctx sdk.Context, txBytes []byte, err error) {
+	if ctx.ExecMode() != sdk.ExecModeFinalize {
+		return
+	}
+	k.failedExecutions[string(types.GetPendingExecutionKey(txBytes))] = newScheduleExecution(ctx, err)
+}
+
+// newScheduleExecution describes the scheduled tx that is being executed in ctx
+func newScheduleExecution(ctx sdk.Context, err error) types.ScheduleExecution {
+	execution := types.ScheduleExecution{
+		Height:  uint64(ctx.BlockHeight()), //nolint:gosec
+		Success: err == nil,
+		GasUsed: ctx.GasMeter().GasConsumed(),
+	}
+	if txBytes := ctx.TxBytes(); len(txBytes) > 0 {
+		execution.TxHash = fmt.Sprintf("%X", sha256.Sum256(txBytes))
+	}
+	if err != nil {
+		execution.Error = executionError(err)
+	}
+	return execution
+}
+
+// executionError describes a failed execution by its ABCI codespace and code. Error messages
+// aren't guaranteed to be the same on every node, so they aren't kept in state
+func executionError(err error) string {
+	codespace, code, _ := errorsmod.ABCIInfo(err, false)
+	return fmt.Sprintf("codespace: %s, code: %d", codespace, code)
+}
+
+// recordScheduleExecution appends an execution to the schedule's log, drops the oldest executions
+// above MaxScheduleExecutions and emits it as an event
+func (k *Keeper) recordScheduleExecution(ctx sdk.Context, name string, txHash []byte, execution types.ScheduleExecution) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleExecutionKey)
+	store.Set(types.GetScheduleExecutionKey(name, execution.Height, txHash), k.cdc.MustMarshal(&execution))
+
+	keys := k.scheduleExecutionKeys(store, name)
+	for i := 0; i+types.MaxScheduleExecutions < len(keys); i++ {
+		store.Delete(keys[i])
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventScheduleExecuted{Name: name, Execution: execution}); err != nil {
+		k.Logger(ctx).Error("failed to emit schedule execution event", "schedule", name, "err", err)
+	}
+}
+
+func (k *Keeper) removeScheduleExecutions(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleExecutionKey)
+	for _, key := range k.scheduleExecutionKeys(store, name) {
+		store.Delete(key)
+	}
+}
+
+// scheduleExecutionKeys returns the keys of all executions of a schedule, oldest first
+func (k *Keeper) scheduleExecutionKeys(store prefix.Store, name string) [][]byte {
+	var keys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetScheduleExecutionsPrefix(name))
+	defer iterator.Close()
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	return keys
+}
+
+func (k *Keeper) setExecutedSchedule(ctx sdk.Context, name string) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutedScheduleKey)
+	store.Set(types.GetScheduleKey(name), []byte{})
+}
+
+// resolveScheduledExecutions records the results of the schedules executed in the current block.
+// Any scheduled tx that is still pending didn't complete successfully.
+func (k *Keeper) resolveScheduledExecutions(ctx sdk.Context) {
+	// the map is shared between copies of the keeper, so it's cleared in place
+	failedExecutions := make(map[string]types.ScheduleExecution, len(k.failedExecutions))
+	for txHash, execution := range k.failedExecutions {
+		failedExecutions[txHash] = execution
+		delete(k.failedExecutions, txHash)
+	}
+
+	// the scheduled txs of the last block can't be included in later blocks
+	scheduledTxStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduledTxKey)
+	var scheduledTxKeys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(scheduledTxStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		scheduledTxKeys = append(scheduledTxKeys, iterator.Key())
+	}
+	iterator.Close()
+	for _, key := range scheduledTxKeys {
+		scheduledTxStore.Delete(key)
+	}
+
+	pendingStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.PendingExecutionKey)
+	var (
+		pendingKeys  [][]byte
+		pendingNames []string
+	)
+	iterator = storetypes.KVStorePrefixIterator(pendingStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		pendingKeys = append(pendingKeys, iterator.Key())
+		pendingNames = append(pendingNames, string(iterator.Value()))
+	}
+	iterator.Close()
+
+	failures := make(map[string]string)
+	for i, key := range pendingKeys {
+		pendingStore.Delete(key)
+
+		name := pendingNames[i]
+		execution, ok := failedExecutions[string(key)]
+		if !ok {
+			execution = types.ScheduleExecution{
+				Height: uint64(ctx.BlockHeight()), //nolint:gosec
+				Error:  defaultExecutionError,
+			}
+		}
+		k.recordScheduleExecution(ctx, name, key, execution)
+
+		// keep the error of the first msg that failed
+		if _, failed := failures[name]; !failed {
+			failures[name] = execution.Error
+		}
+	}
+
+	executedStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ExecutedScheduleKey)
+	var executed []string
+	iterator = storetypes.KVStorePrefixIterator(executedStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		executed = append(executed, string(iterator.Key()))
+	}
+	iterator.Close()
+	sort.Strings(executed)
+
+	for _, name := range executed {
+		executedStore.Delete(types.GetScheduleKey(name))
+
+		schedule, found := k.GetSchedule(ctx, name)
+		if !found {
+			// removed since it was scheduled
+			continue
+		}
+
+		if errMsg, failed := failures[name]; failed {
+			k.recordScheduleFailure(ctx, *schedule, errMsg)
+		} else {
+			k.recordScheduleSuccess(ctx, *schedule)
+		}
+	}
+}
+
+func (k *Keeper) recordScheduleSuccess(ctx sdk.Context, schedule types.Schedule) {
+	schedule.LastResult = types.ExecutionResult_EXECUTION_RESULT_SUCCESS
+	schedule.LastError = ""
+	schedule.ConsecutiveFailures = 0
+	k.storeSchedule(ctx, schedule)
+
+	recordExecutedSchedule(true, schedule)
+}
+
+func (k *Keeper) recordScheduleFailure(ctx sdk.Context, schedule types.Schedule, errMsg string) {
+	schedule.LastResult = types.ExecutionResult_EXECUTION_RESULT_FAILURE
+	schedule.LastError = errMsg
+	schedule.ConsecutiveFailures++
+
+	switch schedule.FailurePolicy {
+	case types.FailurePolicy_FAILURE_POLICY_RETRY:
+		// make the schedule due again, so that it is picked up again right away
+		if schedule.Trigger() == types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD {
+			nextHeight := uint64(ctx.BlockHeight()) + 1 //nolint:gosec
+			if nextHeight >= schedule.Period {
+				schedule.LastExecuteHeight = nextHeight - schedule.Period
+			} else {
+				schedule.LastExecuteHeight = 0
+			}
+		} else {
+			schedule.NextExecuteTime = schedule.LastExecuteTime
+		}
+	case types.FailurePolicy_FAILURE_POLICY_DISABLE:
+		if schedule.ConsecutiveFailures >= schedule.MaxFailures {
+			k.Logger(ctx).Info("disabling schedule after consecutive failures", "schedule", schedule.Name, "failures", schedule.ConsecutiveFailures)
+			schedule.Disabled = true
+			schedule.NextExecuteTime = nil
+		}
+	}
+	k.storeSchedule(ctx, schedule)
+
+	recordExecutedSchedule(false, schedule)
+}
@@ -22,12 +22,23 @@ func (k Keeper) Schedules(c context.Context, req *types.QuerySchedulesRequest) (
 
 	scheduleStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKey)
 
-	pageRes, err := query.Paginate(scheduleStore, req.Pagination, func(_, value []byte) error {
+	pageRes, err := query.FilteredPaginate(scheduleStore, req.Pagination, func(_, value []byte, accumulate bool) (bool, error) {
 		var schedule types.Schedule
 		k.cdc.MustUnmarshal(value, &schedule)
 
-		schedules = append(schedules, schedule)
-		return nil
+		if req.Trigger != types.ScheduleTrigger_SCHEDULE_TRIGGER_UNSPECIFIED && schedule.Trigger() != req.Trigger {
+			return false, nil
+		}
+		if req.Owner != "" && schedule.Owner != req.Owner {
+			return false, nil
+		}
+		if req.PauseStatus != types.PauseStatus_PAUSE_STATUS_UNSPECIFIED && schedule.Paused != (req.PauseStatus == types.PauseStatus_PAUSE_STATUS_PAUSED) {
+			return false, nil
+		}
+		if accumulate {
+			schedules = append(schedules, schedule)
+		}
+		return true, nil
 	})
 	if err != nil {
 		return nil, status.Error(codes.Internal, err.Error())
@@ -50,5 +61,52 @@ func (k Keeper) Schedule(c context.Context, req *types.QueryGetScheduleRequest)
 		return nil, status.Error(codes.NotFound, "schedule not found")
 	}
 
-	return &types.QueryGetScheduleResponse{Schedule: *val}, nil
+	return &types.QueryGetScheduleResponse{Schedule: *val, Account: val.Account().String()}, nil
+}
+
+func (k Keeper) ScheduleExecutions(c context.Context, req *types.QueryScheduleExecutionsRequest) (*types.QueryScheduleExecutionsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	if !k.scheduleExists(ctx, req.Name) {
+		return nil, status.Error(codes.NotFound, "schedule not found")
+	}
+
+	var executions []types.ScheduleExecution
+	executionStore := prefix.NewStore(ctx.KVStore(k.storeKey), append(types.ScheduleExecutionKey, types.GetScheduleExecutionsPrefix(req.Name)...))
+
+	pageRes, err := query.Paginate(executionStore, req.Pagination, func(_, value []byte) error {
+		var execution types.ScheduleExecution
+		if err := k.cdc.Unmarshal(value, &execution); err != nil {
+			return err
+		}
+		executions = append(executions, execution)
+		return nil
+	})
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryScheduleExecutionsResponse{Executions: executions, Pagination: pageRes}, nil
+}
+
+func (k Keeper) Backlog(c context.Context, req *types.QueryBacklogRequest) (*types.QueryBacklogResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+	ctx := sdk.UnwrapSDKContext(c)
+
+	// the schedules that are due in the next block and weren't picked up at the end of the last one
+	var res types.QueryBacklogResponse
+	k.iterateQueuedSchedules(ctx, func(_ string, dueHeight uint64) bool {
+		res.Count++
+		if res.OldestDueHeight == 0 || dueHeight < res.OldestDueHeight {
+			res.OldestDueHeight = dueHeight
+		}
+		return false
+	})
+
+	return &res, nil
 }
@@ -1,6 +1,7 @@
 package keeper_test
 
 import (
+	"fmt"
 	"strconv"
 	"testing"
 
@@ -20,7 +21,7 @@ import (
 var _ = strconv.IntSize
 
 func TestScheduleQuerySingle(t *testing.T) {
-	k, ctx := testutil_keeper.CronKeeper(t, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
 	schedules := createNSchedule(t, ctx, k, 2)
 
 	for _, tc := range []struct {
@@ -34,14 +35,20 @@ func TestScheduleQuerySingle(t *testing.T) {
 			request: &types.QueryGetScheduleRequest{
 				Name: schedules[0].Name,
 			},
-			response: &types.QueryGetScheduleResponse{Schedule: schedules[0]},
+			response: &types.QueryGetScheduleResponse{
+				Schedule: schedules[0],
+				Account:  types.ScheduleAccountAddress(nil, schedules[0].Name).String(),
+			},
 		},
 		{
 			desc: "Second",
 			request: &types.QueryGetScheduleRequest{
 				Name: schedules[1].Name,
 			},
-			response: &types.QueryGetScheduleResponse{Schedule: schedules[1]},
+			response: &types.QueryGetScheduleResponse{
+				Schedule: schedules[1],
+				Account:  types.ScheduleAccountAddress(nil, schedules[1].Name).String(),
+			},
 		},
 		{
 			desc: "KeyIsAbsent",
@@ -71,7 +78,7 @@ func TestScheduleQuerySingle(t *testing.T) {
 }
 
 func TestScheduleQueryPaginated(t *testing.T) {
-	k, ctx := testutil_keeper.CronKeeper(t, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
 	schedules := createNSchedule(t, ctx, k, 5)
 
 	request := func(next []byte, offset, limit uint64, total bool) *types.QuerySchedulesRequest {
@@ -143,3 +150,63 @@ func createNSchedule(t *testing.T, ctx sdk.Context, k *cronkeeper.Keeper, n int3
 
 	return res
 }
+
+func TestScheduleExecutionsQuery(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(0).WithExecMode(sdk.ExecModeFinalize)
+
+	msgs := []types.MsgExecuteContract{{Contract: sdk.AccAddress("contract_address____").String(), Msg: "m"}}
+	require.NoError(t, k.AddSchedule(ctx, "schedule", 1, msgs))
+
+	// mimics x/compute, which builds a tx for every scheduled msg and reports its result
+	for height := int64(0); height < types.MaxScheduleExecutions+5; height++ {
+		ctx = ctx.WithBlockHeight(height).WithTxBytes([]byte(strconv.Itoa(int(height - 1))))
+		if height > 0 {
+			if height%2 == 0 {
+				k.CompleteScheduledExecution(ctx, ctx.TxBytes())
+			} else {
+				k.RecordScheduledExecutionError(ctx, ctx.TxBytes(), fmt.Errorf("encrypted error"))
+			}
+		}
+		scheduled := k.GetScheduledMsgs(ctx)
+		require.Len(t, scheduled, 1)
+		k.TrackScheduledExecution(ctx, []byte(strconv.Itoa(int(height))), scheduled[0].ScheduleName)
+	}
+
+	resp, err := k.ScheduleExecutions(ctx, &types.QueryScheduleExecutionsRequest{
+		Name:       "schedule",
+		Pagination: &query.PageRequest{CountTotal: true},
+	})
+	require.NoError(t, err)
+	// the oldest executions are dropped
+	require.Equal(t, uint64(types.MaxScheduleExecutions), resp.Pagination.Total)
+	first := resp.Executions[0]
+	require.Equal(t, uint64(5), first.Height)
+	require.False(t, first.Success)
+	// only the codespace and code of the error are kept
+	require.Equal(t, "codespace: undefined, code: 1", first.Error)
+	require.NotEmpty(t, first.TxHash)
+	last := resp.Executions[len(resp.Executions)-1]
+	require.Equal(t, uint64(types.MaxScheduleExecutions+4), last.Height)
+	require.True(t, last.Success)
+	require.Empty(t, last.Error)
+
+	// the scheduled tx was executed, so the event was emitted
+	var found bool
+	for _, event := range ctx.EventManager().Events() {
+		if event.Type == "secret.cron.EventScheduleExecuted" {
+			found = true
+		}
+	}
+	require.True(t, found)
+
+	_, err = k.ScheduleExecutions(ctx, &types.QueryScheduleExecutionsRequest{Name: "absent_key"})
+	require.ErrorIs(t, err, status.Error(codes.NotFound, "schedule not found"))
+
+	// executions are removed along with the schedule
+	k.RemoveSchedule(ctx, "schedule")
+	require.NoError(t, k.AddSchedule(ctx, "schedule", 1, msgs))
+	resp, err = k.ScheduleExecutions(ctx, &types.QueryScheduleExecutionsRequest{Name: "schedule"})
+	require.NoError(t, err)
+	require.Empty(t, resp.Executions)
+}
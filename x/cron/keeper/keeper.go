@@ -3,7 +3,9 @@ package keeper
 import (
 	"fmt"
 	"strconv"
+	"time"
 
+	"cosmossdk.io/errors"
 	"cosmossdk.io/log"
 	"github.com/hashicorp/go-metrics"
 
@@ -15,6 +17,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/scrtlabs/SecretNetwork/x/cron/types"
 )
 
@@ -22,6 +25,7 @@ var (
 	LabelExecuteReadySchedules   = "execute_ready_schedules"
 	LabelScheduleCount           = "schedule_count"
 	LabelScheduleExecutionsCount = "schedule_executions_count"
+	LabelScheduleBacklog         = "schedule_backlog"
 
 	MetricLabelSuccess      = "success"
 	MetricLabelScheduleName = "schedule_name"
@@ -33,10 +37,14 @@ type (
 		storeKey      storetypes.StoreKey
 		memKey        storetypes.StoreKey
 		accountKeeper types.AccountKeeper
+		bankKeeper    types.BankKeeper
 		regKeeper     types.RegKeeper
 		// WasmMsgServer types.WasmMsgServer
 		authority string
 		txConfig  client.TxConfig
+		// failedExecutions holds the scheduled txs that failed in the current block, by tx hash.
+		// Failed txs revert their state changes, so they're kept in memory until EndBlock
+		failedExecutions map[string]types.ScheduleExecution
 	}
 )
 
@@ -45,20 +53,33 @@ func (k *Keeper) GetTxConfig() client.TxConfig {
 }
 
 // GetScheduledMsgs implements types.CronKeeper.
-func (k *Keeper) GetScheduledMsgs(ctx sdk.Context) []types.MsgExecuteContract {
+// The results of the msgs scheduled in the previous block are recorded first, so that
+// schedules that failed and should be retried are picked up again.
+func (k *Keeper) GetScheduledMsgs(ctx sdk.Context) []types.ScheduledMsg {
+	k.resolveScheduledExecutions(ctx)
+
 	schedules := k.getSchedulesReadyForExecution(ctx)
-	var msgExecuteContractList []types.MsgExecuteContract
+	var scheduledMsgs []types.ScheduledMsg
 	for _, schedule := range schedules {
+		if !k.chargeExecutionFee(ctx, &schedule) {
+			continue
+		}
 		msgs, err := k.getCronsMsgs(ctx, schedule)
 		if err != nil {
 			ctx.Logger().Error("Failed to get crons msgs", "error", err)
+			if stored, found := k.GetSchedule(ctx, schedule.Name); found {
+				k.recordScheduleFailure(ctx, *stored, err.Error())
+			}
 			continue
 		}
 
-		msgExecuteContractList = append(msgExecuteContractList, msgs...)
-		recordExecutedSchedule(nil, schedule)
+		sender := schedule.TxSender()
+		for _, msg := range msgs {
+			scheduledMsgs = append(scheduledMsgs, types.ScheduledMsg{ScheduleName: schedule.Name, Sender: sender, Msg: msg})
+		}
+		k.setExecutedSchedule(ctx, schedule.Name)
 	}
-	return msgExecuteContractList
+	return scheduledMsgs
 }
 
 // executeSchedule executes all msgs in a given schedule and changes LastExecuteHeight
@@ -67,6 +88,19 @@ func (k *Keeper) getCronsMsgs(ctx sdk.Context, schedule types.Schedule) ([]types
 	// Even if contract execution returned an error, we still increase the height
 	// and execute it after this interval
 	schedule.LastExecuteHeight = uint64(ctx.BlockHeight() + 1) //nolint:gosec
+	if schedule.Trigger() != types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD {
+		schedule.DueHeight = 0
+		blockTime := ctx.BlockTime()
+		schedule.LastExecuteTime = &blockTime
+		next, err := schedule.ComputeNextExecuteTime(blockTime)
+		if err != nil {
+			// can't happen for schedules that passed validation, but make sure we don't execute it every block
+			ctx.Logger().Error("getCronsMsgs: failed to compute next execution time", "schedule", schedule.Name, "err", err)
+			schedule.NextExecuteTime = nil
+		} else {
+			schedule.NextExecuteTime = &next
+		}
+	}
 	k.storeSchedule(ctx, schedule)
 
 	var cronMsgs []types.MsgExecuteContract
@@ -80,6 +114,8 @@ func (k *Keeper) getCronsMsgs(ctx sdk.Context, schedule types.Schedule) ([]types
 		executeMsg := types.MsgExecuteContract{
 			Contract: contractAddr.String(),
 			Msg:      msg.Msg,
+			GasLimit: msg.GetGasLimitOrDefault(),
+			Funds:    msg.Funds,
 		}
 		cronMsgs = append(cronMsgs, executeMsg)
 	}
@@ -91,6 +127,7 @@ func NewKeeper(
 	storeKey,
 	memKey storetypes.StoreKey,
 	accountKeeper types.AccountKeeper,
+	bankKeeper types.BankKeeper,
 	authority string,
 ) *Keeper {
 	return &Keeper{
@@ -98,9 +135,12 @@ func NewKeeper(
 		storeKey:      storeKey,
 		memKey:        memKey,
 		accountKeeper: accountKeeper,
+		bankKeeper:    bankKeeper,
 		regKeeper:     nil,
 		authority:     authority,
 		txConfig:      nil,
+
+		failedExecutions: make(map[string]types.ScheduleExecution),
 	}
 }
 
@@ -129,32 +169,198 @@ func (k *Keeper) AddSchedule(
 	period uint64,
 	msgs []types.MsgExecuteContract,
 ) error {
-	if k.scheduleExists(ctx, name) {
-		return fmt.Errorf("schedule already exists with name=%v", name)
-	}
-
-	schedule := types.Schedule{
+	return k.CreateSchedule(ctx, types.Schedule{
 		Name:   name,
 		Period: period,
 		Msgs:   msgs,
-		// let's execute newly added schedule on `now + period` block
+	})
+}
+
+// AddTimeSchedule adds a new schedule that is due every `interval` of block time, or whenever
+// `cronExpression` matches the block time. Exactly one of them must be set.
+// First schedule execution is on the first block at or after the first due time.
+func (k *Keeper) AddTimeSchedule(
+	ctx sdk.Context,
+	name string,
+	interval time.Duration,
+	cronExpression string,
+	msgs []types.MsgExecuteContract,
+) error {
+	schedule := types.Schedule{
+		Name:           name,
+		Msgs:           msgs,
+		CronExpression: cronExpression,
+	}
+	if interval != 0 {
+		schedule.Interval = &interval
+	}
+	return k.CreateSchedule(ctx, schedule)
+}
+
+// CreateSchedule adds a new schedule with the trigger, msgs and failure policy of the given schedule.
+// Its execution state is reset: block period schedules are first executed on `now + period` block,
+// time based schedules on the first block at or after their first due time.
+func (k *Keeper) CreateSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	if k.scheduleExists(ctx, schedule.Name) {
+		return fmt.Errorf("schedule already exists with name=%v", schedule.Name)
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	schedule = types.Schedule{
+		Name:           schedule.Name,
+		Period:         schedule.Period,
+		Msgs:           schedule.Msgs,
+		Interval:       schedule.Interval,
+		CronExpression: schedule.CronExpression,
+		FailurePolicy:  schedule.FailurePolicy,
+		MaxFailures:    schedule.MaxFailures,
+		Owner:          schedule.Owner,
+		Deposit:        schedule.Deposit,
+		Paused:         schedule.Paused,
+		PausedBy:       schedule.PausedBy,
+		Priority:       schedule.Priority,
+		// let's execute newly added block period schedule on `now + period` block
 		LastExecuteHeight: uint64(ctx.BlockHeight()), //nolint:gosec
 	}
 
-	k.storeSchedule(ctx, schedule)
-	k.changeTotalCount(ctx, 1)
+	if schedule.Trigger() != types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD {
+		next, err := schedule.ComputeNextExecuteTime(ctx.BlockTime())
+		if err != nil {
+			return err
+		}
+		schedule.NextExecuteTime = &next
+	}
+
+	k.addSchedule(ctx, schedule)
+	return nil
+}
+
+// ImportSchedule adds an exported schedule, keeping its execution state. Time based schedules
+// without a next execution time are due from the current block time
+func (k *Keeper) ImportSchedule(ctx sdk.Context, schedule types.Schedule) error {
+	if k.scheduleExists(ctx, schedule.Name) {
+		return fmt.Errorf("schedule already exists with name=%v", schedule.Name)
+	}
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
 
+	if schedule.Trigger() != types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD && schedule.NextExecuteTime == nil {
+		next, err := schedule.ComputeNextExecuteTime(ctx.BlockTime())
+		if err != nil {
+			return err
+		}
+		schedule.NextExecuteTime = &next
+	}
+
+	k.addSchedule(ctx, schedule)
 	return nil
 }
 
-// RemoveSchedule removes schedule with a given `name`
-func (k *Keeper) RemoveSchedule(ctx sdk.Context, name string) {
-	if !k.scheduleExists(ctx, name) {
-		return
+func (k *Keeper) addSchedule(ctx sdk.Context, schedule types.Schedule) {
+	k.storeSchedule(ctx, schedule)
+	k.changeTotalCount(ctx, 1)
+	if schedule.Owner != "" {
+		k.setOwnerIndex(ctx, schedule)
+	}
+}
+
+// RemoveSchedule removes schedule with a given `name`, refunding the remaining deposit and the account
+// balance of owned schedules
+func (k *Keeper) RemoveSchedule(ctx sdk.Context, name string) error {
+	schedule, found := k.GetSchedule(ctx, name)
+	if !found {
+		return nil
+	}
+
+	if err := k.refundDeposit(ctx, *schedule); err != nil {
+		return err
+	}
+	if err := k.refundScheduleAccount(ctx, *schedule); err != nil {
+		return err
 	}
 
 	k.changeTotalCount(ctx, -1)
 	k.removeSchedule(ctx, name)
+	return nil
+}
+
+// UpdateSchedule replaces the trigger and msgs of the schedule with a given `name`, keeping its execution state.
+// Block period schedules are due `period` blocks after their last execution, time based schedules are due
+// at the first due time after their last execution, or after the current block time if they weren't executed yet.
+func (k *Keeper) UpdateSchedule(
+	ctx sdk.Context,
+	name string,
+	period uint64,
+	interval *time.Duration,
+	cronExpression string,
+	msgs []types.MsgExecuteContract,
+) error {
+	schedule, found := k.GetSchedule(ctx, name)
+	if !found {
+		return errors.Wrapf(sdkerrors.ErrNotFound, "schedule %s", name)
+	}
+
+	schedule.Period = period
+	schedule.Interval = interval
+	schedule.CronExpression = cronExpression
+	schedule.Msgs = msgs
+	if err := schedule.Validate(); err != nil {
+		return err
+	}
+
+	schedule.NextExecuteTime = nil
+	schedule.DueHeight = 0
+	if schedule.Trigger() != types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD {
+		from := ctx.BlockTime()
+		if schedule.LastExecuteTime != nil {
+			from = *schedule.LastExecuteTime
+		}
+		next, err := schedule.ComputeNextExecuteTime(from)
+		if err != nil {
+			return err
+		}
+		schedule.NextExecuteTime = &next
+	}
+
+	k.storeSchedule(ctx, *schedule)
+	return nil
+}
+
+// PauseSchedule pauses the schedule with a given `name` on behalf of `pausedBy`.
+// Paused schedules are not executed until they're resumed
+func (k *Keeper) PauseSchedule(ctx sdk.Context, name, pausedBy string) error {
+	return k.setPaused(ctx, name, true, pausedBy)
+}
+
+// ResumeSchedule resumes the paused schedule with a given `name`.
+// Schedules that were due while paused are executed in the next block.
+func (k *Keeper) ResumeSchedule(ctx sdk.Context, name string) error {
+	return k.setPaused(ctx, name, false, "")
+}
+
+func (k *Keeper) setPaused(ctx sdk.Context, name string, paused bool, pausedBy string) error {
+	schedule, found := k.GetSchedule(ctx, name)
+	if !found {
+		return errors.Wrapf(sdkerrors.ErrNotFound, "schedule %s", name)
+	}
+	if schedule.Paused == paused {
+		return errors.Wrapf(sdkerrors.ErrInvalidRequest, "schedule %s is already %s", name, pauseStatus(paused))
+	}
+
+	schedule.Paused = paused
+	schedule.PausedBy = pausedBy
+	k.storeSchedule(ctx, *schedule)
+	return nil
+}
+
+func pauseStatus(paused bool) string {
+	if paused {
+		return "paused"
+	}
+	return "active"
 }
 
 // GetSchedule returns schedule with a given `name`
@@ -192,36 +398,92 @@ func (k *Keeper) GetScheduleCount(ctx sdk.Context) int32 {
 	return k.getScheduleCount(ctx)
 }
 
+// getSchedulesReadyForExecution returns the schedules that are due in the next block, up to the per block limit.
+// Schedules of a higher priority tier come first, and within a tier the ones that have been due the longest.
+// Schedules over the limit stay queued with their due height, so they're picked up before the schedules
+// that become due later.
 func (k *Keeper) getSchedulesReadyForExecution(ctx sdk.Context) []types.Schedule {
 	params := k.GetParams(ctx)
-	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKey)
-	count := uint64(0)
+	k.queueTimeSchedulesDue(ctx)
 
 	res := make([]types.Schedule, 0)
+	backlog := uint64(0)
+	k.iterateQueuedSchedules(ctx, func(name string, _ uint64) bool {
+		if uint64(len(res)) >= params.Limit {
+			backlog++
+			return false
+		}
+		if schedule, found := k.GetSchedule(ctx, name); found {
+			res = append(res, *schedule)
+		}
+		return false
+	})
 
-	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
-	defer iterator.Close()
+	if backlog > 0 {
+		k.Logger(ctx).Info("limit of schedule executions per block reached", "backlog", backlog)
+	}
+	telemetry.ModuleSetGauge(types.ModuleName, float32(backlog), LabelScheduleBacklog)
 
+	return res
+}
+
+// queueTimeSchedulesDue queues the time based schedules whose next execution time is reached at the
+// current block time, to be executed in the next block
+func (k *Keeper) queueTimeSchedulesDue(ctx sdk.Context) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByTimeKey)
+
+	var names []string
+	iterator := store.Iterator(nil, storetypes.PrefixEndBytes(sdk.FormatTimeBytes(ctx.BlockTime())))
 	for ; iterator.Valid(); iterator.Next() {
-		var schedule types.Schedule
-		k.cdc.MustUnmarshal(iterator.Value(), &schedule)
-		if k.intervalPassed(ctx, schedule) {
-			res = append(res, schedule)
-			count++
+		names = append(names, types.ParseScheduleByTimeKey(iterator.Key()))
+	}
+	iterator.Close()
 
-			if count >= params.Limit {
-				k.Logger(ctx).Info("limit of schedule executions per block reached")
-				return res
-			}
+	for _, name := range names {
+		schedule, found := k.GetSchedule(ctx, name)
+		if !found || schedule.Disabled || schedule.Paused {
+			continue
 		}
+		schedule.DueHeight = uint64(ctx.BlockHeight()) + 1 //nolint:gosec
+		k.storeSchedule(ctx, *schedule)
 	}
+}
 
-	return res
+// iterateQueuedSchedules calls cb with the name and due height of the schedules that are due in the next block,
+// in execution order, until cb returns true
+func (k *Keeper) iterateQueuedSchedules(ctx sdk.Context, cb func(name string, dueHeight uint64) bool) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleQueueKey)
+	nextHeight := uint64(ctx.BlockHeight()) + 1 //nolint:gosec
+
+	for _, priority := range types.PriorityTiers {
+		iterator := store.Iterator([]byte{priority.Tier()}, types.GetScheduleQueueEnd(priority, nextHeight))
+		for ; iterator.Valid(); iterator.Next() {
+			dueHeight, name := types.ParseScheduleQueueKey(iterator.Key())
+			if cb(name, dueHeight) {
+				iterator.Close()
+				return
+			}
+		}
+		iterator.Close()
+	}
 }
 
 func (k *Keeper) storeSchedule(ctx sdk.Context, schedule types.Schedule) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKey)
 
+	// keep the time index and the queue in sync with the schedule's execution state
+	if old, found := k.GetSchedule(ctx, schedule.Name); found {
+		k.removeFromIndexes(ctx, *old)
+	}
+	if schedule.NextExecuteTime != nil && schedule.DueHeight == 0 {
+		timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByTimeKey)
+		timeStore.Set(types.GetScheduleByTimeKey(*schedule.NextExecuteTime, schedule.Name), []byte{})
+	}
+	if dueHeight, queued := schedule.QueuedHeight(); queued {
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleQueueKey)
+		queueStore.Set(types.GetScheduleQueueKey(schedule.Priority, dueHeight, schedule.Name), []byte{})
+	}
+
 	bzSchedule := k.cdc.MustMarshal(&schedule)
 	store.Set(types.GetScheduleKey(schedule.Name), bzSchedule)
 }
@@ -229,7 +491,25 @@ func (k *Keeper) storeSchedule(ctx sdk.Context, schedule types.Schedule) {
 func (k *Keeper) removeSchedule(ctx sdk.Context, name string) {
 	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleKey)
 
+	if schedule, found := k.GetSchedule(ctx, name); found {
+		k.removeFromIndexes(ctx, *schedule)
+		if schedule.Owner != "" {
+			k.removeOwnerIndex(ctx, *schedule)
+		}
+	}
 	store.Delete(types.GetScheduleKey(name))
+	k.removeScheduleExecutions(ctx, name)
+}
+
+func (k *Keeper) removeFromIndexes(ctx sdk.Context, schedule types.Schedule) {
+	if schedule.NextExecuteTime != nil && schedule.DueHeight == 0 {
+		timeStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByTimeKey)
+		timeStore.Delete(types.GetScheduleByTimeKey(*schedule.NextExecuteTime, schedule.Name))
+	}
+	if dueHeight, queued := schedule.QueuedHeight(); queued {
+		queueStore := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleQueueKey)
+		queueStore.Delete(types.GetScheduleQueueKey(schedule.Priority, dueHeight, schedule.Name))
+	}
 }
 
 func (k *Keeper) scheduleExists(ctx sdk.Context, name string) bool {
@@ -237,13 +517,6 @@ func (k *Keeper) scheduleExists(ctx sdk.Context, name string) bool {
 	return store.Has(types.GetScheduleKey(name))
 }
 
-func (k *Keeper) intervalPassed(ctx sdk.Context, schedule types.Schedule) bool {
-	// EndBlock runs at the end of block N and prepares messages for block N+1.
-	// So we check if the schedule should execute in block N+1:
-	// (currentHeight + 1) >= (lastExecuteHeight + period)
-	return uint64(ctx.BlockHeight())+1 >= (schedule.LastExecuteHeight + schedule.Period) //nolint:gosec
-}
-
 func (k *Keeper) changeTotalCount(ctx sdk.Context, incrementAmount int32) {
 	store := ctx.KVStore(k.storeKey)
 	count := k.getScheduleCount(ctx)
@@ -266,10 +539,10 @@ func (k *Keeper) getScheduleCount(ctx sdk.Context) int32 {
 	return count.Count
 }
 
-func recordExecutedSchedule(err error, schedule types.Schedule) {
+func recordExecutedSchedule(success bool, schedule types.Schedule) {
 	telemetry.IncrCounterWithLabels([]string{LabelScheduleExecutionsCount}, 1, []metrics.Label{
 		telemetry.NewLabel(telemetry.MetricLabelNameModule, types.ModuleName),
-		telemetry.NewLabel(MetricLabelSuccess, strconv.FormatBool(err == nil)),
+		telemetry.NewLabel(MetricLabelSuccess, strconv.FormatBool(success)),
 		telemetry.NewLabel(MetricLabelScheduleName, schedule.Name),
 	})
 }
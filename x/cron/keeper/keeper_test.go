@@ -1,17 +1,25 @@
 package keeper_test
 
 import (
+	"bytes"
+	"fmt"
+	"sort"
 	"strconv"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/golang/mock/gomock"
 	"github.com/stretchr/testify/require"
 
 	"github.com/scrtlabs/SecretNetwork/testutil"
 	testutil_keeper "github.com/scrtlabs/SecretNetwork/testutil/cron/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/cron/keeper"
 	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+	regtypes "github.com/scrtlabs/SecretNetwork/x/registration"
 )
 
 // ExecuteReadySchedules:
@@ -23,7 +31,7 @@ func TestKeeperExecuteReadySchedules(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	k, ctx := testutil_keeper.CronKeeper(t, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
 	ctx = ctx.WithBlockHeight(0)
 
 	err := k.SetParams(ctx, types.Params{
@@ -117,15 +125,16 @@ func TestKeeperExecuteReadySchedules(t *testing.T) {
 	ready3, _ := k.GetSchedule(ctx, "5_ready3")
 	ready4, _ := k.GetSchedule(ctx, "6_ready4")
 
+	// the schedules that have been due the longest are executed first
 	require.Equal(t, uint64(4), unready1.LastExecuteHeight)
-	require.Equal(t, uint64(5), ready1.LastExecuteHeight)
-	require.Equal(t, uint64(5), ready2.LastExecuteHeight)
+	require.Equal(t, uint64(0), ready1.LastExecuteHeight)
+	require.Equal(t, uint64(0), ready2.LastExecuteHeight)
 	require.Equal(t, uint64(4), unready2.LastExecuteHeight)
-	require.Equal(t, uint64(0), ready3.LastExecuteHeight)
-	require.Equal(t, uint64(0), ready4.LastExecuteHeight)
+	require.Equal(t, uint64(6), ready3.LastExecuteHeight)
+	require.Equal(t, uint64(6), ready4.LastExecuteHeight)
 
 	// let's make another call at the next height
-	// Notice that now only one ready schedule left because we got limit of 2 at once
+	// Notice that the schedules held back by the limit of 2 at once are executed now
 	ctx = ctx.WithBlockHeight(6)
 
 	_ = k.GetScheduledMsgs(ctx)
@@ -138,13 +147,13 @@ func TestKeeperExecuteReadySchedules(t *testing.T) {
 	ready4, _ = k.GetSchedule(ctx, "6_ready4")
 
 	require.Equal(t, uint64(4), unready1.LastExecuteHeight)
-	require.Equal(t, uint64(5), ready1.LastExecuteHeight)
-	require.Equal(t, uint64(5), ready2.LastExecuteHeight)
+	require.Equal(t, uint64(7), ready1.LastExecuteHeight)
+	require.Equal(t, uint64(7), ready2.LastExecuteHeight)
 	require.Equal(t, uint64(4), unready2.LastExecuteHeight)
 	require.Equal(t, uint64(6), ready3.LastExecuteHeight)
 	require.Equal(t, uint64(6), ready4.LastExecuteHeight)
 
-	ctx = ctx.WithBlockHeight(8)
+	ctx = ctx.WithBlockHeight(7)
 
 	_ = k.GetScheduledMsgs(ctx)
 
@@ -156,8 +165,8 @@ func TestKeeperExecuteReadySchedules(t *testing.T) {
 	ready4, _ = k.GetSchedule(ctx, "6_ready4")
 
 	require.Equal(t, uint64(4), unready1.LastExecuteHeight)
-	require.Equal(t, uint64(5), ready1.LastExecuteHeight)
-	require.Equal(t, uint64(5), ready2.LastExecuteHeight)
+	require.Equal(t, uint64(7), ready1.LastExecuteHeight)
+	require.Equal(t, uint64(7), ready2.LastExecuteHeight)
 	require.Equal(t, uint64(4), unready2.LastExecuteHeight)
 	require.Equal(t, uint64(6), ready3.LastExecuteHeight)
 	require.Equal(t, uint64(6), ready4.LastExecuteHeight)
@@ -240,7 +249,7 @@ func TestAddSchedule(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	defer ctrl.Finish()
 
-	k, ctx := testutil_keeper.CronKeeper(t, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
 	ctx = ctx.WithBlockHeight(0)
 
 	err := k.SetParams(ctx, types.Params{
@@ -294,7 +303,7 @@ func TestAddSchedule(t *testing.T) {
 }
 
 func TestGetAllSchedules(t *testing.T) {
-	k, ctx := testutil_keeper.CronKeeper(t, nil)
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
 
 	err := k.SetParams(ctx, types.Params{
 		SecurityAddress: testutil.TestOwnerAddress,
@@ -320,3 +329,265 @@ func TestGetAllSchedules(t *testing.T) {
 	assert.ElementsMatch(t, schedules, expectedSchedules)
 	assert.Equal(t, int32(3), k.GetScheduleCount(ctx))
 }
+
+func TestKeeperTimeSchedules(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	start := time.Date(2024, 1, 3, 23, 58, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(1).WithBlockTime(start)
+
+	err := k.SetParams(ctx, types.Params{
+		SecurityAddress: testutil.TestOwnerAddress,
+		Limit:           5,
+	})
+	require.NoError(t, err)
+
+	msgs := []types.MsgExecuteContract{{Contract: "c", Msg: "m"}}
+	require.NoError(t, k.AddTimeSchedule(ctx, "interval", time.Minute, "", msgs))
+	require.NoError(t, k.AddTimeSchedule(ctx, "daily", 0, "0 0 * * *", msgs))
+	require.Error(t, k.AddTimeSchedule(ctx, "both", time.Minute, "0 0 * * *", msgs))
+
+	interval, _ := k.GetSchedule(ctx, "interval")
+	require.Equal(t, start.Add(time.Minute), *interval.NextExecuteTime)
+	daily, _ := k.GetSchedule(ctx, "daily")
+	require.Equal(t, time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC), *daily.NextExecuteTime)
+
+	// nothing is due yet
+	ctx = ctx.WithBlockHeight(2).WithBlockTime(start.Add(30 * time.Second))
+	_ = k.GetScheduledMsgs(ctx)
+	interval, _ = k.GetSchedule(ctx, "interval")
+	require.Nil(t, interval.LastExecuteTime)
+
+	// the interval schedule is due
+	ctx = ctx.WithBlockHeight(3).WithBlockTime(start.Add(time.Minute))
+	_ = k.GetScheduledMsgs(ctx)
+	interval, _ = k.GetSchedule(ctx, "interval")
+	require.Equal(t, start.Add(time.Minute), *interval.LastExecuteTime)
+	require.Equal(t, start.Add(2*time.Minute), *interval.NextExecuteTime)
+	daily, _ = k.GetSchedule(ctx, "daily")
+	require.Nil(t, daily.LastExecuteTime)
+
+	// both are due; missed interval executions are skipped
+	ctx = ctx.WithBlockHeight(4).WithBlockTime(start.Add(4*time.Minute + 10*time.Second))
+	_ = k.GetScheduledMsgs(ctx)
+	interval, _ = k.GetSchedule(ctx, "interval")
+	require.Equal(t, start.Add(5*time.Minute), *interval.NextExecuteTime)
+	daily, _ = k.GetSchedule(ctx, "daily")
+	require.Equal(t, time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC), *daily.NextExecuteTime)
+	require.Equal(t, uint64(5), daily.LastExecuteHeight)
+
+	// removed schedules are dropped from the time index
+	k.RemoveSchedule(ctx, "interval")
+	ctx = ctx.WithBlockHeight(5).WithBlockTime(start.Add(time.Hour))
+	_ = k.GetScheduledMsgs(ctx)
+	daily, _ = k.GetSchedule(ctx, "daily")
+	require.Equal(t, uint64(5), daily.LastExecuteHeight)
+}
+
+func TestKeeperScheduleFailurePolicies(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(0).WithExecMode(sdk.ExecModeFinalize)
+
+	err := k.SetParams(ctx, types.Params{
+		SecurityAddress: testutil.TestOwnerAddress,
+		Limit:           5,
+	})
+	require.NoError(t, err)
+
+	msgs := []types.MsgExecuteContract{{Contract: sdk.AccAddress("contract_address____").String(), Msg: "m"}}
+	require.NoError(t, k.CreateSchedule(ctx, types.Schedule{Name: "ok", Period: 1, Msgs: msgs}))
+	require.NoError(t, k.CreateSchedule(ctx, types.Schedule{Name: "retry", Period: 3, Msgs: msgs, FailurePolicy: types.FailurePolicy_FAILURE_POLICY_RETRY}))
+	require.NoError(t, k.CreateSchedule(ctx, types.Schedule{Name: "disable", Period: 1, Msgs: msgs, FailurePolicy: types.FailurePolicy_FAILURE_POLICY_DISABLE, MaxFailures: 2}))
+
+	// mimics x/compute, which builds a tx for every scheduled msg
+	track := func(scheduled []types.ScheduledMsg) map[string][]byte {
+		txs := make(map[string][]byte)
+		for _, msg := range scheduled {
+			require.Equal(t, types.DefaultScheduleGasLimit, msg.Msg.GasLimit)
+			txBytes := []byte(fmt.Sprintf("cron/%s/%d", msg.ScheduleName, ctx.BlockHeight()))
+			k.TrackScheduledExecution(ctx, txBytes, msg.ScheduleName)
+			txs[msg.ScheduleName] = txBytes
+		}
+		return txs
+	}
+	names := func(txs map[string][]byte) []string {
+		var res []string
+		for name := range txs {
+			res = append(res, name)
+		}
+		sort.Strings(res)
+		return res
+	}
+
+	txs := track(k.GetScheduledMsgs(ctx))
+	require.Equal(t, []string{"disable", "ok"}, names(txs))
+
+	// "ok" succeeds, "disable" fails
+	ctx = ctx.WithBlockHeight(1)
+	k.CompleteScheduledExecution(ctx, txs["ok"])
+	k.RecordScheduledExecutionError(ctx, txs["disable"], sdkerrors.ErrOutOfGas)
+	txs = track(k.GetScheduledMsgs(ctx))
+	require.Equal(t, []string{"disable", "ok"}, names(txs))
+
+	ok, _ := k.GetSchedule(ctx, "ok")
+	require.Equal(t, types.ExecutionResult_EXECUTION_RESULT_SUCCESS, ok.LastResult)
+	disable, _ := k.GetSchedule(ctx, "disable")
+	require.Equal(t, types.ExecutionResult_EXECUTION_RESULT_FAILURE, disable.LastResult)
+	require.Equal(t, "codespace: sdk, code: 11", disable.LastError)
+	require.Equal(t, uint64(1), disable.ConsecutiveFailures)
+	require.False(t, disable.Disabled)
+
+	// "disable" fails again and is disabled, "retry" is due
+	ctx = ctx.WithBlockHeight(2)
+	k.CompleteScheduledExecution(ctx, txs["ok"])
+	txs = track(k.GetScheduledMsgs(ctx))
+	require.Equal(t, []string{"ok", "retry"}, names(txs))
+
+	disable, _ = k.GetSchedule(ctx, "disable")
+	require.Equal(t, uint64(2), disable.ConsecutiveFailures)
+	require.True(t, disable.Disabled)
+
+	// "retry" fails and is picked up again right away
+	ctx = ctx.WithBlockHeight(3)
+	k.CompleteScheduledExecution(ctx, txs["ok"])
+	txs = track(k.GetScheduledMsgs(ctx))
+	require.Equal(t, []string{"ok", "retry"}, names(txs))
+
+	retry, _ := k.GetSchedule(ctx, "retry")
+	require.Equal(t, uint64(4), retry.LastExecuteHeight)
+	require.Equal(t, types.ExecutionResult_EXECUTION_RESULT_FAILURE, retry.LastResult)
+	require.Equal(t, "scheduled execution failed", retry.LastError)
+
+	// and recovers
+	ctx = ctx.WithBlockHeight(4)
+	k.CompleteScheduledExecution(ctx, txs["ok"])
+	k.CompleteScheduledExecution(ctx, txs["retry"])
+	txs = track(k.GetScheduledMsgs(ctx))
+	require.Equal(t, []string{"ok"}, names(txs))
+
+	retry, _ = k.GetSchedule(ctx, "retry")
+	require.Equal(t, types.ExecutionResult_EXECUTION_RESULT_SUCCESS, retry.LastResult)
+	require.Zero(t, retry.ConsecutiveFailures)
+}
+
+func TestKeeperScheduledTxs(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(1)
+
+	txBytes := []byte("scheduled tx")
+	require.False(t, k.IsScheduledTx(ctx, txBytes))
+
+	k.TrackScheduledTx(ctx, txBytes)
+	require.True(t, k.IsScheduledTx(ctx, txBytes))
+	require.False(t, k.IsScheduledTx(ctx, []byte("another tx")))
+
+	// the scheduled txs are only valid in the next block
+	ctx = ctx.WithBlockHeight(2)
+	_ = k.GetScheduledMsgs(ctx)
+	require.False(t, k.IsScheduledTx(ctx, txBytes))
+
+	// the sender of scheduled txs has no key and isn't the retired sender
+	require.NotEqual(t, types.LegacyScheduledTxSender(), types.ScheduledTxSender())
+	require.Len(t, types.ScheduledTxSender(), 20)
+}
+
+// masterKeyRegKeeper returns a fixed consensus IO key
+type masterKeyRegKeeper struct{}
+
+func (masterKeyRegKeeper) GetMasterKey(sdk.Context, string) *regtypes.MasterKey {
+	return &regtypes.MasterKey{Bytes: bytes.Repeat([]byte{9}, 32)}
+}
+
+// Every validator must build the same scheduled txs, so their msgs are encrypted deterministically
+func TestEncryptScheduledMsg(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	k.SetRegKeeper(masterKeyRegKeeper{})
+	ctx = ctx.WithChainID("secret-4").WithBlockHeight(10)
+	msg := []byte(`{"tick":{}}`)
+
+	encrypted, err := keeper.Encrypt(ctx, k, "cron/a/1", msg)
+	require.NoError(t, err)
+	again, err := keeper.Encrypt(ctx, k, "cron/a/1", msg)
+	require.NoError(t, err)
+	require.Equal(t, encrypted, again)
+
+	// the nonce is unique per tx, block and chain
+	for _, other := range []struct {
+		ctx  sdk.Context
+		txID string
+	}{
+		{ctx, "cron/a/2"},
+		{ctx, "cron/b/1"},
+		{ctx.WithBlockHeight(11), "cron/a/1"},
+		{ctx.WithChainID("pulsar-3"), "cron/a/1"},
+	} {
+		otherEncrypted, err := keeper.Encrypt(other.ctx, k, other.txID, msg)
+		require.NoError(t, err)
+		require.NotEqual(t, encrypted[:32], otherEncrypted[:32], other.txID)
+	}
+}
+
+func scheduledNames(scheduledMsgs []types.ScheduledMsg) []string {
+	var names []string
+	for _, msg := range scheduledMsgs {
+		names = append(names, msg.ScheduleName)
+	}
+	return names
+}
+
+// Due schedules over the per block limit are carried over to the next blocks, higher priority tiers first
+// and the schedules that have been due the longest first within a tier
+func TestKeeperScheduleQueue(t *testing.T) {
+	k, ctx := testutil_keeper.CronKeeper(t, nil, nil)
+	ctx = ctx.WithBlockHeight(10)
+
+	params := types.DefaultParams()
+	params.Limit = 2
+	require.NoError(t, k.SetParams(ctx, params))
+
+	msgs := []types.MsgExecuteContract{{Contract: sdk.AccAddress("contract_address____").String(), Msg: "m"}}
+	for _, schedule := range []types.Schedule{
+		{Name: "a_normal", Period: 1, Msgs: msgs},
+		{Name: "b_normal", Period: 1, Msgs: msgs},
+		{Name: "c_low", Period: 1, Msgs: msgs, Priority: types.SchedulePriority_SCHEDULE_PRIORITY_LOW},
+		{Name: "d_high", Period: 1, Msgs: msgs, Priority: types.SchedulePriority_SCHEDULE_PRIORITY_HIGH},
+	} {
+		require.NoError(t, k.CreateSchedule(ctx, schedule))
+	}
+
+	// owned schedules can't have high priority
+	err := k.CreateSchedule(ctx, types.Schedule{
+		Name:     "owned",
+		Period:   1,
+		Msgs:     msgs,
+		Owner:    sdk.AccAddress("owner_______________").String(),
+		Deposit:  sdk.NewInt64Coin("uscrt", 1),
+		Priority: types.SchedulePriority_SCHEDULE_PRIORITY_HIGH,
+	})
+	require.ErrorContains(t, err, "high priority")
+
+	require.Equal(t, []string{"d_high", "a_normal"}, scheduledNames(k.GetScheduledMsgs(ctx)))
+
+	backlog, err := k.Backlog(ctx, &types.QueryBacklogRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBacklogResponse{Count: 2, OldestDueHeight: 11}, backlog)
+
+	// b_normal was held back, so it goes before a_normal
+	ctx = ctx.WithBlockHeight(11)
+	require.Equal(t, []string{"d_high", "b_normal"}, scheduledNames(k.GetScheduledMsgs(ctx)))
+
+	// c_low only runs once the higher tiers fit in the limit
+	params.Limit = 3
+	require.NoError(t, k.SetParams(ctx, params))
+	require.NoError(t, k.PauseSchedule(ctx, "d_high", k.GetAuthority()))
+	ctx = ctx.WithBlockHeight(12)
+	require.Equal(t, []string{"a_normal", "b_normal", "c_low"}, scheduledNames(k.GetScheduledMsgs(ctx)))
+
+	backlog, err = k.Backlog(ctx, &types.QueryBacklogRequest{})
+	require.NoError(t, err)
+	require.Equal(t, &types.QueryBacklogResponse{}, backlog)
+
+	// the paused schedule keeps its place when it's resumed
+	require.NoError(t, k.ResumeSchedule(ctx, "d_high"))
+	ctx = ctx.WithBlockHeight(13)
+	require.Equal(t, []string{"d_high", "a_normal", "b_normal"}, scheduledNames(k.GetScheduledMsgs(ctx)))
+}
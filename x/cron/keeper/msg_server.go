@@ -34,30 +34,147 @@ func (k msgServer) AddSchedule(goCtx context.Context, req *types.MsgAddSchedule)
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
-	if err := k.keeper.AddSchedule(ctx, req.Name, req.Period, req.Msgs); err != nil {
+	err := k.keeper.CreateSchedule(ctx, types.Schedule{
+		Name:           req.Name,
+		Period:         req.Period,
+		Msgs:           req.Msgs,
+		Interval:       req.Interval,
+		CronExpression: req.CronExpression,
+		FailurePolicy:  req.FailurePolicy,
+		MaxFailures:    req.MaxFailures,
+		Priority:       req.Priority,
+	})
+	if err != nil {
 		return nil, errors.Wrap(err, "failed to add schedule")
 	}
 
 	return &types.MsgAddScheduleResponse{}, nil
 }
 
-// RemoveSchedule removes schedule
+// RegisterSchedule adds new schedule owned by the sender
+func (k msgServer) RegisterSchedule(goCtx context.Context, req *types.MsgRegisterSchedule) (*types.MsgRegisterScheduleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgRegisterSchedule")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	err := k.keeper.RegisterSchedule(ctx, types.Schedule{
+		Name:           req.Name,
+		Period:         req.Period,
+		Msgs:           req.Msgs,
+		Interval:       req.Interval,
+		CronExpression: req.CronExpression,
+		FailurePolicy:  req.FailurePolicy,
+		MaxFailures:    req.MaxFailures,
+		Priority:       req.Priority,
+		Owner:          req.Owner,
+	}, req.Deposit)
+	if err != nil {
+		return nil, errors.Wrap(err, "failed to register schedule")
+	}
+
+	return &types.MsgRegisterScheduleResponse{}, nil
+}
+
+// RemoveSchedule removes schedule. Schedules can be removed by governance, the security address and their owner
 func (k msgServer) RemoveSchedule(goCtx context.Context, req *types.MsgRemoveSchedule) (*types.MsgRemoveScheduleResponse, error) {
 	if err := req.Validate(); err != nil {
 		return nil, errors.Wrap(err, "failed to validate MsgRemoveSchedule")
 	}
 
-	authority := k.keeper.GetAuthority()
-	if authority != req.Authority {
-		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, got %s", authority, req.Authority)
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.authorize(ctx, req.Authority, req.Name, true); err != nil {
+		return nil, err
 	}
 
-	ctx := sdk.UnwrapSDKContext(goCtx)
-	k.keeper.RemoveSchedule(ctx, req.Name)
+	if err := k.keeper.RemoveSchedule(ctx, req.Name); err != nil {
+		return nil, errors.Wrap(err, "failed to remove schedule")
+	}
 
 	return &types.MsgRemoveScheduleResponse{}, nil
 }
 
+// UpdateSchedule updates the trigger and msgs of a schedule. Schedules can be updated by governance and their owner
+func (k msgServer) UpdateSchedule(goCtx context.Context, req *types.MsgUpdateSchedule) (*types.MsgUpdateScheduleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgUpdateSchedule")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.authorize(ctx, req.Authority, req.Name, false); err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.UpdateSchedule(ctx, req.Name, req.Period, req.Interval, req.CronExpression, req.Msgs); err != nil {
+		return nil, errors.Wrap(err, "failed to update schedule")
+	}
+
+	return &types.MsgUpdateScheduleResponse{}, nil
+}
+
+// PauseSchedule pauses a schedule. Schedules can be paused by governance, the security address and their owner
+func (k msgServer) PauseSchedule(goCtx context.Context, req *types.MsgPauseSchedule) (*types.MsgPauseScheduleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgPauseSchedule")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.authorize(ctx, req.Authority, req.Name, true); err != nil {
+		return nil, err
+	}
+
+	if err := k.keeper.PauseSchedule(ctx, req.Name, req.Authority); err != nil {
+		return nil, errors.Wrap(err, "failed to pause schedule")
+	}
+
+	return &types.MsgPauseScheduleResponse{}, nil
+}
+
+// ResumeSchedule resumes a paused schedule. Schedules can be resumed by governance and their owner,
+// but not by the security address. Schedules paused by governance or the security address can only be
+// resumed by governance
+func (k msgServer) ResumeSchedule(goCtx context.Context, req *types.MsgResumeSchedule) (*types.MsgResumeScheduleResponse, error) {
+	if err := req.Validate(); err != nil {
+		return nil, errors.Wrap(err, "failed to validate MsgResumeSchedule")
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := k.authorize(ctx, req.Authority, req.Name, false); err != nil {
+		return nil, err
+	}
+	authority := k.keeper.GetAuthority()
+	if schedule, found := k.keeper.GetSchedule(ctx, req.Name); found && req.Authority != authority &&
+		schedule.PausedBy != "" && schedule.PausedBy != schedule.Owner {
+		return nil, errors.Wrapf(sdkerrors.ErrUnauthorized, "schedule %s was paused by %s and can only be resumed by %s", req.Name, schedule.PausedBy, authority)
+	}
+
+	if err := k.keeper.ResumeSchedule(ctx, req.Name); err != nil {
+		return nil, errors.Wrap(err, "failed to resume schedule")
+	}
+
+	return &types.MsgResumeScheduleResponse{}, nil
+}
+
+// authorize checks that `sender` is the governance account or the owner of the schedule,
+// or the security address if `allowSecurityAddress` is set
+func (k msgServer) authorize(ctx sdk.Context, sender, name string, allowSecurityAddress bool) error {
+	authority := k.keeper.GetAuthority()
+	if sender == authority {
+		return nil
+	}
+	if allowSecurityAddress && sender == k.keeper.GetParams(ctx).SecurityAddress {
+		return nil
+	}
+	if schedule, found := k.keeper.GetSchedule(ctx, name); found && schedule.Owner != "" && schedule.Owner == sender {
+		return nil
+	}
+
+	if allowSecurityAddress {
+		return errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s, the security address or the schedule owner, got %s", authority, sender)
+	}
+	return errors.Wrapf(sdkerrors.ErrUnauthorized, "invalid authority; expected %s or the schedule owner, got %s", authority, sender)
+}
+
 // UpdateParams updates the module parameters
 func (k msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
 	if err := req.Validate(); err != nil {
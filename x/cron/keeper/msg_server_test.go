@@ -2,7 +2,10 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/stretchr/testify/require"
 
 	testkeeper "github.com/scrtlabs/SecretNetwork/testutil/cron/keeper"
@@ -13,8 +16,9 @@ import (
 // const k.GetAuthority() = "secret10d07y265gmmuvt4z0w9aw880jnsr700jc88vt0"
 
 func TestMsgAddScheduleValidate(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil)
 	msgServer := cronkeeper.NewMsgServerImpl(*k)
+	shortInterval := time.Millisecond
 
 	tests := []struct {
 		name        string
@@ -79,7 +83,85 @@ func TestMsgAddScheduleValidate(t *testing.T) {
 					},
 				},
 			},
-			"period is invalid",
+			"exactly one of period, interval and cron expression must be set",
+		},
+		{
+			"period and cron expression",
+			types.MsgAddSchedule{
+				Authority:      k.GetAuthority(),
+				Name:           "name",
+				Period:         3,
+				CronExpression: "0 0 * * *",
+				Msgs: []types.MsgExecuteContract{
+					{
+						Contract: "contract",
+						Msg:      "msg",
+					},
+				},
+			},
+			"exactly one of period, interval and cron expression must be set",
+		},
+		{
+			"invalid cron expression",
+			types.MsgAddSchedule{
+				Authority:      k.GetAuthority(),
+				Name:           "name",
+				CronExpression: "0 25 * * *",
+				Msgs: []types.MsgExecuteContract{
+					{
+						Contract: "contract",
+						Msg:      "msg",
+					},
+				},
+			},
+			"invalid hour field",
+		},
+		{
+			"interval too short",
+			types.MsgAddSchedule{
+				Authority: k.GetAuthority(),
+				Name:      "name",
+				Interval:  &shortInterval,
+				Msgs: []types.MsgExecuteContract{
+					{
+						Contract: "contract",
+						Msg:      "msg",
+					},
+				},
+			},
+			"interval must be at least",
+		},
+		{
+			"gas limit too high",
+			types.MsgAddSchedule{
+				Authority: k.GetAuthority(),
+				Name:      "name",
+				Period:    3,
+				Msgs: []types.MsgExecuteContract{
+					{
+						Contract: "contract",
+						Msg:      "msg",
+						GasLimit: types.MaxScheduleGasLimit + 1,
+					},
+				},
+			},
+			"gas limit must not be above",
+		},
+		{
+			"disable policy without max failures",
+			types.MsgAddSchedule{
+				Authority:     k.GetAuthority(),
+				Name:          "name",
+				Period:        3,
+				FailurePolicy: types.FailurePolicy_FAILURE_POLICY_DISABLE,
+				Msgs: []types.MsgExecuteContract{
+					{
+						Contract: "contract",
+						Msg:      "msg",
+					},
+				},
+			},
+			"max failures must be set",
 		},
 		{
 			"empty msgs",
@@ -118,7 +200,7 @@ func TestMsgAddScheduleValidate(t *testing.T) {
 }
 
 func TestMsgRemoveScheduleValidate(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil)
 	msgServer := cronkeeper.NewMsgServerImpl(*k)
 
 	tests := []struct {
@@ -162,7 +244,7 @@ func TestMsgRemoveScheduleValidate(t *testing.T) {
 }
 
 func TestMsgUpdateParamsValidate(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil)
+	k, ctx := testkeeper.CronKeeper(t, nil, nil)
 	msgServer := cronkeeper.NewMsgServerImpl(*k)
 
 	tests := []struct {
@@ -214,3 +296,115 @@ func TestMsgUpdateParamsValidate(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgPauseResumeSchedule(t *testing.T) {
+	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	msgServer := cronkeeper.NewMsgServerImpl(*k)
+
+	owner := sdk.AccAddress("owner_______________").String()
+	security := sdk.AccAddress("security____________").String()
+	other := sdk.AccAddress("other_______________").String()
+
+	params := k.GetParams(ctx)
+	params.SecurityAddress = security
+	params.ExecutionFee = sdk.NewInt64Coin("uscrt", 0)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	msgs := []types.MsgExecuteContract{{Contract: sdk.AccAddress("contract_address____").String(), Msg: "m"}}
+	require.NoError(t, k.CreateSchedule(ctx, types.Schedule{Name: "owned", Period: 1, Msgs: msgs, Owner: owner, Deposit: sdk.NewInt64Coin("uscrt", 1)}))
+	require.NoError(t, k.CreateSchedule(ctx, types.Schedule{Name: "gov", Period: 1, Msgs: msgs}))
+
+	_, err := msgServer.PauseSchedule(ctx, &types.MsgPauseSchedule{Authority: other, Name: "owned"})
+	require.ErrorContains(t, err, "invalid authority")
+	_, err = msgServer.PauseSchedule(ctx, &types.MsgPauseSchedule{Authority: owner, Name: "gov"})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// the security address can pause any schedule
+	_, err = msgServer.PauseSchedule(ctx, &types.MsgPauseSchedule{Authority: security, Name: "owned"})
+	require.NoError(t, err)
+	_, err = msgServer.PauseSchedule(ctx, &types.MsgPauseSchedule{Authority: security, Name: "gov"})
+	require.NoError(t, err)
+	_, err = msgServer.PauseSchedule(ctx, &types.MsgPauseSchedule{Authority: security, Name: "gov"})
+	require.ErrorContains(t, err, "already paused")
+
+	// paused schedules aren't executed
+	ctx = ctx.WithBlockHeight(5)
+	require.Empty(t, k.GetScheduledMsgs(ctx))
+
+	resp, err := k.Schedules(ctx, &types.QuerySchedulesRequest{PauseStatus: types.PauseStatus_PAUSE_STATUS_PAUSED})
+	require.NoError(t, err)
+	require.Len(t, resp.Schedules, 2)
+
+	// but it can't resume them
+	_, err = msgServer.ResumeSchedule(ctx, &types.MsgResumeSchedule{Authority: security, Name: "owned"})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// and neither can the owner, only governance can
+	schedule, _ := k.GetSchedule(ctx, "owned")
+	require.Equal(t, security, schedule.PausedBy)
+	_, err = msgServer.ResumeSchedule(ctx, &types.MsgResumeSchedule{Authority: owner, Name: "owned"})
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	_, err = msgServer.ResumeSchedule(ctx, &types.MsgResumeSchedule{Authority: k.GetAuthority(), Name: "owned"})
+	require.NoError(t, err)
+	_, err = msgServer.ResumeSchedule(ctx, &types.MsgResumeSchedule{Authority: k.GetAuthority(), Name: "gov"})
+	require.NoError(t, err)
+	schedule, _ = k.GetSchedule(ctx, "owned")
+	require.Empty(t, schedule.PausedBy)
+
+	// owners can resume the schedules they paused themselves
+	_, err = msgServer.PauseSchedule(ctx, &types.MsgPauseSchedule{Authority: owner, Name: "owned"})
+	require.NoError(t, err)
+	_, err = msgServer.ResumeSchedule(ctx, &types.MsgResumeSchedule{Authority: owner, Name: "owned"})
+	require.NoError(t, err)
+
+	resp, err = k.Schedules(ctx, &types.QuerySchedulesRequest{PauseStatus: types.PauseStatus_PAUSE_STATUS_ACTIVE})
+	require.NoError(t, err)
+	require.Len(t, resp.Schedules, 2)
+	require.Len(t, k.GetScheduledMsgs(ctx), 2)
+}
+
+func TestMsgUpdateSchedule(t *testing.T) {
+	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	msgServer := cronkeeper.NewMsgServerImpl(*k)
+
+	owner := sdk.AccAddress("owner_______________").String()
+	security := sdk.AccAddress("security____________").String()
+
+	params := k.GetParams(ctx)
+	params.SecurityAddress = security
+	require.NoError(t, k.SetParams(ctx, params))
+
+	msgs := []types.MsgExecuteContract{{Contract: sdk.AccAddress("contract_address____").String(), Msg: "m"}}
+	ctx = ctx.WithBlockHeight(10)
+	require.NoError(t, k.CreateSchedule(ctx, types.Schedule{Name: "owned", Period: 5, Msgs: msgs, Owner: owner, Deposit: sdk.NewInt64Coin("uscrt", 1)}))
+
+	newMsgs := []types.MsgExecuteContract{{Contract: sdk.AccAddress("contract_address____").String(), Msg: "new"}}
+	update := types.MsgUpdateSchedule{Authority: security, Name: "owned", Period: 20, Msgs: newMsgs}
+
+	// the security address can't update schedules
+	_, err := msgServer.UpdateSchedule(ctx, &update)
+	require.ErrorContains(t, err, "invalid authority")
+
+	update.Authority = owner
+	_, err = msgServer.UpdateSchedule(ctx, &update)
+	require.NoError(t, err)
+
+	schedule, _ := k.GetSchedule(ctx, "owned")
+	require.Equal(t, uint64(20), schedule.Period)
+	require.Equal(t, newMsgs, schedule.Msgs)
+	require.Equal(t, uint64(10), schedule.LastExecuteHeight)
+
+	// switch to a time based trigger
+	interval := time.Hour
+	update = types.MsgUpdateSchedule{Authority: k.GetAuthority(), Name: "owned", Interval: &interval, Msgs: newMsgs}
+	_, err = msgServer.UpdateSchedule(ctx, &update)
+	require.NoError(t, err)
+
+	schedule, _ = k.GetSchedule(ctx, "owned")
+	require.Equal(t, types.ScheduleTrigger_SCHEDULE_TRIGGER_INTERVAL, schedule.Trigger())
+	require.Equal(t, ctx.BlockTime().Add(time.Hour), *schedule.NextExecuteTime)
+
+	update.Name = "missing"
+	_, err = msgServer.UpdateSchedule(ctx, &update)
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,132 @@
+package keeper
+
+import (
+	"fmt"
+
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// RegisterSchedule adds a new schedule owned by `schedule.Owner`, escrowing `deposit` in the module account.
+// Unlike schedules added by governance, owned schedules are subject to the schedule caps in the params
+// and pay the execution fee from their deposit every time they're executed.
+func (k *Keeper) RegisterSchedule(ctx sdk.Context, schedule types.Schedule, deposit sdk.Coin) error {
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		return errors.Wrap(err, "invalid owner")
+	}
+
+	params := k.GetParams(ctx)
+	if deposit.Denom != params.MinDeposit.Denom || deposit.IsLT(params.MinDeposit) {
+		return errors.Wrapf(types.ErrInsufficientDeposit, "expected at least %s, got %s", params.MinDeposit, deposit)
+	}
+	if uint64(k.getScheduleCount(ctx)) >= params.MaxSchedules { //nolint:gosec
+		return errors.Wrapf(types.ErrScheduleLimitReached, "max %d schedules", params.MaxSchedules)
+	}
+	if k.getOwnerScheduleCount(ctx, owner) >= params.MaxSchedulesPerOwner {
+		return errors.Wrapf(types.ErrScheduleLimitReached, "max %d schedules per owner", params.MaxSchedulesPerOwner)
+	}
+
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, owner, types.ModuleName, sdk.NewCoins(deposit)); err != nil {
+		return errors.Wrap(err, "failed to bond deposit")
+	}
+
+	schedule.Deposit = deposit
+	return k.CreateSchedule(ctx, schedule)
+}
+
+// refundDeposit returns the remaining deposit of an owned schedule to its owner
+func (k *Keeper) refundDeposit(ctx sdk.Context, schedule types.Schedule) error {
+	if schedule.Owner == "" || schedule.Deposit.IsNil() || !schedule.Deposit.IsPositive() {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		return errors.Wrap(err, "invalid owner")
+	}
+	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, owner, sdk.NewCoins(schedule.Deposit)); err != nil {
+		return errors.Wrapf(err, "failed to refund deposit of schedule %s", schedule.Name)
+	}
+	return nil
+}
+
+// refundScheduleAccount returns the balance of the account of an owned schedule to its owner.
+// The account is derived from the owner and the name, so it would be spent by the next schedule the owner
+// registers under the same name otherwise. The accounts of schedules added by governance are kept.
+func (k *Keeper) refundScheduleAccount(ctx sdk.Context, schedule types.Schedule) error {
+	if schedule.Owner == "" {
+		return nil
+	}
+	owner, err := sdk.AccAddressFromBech32(schedule.Owner)
+	if err != nil {
+		return errors.Wrap(err, "invalid owner")
+	}
+	account := schedule.Account()
+	balance := k.bankKeeper.GetAllBalances(ctx, account)
+	if balance.IsZero() {
+		return nil
+	}
+	if err := k.bankKeeper.SendCoins(ctx, account, owner, balance); err != nil {
+		return errors.Wrapf(err, "failed to refund account of schedule %s", schedule.Name)
+	}
+	return nil
+}
+
+// chargeExecutionFee pays the execution fee of an owned schedule from its deposit to the fee collector.
+// Schedules whose deposit can't cover the fee are disabled, in which case false is returned.
+// Schedules added by governance don't pay fees.
+func (k *Keeper) chargeExecutionFee(ctx sdk.Context, schedule *types.Schedule) bool {
+	if schedule.Owner == "" {
+		return true
+	}
+
+	fee := k.GetParams(ctx).ExecutionFee
+	if fee.IsZero() {
+		return true
+	}
+
+	var err error
+	if schedule.Deposit.Denom != fee.Denom || schedule.Deposit.IsLT(fee) {
+		err = fmt.Errorf("deposit %s can't cover the execution fee %s", schedule.Deposit, fee)
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, authtypes.FeeCollectorName, sdk.NewCoins(fee))
+	}
+	if err != nil {
+		k.Logger(ctx).Info("disabling schedule", "schedule", schedule.Name, "err", err)
+		schedule.Disabled = true
+		schedule.NextExecuteTime = nil
+		schedule.LastError = err.Error()
+		k.storeSchedule(ctx, *schedule)
+		return false
+	}
+
+	schedule.Deposit = schedule.Deposit.Sub(fee)
+	return true
+}
+
+func (k *Keeper) setOwnerIndex(ctx sdk.Context, schedule types.Schedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByOwnerKey)
+	store.Set(types.GetScheduleByOwnerKey(sdk.MustAccAddressFromBech32(schedule.Owner), schedule.Name), []byte{})
+}
+
+func (k *Keeper) removeOwnerIndex(ctx sdk.Context, schedule types.Schedule) {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByOwnerKey)
+	store.Delete(types.GetScheduleByOwnerKey(sdk.MustAccAddressFromBech32(schedule.Owner), schedule.Name))
+}
+
+func (k *Keeper) getOwnerScheduleCount(ctx sdk.Context, owner sdk.AccAddress) uint64 {
+	store := prefix.NewStore(ctx.KVStore(k.storeKey), types.ScheduleByOwnerKey)
+	iterator := storetypes.KVStorePrefixIterator(store, types.GetScheduleByOwnerPrefix(owner))
+	defer iterator.Close()
+
+	count := uint64(0)
+	for ; iterator.Valid(); iterator.Next() {
+		count++
+	}
+	return count
+}
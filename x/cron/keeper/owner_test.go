@@ -0,0 +1,193 @@
+package keeper_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	testkeeper "github.com/scrtlabs/SecretNetwork/testutil/cron/keeper"
+	cronkeeper "github.com/scrtlabs/SecretNetwork/x/cron/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// fakeBankKeeper keeps balances in memory, with module accounts keyed by their name
+type fakeBankKeeper struct {
+	balances map[string]sdk.Coins
+}
+
+func newFakeBankKeeper() *fakeBankKeeper {
+	return &fakeBankKeeper{balances: make(map[string]sdk.Coins)}
+}
+
+func (b *fakeBankKeeper) send(from, to string, amt sdk.Coins) error {
+	balance, negative := b.balances[from].SafeSub(amt...)
+	if negative {
+		return fmt.Errorf("insufficient funds: %s < %s", b.balances[from], amt)
+	}
+	b.balances[from] = balance
+	b.balances[to] = b.balances[to].Add(amt...)
+	return nil
+}
+
+func (b *fakeBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return b.balances[addr.String()]
+}
+
+func (b *fakeBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(fromAddr.String(), toAddr.String(), amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderAddr.String(), recipientModule, amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	return b.send(senderModule, recipientAddr.String(), amt)
+}
+
+func (b *fakeBankKeeper) SendCoinsFromModuleToModule(_ context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return b.send(senderModule, recipientModule, amt)
+}
+
+func ownedSchedule(name string, owner sdk.AccAddress) types.Schedule {
+	return types.Schedule{
+		Name:   name,
+		Period: 1,
+		Msgs: []types.MsgExecuteContract{
+			{
+				Contract: owner.String(),
+				Msg:      "msg",
+			},
+		},
+		Owner: owner.String(),
+	}
+}
+
+func TestRegisterSchedule(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := testkeeper.CronKeeper(t, nil, bank)
+
+	params := k.GetParams(ctx)
+	params.MaxSchedulesPerOwner = 2
+	params.MaxSchedules = 3
+	require.NoError(t, k.SetParams(ctx, params))
+
+	owner := sdk.AccAddress("owner_______________")
+	other := sdk.AccAddress("other_______________")
+	bank.balances[owner.String()] = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100_000_000))
+	bank.balances[other.String()] = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100_000_000))
+
+	// deposit below the minimum
+	err := k.RegisterSchedule(ctx, ownedSchedule("a", owner), sdk.NewInt64Coin("uscrt", 1))
+	require.ErrorIs(t, err, types.ErrInsufficientDeposit)
+
+	// deposit in the wrong denom
+	err = k.RegisterSchedule(ctx, ownedSchedule("a", owner), sdk.NewInt64Coin("uatom", 100_000_000))
+	require.ErrorIs(t, err, types.ErrInsufficientDeposit)
+
+	require.NoError(t, k.RegisterSchedule(ctx, ownedSchedule("a", owner), params.MinDeposit))
+	require.NoError(t, k.RegisterSchedule(ctx, ownedSchedule("b", owner), params.MinDeposit))
+	require.Equal(t, sdk.NewCoins(params.MinDeposit.Add(params.MinDeposit)), bank.balances[types.ModuleName])
+
+	schedule, found := k.GetSchedule(ctx, "a")
+	require.True(t, found)
+	require.Equal(t, owner.String(), schedule.Owner)
+	require.Equal(t, params.MinDeposit, schedule.Deposit)
+
+	// per owner cap
+	err = k.RegisterSchedule(ctx, ownedSchedule("c", owner), params.MinDeposit)
+	require.ErrorIs(t, err, types.ErrScheduleLimitReached)
+
+	// global cap
+	require.NoError(t, k.RegisterSchedule(ctx, ownedSchedule("c", other), params.MinDeposit))
+	err = k.RegisterSchedule(ctx, ownedSchedule("d", other), params.MinDeposit)
+	require.ErrorIs(t, err, types.ErrScheduleLimitReached)
+
+	// owner filter
+	resp, err := k.Schedules(ctx, &types.QuerySchedulesRequest{Owner: owner.String()})
+	require.NoError(t, err)
+	require.Len(t, resp.Schedules, 2)
+}
+
+func TestRemoveOwnedSchedule(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := testkeeper.CronKeeper(t, nil, bank)
+	msgServer := cronkeeper.NewMsgServerImpl(*k)
+
+	owner := sdk.AccAddress("owner_______________")
+	other := sdk.AccAddress("other_______________")
+	security := sdk.AccAddress("security____________")
+
+	params := k.GetParams(ctx)
+	params.SecurityAddress = security.String()
+	require.NoError(t, k.SetParams(ctx, params))
+
+	bank.balances[owner.String()] = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100_000_000))
+	require.NoError(t, k.RegisterSchedule(ctx, ownedSchedule("a", owner), params.MinDeposit))
+	require.NoError(t, k.RegisterSchedule(ctx, ownedSchedule("b", owner), params.MinDeposit))
+
+	_, err := msgServer.RemoveSchedule(ctx, &types.MsgRemoveSchedule{Authority: other.String(), Name: "a"})
+	require.ErrorContains(t, err, "invalid authority")
+
+	// the owner gets the deposit back
+	_, err = msgServer.RemoveSchedule(ctx, &types.MsgRemoveSchedule{Authority: owner.String(), Name: "a"})
+	require.NoError(t, err)
+	_, found := k.GetSchedule(ctx, "a")
+	require.False(t, found)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uscrt", 90_000_000)), bank.balances[owner.String()])
+
+	// the account of a schedule is derived from its owner, so another owner can't take it over by name
+	schedule, _ := k.GetSchedule(ctx, "b")
+	require.Equal(t, types.ScheduleAccountAddress(owner, "b"), schedule.Account())
+	require.NotEqual(t, types.ScheduleAccountAddress(other, "b"), schedule.Account())
+	bank.balances[schedule.Account().String()] = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 5))
+
+	// the deposit and the account balance are refunded to the owner when the security address removes the schedule
+	_, err = msgServer.RemoveSchedule(ctx, &types.MsgRemoveSchedule{Authority: security.String(), Name: "b"})
+	require.NoError(t, err)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uscrt", 100_000_005)), bank.balances[owner.String()])
+	require.True(t, bank.balances[types.ModuleName].IsZero())
+	require.True(t, bank.balances[schedule.Account().String()].IsZero())
+}
+
+func TestOwnedScheduleExecutionFee(t *testing.T) {
+	bank := newFakeBankKeeper()
+	k, ctx := testkeeper.CronKeeper(t, nil, bank)
+
+	params := k.GetParams(ctx)
+	params.MinDeposit = sdk.NewInt64Coin("uscrt", 25)
+	params.ExecutionFee = sdk.NewInt64Coin("uscrt", 10)
+	require.NoError(t, k.SetParams(ctx, params))
+
+	owner := sdk.AccAddress("owner_______________")
+	bank.balances[owner.String()] = sdk.NewCoins(sdk.NewInt64Coin("uscrt", 25))
+	require.NoError(t, k.RegisterSchedule(ctx, ownedSchedule("a", owner), params.MinDeposit))
+
+	// the first two executions are paid from the deposit
+	for height := int64(1); height <= 2; height++ {
+		ctx = ctx.WithBlockHeight(height)
+		scheduled := k.GetScheduledMsgs(ctx)
+		require.Len(t, scheduled, 1)
+		// owned schedules don't run as the scheduled tx sender of governance schedules
+		require.Equal(t, types.OwnerScheduledTxSender(owner), scheduled[0].Sender)
+		require.NotEqual(t, types.ScheduledTxSender(), scheduled[0].Sender)
+	}
+	schedule, _ := k.GetSchedule(ctx, "a")
+	require.Equal(t, sdk.NewInt64Coin("uscrt", 5), schedule.Deposit)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uscrt", 20)), bank.balances[authtypes.FeeCollectorName])
+
+	// the remaining deposit can't cover the fee
+	ctx = ctx.WithBlockHeight(3)
+	require.Empty(t, k.GetScheduledMsgs(ctx))
+	schedule, _ = k.GetSchedule(ctx, "a")
+	require.True(t, schedule.Disabled)
+	require.Contains(t, schedule.LastError, "can't cover the execution fee")
+
+	// the rest of the deposit is refunded on removal
+	require.NoError(t, k.RemoveSchedule(ctx, "a"))
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin("uscrt", 5)), bank.balances[owner.String()])
+}
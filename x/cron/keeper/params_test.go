@@ -11,11 +11,9 @@ import (
 )
 
 func TestGetParams(t *testing.T) {
-	k, ctx := testkeeper.CronKeeper(t, nil)
-	params := types.Params{
-		SecurityAddress: k.GetAuthority(),
-		Limit:           5,
-	}
+	k, ctx := testkeeper.CronKeeper(t, nil, nil)
+	params := types.DefaultParams()
+	params.SecurityAddress = k.GetAuthority()
 
 	err := k.SetParams(ctx, params)
 	require.NoError(t, err)
@@ -11,11 +11,40 @@ import (
 )
 
 // MigrateStore performs in-place store migrations.
-// The migration adds execution stage for schedules.
+// The migration adds execution stage for schedules and the deposit and cap params of owned schedules.
 func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	if err := migrateParams(ctx, cdc, storeKey); err != nil {
+		return err
+	}
 	return migrateSchedules(ctx, cdc, storeKey)
 }
 
+func migrateParams(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating cron Params...")
+
+	store := ctx.KVStore(storeKey)
+	var params types.Params
+	if bz := store.Get(types.ParamsKey); bz != nil {
+		cdc.MustUnmarshal(bz, &params)
+	} else {
+		params = types.DefaultParams()
+	}
+
+	params.MinDeposit = types.DefaultMinDeposit
+	params.ExecutionFee = types.DefaultExecutionFee
+	params.MaxSchedulesPerOwner = types.DefaultMaxSchedulesPerOwner
+	params.MaxSchedules = types.DefaultMaxSchedules
+	if err := params.Validate(); err != nil {
+		return errors.Wrap(err, "invalid migrated params")
+	}
+
+	store.Set(types.ParamsKey, cdc.MustMarshal(&params))
+
+	ctx.Logger().Info("Finished migrating cron Params...")
+
+	return nil
+}
+
 type migrationUpdate struct {
 	key []byte
 	val []byte
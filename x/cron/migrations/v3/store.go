@@ -0,0 +1,80 @@
+package v3
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration moves scheduled txs from the retired signing key to the unsigned scheduled tx sender.
+// The scheduled txs of the upgrade block were signed with the retired key and are rejected, so the
+// schedules they belong to are made due again instead of being recorded as failed.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating cron scheduled txs...")
+
+	clearPrefix(ctx.KVStore(storeKey), types.PendingExecutionKey)
+
+	executedStore := prefix.NewStore(ctx.KVStore(storeKey), types.ExecutedScheduleKey)
+	var executed []string
+	iterator := storetypes.KVStorePrefixIterator(executedStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		executed = append(executed, string(iterator.Key()))
+	}
+	if err := iterator.Close(); err != nil {
+		return errors.Wrap(err, "iterator failed to close during migration")
+	}
+
+	scheduleStore := prefix.NewStore(ctx.KVStore(storeKey), types.ScheduleKey)
+	timeStore := prefix.NewStore(ctx.KVStore(storeKey), types.ScheduleByTimeKey)
+	for _, name := range executed {
+		executedStore.Delete(types.GetScheduleKey(name))
+
+		bz := scheduleStore.Get(types.GetScheduleKey(name))
+		if bz == nil {
+			continue
+		}
+		var schedule types.Schedule
+		cdc.MustUnmarshal(bz, &schedule)
+
+		if schedule.Trigger() == types.ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD {
+			nextHeight := uint64(ctx.BlockHeight()) + 1 //nolint:gosec
+			if nextHeight >= schedule.Period {
+				schedule.LastExecuteHeight = nextHeight - schedule.Period
+			} else {
+				schedule.LastExecuteHeight = 0
+			}
+		} else {
+			if schedule.NextExecuteTime != nil {
+				timeStore.Delete(types.GetScheduleByTimeKey(*schedule.NextExecuteTime, schedule.Name))
+			}
+			schedule.NextExecuteTime = schedule.LastExecuteTime
+			if schedule.NextExecuteTime != nil && !schedule.Disabled {
+				timeStore.Set(types.GetScheduleByTimeKey(*schedule.NextExecuteTime, schedule.Name), []byte{})
+			}
+		}
+		scheduleStore.Set(types.GetScheduleKey(name), cdc.MustMarshal(&schedule))
+	}
+
+	ctx.Logger().Info("Finished migrating cron scheduled txs...")
+
+	return nil
+}
+
+func clearPrefix(store storetypes.KVStore, keyPrefix []byte) {
+	prefixStore := prefix.NewStore(store, keyPrefix)
+	var keys [][]byte
+	iterator := storetypes.KVStorePrefixIterator(prefixStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		keys = append(keys, iterator.Key())
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		prefixStore.Delete(key)
+	}
+}
@@ -0,0 +1,39 @@
+package v4
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/cron/types"
+)
+
+// MigrateStore performs in-place store migrations.
+// The migration queues the block period schedules by their due height, so that due schedules are
+// looked up in the queue instead of by iterating over all schedules. Existing schedules have normal priority.
+// Time based schedules are queued from the time index once they're due, so they're left as they are.
+func MigrateStore(ctx sdk.Context, cdc codec.BinaryCodec, storeKey storetypes.StoreKey) error {
+	ctx.Logger().Info("Migrating cron schedule queue...")
+
+	scheduleStore := prefix.NewStore(ctx.KVStore(storeKey), types.ScheduleKey)
+	queueStore := prefix.NewStore(ctx.KVStore(storeKey), types.ScheduleQueueKey)
+
+	iterator := storetypes.KVStorePrefixIterator(scheduleStore, []byte{})
+	for ; iterator.Valid(); iterator.Next() {
+		var schedule types.Schedule
+		cdc.MustUnmarshal(iterator.Value(), &schedule)
+
+		if dueHeight, queued := schedule.QueuedHeight(); queued {
+			queueStore.Set(types.GetScheduleQueueKey(schedule.Priority, dueHeight, schedule.Name), []byte{})
+		}
+	}
+	if err := iterator.Close(); err != nil {
+		return errors.Wrap(err, "iterator failed to close during migration")
+	}
+
+	ctx.Logger().Info("Finished migrating cron schedule queue...")
+
+	return nil
+}
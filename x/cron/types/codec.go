@@ -16,7 +16,11 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgUpdateParams{},
 		&MsgAddSchedule{},
+		&MsgRegisterSchedule{},
 		&MsgRemoveSchedule{},
+		&MsgUpdateSchedule{},
+		&MsgPauseSchedule{},
+		&MsgResumeSchedule{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
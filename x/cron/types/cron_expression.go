@@ -0,0 +1,202 @@
+package types
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// cronSearchYears is how far ahead CronExpression.Next looks for a matching time.
+// Eight years always include a leap day, so "0 0 29 2 *" is found.
+const cronSearchYears = 8
+
+// CronExpression is a parsed standard 5 field cron expression:
+//
+//	minute hour day-of-month month day-of-week
+//
+// Fields support `*`, lists (`1,2`), ranges (`1-5`), steps (`*/15`, `1-30/2`),
+// month names (JAN-DEC) and day names (SUN-SAT, 0 and 7 are both Sunday).
+// The descriptors @yearly, @annually, @monthly, @weekly, @daily, @midnight
+// and @hourly are supported as well. Expressions are always evaluated in UTC.
+type CronExpression struct {
+	minute, hour, dom, month, dow uint64
+	// like in standard cron, if both day fields are restricted a day matches if either of them matches
+	domStar, dowStar bool
+}
+
+type cronField struct {
+	min, max uint
+	names    map[string]uint
+}
+
+var (
+	cronMinutes = cronField{min: 0, max: 59}
+	cronHours   = cronField{min: 0, max: 23}
+	cronDom     = cronField{min: 1, max: 31}
+	cronMonths  = cronField{min: 1, max: 12, names: map[string]uint{
+		"jan": 1, "feb": 2, "mar": 3, "apr": 4, "may": 5, "jun": 6,
+		"jul": 7, "aug": 8, "sep": 9, "oct": 10, "nov": 11, "dec": 12,
+	}}
+	// 7 is accepted for Sunday and folded into 0 after parsing
+	cronDow = cronField{min: 0, max: 7, names: map[string]uint{
+		"sun": 0, "mon": 1, "tue": 2, "wed": 3, "thu": 4, "fri": 5, "sat": 6,
+	}}
+)
+
+var cronDescriptors = map[string]string{
+	"@yearly":   "0 0 1 1 *",
+	"@annually": "0 0 1 1 *",
+	"@monthly":  "0 0 1 * *",
+	"@weekly":   "0 0 * * 0",
+	"@daily":    "0 0 * * *",
+	"@midnight": "0 0 * * *",
+	"@hourly":   "0 * * * *",
+}
+
+// ParseCronExpression parses a standard 5 field cron expression
+func ParseCronExpression(expr string) (*CronExpression, error) {
+	expr = strings.TrimSpace(expr)
+	if descriptor, ok := cronDescriptors[strings.ToLower(expr)]; ok {
+		expr = descriptor
+	}
+
+	fields := strings.Fields(expr)
+	if len(fields) != 5 {
+		return nil, fmt.Errorf("cron expression must have 5 fields, got %d: '%s'", len(fields), expr)
+	}
+
+	var (
+		c   CronExpression
+		err error
+	)
+	if c.minute, err = parseCronField(fields[0], cronMinutes); err != nil {
+		return nil, fmt.Errorf("invalid minute field: %w", err)
+	}
+	if c.hour, err = parseCronField(fields[1], cronHours); err != nil {
+		return nil, fmt.Errorf("invalid hour field: %w", err)
+	}
+	if c.dom, err = parseCronField(fields[2], cronDom); err != nil {
+		return nil, fmt.Errorf("invalid day of month field: %w", err)
+	}
+	if c.month, err = parseCronField(fields[3], cronMonths); err != nil {
+		return nil, fmt.Errorf("invalid month field: %w", err)
+	}
+	if c.dow, err = parseCronField(fields[4], cronDow); err != nil {
+		return nil, fmt.Errorf("invalid day of week field: %w", err)
+	}
+	if c.dow&(1<<7) != 0 {
+		c.dow = c.dow&^(1<<7) | 1
+	}
+	c.domStar = fields[2] == "*" || fields[2] == "?"
+	c.dowStar = fields[4] == "*" || fields[4] == "?"
+
+	// reject expressions that never fire, e.g. "0 0 30 2 *"
+	if c.Next(time.Date(2000, 1, 1, 0, 0, 0, 0, time.UTC)).IsZero() {
+		return nil, fmt.Errorf("cron expression never matches: '%s'", expr)
+	}
+
+	return &c, nil
+}
+
+func parseCronField(field string, f cronField) (uint64, error) {
+	var bits uint64
+	for _, part := range strings.Split(field, ",") {
+		if part == "" {
+			return 0, fmt.Errorf("empty list item in '%s'", field)
+		}
+
+		rangePart, step := part, uint(1)
+		if i := strings.Index(part, "/"); i >= 0 {
+			s, err := strconv.ParseUint(part[i+1:], 10, 8)
+			if err != nil || s == 0 {
+				return 0, fmt.Errorf("invalid step in '%s'", part)
+			}
+			rangePart, step = part[:i], uint(s)
+		}
+
+		var low, high uint
+		switch {
+		case rangePart == "*" || rangePart == "?":
+			low, high = f.min, f.max
+		case strings.Contains(rangePart, "-"):
+			bounds := strings.SplitN(rangePart, "-", 2)
+			var err error
+			if low, err = parseCronValue(bounds[0], f); err != nil {
+				return 0, err
+			}
+			if high, err = parseCronValue(bounds[1], f); err != nil {
+				return 0, err
+			}
+			if low > high {
+				return 0, fmt.Errorf("invalid range '%s'", rangePart)
+			}
+		default:
+			v, err := parseCronValue(rangePart, f)
+			if err != nil {
+				return 0, err
+			}
+			low, high = v, v
+			// "5/10" means every 10 starting at 5
+			if step > 1 {
+				high = f.max
+			}
+		}
+
+		for v := low; v <= high; v += step {
+			bits |= 1 << v
+		}
+	}
+	return bits, nil
+}
+
+func parseCronValue(s string, f cronField) (uint, error) {
+	if v, ok := f.names[strings.ToLower(s)]; ok {
+		return v, nil
+	}
+	v, err := strconv.ParseUint(s, 10, 8)
+	if err != nil {
+		return 0, fmt.Errorf("invalid value '%s'", s)
+	}
+	if uint(v) < f.min || uint(v) > f.max {
+		return 0, fmt.Errorf("value %d out of range [%d, %d]", v, f.min, f.max)
+	}
+	return uint(v), nil
+}
+
+// Next returns the first time strictly after t that matches the expression,
+// or the zero time if there's no match in the next few years
+func (c CronExpression) Next(t time.Time) time.Time {
+	t = t.UTC().Truncate(time.Minute).Add(time.Minute)
+	limit := t.AddDate(cronSearchYears, 0, 0)
+
+	for t.Before(limit) {
+		if c.month&(1<<uint(t.Month())) == 0 {
+			t = time.Date(t.Year(), t.Month()+1, 1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if !c.dayMatches(t) {
+			t = time.Date(t.Year(), t.Month(), t.Day()+1, 0, 0, 0, 0, time.UTC)
+			continue
+		}
+		if c.hour&(1<<uint(t.Hour())) == 0 {
+			t = t.Truncate(time.Hour).Add(time.Hour)
+			continue
+		}
+		if c.minute&(1<<uint(t.Minute())) == 0 {
+			t = t.Add(time.Minute)
+			continue
+		}
+		return t
+	}
+	return time.Time{}
+}
+
+func (c CronExpression) dayMatches(t time.Time) bool {
+	domMatch := c.dom&(1<<uint(t.Day())) != 0
+	dowMatch := c.dow&(1<<uint(t.Weekday())) != 0
+	if c.domStar || c.dowStar {
+		return domMatch && dowMatch
+	}
+	return domMatch || dowMatch
+}
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestCronExpressionNext(t *testing.T) {
+	// a Wednesday
+	from := time.Date(2024, 1, 3, 10, 30, 15, 0, time.UTC)
+
+	tests := []struct {
+		expr string
+		next time.Time
+	}{
+		{"* * * * *", time.Date(2024, 1, 3, 10, 31, 0, 0, time.UTC)},
+		{"0 0 * * *", time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"@daily", time.Date(2024, 1, 4, 0, 0, 0, 0, time.UTC)},
+		{"@hourly", time.Date(2024, 1, 3, 11, 0, 0, 0, time.UTC)},
+		{"*/15 * * * *", time.Date(2024, 1, 3, 10, 45, 0, 0, time.UTC)},
+		{"30 10 * * *", time.Date(2024, 1, 4, 10, 30, 0, 0, time.UTC)},
+		{"0 9-17/4 * * *", time.Date(2024, 1, 3, 13, 0, 0, 0, time.UTC)},
+		{"0 0 * * MON", time.Date(2024, 1, 8, 0, 0, 0, 0, time.UTC)},
+		{"0 0 * * 7", time.Date(2024, 1, 7, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1 * *", time.Date(2024, 2, 1, 0, 0, 0, 0, time.UTC)},
+		{"0 0 1,15 * *", time.Date(2024, 1, 15, 0, 0, 0, 0, time.UTC)},
+		{"0 0 29 feb *", time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)},
+		{"0 0 31 * *", time.Date(2024, 1, 31, 0, 0, 0, 0, time.UTC)},
+		// day of month or day of week, when both are restricted
+		{"0 0 10 * 5", time.Date(2024, 1, 5, 0, 0, 0, 0, time.UTC)},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.expr, func(t *testing.T) {
+			expr, err := ParseCronExpression(tt.expr)
+			require.NoError(t, err)
+			require.Equal(t, tt.next, expr.Next(from))
+		})
+	}
+}
+
+func TestParseCronExpressionInvalid(t *testing.T) {
+	for _, expr := range []string{
+		"",
+		"* * * *",
+		"* * * * * *",
+		"60 * * * *",
+		"* 24 * * *",
+		"* * 0 * *",
+		"* * * 13 *",
+		"* * * * 8",
+		"*/0 * * * *",
+		"5-1 * * * *",
+		"1,,2 * * * *",
+		"a * * * *",
+		"0 0 30 2 *",
+	} {
+		_, err := ParseCronExpression(expr)
+		require.Error(t, err, expr)
+	}
+}
@@ -8,5 +8,8 @@ import (
 
 // x/cron module sentinel errors
 var (
-	ErrSample = errors.Register(ModuleName, 1100, "sample error")
+	ErrSample               = errors.Register(ModuleName, 1100, "sample error")
+	ErrInsufficientDeposit  = errors.Register(ModuleName, 1101, "insufficient schedule deposit")
+	ErrScheduleLimitReached = errors.Register(ModuleName, 1102, "schedule limit reached")
+	ErrUnscheduledTx        = errors.Register(ModuleName, 1103, "tx was not scheduled")
 )
@@ -15,6 +15,16 @@ type AccountKeeper interface {
 	// Methods imported from account should be defined here
 }
 
+// BankKeeper defines the expected bank keeper, used to escrow the deposits of owned schedules
+// and to refund their accounts
+type BankKeeper interface {
+	GetAllBalances(ctx context.Context, addr sdk.AccAddress) sdk.Coins
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	SendCoinsFromModuleToAccount(ctx context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error
+}
+
 type RegKeeper interface {
 	GetMasterKey(ctx sdk.Context, keyType string) *regtypes.MasterKey
 }
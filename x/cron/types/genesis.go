@@ -17,6 +17,9 @@ func (gs GenesisState) Validate() error {
 	scheduleIndexMap := make(map[string]struct{})
 
 	for _, elem := range gs.ScheduleList {
+		if err := elem.Validate(); err != nil {
+			return err
+		}
 		index := string(GetScheduleKey(elem.Name))
 		if _, ok := scheduleIndexMap[index]; ok {
 			return fmt.Errorf("duplicated index for schedule")
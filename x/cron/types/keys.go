@@ -1,5 +1,13 @@
 package types
 
+import (
+	"crypto/sha256"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
 const (
 	// ModuleName defines the module name
 	ModuleName = "cron"
@@ -18,14 +26,102 @@ const (
 	prefixScheduleKey = iota + 1
 	prefixScheduleCountKey
 	prefixParamsKey
+	prefixScheduleByTimeKey
+	prefixPendingExecutionKey
+	prefixExecutedScheduleKey
+	prefixScheduleExecutionKey
+	prefixScheduleByOwnerKey
+	prefixScheduledTxKey
+	prefixScheduleQueueKey
 )
 
 var (
 	ScheduleKey      = []byte{prefixScheduleKey}
 	ScheduleCountKey = []byte{prefixScheduleCountKey}
 	ParamsKey        = []byte{prefixParamsKey}
+	// ScheduleByTimeKey indexes time based schedules by their next execution time
+	ScheduleByTimeKey = []byte{prefixScheduleByTimeKey}
+	// PendingExecutionKey maps the hash of each scheduled tx of the last block to its schedule.
+	// Successful executions delete their entry, so whatever is left at the next EndBlock failed
+	PendingExecutionKey = []byte{prefixPendingExecutionKey}
+	// ExecutedScheduleKey holds the schedules that were scheduled for execution in the last block
+	ExecutedScheduleKey = []byte{prefixExecutedScheduleKey}
+	// ScheduleExecutionKey holds the last MaxScheduleExecutions executions of every schedule
+	ScheduleExecutionKey = []byte{prefixScheduleExecutionKey}
+	// ScheduleByOwnerKey indexes owned schedules by their owner
+	ScheduleByOwnerKey = []byte{prefixScheduleByOwnerKey}
+	// ScheduledTxKey holds the hashes of the scheduled txs of the last block. Only those txs can
+	// be sent by the scheduled tx sender
+	ScheduledTxKey = []byte{prefixScheduledTxKey}
+	// ScheduleQueueKey indexes the schedules that are queued for execution by priority tier and due height,
+	// see Schedule.QueuedHeight
+	ScheduleQueueKey = []byte{prefixScheduleQueueKey}
 )
 
+// MaxScheduleExecutions is the number of executions kept per schedule
+const MaxScheduleExecutions = 100
+
 func GetScheduleKey(name string) []byte {
 	return []byte(name)
 }
+
+// GetPendingExecutionKey returns the key of a pending scheduled tx by the hash of its bytes
+func GetPendingExecutionKey(txBytes []byte) []byte {
+	return GetScheduledTxKey(txBytes)
+}
+
+// GetScheduledTxKey returns the key of a scheduled tx by the hash of its bytes
+func GetScheduledTxKey(txBytes []byte) []byte {
+	hash := sha256.Sum256(txBytes)
+	return hash[:]
+}
+
+// GetScheduleExecutionsPrefix returns the prefix of the executions of a schedule.
+// Names are hashed since they aren't length bounded and can't be used as a prefix directly.
+func GetScheduleExecutionsPrefix(name string) []byte {
+	hash := sha256.Sum256([]byte(name))
+	return hash[:]
+}
+
+// GetScheduleExecutionKey returns the key of an execution: `<hash of name><height><tx hash>`
+func GetScheduleExecutionKey(name string, height uint64, txHash []byte) []byte {
+	key := GetScheduleExecutionsPrefix(name)
+	key = append(key, sdk.Uint64ToBigEndian(height)...)
+	return append(key, txHash...)
+}
+
+// GetScheduleByOwnerPrefix returns the prefix of the owner index entries of an owner
+func GetScheduleByOwnerPrefix(owner sdk.AccAddress) []byte {
+	return address.MustLengthPrefix(owner)
+}
+
+// GetScheduleByOwnerKey returns the owner index key of a schedule: `<length prefixed owner><name>`
+func GetScheduleByOwnerKey(owner sdk.AccAddress, name string) []byte {
+	return append(GetScheduleByOwnerPrefix(owner), []byte(name)...)
+}
+
+// GetScheduleQueueKey returns the queue key of a schedule: `<priority tier><due height><name>`
+func GetScheduleQueueKey(priority SchedulePriority, dueHeight uint64, name string) []byte {
+	key := append([]byte{priority.Tier()}, sdk.Uint64ToBigEndian(dueHeight)...)
+	return append(key, []byte(name)...)
+}
+
+// GetScheduleQueueEnd returns the end of the queue keys of a priority tier that are due at or before `height`
+func GetScheduleQueueEnd(priority SchedulePriority, height uint64) []byte {
+	return append([]byte{priority.Tier()}, sdk.Uint64ToBigEndian(height+1)...)
+}
+
+// ParseScheduleQueueKey returns the due height and the schedule name from a queue key
+func ParseScheduleQueueKey(key []byte) (uint64, string) {
+	return sdk.BigEndianToUint64(key[1:9]), string(key[9:])
+}
+
+// GetScheduleByTimeKey returns the time index key of a schedule: `<next execute time><name>`
+func GetScheduleByTimeKey(nextExecuteTime time.Time, name string) []byte {
+	return append(sdk.FormatTimeBytes(nextExecuteTime), []byte(name)...)
+}
+
+// ParseScheduleByTimeKey returns the schedule name from a time index key
+func ParseScheduleByTimeKey(key []byte) string {
+	return string(key[len(sdk.SortableTimeFormat):])
+}
@@ -11,11 +11,19 @@ import (
 var _ paramtypes.ParamSet = (*Params)(nil)
 
 var (
-	KeySecurityAddress = []byte("SecurityAddress")
-	KeyLimit           = []byte("Limit")
-
-	DefaultSecurityAddress = ""
-	DefaultLimit           = uint64(5)
+	KeySecurityAddress      = []byte("SecurityAddress")
+	KeyLimit                = []byte("Limit")
+	KeyMinDeposit           = []byte("MinDeposit")
+	KeyExecutionFee         = []byte("ExecutionFee")
+	KeyMaxSchedulesPerOwner = []byte("MaxSchedulesPerOwner")
+	KeyMaxSchedules         = []byte("MaxSchedules")
+
+	DefaultSecurityAddress      = ""
+	DefaultLimit                = uint64(5)
+	DefaultMinDeposit           = sdk.NewInt64Coin("uscrt", 10_000_000)
+	DefaultExecutionFee         = sdk.NewInt64Coin("uscrt", 10_000)
+	DefaultMaxSchedulesPerOwner = uint64(10)
+	DefaultMaxSchedules         = uint64(1000)
 )
 
 // ParamKeyTable the param key table for launch module
@@ -24,16 +32,34 @@ func ParamKeyTable() paramtypes.KeyTable {
 }
 
 // NewParams creates a new Params instance
-func NewParams(securityAddress string, limit uint64) Params {
+func NewParams(
+	securityAddress string,
+	limit uint64,
+	minDeposit sdk.Coin,
+	executionFee sdk.Coin,
+	maxSchedulesPerOwner uint64,
+	maxSchedules uint64,
+) Params {
 	return Params{
-		SecurityAddress: securityAddress,
-		Limit:           limit,
+		SecurityAddress:      securityAddress,
+		Limit:                limit,
+		MinDeposit:           minDeposit,
+		ExecutionFee:         executionFee,
+		MaxSchedulesPerOwner: maxSchedulesPerOwner,
+		MaxSchedules:         maxSchedules,
 	}
 }
 
 // DefaultParams returns a default set of parameters
 func DefaultParams() Params {
-	return NewParams(DefaultSecurityAddress, DefaultLimit)
+	return NewParams(
+		DefaultSecurityAddress,
+		DefaultLimit,
+		DefaultMinDeposit,
+		DefaultExecutionFee,
+		DefaultMaxSchedulesPerOwner,
+		DefaultMaxSchedules,
+	)
 }
 
 // ParamSetPairs get the params.ParamSet
@@ -49,6 +75,26 @@ func (p *Params) ParamSetPairs() paramtypes.ParamSetPairs {
 			&p.Limit,
 			validateLimit,
 		),
+		paramtypes.NewParamSetPair(
+			KeyMinDeposit,
+			&p.MinDeposit,
+			validateCoin,
+		),
+		paramtypes.NewParamSetPair(
+			KeyExecutionFee,
+			&p.ExecutionFee,
+			validateCoin,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxSchedulesPerOwner,
+			&p.MaxSchedulesPerOwner,
+			validateMaxSchedules,
+		),
+		paramtypes.NewParamSetPair(
+			KeyMaxSchedules,
+			&p.MaxSchedules,
+			validateMaxSchedules,
+		),
 	}
 }
 
@@ -64,6 +110,26 @@ func (p Params) Validate() error {
 		return fmt.Errorf("invalid limit: %w", err)
 	}
 
+	if err := validateCoin(p.MinDeposit); err != nil {
+		return fmt.Errorf("invalid min deposit: %w", err)
+	}
+
+	if err := validateCoin(p.ExecutionFee); err != nil {
+		return fmt.Errorf("invalid execution fee: %w", err)
+	}
+
+	if p.ExecutionFee.Denom != p.MinDeposit.Denom {
+		return fmt.Errorf("execution fee denom %s must match the min deposit denom %s", p.ExecutionFee.Denom, p.MinDeposit.Denom)
+	}
+
+	if err := validateMaxSchedules(p.MaxSchedulesPerOwner); err != nil {
+		return fmt.Errorf("invalid max schedules per owner: %w", err)
+	}
+
+	if err := validateMaxSchedules(p.MaxSchedules); err != nil {
+		return fmt.Errorf("invalid max schedules: %w", err)
+	}
+
 	return nil
 }
 
@@ -104,3 +170,22 @@ func validateLimit(i interface{}) error {
 
 	return nil
 }
+
+func validateCoin(i interface{}) error {
+	c, ok := i.(sdk.Coin)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	return c.Validate()
+}
+
+func validateMaxSchedules(i interface{}) error {
+	_, ok := i.(uint64)
+	if !ok {
+		return fmt.Errorf("invalid parameter type: %T", i)
+	}
+
+	// zero means that schedules can only be added by governance
+	return nil
+}
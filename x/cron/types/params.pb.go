@@ -5,6 +5,7 @@ package types
 
 import (
 	fmt "fmt"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
 	io "io"
@@ -29,6 +30,15 @@ type Params struct {
 	SecurityAddress string `protobuf:"bytes,1,opt,name=security_address,json=securityAddress,proto3" json:"security_address,omitempty"`
 	// Limit of schedules executed in one block
 	Limit uint64 `protobuf:"varint,2,opt,name=limit,proto3" json:"limit,omitempty"`
+	// Minimum deposit bonded by the owner of a schedule registered without governance.
+	// Its denom is the denom deposits and execution fees are paid in
+	MinDeposit types.Coin `protobuf:"bytes,3,opt,name=min_deposit,json=minDeposit,proto3" json:"min_deposit"`
+	// Fee paid from the deposit of an owned schedule every time it's executed
+	ExecutionFee types.Coin `protobuf:"bytes,4,opt,name=execution_fee,json=executionFee,proto3" json:"execution_fee"`
+	// Maximum number of schedules a single owner can register
+	MaxSchedulesPerOwner uint64 `protobuf:"varint,5,opt,name=max_schedules_per_owner,json=maxSchedulesPerOwner,proto3" json:"max_schedules_per_owner,omitempty"`
+	// Maximum number of schedules, above which no more schedules can be registered without governance
+	MaxSchedules uint64 `protobuf:"varint,6,opt,name=max_schedules,json=maxSchedules,proto3" json:"max_schedules,omitempty"`
 }
 
 func (m *Params) Reset()      { *m = Params{} }
@@ -77,6 +87,34 @@ func (m *Params) GetLimit() uint64 {
 	return 0
 }
 
+func (m *Params) GetMinDeposit() types.Coin {
+	if m != nil {
+		return m.MinDeposit
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetExecutionFee() types.Coin {
+	if m != nil {
+		return m.ExecutionFee
+	}
+	return types.Coin{}
+}
+
+func (m *Params) GetMaxSchedulesPerOwner() uint64 {
+	if m != nil {
+		return m.MaxSchedulesPerOwner
+	}
+	return 0
+}
+
+func (m *Params) GetMaxSchedules() uint64 {
+	if m != nil {
+		return m.MaxSchedules
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*Params)(nil), "secret.cron.Params")
 }
@@ -84,20 +122,30 @@ func init() {
 func init() { proto.RegisterFile("secret/cron/params.proto", fileDescriptor_b2b633a1a1b37414) }
 
 var fileDescriptor_b2b633a1a1b37414 = []byte{
-	// 207 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x28, 0x4e, 0x4d, 0x2e,
-	0x4a, 0x2d, 0xd1, 0x4f, 0x2e, 0xca, 0xcf, 0xd3, 0x2f, 0x48, 0x2c, 0x4a, 0xcc, 0x2d, 0xd6, 0x2b,
-	0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x86, 0xc8, 0xe8, 0x81, 0x64, 0xa4, 0x44, 0xd2, 0xf3, 0xd3,
-	0xf3, 0xc1, 0xe2, 0xfa, 0x20, 0x16, 0x44, 0x89, 0x92, 0x3f, 0x17, 0x5b, 0x00, 0x58, 0x8b, 0x90,
-	0x26, 0x97, 0x40, 0x71, 0x6a, 0x72, 0x69, 0x51, 0x66, 0x49, 0x65, 0x7c, 0x62, 0x4a, 0x4a, 0x51,
-	0x6a, 0x71, 0xb1, 0x04, 0xa3, 0x02, 0xa3, 0x06, 0x67, 0x10, 0x3f, 0x4c, 0xdc, 0x11, 0x22, 0x2c,
-	0x24, 0xc2, 0xc5, 0x9a, 0x93, 0x99, 0x9b, 0x59, 0x22, 0xc1, 0xa4, 0xc0, 0xa8, 0xc1, 0x12, 0x04,
-	0xe1, 0x58, 0xb1, 0xcc, 0x58, 0x20, 0xcf, 0xe0, 0xe4, 0x71, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47,
-	0x72, 0x8c, 0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d,
-	0xc7, 0x72, 0x0c, 0x51, 0x7a, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa,
-	0xc5, 0xc9, 0x45, 0x25, 0x39, 0x89, 0x49, 0xc5, 0xfa, 0xc1, 0x60, 0x17, 0xfa, 0xa5, 0x96, 0x94,
-	0xe7, 0x17, 0x65, 0xeb, 0x57, 0x40, 0x3c, 0x51, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76,
-	0xa1, 0x31, 0x20, 0x00, 0x00, 0xff, 0xff, 0xf9, 0xd2, 0x4e, 0xc9, 0xe0, 0x00, 0x00, 0x00,
+	// 355 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x51, 0xbf, 0x6e, 0xda, 0x40,
+	0x18, 0xb7, 0xa9, 0x41, 0xea, 0x01, 0x6a, 0x65, 0x21, 0xd5, 0x65, 0x30, 0xa8, 0x5d, 0xe8, 0x72,
+	0x27, 0x5a, 0x75, 0xe9, 0xd4, 0x10, 0x14, 0x65, 0x4a, 0x10, 0x6c, 0x59, 0xac, 0xf3, 0xf9, 0x0b,
+	0x9c, 0xc2, 0xf9, 0xac, 0xbb, 0x73, 0x30, 0x6f, 0x91, 0x31, 0x63, 0xde, 0x21, 0x2f, 0xc1, 0xc8,
+	0x98, 0x29, 0x8a, 0xe0, 0x45, 0x22, 0xfb, 0x42, 0x94, 0x6c, 0xd9, 0xbe, 0xef, 0xf7, 0x4f, 0x3f,
+	0xe9, 0x87, 0x02, 0x0d, 0x4c, 0x81, 0x21, 0x4c, 0xc9, 0x94, 0x64, 0x54, 0x51, 0xa1, 0x71, 0xa6,
+	0xa4, 0x91, 0x7e, 0xd3, 0x32, 0xb8, 0x64, 0xba, 0x21, 0x93, 0x5a, 0x48, 0x4d, 0x62, 0xaa, 0x81,
+	0x5c, 0x0f, 0x63, 0x30, 0x74, 0x48, 0x98, 0xe4, 0xa9, 0x15, 0x77, 0x3b, 0x73, 0x39, 0x97, 0xd5,
+	0x49, 0xca, 0xcb, 0xa2, 0x3f, 0xee, 0x6b, 0xa8, 0x31, 0xa9, 0x32, 0xfd, 0x5f, 0xe8, 0xab, 0x06,
+	0x96, 0x2b, 0x6e, 0xd6, 0x11, 0x4d, 0x12, 0x05, 0x5a, 0x07, 0x6e, 0xdf, 0x1d, 0x7c, 0x9e, 0x7e,
+	0x39, 0xe0, 0x47, 0x16, 0xf6, 0x3b, 0xa8, 0xbe, 0xe4, 0x82, 0x9b, 0xa0, 0xd6, 0x77, 0x07, 0xde,
+	0xd4, 0x3e, 0xfe, 0x7f, 0xd4, 0x14, 0x3c, 0x8d, 0x12, 0xc8, 0xa4, 0xe6, 0x26, 0xf8, 0xd4, 0x77,
+	0x07, 0xcd, 0xdf, 0xdf, 0xb1, 0xed, 0x85, 0xcb, 0x5e, 0xf8, 0xa5, 0x17, 0x3e, 0x96, 0x3c, 0x1d,
+	0x79, 0x9b, 0xc7, 0x9e, 0x33, 0x45, 0x82, 0xa7, 0x63, 0x6b, 0xf1, 0xc7, 0xa8, 0x0d, 0x05, 0xb0,
+	0xdc, 0x70, 0x99, 0x46, 0x97, 0x00, 0x81, 0xf7, 0xb1, 0x8c, 0xd6, 0xab, 0xeb, 0x04, 0xc0, 0xff,
+	0x8b, 0xbe, 0x09, 0x5a, 0x44, 0x9a, 0x2d, 0x20, 0xc9, 0x97, 0xa0, 0xa3, 0x0c, 0x54, 0x24, 0x57,
+	0x29, 0xa8, 0xa0, 0x5e, 0xf5, 0xed, 0x08, 0x5a, 0xcc, 0x0e, 0xec, 0x04, 0xd4, 0x79, 0xc9, 0xf9,
+	0x3f, 0x51, 0xfb, 0x9d, 0x2d, 0x68, 0x54, 0xe2, 0xd6, 0x5b, 0xf1, 0x3f, 0xef, 0xf6, 0xae, 0xe7,
+	0x8c, 0x4e, 0x37, 0xbb, 0xd0, 0xdd, 0xee, 0x42, 0xf7, 0x69, 0x17, 0xba, 0x37, 0xfb, 0xd0, 0xd9,
+	0xee, 0x43, 0xe7, 0x61, 0x1f, 0x3a, 0x17, 0x78, 0xce, 0xcd, 0x22, 0x8f, 0x31, 0x93, 0x82, 0x68,
+	0xa6, 0xcc, 0x92, 0xc6, 0x9a, 0xcc, 0xaa, 0x99, 0xce, 0xc0, 0xac, 0xa4, 0xba, 0x22, 0x85, 0x5d,
+	0xd2, 0xac, 0x33, 0xd0, 0x71, 0xa3, 0x9a, 0xe1, 0xcf, 0xf3, 0x00, 0x7f, 0xbb, 0xe1, 0x8d, 0xe5,
+	0x01, 0x00, 0x00,
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -120,6 +168,36 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MaxSchedules != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSchedules))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.MaxSchedulesPerOwner != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSchedulesPerOwner))
+		i--
+		dAtA[i] = 0x28
+	}
+	{
+		size, err := m.ExecutionFee.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
+	{
+		size, err := m.MinDeposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
 	if m.Limit != 0 {
 		i = encodeVarintParams(dAtA, i, uint64(m.Limit))
 		i--
@@ -159,6 +237,16 @@ func (m *Params) Size() (n int) {
 	if m.Limit != 0 {
 		n += 1 + sovParams(uint64(m.Limit))
 	}
+	l = m.MinDeposit.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.ExecutionFee.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxSchedulesPerOwner != 0 {
+		n += 1 + sovParams(uint64(m.MaxSchedulesPerOwner))
+	}
+	if m.MaxSchedules != 0 {
+		n += 1 + sovParams(uint64(m.MaxSchedules))
+	}
 	return n
 }
 
@@ -248,6 +336,110 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinDeposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MinDeposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutionFee", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.ExecutionFee.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchedulesPerOwner", wireType)
+			}
+			m.MaxSchedulesPerOwner = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchedulesPerOwner |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSchedules", wireType)
+			}
+			m.MaxSchedules = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSchedules |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -161,6 +161,8 @@ func (m *QueryGetScheduleRequest) GetName() string {
 // The response type for the Query/Params RPC method.
 type QueryGetScheduleResponse struct {
 	Schedule Schedule `protobuf:"bytes,1,opt,name=schedule,proto3" json:"schedule"`
+	// The account that funds the msgs of the schedule
+	Account string `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
 }
 
 func (m *QueryGetScheduleResponse) Reset()         { *m = QueryGetScheduleResponse{} }
@@ -203,9 +205,22 @@ func (m *QueryGetScheduleResponse) GetSchedule() Schedule {
 	return Schedule{}
 }
 
+func (m *QueryGetScheduleResponse) GetAccount() string {
+	if m != nil {
+		return m.Account
+	}
+	return ""
+}
+
 // The request type for the Query/Schedules RPC method.
 type QuerySchedulesRequest struct {
 	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+	// Only return schedules with this trigger. Unspecified returns all schedules
+	Trigger ScheduleTrigger `protobuf:"varint,2,opt,name=trigger,proto3,enum=secret.cron.ScheduleTrigger" json:"trigger,omitempty"`
+	// Only return schedules owned by this address. Empty returns all schedules
+	Owner string `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Only return paused or active schedules. Unspecified returns all schedules
+	PauseStatus PauseStatus `protobuf:"varint,4,opt,name=pause_status,json=pauseStatus,proto3,enum=secret.cron.PauseStatus" json:"pause_status,omitempty"`
 }
 
 func (m *QuerySchedulesRequest) Reset()         { *m = QuerySchedulesRequest{} }
@@ -248,6 +263,27 @@ func (m *QuerySchedulesRequest) GetPagination() *query.PageRequest {
 	return nil
 }
 
+func (m *QuerySchedulesRequest) GetTrigger() ScheduleTrigger {
+	if m != nil {
+		return m.Trigger
+	}
+	return ScheduleTrigger_SCHEDULE_TRIGGER_UNSPECIFIED
+}
+
+func (m *QuerySchedulesRequest) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *QuerySchedulesRequest) GetPauseStatus() PauseStatus {
+	if m != nil {
+		return m.PauseStatus
+	}
+	return PauseStatus_PAUSE_STATUS_UNSPECIFIED
+}
+
 // The response type for the Query/Params RPC method.
 type QuerySchedulesResponse struct {
 	Schedules  []Schedule          `protobuf:"bytes,1,rep,name=schedules,proto3" json:"schedules"`
@@ -301,6 +337,204 @@ func (m *QuerySchedulesResponse) GetPagination() *query.PageResponse {
 	return nil
 }
 
+// The request type for the Query/ScheduleExecutions RPC method.
+type QueryScheduleExecutionsRequest struct {
+	Name       string             `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduleExecutionsRequest) Reset()         { *m = QueryScheduleExecutionsRequest{} }
+func (m *QueryScheduleExecutionsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleExecutionsRequest) ProtoMessage()    {}
+func (*QueryScheduleExecutionsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_097808e20bacb68e, []int{6}
+}
+func (m *QueryScheduleExecutionsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleExecutionsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleExecutionsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleExecutionsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleExecutionsRequest.Merge(m, src)
+}
+func (m *QueryScheduleExecutionsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleExecutionsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleExecutionsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleExecutionsRequest proto.InternalMessageInfo
+
+func (m *QueryScheduleExecutionsRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *QueryScheduleExecutionsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The response type for the Query/ScheduleExecutions RPC method.
+type QueryScheduleExecutionsResponse struct {
+	Executions []ScheduleExecution `protobuf:"bytes,1,rep,name=executions,proto3" json:"executions"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryScheduleExecutionsResponse) Reset()         { *m = QueryScheduleExecutionsResponse{} }
+func (m *QueryScheduleExecutionsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryScheduleExecutionsResponse) ProtoMessage()    {}
+func (*QueryScheduleExecutionsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_097808e20bacb68e, []int{7}
+}
+func (m *QueryScheduleExecutionsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryScheduleExecutionsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryScheduleExecutionsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryScheduleExecutionsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryScheduleExecutionsResponse.Merge(m, src)
+}
+func (m *QueryScheduleExecutionsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryScheduleExecutionsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryScheduleExecutionsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryScheduleExecutionsResponse proto.InternalMessageInfo
+
+func (m *QueryScheduleExecutionsResponse) GetExecutions() []ScheduleExecution {
+	if m != nil {
+		return m.Executions
+	}
+	return nil
+}
+
+func (m *QueryScheduleExecutionsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// The request type for the Query/Backlog RPC method.
+type QueryBacklogRequest struct {
+}
+
+func (m *QueryBacklogRequest) Reset()         { *m = QueryBacklogRequest{} }
+func (m *QueryBacklogRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBacklogRequest) ProtoMessage()    {}
+func (*QueryBacklogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_097808e20bacb68e, []int{8}
+}
+func (m *QueryBacklogRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBacklogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBacklogRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBacklogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBacklogRequest.Merge(m, src)
+}
+func (m *QueryBacklogRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBacklogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBacklogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBacklogRequest proto.InternalMessageInfo
+
+// The response type for the Query/Backlog RPC method.
+type QueryBacklogResponse struct {
+	// Number of schedules that are due and weren't executed yet
+	Count uint64 `protobuf:"varint,1,opt,name=count,proto3" json:"count,omitempty"`
+	// Height of the block the longest waiting schedule was due to be executed in, zero if there's no backlog
+	OldestDueHeight uint64 `protobuf:"varint,2,opt,name=oldest_due_height,json=oldestDueHeight,proto3" json:"oldest_due_height,omitempty"`
+}
+
+func (m *QueryBacklogResponse) Reset()         { *m = QueryBacklogResponse{} }
+func (m *QueryBacklogResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBacklogResponse) ProtoMessage()    {}
+func (*QueryBacklogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_097808e20bacb68e, []int{9}
+}
+func (m *QueryBacklogResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBacklogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBacklogResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBacklogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBacklogResponse.Merge(m, src)
+}
+func (m *QueryBacklogResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBacklogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBacklogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBacklogResponse proto.InternalMessageInfo
+
+func (m *QueryBacklogResponse) GetCount() uint64 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *QueryBacklogResponse) GetOldestDueHeight() uint64 {
+	if m != nil {
+		return m.OldestDueHeight
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*QueryParamsRequest)(nil), "secret.cron.QueryParamsRequest")
 	proto.RegisterType((*QueryParamsResponse)(nil), "secret.cron.QueryParamsResponse")
@@ -308,44 +542,63 @@ func init() {
 	proto.RegisterType((*QueryGetScheduleResponse)(nil), "secret.cron.QueryGetScheduleResponse")
 	proto.RegisterType((*QuerySchedulesRequest)(nil), "secret.cron.QuerySchedulesRequest")
 	proto.RegisterType((*QuerySchedulesResponse)(nil), "secret.cron.QuerySchedulesResponse")
+	proto.RegisterType((*QueryScheduleExecutionsRequest)(nil), "secret.cron.QueryScheduleExecutionsRequest")
+	proto.RegisterType((*QueryScheduleExecutionsResponse)(nil), "secret.cron.QueryScheduleExecutionsResponse")
+	proto.RegisterType((*QueryBacklogRequest)(nil), "secret.cron.QueryBacklogRequest")
+	proto.RegisterType((*QueryBacklogResponse)(nil), "secret.cron.QueryBacklogResponse")
 }
 
 func init() { proto.RegisterFile("secret/cron/query.proto", fileDescriptor_097808e20bacb68e) }
 
 var fileDescriptor_097808e20bacb68e = []byte{
-	// 497 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x93, 0xcf, 0x6e, 0xd3, 0x40,
-	0x10, 0xc6, 0xe3, 0x50, 0xa2, 0x66, 0x7a, 0x9b, 0x34, 0x24, 0x32, 0xc5, 0xad, 0x4c, 0xf9, 0x23,
-	0x24, 0x76, 0x95, 0x72, 0x40, 0x5c, 0x7b, 0xa0, 0x3d, 0xa1, 0x92, 0xdc, 0xb8, 0xa0, 0xb5, 0x59,
-	0x39, 0x11, 0x89, 0xd7, 0xf5, 0x6e, 0x80, 0x0a, 0xf5, 0xc2, 0x13, 0x20, 0x71, 0xe5, 0x81, 0x7a,
-	0xac, 0xc4, 0x85, 0x13, 0x42, 0x09, 0xbc, 0x07, 0xf2, 0xfe, 0x69, 0xed, 0xba, 0x34, 0xb7, 0x95,
-	0xe7, 0x9b, 0xef, 0xfb, 0xed, 0xce, 0x18, 0x7a, 0x92, 0xc7, 0x39, 0x57, 0x34, 0xce, 0x45, 0x4a,
-	0x8f, 0xe7, 0x3c, 0x3f, 0x21, 0x59, 0x2e, 0x94, 0xc0, 0x0d, 0x53, 0x20, 0x45, 0xc1, 0x7f, 0x12,
-	0x0b, 0x39, 0x13, 0x92, 0x46, 0x4c, 0x72, 0xa3, 0xa2, 0x1f, 0x06, 0x11, 0x57, 0x6c, 0x40, 0x33,
-	0x96, 0x4c, 0x52, 0xa6, 0x26, 0x22, 0x35, 0x8d, 0xfe, 0x66, 0x22, 0x12, 0xa1, 0x8f, 0xb4, 0x38,
-	0xd9, 0xaf, 0x5b, 0x89, 0x10, 0xc9, 0x94, 0x53, 0x96, 0x4d, 0x28, 0x4b, 0x53, 0xa1, 0x74, 0x8b,
-	0xb4, 0xd5, 0x7e, 0x99, 0x22, 0x63, 0x39, 0x9b, 0xb9, 0x8a, 0x5f, 0xae, 0xc8, 0x78, 0xcc, 0xdf,
-	0xcd, 0xa7, 0xdc, 0xd4, 0xc2, 0x4d, 0xc0, 0xd7, 0x05, 0xcb, 0x91, 0x6e, 0x18, 0xf2, 0xe3, 0x39,
-	0x97, 0x2a, 0x3c, 0x84, 0x4e, 0xe5, 0xab, 0xcc, 0x44, 0x2a, 0x39, 0x0e, 0xa0, 0x65, 0x8c, 0xfb,
-	0xde, 0x8e, 0xf7, 0x78, 0x63, 0xaf, 0x43, 0x4a, 0x17, 0x24, 0x46, 0xbc, 0xbf, 0x76, 0xf6, 0x6b,
-	0xbb, 0x31, 0xb4, 0xc2, 0xf0, 0x29, 0xf4, 0xb4, 0xd3, 0x01, 0x57, 0x23, 0x9b, 0x6c, 0x43, 0x10,
-	0x61, 0x2d, 0x65, 0x33, 0xae, 0xbd, 0xda, 0x43, 0x7d, 0x0e, 0x47, 0xd0, 0xaf, 0xcb, 0x6d, 0xfa,
-	0x73, 0x58, 0x77, 0xf0, 0x36, 0xbf, 0x5b, 0xc9, 0x77, 0x0d, 0x96, 0xe0, 0x42, 0x1c, 0xbe, 0x85,
-	0xae, 0x36, 0x75, 0x02, 0x77, 0x4d, 0x7c, 0x09, 0x70, 0xf9, 0xf4, 0xd6, 0xf3, 0x21, 0x31, 0x73,
-	0x22, 0xc5, 0x9c, 0x88, 0x99, 0xa6, 0x9d, 0x13, 0x39, 0x62, 0x89, 0xa3, 0x1f, 0x96, 0x3a, 0xc3,
-	0xef, 0x1e, 0xdc, 0xb9, 0x9a, 0x60, 0xa1, 0x5f, 0x40, 0xdb, 0x71, 0x14, 0xaf, 0x76, 0x6b, 0x15,
-	0xf5, 0xa5, 0x1a, 0x0f, 0x2a, 0x74, 0x4d, 0x4d, 0xf7, 0x68, 0x25, 0x9d, 0xc9, 0x2d, 0xe3, 0xed,
-	0xfd, 0x6d, 0xc2, 0x6d, 0x8d, 0x87, 0x63, 0x68, 0x99, 0x29, 0xe1, 0x76, 0x05, 0xa2, 0xbe, 0x02,
-	0xfe, 0xce, 0xff, 0x05, 0x26, 0x22, 0xbc, 0xfb, 0xe5, 0xc7, 0x9f, 0x6f, 0xcd, 0x2e, 0x76, 0x68,
-	0x7d, 0xf3, 0xf0, 0x14, 0xd6, 0xdd, 0xcd, 0x70, 0xb7, 0x6e, 0x55, 0x5f, 0x07, 0xff, 0xc1, 0x0a,
-	0x95, 0x4d, 0xdd, 0xd5, 0xa9, 0x01, 0x6e, 0xd1, 0xeb, 0xb6, 0x9a, 0x7e, 0x2e, 0xd6, 0xe8, 0x14,
-	0x25, 0xb4, 0x2f, 0x66, 0x81, 0x61, 0xdd, 0xf9, 0xea, 0x2a, 0xf8, 0xf7, 0x6f, 0xd4, 0xd8, 0xec,
-	0x7b, 0x3a, 0xbb, 0x87, 0xdd, 0x6b, 0xb3, 0xf7, 0x0f, 0xcf, 0x16, 0x81, 0x77, 0xbe, 0x08, 0xbc,
-	0xdf, 0x8b, 0xc0, 0xfb, 0xba, 0x0c, 0x1a, 0xe7, 0xcb, 0xa0, 0xf1, 0x73, 0x19, 0x34, 0xde, 0x90,
-	0x64, 0xa2, 0xc6, 0xf3, 0x88, 0xc4, 0x62, 0x46, 0x65, 0x9c, 0xab, 0x29, 0x8b, 0x24, 0x1d, 0x69,
-	0x8f, 0x57, 0x5c, 0x7d, 0x14, 0xf9, 0x7b, 0xfa, 0xc9, 0x98, 0xa9, 0x93, 0x8c, 0xcb, 0xa8, 0xa5,
-	0x7f, 0xce, 0x67, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0xfe, 0x68, 0xcb, 0x84, 0x5a, 0x04, 0x00,
-	0x00,
+	// 745 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x55, 0x4f, 0x4f, 0xd4, 0x4c,
+	0x18, 0xdf, 0xc2, 0xf2, 0xef, 0xe1, 0xcd, 0xfb, 0xe6, 0x1d, 0x76, 0xa1, 0xa9, 0x6b, 0xc1, 0x8a,
+	0xa2, 0xa8, 0x9d, 0x80, 0x89, 0xc6, 0x78, 0x23, 0x08, 0x9c, 0x0c, 0x76, 0x3d, 0x18, 0x2f, 0x64,
+	0xb6, 0x4c, 0xba, 0x1b, 0x76, 0x3b, 0xa5, 0x33, 0x15, 0x88, 0x72, 0xf1, 0x13, 0x98, 0x78, 0xf5,
+	0xe0, 0x47, 0xf0, 0x63, 0x70, 0x24, 0xf1, 0xe2, 0xc9, 0x18, 0xf0, 0xe4, 0x07, 0xf0, 0x6c, 0x3a,
+	0x33, 0x5d, 0xb6, 0x74, 0x59, 0x8c, 0xf1, 0xd6, 0x99, 0xe7, 0xf7, 0x3c, 0xbf, 0xdf, 0xfc, 0x9e,
+	0x67, 0xa6, 0x30, 0xc3, 0xa9, 0x1f, 0x53, 0x81, 0xfd, 0x98, 0x85, 0x78, 0x37, 0xa1, 0xf1, 0x81,
+	0x1b, 0xc5, 0x4c, 0x30, 0x34, 0xa9, 0x02, 0x6e, 0x1a, 0xb0, 0x16, 0x7d, 0xc6, 0x3b, 0x8c, 0xe3,
+	0x06, 0xe1, 0x54, 0xa1, 0xf0, 0xab, 0xa5, 0x06, 0x15, 0x64, 0x09, 0x47, 0x24, 0x68, 0x85, 0x44,
+	0xb4, 0x58, 0xa8, 0x12, 0xad, 0x4a, 0xc0, 0x02, 0x26, 0x3f, 0x71, 0xfa, 0xa5, 0x77, 0x6b, 0x01,
+	0x63, 0x41, 0x9b, 0x62, 0x12, 0xb5, 0x30, 0x09, 0x43, 0x26, 0x64, 0x0a, 0xd7, 0x51, 0xb3, 0x57,
+	0x45, 0x44, 0x62, 0xd2, 0xc9, 0x22, 0x56, 0x6f, 0x84, 0xfb, 0x4d, 0xba, 0x9d, 0xb4, 0xa9, 0x8a,
+	0x39, 0x15, 0x40, 0xcf, 0x52, 0x2d, 0x9b, 0x32, 0xc1, 0xa3, 0xbb, 0x09, 0xe5, 0xc2, 0xd9, 0x80,
+	0xa9, 0xdc, 0x2e, 0x8f, 0x58, 0xc8, 0x29, 0x5a, 0x82, 0x51, 0x55, 0xd8, 0x34, 0xe6, 0x8c, 0x5b,
+	0x93, 0xcb, 0x53, 0x6e, 0xcf, 0x01, 0x5d, 0x05, 0x5e, 0x29, 0x1f, 0x7d, 0x9d, 0x2d, 0x79, 0x1a,
+	0xe8, 0xdc, 0x83, 0x19, 0x59, 0x69, 0x9d, 0x8a, 0xba, 0x66, 0xd6, 0x24, 0x08, 0x41, 0x39, 0x24,
+	0x1d, 0x2a, 0x6b, 0x4d, 0x78, 0xf2, 0xdb, 0xe9, 0x80, 0x59, 0x84, 0x6b, 0xf6, 0x87, 0x30, 0x9e,
+	0x89, 0xd7, 0xfc, 0xd5, 0x1c, 0x7f, 0x96, 0xa0, 0x15, 0x74, 0xc1, 0xc8, 0x84, 0x31, 0xe2, 0xfb,
+	0x2c, 0x09, 0x85, 0x39, 0x24, 0xb9, 0xb2, 0xa5, 0xf3, 0xc3, 0x80, 0xaa, 0xe4, 0xcb, 0x72, 0x33,
+	0x07, 0xd0, 0x1a, 0xc0, 0x59, 0x57, 0x34, 0xdd, 0x4d, 0x57, 0xb5, 0xd0, 0x4d, 0x5b, 0xe8, 0xaa,
+	0x46, 0xeb, 0x16, 0xba, 0x9b, 0x24, 0xc8, 0x0e, 0xe6, 0xf5, 0x64, 0xa2, 0x07, 0x30, 0x26, 0xe2,
+	0x56, 0x10, 0xd0, 0x58, 0x72, 0xff, 0xbb, 0x5c, 0xeb, 0xab, 0xf9, 0xb9, 0xc2, 0x78, 0x19, 0x18,
+	0x55, 0x60, 0x84, 0xed, 0x85, 0x34, 0x36, 0x87, 0xa5, 0x62, 0xb5, 0x40, 0x8f, 0xe1, 0x9f, 0x88,
+	0x24, 0x9c, 0x6e, 0x71, 0x41, 0x44, 0xc2, 0xcd, 0xb2, 0x2c, 0x69, 0x9e, 0x6b, 0x43, 0xc2, 0x69,
+	0x5d, 0xc6, 0xbd, 0xc9, 0xe8, 0x6c, 0xe1, 0x7c, 0x30, 0x60, 0xfa, 0xfc, 0x61, 0xb5, 0xb5, 0x8f,
+	0x60, 0x22, 0x73, 0x2b, 0xed, 0xed, 0xf0, 0x65, 0xde, 0x9e, 0xa1, 0xd1, 0x7a, 0xce, 0xa8, 0x21,
+	0x69, 0xd4, 0xc2, 0xa5, 0x46, 0x29, 0xde, 0x5e, 0xa7, 0x9c, 0x37, 0x60, 0xe7, 0xd4, 0x3d, 0xd9,
+	0xa7, 0x7e, 0x92, 0x46, 0xf8, 0x80, 0x81, 0x41, 0x6b, 0x7d, 0xe8, 0xff, 0xa0, 0x4f, 0xce, 0x27,
+	0x03, 0x66, 0x2f, 0xa4, 0xd7, 0x2e, 0xad, 0x02, 0xd0, 0xee, 0xae, 0xb6, 0xc9, 0xee, 0x6b, 0x53,
+	0x37, 0x59, 0xfb, 0xd5, 0x93, 0xf7, 0xf7, 0x0c, 0xab, 0xea, 0x4b, 0xba, 0x42, 0xfc, 0x9d, 0x36,
+	0x0b, 0xb2, 0xbb, 0xfb, 0x02, 0x2a, 0xf9, 0x6d, 0xad, 0xbe, 0x02, 0x23, 0xea, 0x0e, 0xa4, 0xf6,
+	0x95, 0x3d, 0xb5, 0x40, 0x8b, 0xf0, 0x3f, 0x6b, 0x6f, 0x53, 0x2e, 0xb6, 0xb6, 0x13, 0xba, 0xd5,
+	0xa4, 0xad, 0xa0, 0xa9, 0x6e, 0x49, 0xd9, 0xfb, 0x4f, 0x05, 0x56, 0x13, 0xba, 0x21, 0xb7, 0x97,
+	0x7f, 0x96, 0x61, 0x44, 0x96, 0x46, 0x4d, 0x18, 0x55, 0xb7, 0x1d, 0xcd, 0xe6, 0xce, 0x5f, 0x7c,
+	0x4a, 0xac, 0xb9, 0x8b, 0x01, 0x4a, 0x98, 0x73, 0xe5, 0xed, 0xe7, 0xef, 0xef, 0x87, 0xaa, 0x68,
+	0x0a, 0x17, 0x5f, 0x30, 0x74, 0x08, 0xe3, 0x99, 0xa9, 0x68, 0xbe, 0x58, 0xaa, 0xf8, 0xac, 0x58,
+	0x37, 0x2e, 0x41, 0x69, 0xd6, 0x79, 0xc9, 0x6a, 0xa3, 0x1a, 0xee, 0xf7, 0x3a, 0xe2, 0xd7, 0xe9,
+	0x74, 0x1d, 0x22, 0x0e, 0x13, 0xf5, 0xee, 0xa8, 0x3b, 0xc5, 0xca, 0xe7, 0xdf, 0x0d, 0xeb, 0xfa,
+	0x40, 0x8c, 0xe6, 0xbe, 0x2a, 0xb9, 0x67, 0x50, 0xb5, 0x2f, 0x37, 0xfa, 0x68, 0x00, 0x2a, 0x8e,
+	0x21, 0xba, 0x73, 0x71, 0xe9, 0xc2, 0x5d, 0xb1, 0xee, 0xfe, 0x1e, 0x58, 0x0b, 0xc2, 0x52, 0xd0,
+	0x6d, 0xb4, 0x30, 0xc8, 0x0c, 0xdc, 0x33, 0xc4, 0x6d, 0x18, 0xd3, 0xf3, 0x85, 0xfa, 0x34, 0x38,
+	0x3f, 0x91, 0xd6, 0xb5, 0x01, 0x08, 0x2d, 0xa0, 0x26, 0x05, 0x4c, 0xa3, 0x4a, 0x4e, 0x40, 0x43,
+	0xa1, 0x56, 0x36, 0x8e, 0x4e, 0x6c, 0xe3, 0xf8, 0xc4, 0x36, 0xbe, 0x9d, 0xd8, 0xc6, 0xbb, 0x53,
+	0xbb, 0x74, 0x7c, 0x6a, 0x97, 0xbe, 0x9c, 0xda, 0xa5, 0x97, 0x6e, 0xd0, 0x12, 0xcd, 0xa4, 0xe1,
+	0xfa, 0xac, 0x83, 0xb9, 0x1f, 0x8b, 0x36, 0x69, 0x70, 0x5c, 0x97, 0x25, 0x9e, 0x52, 0xb1, 0xc7,
+	0xe2, 0x1d, 0xbc, 0xaf, 0x6a, 0x89, 0x83, 0x88, 0xf2, 0xc6, 0xa8, 0xfc, 0xeb, 0xdd, 0xff, 0x35,
+	0x00, 0x2f, 0x08, 0x5e, 0xd3, 0xb3, 0x07, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -366,6 +619,10 @@ type QueryClient interface {
 	Schedule(ctx context.Context, in *QueryGetScheduleRequest, opts ...grpc.CallOption) (*QueryGetScheduleResponse, error)
 	// Queries a list of Schedule items.
 	Schedules(ctx context.Context, in *QuerySchedulesRequest, opts ...grpc.CallOption) (*QuerySchedulesResponse, error)
+	// Queries the recorded executions of a Schedule, oldest first.
+	ScheduleExecutions(ctx context.Context, in *QueryScheduleExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduleExecutionsResponse, error)
+	// Queries the schedules that are due but were held back by the per block limit.
+	Backlog(ctx context.Context, in *QueryBacklogRequest, opts ...grpc.CallOption) (*QueryBacklogResponse, error)
 }
 
 type queryClient struct {
@@ -403,6 +660,24 @@ func (c *queryClient) Schedules(ctx context.Context, in *QuerySchedulesRequest,
 	return out, nil
 }
 
+func (c *queryClient) ScheduleExecutions(ctx context.Context, in *QueryScheduleExecutionsRequest, opts ...grpc.CallOption) (*QueryScheduleExecutionsResponse, error) {
+	out := new(QueryScheduleExecutionsResponse)
+	err := c.cc.Invoke(ctx, "/secret.cron.Query/ScheduleExecutions", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Backlog(ctx context.Context, in *QueryBacklogRequest, opts ...grpc.CallOption) (*QueryBacklogResponse, error) {
+	out := new(QueryBacklogResponse)
+	err := c.cc.Invoke(ctx, "/secret.cron.Query/Backlog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Queries the parameters of the module.
@@ -411,6 +686,10 @@ type QueryServer interface {
 	Schedule(context.Context, *QueryGetScheduleRequest) (*QueryGetScheduleResponse, error)
 	// Queries a list of Schedule items.
 	Schedules(context.Context, *QuerySchedulesRequest) (*QuerySchedulesResponse, error)
+	// Queries the recorded executions of a Schedule, oldest first.
+	ScheduleExecutions(context.Context, *QueryScheduleExecutionsRequest) (*QueryScheduleExecutionsResponse, error)
+	// Queries the schedules that are due but were held back by the per block limit.
+	Backlog(context.Context, *QueryBacklogRequest) (*QueryBacklogResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -426,6 +705,12 @@ func (*UnimplementedQueryServer) Schedule(ctx context.Context, req *QueryGetSche
 func (*UnimplementedQueryServer) Schedules(ctx context.Context, req *QuerySchedulesRequest) (*QuerySchedulesResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Schedules not implemented")
 }
+func (*UnimplementedQueryServer) ScheduleExecutions(ctx context.Context, req *QueryScheduleExecutionsRequest) (*QueryScheduleExecutionsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ScheduleExecutions not implemented")
+}
+func (*UnimplementedQueryServer) Backlog(ctx context.Context, req *QueryBacklogRequest) (*QueryBacklogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Backlog not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -485,6 +770,42 @@ func _Query_Schedules_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Query_ScheduleExecutions_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryScheduleExecutionsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ScheduleExecutions(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.cron.Query/ScheduleExecutions",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ScheduleExecutions(ctx, req.(*QueryScheduleExecutionsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Backlog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBacklogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Backlog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.cron.Query/Backlog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Backlog(ctx, req.(*QueryBacklogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Query_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.cron.Query",
 	HandlerType: (*QueryServer)(nil),
@@ -501,6 +822,14 @@ var _Query_serviceDesc = grpc.ServiceDesc{
 			MethodName: "Schedules",
 			Handler:    _Query_Schedules_Handler,
 		},
+		{
+			MethodName: "ScheduleExecutions",
+			Handler:    _Query_ScheduleExecutions_Handler,
+		},
+		{
+			MethodName: "Backlog",
+			Handler:    _Query_Backlog_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/cron/query.proto",
@@ -612,6 +941,13 @@ func (m *QueryGetScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error
 	_ = i
 	var l int
 	_ = l
+	if len(m.Account) > 0 {
+		i -= len(m.Account)
+		copy(dAtA[i:], m.Account)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Account)))
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Schedule.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -645,6 +981,23 @@ func (m *QuerySchedulesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.PauseStatus != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.PauseStatus))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.Trigger != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Trigger))
+		i--
+		dAtA[i] = 0x10
+	}
 	if m.Pagination != nil {
 		{
 			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
@@ -709,58 +1062,209 @@ func (m *QuerySchedulesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
-	}
-	dAtA[offset] = uint8(v)
-	return base
-}
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryScheduleExecutionsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+func (m *QueryScheduleExecutionsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *QueryGetScheduleRequest) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *QueryScheduleExecutionsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
 	}
-	return n
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *QueryGetScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
+func (m *QueryScheduleExecutionsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryScheduleExecutionsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryScheduleExecutionsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Executions) > 0 {
+		for iNdEx := len(m.Executions) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Executions[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBacklogRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBacklogRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBacklogRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBacklogResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBacklogResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBacklogResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OldestDueHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.OldestDueHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if m.Count != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryGetScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryGetScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
 	l = m.Schedule.Size()
 	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.Account)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
 	return n
 }
 
@@ -774,6 +1278,16 @@ func (m *QuerySchedulesRequest) Size() (n int) {
 		l = m.Pagination.Size()
 		n += 1 + l + sovQuery(uint64(l))
 	}
+	if m.Trigger != 0 {
+		n += 1 + sovQuery(uint64(m.Trigger))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.PauseStatus != 0 {
+		n += 1 + sovQuery(uint64(m.PauseStatus))
+	}
 	return n
 }
 
@@ -796,6 +1310,66 @@ func (m *QuerySchedulesResponse) Size() (n int) {
 	return n
 }
 
+func (m *QueryScheduleExecutionsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryScheduleExecutionsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Executions) > 0 {
+		for _, e := range m.Executions {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryBacklogRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBacklogResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Count != 0 {
+		n += 1 + sovQuery(uint64(m.Count))
+	}
+	if m.OldestDueHeight != 0 {
+		n += 1 + sovQuery(uint64(m.OldestDueHeight))
+	}
+	return n
+}
+
 func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -1079,6 +1653,38 @@ func (m *QueryGetScheduleResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Account", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Account = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -1165,25 +1771,95 @@ func (m *QuerySchedulesRequest) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipQuery(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Trigger", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthQuery
+			m.Trigger = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Trigger |= ScheduleTrigger(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStatus", wireType)
+			}
+			m.PauseStatus = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PauseStatus |= PauseStatus(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
 	return nil
 }
 func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
@@ -1306,6 +1982,382 @@ func (m *QuerySchedulesResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *QueryScheduleExecutionsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleExecutionsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleExecutionsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryScheduleExecutionsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryScheduleExecutionsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryScheduleExecutionsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Executions", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Executions = append(m.Executions, ScheduleExecution{})
+			if err := m.Executions[len(m.Executions)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBacklogRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBacklogRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBacklogRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBacklogResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBacklogResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBacklogResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Count", wireType)
+			}
+			m.Count = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Count |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OldestDueHeight", wireType)
+			}
+			m.OldestDueHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.OldestDueHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQuery(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
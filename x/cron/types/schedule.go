@@ -0,0 +1,231 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+)
+
+const (
+	// MinScheduleInterval is the shortest interval a time based schedule can have
+	MinScheduleInterval = time.Second
+
+	// DefaultScheduleGasLimit is the gas limit of scheduled msgs that don't set one
+	DefaultScheduleGasLimit = uint64(5_000_000)
+	// MaxScheduleGasLimit is the highest gas limit a scheduled msg can set
+	MaxScheduleGasLimit = uint64(100_000_000)
+)
+
+// ScheduledMsg is a msg of a schedule that is due for execution
+type ScheduledMsg struct {
+	ScheduleName string
+	// Sender is the sender of the msg's scheduled tx, see Schedule.TxSender
+	Sender sdk.AccAddress
+	Msg    MsgExecuteContract
+}
+
+// ScheduleAccountAddress returns the address of the account that funds the msgs of a schedule.
+// Anyone can fund it with a bank send. The accounts of owned schedules are derived from their owner too,
+// so that a schedule registered by another owner under the same name can't spend them.
+func ScheduleAccountAddress(owner sdk.AccAddress, name string) sdk.AccAddress {
+	if owner.Empty() {
+		return address.Module(ModuleName, []byte(name))
+	}
+	return address.Module(ModuleName, owner, []byte(name))
+}
+
+// Account returns the address of the account that funds the msgs of the schedule, see ScheduleAccountAddress
+func (s Schedule) Account() sdk.AccAddress {
+	var owner sdk.AccAddress
+	if s.Owner != "" {
+		owner = sdk.MustAccAddressFromBech32(s.Owner)
+	}
+	return ScheduleAccountAddress(owner, s.Name)
+}
+
+// ValidateScheduleMsgs checks the gas limit and funds of scheduled msgs
+func ValidateScheduleMsgs(msgs []MsgExecuteContract) error {
+	for i, msg := range msgs {
+		if msg.GasLimit > MaxScheduleGasLimit {
+			return fmt.Errorf("msg %d: gas limit must not be above %d", i, MaxScheduleGasLimit)
+		}
+		if err := msg.Funds.Validate(); err != nil {
+			return fmt.Errorf("msg %d: invalid funds: %w", i, err)
+		}
+	}
+	return nil
+}
+
+// ValidateFailurePolicy checks the failure policy and max failures of a schedule
+func ValidateFailurePolicy(policy FailurePolicy, maxFailures uint32) error {
+	if _, ok := FailurePolicy_name[int32(policy)]; !ok {
+		return fmt.Errorf("unknown failure policy %d", policy)
+	}
+	if policy == FailurePolicy_FAILURE_POLICY_DISABLE && maxFailures == 0 {
+		return fmt.Errorf("max failures must be set for the disable failure policy")
+	}
+	if policy != FailurePolicy_FAILURE_POLICY_DISABLE && maxFailures != 0 {
+		return fmt.Errorf("max failures can only be set for the disable failure policy")
+	}
+	return nil
+}
+
+// ValidatePriority checks the priority tier of a schedule. Owned schedules can't have high priority
+func ValidatePriority(priority SchedulePriority, owned bool) error {
+	if _, ok := SchedulePriority_name[int32(priority)]; !ok {
+		return fmt.Errorf("unknown priority %d", priority)
+	}
+	if owned && priority == SchedulePriority_SCHEDULE_PRIORITY_HIGH {
+		return fmt.Errorf("only schedules added by governance can have high priority")
+	}
+	return nil
+}
+
+// PriorityTiers lists the priority tiers in the order their due schedules are executed
+var PriorityTiers = []SchedulePriority{
+	SchedulePriority_SCHEDULE_PRIORITY_HIGH,
+	SchedulePriority_SCHEDULE_PRIORITY_NORMAL,
+	SchedulePriority_SCHEDULE_PRIORITY_LOW,
+}
+
+// Tier returns the position of the priority in PriorityTiers, used to order the queue of due schedules
+func (p SchedulePriority) Tier() byte {
+	switch p {
+	case SchedulePriority_SCHEDULE_PRIORITY_HIGH:
+		return 0
+	case SchedulePriority_SCHEDULE_PRIORITY_LOW:
+		return 2
+	default:
+		return 1
+	}
+}
+
+// GetGasLimitOrDefault returns the gas limit of the scheduled msg's tx
+func (msg MsgExecuteContract) GetGasLimitOrDefault() uint64 {
+	if msg.GasLimit == 0 {
+		return DefaultScheduleGasLimit
+	}
+	return msg.GasLimit
+}
+
+// ValidateScheduleTrigger checks that exactly one of the block period, the interval
+// and the cron expression is set, and that it's valid
+func ValidateScheduleTrigger(period uint64, interval *time.Duration, cronExpression string) error {
+	triggers := 0
+	if period > 0 {
+		triggers++
+	}
+	if interval != nil && *interval != 0 {
+		triggers++
+		if *interval < MinScheduleInterval {
+			return fmt.Errorf("interval must be at least %s", MinScheduleInterval)
+		}
+	}
+	if cronExpression != "" {
+		triggers++
+		if _, err := ParseCronExpression(cronExpression); err != nil {
+			return err
+		}
+	}
+	if triggers != 1 {
+		return fmt.Errorf("exactly one of period, interval and cron expression must be set")
+	}
+	return nil
+}
+
+// TxSender returns the sender of the scheduled txs of the schedule. Schedules added by governance are sent
+// by ScheduledTxSender, owned schedules by the sender derived from their owner.
+func (s Schedule) TxSender() sdk.AccAddress {
+	if s.Owner == "" {
+		return ScheduledTxSender()
+	}
+	return OwnerScheduledTxSender(sdk.MustAccAddressFromBech32(s.Owner))
+}
+
+// Trigger returns what triggers the execution of the schedule
+func (s Schedule) Trigger() ScheduleTrigger {
+	switch {
+	case s.Period > 0:
+		return ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD
+	case s.Interval != nil && *s.Interval != 0:
+		return ScheduleTrigger_SCHEDULE_TRIGGER_INTERVAL
+	case s.CronExpression != "":
+		return ScheduleTrigger_SCHEDULE_TRIGGER_CRON_EXPRESSION
+	default:
+		return ScheduleTrigger_SCHEDULE_TRIGGER_UNSPECIFIED
+	}
+}
+
+// QueuedHeight returns the height of the block the schedule is due to be executed in, and false if it isn't
+// queued for execution. Block period schedules are due `period` blocks after their last execution, time based
+// schedules are queued once their next execution time is reached. Disabled and paused schedules aren't queued.
+func (s Schedule) QueuedHeight() (uint64, bool) {
+	if s.Disabled || s.Paused {
+		return 0, false
+	}
+	if s.Trigger() == ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD {
+		return s.LastExecuteHeight + s.Period, true
+	}
+	return s.DueHeight, s.DueHeight != 0
+}
+
+// Validate performs a stateless validation of the schedule
+func (s Schedule) Validate() error {
+	if s.Name == "" {
+		return fmt.Errorf("schedule name is empty")
+	}
+	if err := ValidateScheduleTrigger(s.Period, s.Interval, s.CronExpression); err != nil {
+		return fmt.Errorf("invalid schedule '%s': %w", s.Name, err)
+	}
+	if err := ValidateScheduleMsgs(s.Msgs); err != nil {
+		return fmt.Errorf("invalid schedule '%s': %w", s.Name, err)
+	}
+	if err := ValidateFailurePolicy(s.FailurePolicy, s.MaxFailures); err != nil {
+		return fmt.Errorf("invalid schedule '%s': %w", s.Name, err)
+	}
+	if err := ValidatePriority(s.Priority, s.Owner != ""); err != nil {
+		return fmt.Errorf("invalid schedule '%s': %w", s.Name, err)
+	}
+	if s.Owner != "" {
+		if _, err := sdk.AccAddressFromBech32(s.Owner); err != nil {
+			return fmt.Errorf("invalid schedule '%s': invalid owner: %w", s.Name, err)
+		}
+		if err := s.Deposit.Validate(); err != nil {
+			return fmt.Errorf("invalid schedule '%s': invalid deposit: %w", s.Name, err)
+		}
+	}
+	return nil
+}
+
+// ComputeNextExecuteTime returns the next time a time based schedule is due, given the current block time.
+// Interval schedules stay aligned to their previous due time, skipping the executions that were missed,
+// e.g. while the chain was halted.
+func (s Schedule) ComputeNextExecuteTime(blockTime time.Time) (time.Time, error) {
+	switch s.Trigger() {
+	case ScheduleTrigger_SCHEDULE_TRIGGER_INTERVAL:
+		interval := *s.Interval
+		if s.NextExecuteTime == nil {
+			return blockTime.Add(interval), nil
+		}
+		next := s.NextExecuteTime.Add(interval)
+		if !next.After(blockTime) {
+			missed := blockTime.Sub(*s.NextExecuteTime) / interval
+			next = s.NextExecuteTime.Add((missed + 1) * interval)
+		}
+		return next, nil
+	case ScheduleTrigger_SCHEDULE_TRIGGER_CRON_EXPRESSION:
+		expr, err := ParseCronExpression(s.CronExpression)
+		if err != nil {
+			return time.Time{}, err
+		}
+		next := expr.Next(blockTime)
+		if next.IsZero() {
+			return time.Time{}, fmt.Errorf("cron expression never matches: '%s'", s.CronExpression)
+		}
+		return next, nil
+	default:
+		return time.Time{}, fmt.Errorf("schedule '%s' is not time based", s.Name)
+	}
+}
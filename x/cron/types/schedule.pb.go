@@ -5,17 +5,23 @@ package types
 
 import (
 	fmt "fmt"
+	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google/protobuf"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -23,16 +29,216 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// Defines what triggers the execution of a schedule
+type ScheduleTrigger int32
+
+const (
+	// Unspecified trigger, used to not filter by trigger
+	ScheduleTrigger_SCHEDULE_TRIGGER_UNSPECIFIED ScheduleTrigger = 0
+	// Executed every `period` blocks
+	ScheduleTrigger_SCHEDULE_TRIGGER_BLOCK_PERIOD ScheduleTrigger = 1
+	// Executed every `interval` of block time
+	ScheduleTrigger_SCHEDULE_TRIGGER_INTERVAL ScheduleTrigger = 2
+	// Executed according to `cron_expression`, evaluated against block time
+	ScheduleTrigger_SCHEDULE_TRIGGER_CRON_EXPRESSION ScheduleTrigger = 3
+)
+
+var ScheduleTrigger_name = map[int32]string{
+	0: "SCHEDULE_TRIGGER_UNSPECIFIED",
+	1: "SCHEDULE_TRIGGER_BLOCK_PERIOD",
+	2: "SCHEDULE_TRIGGER_INTERVAL",
+	3: "SCHEDULE_TRIGGER_CRON_EXPRESSION",
+}
+
+var ScheduleTrigger_value = map[string]int32{
+	"SCHEDULE_TRIGGER_UNSPECIFIED":     0,
+	"SCHEDULE_TRIGGER_BLOCK_PERIOD":    1,
+	"SCHEDULE_TRIGGER_INTERVAL":        2,
+	"SCHEDULE_TRIGGER_CRON_EXPRESSION": 3,
+}
+
+func (x ScheduleTrigger) String() string {
+	return proto.EnumName(ScheduleTrigger_name, int32(x))
+}
+
+func (ScheduleTrigger) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d6729589d2158da, []int{0}
+}
+
+// Defines whether schedules are paused, used to filter schedules
+type PauseStatus int32
+
+const (
+	// Unspecified status, used to not filter by status
+	PauseStatus_PAUSE_STATUS_UNSPECIFIED PauseStatus = 0
+	// Schedules that are not paused
+	PauseStatus_PAUSE_STATUS_ACTIVE PauseStatus = 1
+	// Schedules that are paused
+	PauseStatus_PAUSE_STATUS_PAUSED PauseStatus = 2
+)
+
+var PauseStatus_name = map[int32]string{
+	0: "PAUSE_STATUS_UNSPECIFIED",
+	1: "PAUSE_STATUS_ACTIVE",
+	2: "PAUSE_STATUS_PAUSED",
+}
+
+var PauseStatus_value = map[string]int32{
+	"PAUSE_STATUS_UNSPECIFIED": 0,
+	"PAUSE_STATUS_ACTIVE":      1,
+	"PAUSE_STATUS_PAUSED":      2,
+}
+
+func (x PauseStatus) String() string {
+	return proto.EnumName(PauseStatus_name, int32(x))
+}
+
+func (PauseStatus) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d6729589d2158da, []int{1}
+}
+
+// Defines the priority tier of a schedule. When more schedules are due than the per block limit,
+// schedules of a higher tier are executed first. Within a tier, the schedules that have been due
+// the longest are executed first
+type SchedulePriority int32
+
+const (
+	// Default tier
+	SchedulePriority_SCHEDULE_PRIORITY_NORMAL SchedulePriority = 0
+	// Executed before the other tiers. Only governance can add high priority schedules
+	SchedulePriority_SCHEDULE_PRIORITY_HIGH SchedulePriority = 1
+	// Executed only when no schedule of the other tiers is due
+	SchedulePriority_SCHEDULE_PRIORITY_LOW SchedulePriority = 2
+)
+
+var SchedulePriority_name = map[int32]string{
+	0: "SCHEDULE_PRIORITY_NORMAL",
+	1: "SCHEDULE_PRIORITY_HIGH",
+	2: "SCHEDULE_PRIORITY_LOW",
+}
+
+var SchedulePriority_value = map[string]int32{
+	"SCHEDULE_PRIORITY_NORMAL": 0,
+	"SCHEDULE_PRIORITY_HIGH":   1,
+	"SCHEDULE_PRIORITY_LOW":    2,
+}
+
+func (x SchedulePriority) String() string {
+	return proto.EnumName(SchedulePriority_name, int32(x))
+}
+
+func (SchedulePriority) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d6729589d2158da, []int{2}
+}
+
+// Defines what happens when the execution of a schedule fails
+type FailurePolicy int32
+
+const (
+	// Skip the failed execution and wait until the schedule is due again
+	FailurePolicy_FAILURE_POLICY_SKIP FailurePolicy = 0
+	// Retry the failed execution in the next block
+	FailurePolicy_FAILURE_POLICY_RETRY FailurePolicy = 1
+	// Skip the failed execution, and disable the schedule after `max_failures` consecutive failures
+	FailurePolicy_FAILURE_POLICY_DISABLE FailurePolicy = 2
+)
+
+var FailurePolicy_name = map[int32]string{
+	0: "FAILURE_POLICY_SKIP",
+	1: "FAILURE_POLICY_RETRY",
+	2: "FAILURE_POLICY_DISABLE",
+}
+
+var FailurePolicy_value = map[string]int32{
+	"FAILURE_POLICY_SKIP":    0,
+	"FAILURE_POLICY_RETRY":   1,
+	"FAILURE_POLICY_DISABLE": 2,
+}
+
+func (x FailurePolicy) String() string {
+	return proto.EnumName(FailurePolicy_name, int32(x))
+}
+
+func (FailurePolicy) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d6729589d2158da, []int{3}
+}
+
+// Defines the result of the last execution of a schedule
+type ExecutionResult int32
+
+const (
+	// The schedule was never executed
+	ExecutionResult_EXECUTION_RESULT_UNSPECIFIED ExecutionResult = 0
+	// All msgs of the schedule executed successfully
+	ExecutionResult_EXECUTION_RESULT_SUCCESS ExecutionResult = 1
+	// At least one msg of the schedule failed
+	ExecutionResult_EXECUTION_RESULT_FAILURE ExecutionResult = 2
+)
+
+var ExecutionResult_name = map[int32]string{
+	0: "EXECUTION_RESULT_UNSPECIFIED",
+	1: "EXECUTION_RESULT_SUCCESS",
+	2: "EXECUTION_RESULT_FAILURE",
+}
+
+var ExecutionResult_value = map[string]int32{
+	"EXECUTION_RESULT_UNSPECIFIED": 0,
+	"EXECUTION_RESULT_SUCCESS":     1,
+	"EXECUTION_RESULT_FAILURE":     2,
+}
+
+func (x ExecutionResult) String() string {
+	return proto.EnumName(ExecutionResult_name, int32(x))
+}
+
+func (ExecutionResult) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_3d6729589d2158da, []int{4}
+}
+
 // Defines the schedule for execution
 type Schedule struct {
 	// Name of schedule
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
-	// Period in blocks
+	// Period in blocks. Exactly one of `period`, `interval` and `cron_expression` is set
 	Period uint64 `protobuf:"varint,2,opt,name=period,proto3" json:"period,omitempty"`
-	// Msgs that will be executed every certain number of blocks, specified in the `period` field
+	// Msgs that will be executed when the schedule is due
 	Msgs []MsgExecuteContract `protobuf:"bytes,3,rep,name=msgs,proto3" json:"msgs"`
 	// Last execution's block height
 	LastExecuteHeight uint64 `protobuf:"varint,4,opt,name=last_execute_height,json=lastExecuteHeight,proto3" json:"last_execute_height,omitempty"`
+	// Fixed duration of block time between executions
+	Interval *time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// Standard 5 field cron expression (e.g. "0 0 * * *"), evaluated in UTC against block time
+	CronExpression string `protobuf:"bytes,6,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// Block time at which an interval or cron expression schedule is due next
+	NextExecuteTime *time.Time `protobuf:"bytes,7,opt,name=next_execute_time,json=nextExecuteTime,proto3,stdtime" json:"next_execute_time,omitempty"`
+	// Last execution's block time, for interval and cron expression schedules
+	LastExecuteTime *time.Time `protobuf:"bytes,8,opt,name=last_execute_time,json=lastExecuteTime,proto3,stdtime" json:"last_execute_time,omitempty"`
+	// What happens when an execution fails
+	FailurePolicy FailurePolicy `protobuf:"varint,9,opt,name=failure_policy,json=failurePolicy,proto3,enum=secret.cron.FailurePolicy" json:"failure_policy,omitempty"`
+	// Consecutive failures after which the schedule is disabled, for FAILURE_POLICY_DISABLE
+	MaxFailures uint32 `protobuf:"varint,10,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// Result of the last execution
+	LastResult ExecutionResult `protobuf:"varint,11,opt,name=last_result,json=lastResult,proto3,enum=secret.cron.ExecutionResult" json:"last_result,omitempty"`
+	// Error of the last execution, if it failed
+	LastError string `protobuf:"bytes,12,opt,name=last_error,json=lastError,proto3" json:"last_error,omitempty"`
+	// Number of consecutive failed executions
+	ConsecutiveFailures uint32 `protobuf:"varint,13,opt,name=consecutive_failures,json=consecutiveFailures,proto3" json:"consecutive_failures,omitempty"`
+	// Disabled schedules are not executed anymore
+	Disabled bool `protobuf:"varint,14,opt,name=disabled,proto3" json:"disabled,omitempty"`
+	// Account or contract that registered the schedule. Empty for schedules added by governance
+	Owner string `protobuf:"bytes,15,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Remaining deposit of an owned schedule, execution fees are paid from it
+	Deposit types.Coin `protobuf:"bytes,16,opt,name=deposit,proto3" json:"deposit"`
+	// Paused schedules are not executed until they're resumed
+	Paused bool `protobuf:"varint,17,opt,name=paused,proto3" json:"paused,omitempty"`
+	// Priority tier of the schedule, set when it's created
+	Priority SchedulePriority `protobuf:"varint,18,opt,name=priority,proto3,enum=secret.cron.SchedulePriority" json:"priority,omitempty"`
+	// Height of the block a due interval or cron expression schedule is executed in, zero until it's due.
+	// It's kept while the schedule is held back by the per block limit, so that it's executed before
+	// schedules that became due later
+	DueHeight uint64 `protobuf:"varint,19,opt,name=due_height,json=dueHeight,proto3" json:"due_height,omitempty"`
+	// Account that paused the schedule, empty while it's active
+	PausedBy string `protobuf:"bytes,20,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
 }
 
 func (m *Schedule) Reset()         { *m = Schedule{} }
@@ -96,12 +302,128 @@ func (m *Schedule) GetLastExecuteHeight() uint64 {
 	return 0
 }
 
+func (m *Schedule) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *Schedule) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *Schedule) GetNextExecuteTime() *time.Time {
+	if m != nil {
+		return m.NextExecuteTime
+	}
+	return nil
+}
+
+func (m *Schedule) GetLastExecuteTime() *time.Time {
+	if m != nil {
+		return m.LastExecuteTime
+	}
+	return nil
+}
+
+func (m *Schedule) GetFailurePolicy() FailurePolicy {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return FailurePolicy_FAILURE_POLICY_SKIP
+}
+
+func (m *Schedule) GetMaxFailures() uint32 {
+	if m != nil {
+		return m.MaxFailures
+	}
+	return 0
+}
+
+func (m *Schedule) GetLastResult() ExecutionResult {
+	if m != nil {
+		return m.LastResult
+	}
+	return ExecutionResult_EXECUTION_RESULT_UNSPECIFIED
+}
+
+func (m *Schedule) GetLastError() string {
+	if m != nil {
+		return m.LastError
+	}
+	return ""
+}
+
+func (m *Schedule) GetConsecutiveFailures() uint32 {
+	if m != nil {
+		return m.ConsecutiveFailures
+	}
+	return 0
+}
+
+func (m *Schedule) GetDisabled() bool {
+	if m != nil {
+		return m.Disabled
+	}
+	return false
+}
+
+func (m *Schedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *Schedule) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *Schedule) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *Schedule) GetPriority() SchedulePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return SchedulePriority_SCHEDULE_PRIORITY_NORMAL
+}
+
+func (m *Schedule) GetDueHeight() uint64 {
+	if m != nil {
+		return m.DueHeight
+	}
+	return 0
+}
+
+func (m *Schedule) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
 // Defines the contract and the message to pass
 type MsgExecuteContract struct {
 	// The address of the smart contract
 	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
 	// JSON encoded message to be passed to the contract
 	Msg string `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+	// Gas limit of the execution. Zero means the default gas limit
+	GasLimit uint64 `protobuf:"varint,3,opt,name=gas_limit,json=gasLimit,proto3" json:"gas_limit,omitempty"`
+	// Funds sent to the contract, drawn from the schedule's account
+	Funds github_com_cosmos_cosmos_sdk_types.Coins `protobuf:"bytes,4,rep,name=funds,proto3,castrepeated=github.com/cosmos/cosmos-sdk/types.Coins" json:"funds"`
 }
 
 func (m *MsgExecuteContract) Reset()         { *m = MsgExecuteContract{} }
@@ -151,6 +473,102 @@ func (m *MsgExecuteContract) GetMsg() string {
 	return ""
 }
 
+func (m *MsgExecuteContract) GetGasLimit() uint64 {
+	if m != nil {
+		return m.GasLimit
+	}
+	return 0
+}
+
+func (m *MsgExecuteContract) GetFunds() github_com_cosmos_cosmos_sdk_types.Coins {
+	if m != nil {
+		return m.Funds
+	}
+	return nil
+}
+
+// Defines a single execution of a scheduled msg
+type ScheduleExecution struct {
+	// Block height the scheduled tx was executed at
+	Height uint64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// Hash of the scheduled tx, empty if the tx wasn't executed
+	TxHash string `protobuf:"bytes,2,opt,name=tx_hash,json=txHash,proto3" json:"tx_hash,omitempty"`
+	// Whether the execution succeeded
+	Success bool `protobuf:"varint,3,opt,name=success,proto3" json:"success,omitempty"`
+	// Gas used by the execution
+	GasUsed uint64 `protobuf:"varint,4,opt,name=gas_used,json=gasUsed,proto3" json:"gas_used,omitempty"`
+	// ABCI codespace and code of the execution's error, if it failed
+	Error string `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+}
+
+func (m *ScheduleExecution) Reset()         { *m = ScheduleExecution{} }
+func (m *ScheduleExecution) String() string { return proto.CompactTextString(m) }
+func (*ScheduleExecution) ProtoMessage()    {}
+func (*ScheduleExecution) Descriptor() ([]byte, []int) {
+	return fileDescriptor_3d6729589d2158da, []int{2}
+}
+func (m *ScheduleExecution) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleExecution) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleExecution.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleExecution) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleExecution.Merge(m, src)
+}
+func (m *ScheduleExecution) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleExecution) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleExecution.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleExecution proto.InternalMessageInfo
+
+func (m *ScheduleExecution) GetHeight() uint64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *ScheduleExecution) GetTxHash() string {
+	if m != nil {
+		return m.TxHash
+	}
+	return ""
+}
+
+func (m *ScheduleExecution) GetSuccess() bool {
+	if m != nil {
+		return m.Success
+	}
+	return false
+}
+
+func (m *ScheduleExecution) GetGasUsed() uint64 {
+	if m != nil {
+		return m.GasUsed
+	}
+	return 0
+}
+
+func (m *ScheduleExecution) GetError() string {
+	if m != nil {
+		return m.Error
+	}
+	return ""
+}
+
 // Defines the number of current schedules
 type ScheduleCount struct {
 	// The number of current schedules
@@ -161,7 +579,7 @@ func (m *ScheduleCount) Reset()         { *m = ScheduleCount{} }
 func (m *ScheduleCount) String() string { return proto.CompactTextString(m) }
 func (*ScheduleCount) ProtoMessage()    {}
 func (*ScheduleCount) Descriptor() ([]byte, []int) {
-	return fileDescriptor_3d6729589d2158da, []int{2}
+	return fileDescriptor_3d6729589d2158da, []int{3}
 }
 func (m *ScheduleCount) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -198,35 +616,91 @@ func (m *ScheduleCount) GetCount() int32 {
 }
 
 func init() {
+	proto.RegisterEnum("secret.cron.ScheduleTrigger", ScheduleTrigger_name, ScheduleTrigger_value)
+	proto.RegisterEnum("secret.cron.PauseStatus", PauseStatus_name, PauseStatus_value)
+	proto.RegisterEnum("secret.cron.SchedulePriority", SchedulePriority_name, SchedulePriority_value)
+	proto.RegisterEnum("secret.cron.FailurePolicy", FailurePolicy_name, FailurePolicy_value)
+	proto.RegisterEnum("secret.cron.ExecutionResult", ExecutionResult_name, ExecutionResult_value)
 	proto.RegisterType((*Schedule)(nil), "secret.cron.Schedule")
 	proto.RegisterType((*MsgExecuteContract)(nil), "secret.cron.MsgExecuteContract")
+	proto.RegisterType((*ScheduleExecution)(nil), "secret.cron.ScheduleExecution")
 	proto.RegisterType((*ScheduleCount)(nil), "secret.cron.ScheduleCount")
 }
 
 func init() { proto.RegisterFile("secret/cron/schedule.proto", fileDescriptor_3d6729589d2158da) }
 
 var fileDescriptor_3d6729589d2158da = []byte{
-	// 315 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x91, 0xc1, 0x6a, 0x3a, 0x31,
-	0x10, 0xc6, 0x37, 0x7f, 0x57, 0xd1, 0xc8, 0x1f, 0xda, 0x54, 0xca, 0xe2, 0x21, 0x8a, 0x50, 0xf0,
-	0x94, 0x40, 0x7b, 0xea, 0x55, 0x29, 0x78, 0x69, 0x0f, 0xeb, 0xad, 0x17, 0x59, 0xe3, 0x90, 0x95,
-	0xba, 0x1b, 0x49, 0x66, 0xa9, 0x7d, 0x8b, 0xbe, 0x42, 0xdf, 0xc6, 0xa3, 0xc7, 0x9e, 0x4a, 0xd1,
-	0x17, 0x29, 0x9b, 0xdd, 0x96, 0x42, 0x6f, 0xbf, 0x2f, 0xdf, 0x7c, 0x93, 0x99, 0x84, 0xf6, 0x1d,
-	0x28, 0x0b, 0x28, 0x95, 0x35, 0xb9, 0x74, 0x2a, 0x85, 0x55, 0xb1, 0x01, 0xb1, 0xb5, 0x06, 0x0d,
-	0xeb, 0x56, 0x9e, 0x28, 0xbd, 0x7e, 0x4f, 0x1b, 0x6d, 0xfc, 0xb9, 0x2c, 0xa9, 0x2a, 0x19, 0xbd,
-	0x11, 0xda, 0x9e, 0xd7, 0x29, 0xc6, 0x68, 0x98, 0x27, 0x19, 0x44, 0x64, 0x48, 0xc6, 0x9d, 0xd8,
-	0x33, 0xbb, 0xa4, 0xad, 0x2d, 0xd8, 0xb5, 0x59, 0x45, 0xff, 0x86, 0x64, 0x1c, 0xc6, 0xb5, 0x62,
-	0xb7, 0x34, 0xcc, 0x9c, 0x76, 0x51, 0x63, 0xd8, 0x18, 0x77, 0xaf, 0x07, 0xe2, 0xd7, 0x55, 0xe2,
-	0xde, 0xe9, 0xbb, 0x1d, 0xa8, 0x02, 0x61, 0x6a, 0x72, 0xb4, 0x89, 0xc2, 0x49, 0xb8, 0xff, 0x18,
-	0x04, 0xb1, 0x8f, 0x30, 0x41, 0x2f, 0x36, 0x89, 0xc3, 0x05, 0x54, 0x35, 0x8b, 0x14, 0xd6, 0x3a,
-	0xc5, 0x28, 0xf4, 0xfd, 0xcf, 0x4b, 0xab, 0x4e, 0xcf, 0xbc, 0x31, 0x9a, 0x50, 0xf6, 0xb7, 0x23,
-	0xeb, 0xd3, 0xb6, 0xaa, 0xb9, 0x1e, 0xf8, 0x47, 0xb3, 0x33, 0xda, 0xc8, 0x9c, 0xf6, 0x13, 0x77,
-	0xe2, 0x12, 0x47, 0x57, 0xf4, 0xff, 0xf7, 0x9a, 0x53, 0x53, 0xe4, 0xc8, 0x7a, 0xb4, 0xa9, 0x4a,
-	0xf0, 0xd9, 0x66, 0x5c, 0x89, 0xc9, 0x6c, 0x7f, 0xe4, 0xe4, 0x70, 0xe4, 0xe4, 0xf3, 0xc8, 0xc9,
-	0xeb, 0x89, 0x07, 0x87, 0x13, 0x0f, 0xde, 0x4f, 0x3c, 0x78, 0x14, 0x7a, 0x8d, 0x69, 0xb1, 0x14,
-	0xca, 0x64, 0xd2, 0x29, 0x8b, 0x9b, 0x64, 0xe9, 0xe4, 0xdc, 0x2f, 0xfd, 0x00, 0xf8, 0x6c, 0xec,
-	0x93, 0xdc, 0x55, 0x9f, 0x80, 0x2f, 0x5b, 0x70, 0xcb, 0x96, 0x7f, 0xdf, 0x9b, 0xaf, 0x00, 0x00,
-	0x00, 0xff, 0xff, 0xb1, 0x42, 0x5e, 0xe1, 0xa0, 0x01, 0x00, 0x00,
+	// 1120 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x56, 0xd1, 0x6e, 0xe2, 0x46,
+	0x17, 0xc6, 0x40, 0x02, 0x0c, 0x9b, 0xc4, 0x99, 0xe4, 0xdf, 0x75, 0xf8, 0x13, 0xc2, 0x46, 0xad,
+	0x8a, 0x22, 0xd5, 0x6e, 0xd2, 0xab, 0xa8, 0xea, 0x05, 0x18, 0x27, 0x58, 0xcb, 0x02, 0x1a, 0xc3,
+	0x76, 0x53, 0xa9, 0xb5, 0x8c, 0x99, 0x18, 0x6b, 0xc1, 0x83, 0x3c, 0xe3, 0x2c, 0x79, 0x8a, 0xee,
+	0x55, 0x55, 0xa9, 0x6f, 0xd0, 0xc7, 0xe8, 0xd5, 0x5e, 0xee, 0x65, 0xaf, 0xba, 0x55, 0xf2, 0x22,
+	0xd5, 0x8c, 0x0d, 0x1b, 0xa0, 0xaa, 0x7a, 0x95, 0x73, 0xe6, 0x3b, 0x67, 0xce, 0x77, 0x3e, 0x9f,
+	0x33, 0x01, 0x94, 0x28, 0x76, 0x43, 0xcc, 0x34, 0x37, 0x24, 0x81, 0x46, 0xdd, 0x11, 0x1e, 0x46,
+	0x63, 0xac, 0x4e, 0x43, 0xc2, 0x08, 0x2c, 0xc6, 0x98, 0xca, 0xb1, 0x52, 0xd9, 0x25, 0x74, 0x42,
+	0xa8, 0x36, 0x70, 0x28, 0xd6, 0x6e, 0xcf, 0x06, 0x98, 0x39, 0x67, 0x9a, 0x4b, 0xfc, 0x20, 0x0e,
+	0x2e, 0xed, 0x7b, 0xc4, 0x23, 0xc2, 0xd4, 0xb8, 0x95, 0x9c, 0x96, 0x3d, 0x42, 0xbc, 0x31, 0xd6,
+	0x84, 0x37, 0x88, 0x6e, 0xb4, 0x61, 0x14, 0x3a, 0xcc, 0x27, 0xf3, 0xac, 0xe3, 0x55, 0x9c, 0xf9,
+	0x13, 0x4c, 0x99, 0x33, 0x99, 0xc6, 0x01, 0x27, 0x3f, 0xe7, 0x40, 0xde, 0x4a, 0x68, 0x41, 0x08,
+	0xb2, 0x81, 0x33, 0xc1, 0x8a, 0x54, 0x91, 0xaa, 0x05, 0x24, 0x6c, 0xf8, 0x14, 0x6c, 0x4e, 0x71,
+	0xe8, 0x93, 0xa1, 0x92, 0xae, 0x48, 0xd5, 0x2c, 0x4a, 0x3c, 0x78, 0x01, 0xb2, 0x13, 0xea, 0x51,
+	0x25, 0x53, 0xc9, 0x54, 0x8b, 0xe7, 0xc7, 0xea, 0xa3, 0x5e, 0xd4, 0x97, 0xd4, 0x33, 0x66, 0xd8,
+	0x8d, 0x18, 0xd6, 0x49, 0xc0, 0x42, 0xc7, 0x65, 0xf5, 0xec, 0xfb, 0x3f, 0x8f, 0x53, 0x48, 0xa4,
+	0x40, 0x15, 0xec, 0x8d, 0x1d, 0xca, 0x6c, 0x1c, 0xc7, 0xd8, 0x23, 0xec, 0x7b, 0x23, 0xa6, 0x64,
+	0xc5, 0xfd, 0xbb, 0x1c, 0x4a, 0xb2, 0x9b, 0x02, 0x80, 0xdf, 0x80, 0xbc, 0x1f, 0x30, 0x1c, 0xde,
+	0x3a, 0x63, 0x65, 0xa3, 0x22, 0x55, 0x8b, 0xe7, 0x07, 0x6a, 0xdc, 0x97, 0x3a, 0xef, 0x4b, 0x6d,
+	0x24, 0x7d, 0xd7, 0xb3, 0xbf, 0x7c, 0x3c, 0x96, 0xd0, 0x22, 0x01, 0x7e, 0x01, 0x76, 0x38, 0x27,
+	0x1b, 0xcf, 0xa6, 0x21, 0xa6, 0xd4, 0x27, 0x81, 0xb2, 0x29, 0xda, 0xdb, 0xe6, 0xc7, 0xc6, 0xe2,
+	0x14, 0xb6, 0xc0, 0x6e, 0x80, 0x67, 0x9f, 0x58, 0x71, 0xa5, 0x94, 0x9c, 0x28, 0x57, 0x5a, 0x2b,
+	0xd7, 0x9b, 0xcb, 0x58, 0xcf, 0xbe, 0xe3, 0xf5, 0x76, 0x78, 0x6a, 0xc2, 0x9a, 0x63, 0xfc, 0xb6,
+	0xa5, 0x1e, 0xc5, 0x6d, 0xf9, 0xff, 0x7a, 0xdb, 0x23, 0x0d, 0xc4, 0x6d, 0x35, 0xb0, 0x7d, 0xe3,
+	0xf8, 0xe3, 0x28, 0xc4, 0xf6, 0x94, 0x8c, 0x7d, 0xf7, 0x4e, 0x29, 0x54, 0xa4, 0xea, 0xf6, 0x79,
+	0x69, 0x49, 0xf6, 0xcb, 0x38, 0xa4, 0x2b, 0x22, 0xd0, 0xd6, 0xcd, 0x63, 0x17, 0x3e, 0x07, 0x4f,
+	0x26, 0xce, 0xcc, 0x4e, 0x0e, 0xa9, 0x02, 0x2a, 0x52, 0x75, 0x0b, 0x15, 0x27, 0xce, 0x2c, 0x49,
+	0xa3, 0xf0, 0x5b, 0x50, 0x14, 0x9c, 0x43, 0x4c, 0xa3, 0x31, 0x53, 0x8a, 0xa2, 0xc4, 0xe1, 0x52,
+	0x89, 0x98, 0x94, 0x4f, 0x02, 0x24, 0x62, 0x10, 0xe0, 0x09, 0xb1, 0x0d, 0x8f, 0x00, 0x88, 0x5b,
+	0x0e, 0x43, 0x12, 0x2a, 0x4f, 0x84, 0xc8, 0x05, 0xd1, 0x09, 0x3f, 0x80, 0x67, 0x60, 0xdf, 0x25,
+	0x01, 0x15, 0xf9, 0xb7, 0xf8, 0x13, 0x91, 0x2d, 0x41, 0x64, 0xef, 0x11, 0xb6, 0x20, 0x54, 0x02,
+	0xf9, 0xa1, 0x4f, 0x9d, 0xc1, 0x18, 0x0f, 0x95, 0xed, 0x8a, 0x54, 0xcd, 0xa3, 0x85, 0x0f, 0xf7,
+	0xc1, 0x06, 0x79, 0x1b, 0xe0, 0x50, 0xd9, 0x11, 0x85, 0x62, 0x07, 0x5e, 0x80, 0xdc, 0x10, 0x4f,
+	0x09, 0xf5, 0x99, 0x22, 0x27, 0x93, 0x12, 0xef, 0x95, 0xca, 0xf7, 0x4a, 0x4d, 0xf6, 0x4a, 0xd5,
+	0x89, 0x1f, 0x24, 0x23, 0x39, 0x8f, 0x17, 0x83, 0xee, 0x44, 0x14, 0x0f, 0x95, 0x5d, 0x51, 0x2a,
+	0xf1, 0xe0, 0x05, 0xc8, 0x4f, 0x43, 0x9f, 0x84, 0x3e, 0xbb, 0x53, 0xa0, 0x90, 0xe4, 0x68, 0x49,
+	0x92, 0xf9, 0xf6, 0x74, 0x93, 0x20, 0xb4, 0x08, 0xe7, 0x8a, 0x0c, 0xa3, 0xc5, 0x7c, 0xef, 0x89,
+	0xf9, 0x2e, 0x0c, 0xa3, 0xf9, 0x5c, 0xff, 0x1f, 0x14, 0xe2, 0x1a, 0xf6, 0xe0, 0x4e, 0xd9, 0x17,
+	0x6d, 0xe4, 0xe3, 0x83, 0xfa, 0xdd, 0xc9, 0xef, 0x12, 0x80, 0xeb, 0x7b, 0xc4, 0x25, 0x71, 0x13,
+	0x3b, 0x59, 0xd3, 0x85, 0x0f, 0x65, 0x90, 0x99, 0x50, 0x4f, 0xec, 0x69, 0x01, 0x71, 0x93, 0x57,
+	0xf0, 0x1c, 0x6a, 0x8f, 0xfd, 0x89, 0xcf, 0x94, 0x8c, 0xa8, 0x9f, 0xf7, 0x1c, 0xda, 0xe2, 0x3e,
+	0x74, 0xc0, 0xc6, 0x4d, 0x14, 0x0c, 0xa9, 0x92, 0xad, 0x64, 0xfe, 0x5d, 0xa9, 0xaf, 0xb8, 0x52,
+	0xbf, 0x7d, 0x3c, 0xae, 0x7a, 0x3e, 0x1b, 0x45, 0x03, 0xd5, 0x25, 0x13, 0x2d, 0x79, 0xae, 0xe2,
+	0x3f, 0x5f, 0xd2, 0xe1, 0x1b, 0x8d, 0xdd, 0x4d, 0x31, 0x15, 0x09, 0x14, 0xc5, 0x37, 0x9f, 0xfc,
+	0x24, 0x81, 0xdd, 0xb9, 0x3e, 0x8b, 0xd1, 0xe1, 0x4a, 0x27, 0x92, 0x48, 0xf1, 0x93, 0x12, 0x7b,
+	0xf0, 0x19, 0xc8, 0xb1, 0x99, 0x3d, 0x72, 0xe8, 0x28, 0xe9, 0x61, 0x93, 0xcd, 0x9a, 0x0e, 0x1d,
+	0x41, 0x05, 0xe4, 0x68, 0xe4, 0xba, 0x98, 0x52, 0xd1, 0x44, 0x1e, 0xcd, 0x5d, 0x78, 0x00, 0x78,
+	0x3f, 0xb6, 0xf8, 0x6c, 0xf1, 0xfb, 0x91, 0xf3, 0x1c, 0xda, 0xa7, 0xf1, 0x80, 0xc4, 0x93, 0xb8,
+	0x11, 0x0f, 0x88, 0x70, 0x4e, 0x3e, 0x07, 0x5b, 0x73, 0x42, 0x3a, 0x89, 0x02, 0xc6, 0xc3, 0x5c,
+	0x6e, 0x08, 0x2e, 0x1b, 0x28, 0x76, 0x4e, 0x7f, 0x95, 0xc0, 0xce, 0x3c, 0xae, 0x17, 0xfa, 0x9e,
+	0x87, 0x43, 0x58, 0x01, 0x87, 0x96, 0xde, 0x34, 0x1a, 0xfd, 0x96, 0x61, 0xf7, 0x90, 0x79, 0x75,
+	0x65, 0x20, 0xbb, 0xdf, 0xb6, 0xba, 0x86, 0x6e, 0x5e, 0x9a, 0x46, 0x43, 0x4e, 0xc1, 0xe7, 0xe0,
+	0x68, 0x2d, 0xa2, 0xde, 0xea, 0xe8, 0x2f, 0xec, 0xae, 0x81, 0xcc, 0x4e, 0x43, 0x96, 0xe0, 0x11,
+	0x38, 0x58, 0x0b, 0x31, 0xdb, 0x3d, 0x03, 0xbd, 0xaa, 0xb5, 0xe4, 0x34, 0xfc, 0x0c, 0x54, 0xd6,
+	0x60, 0x1d, 0x75, 0xda, 0xb6, 0xf1, 0xba, 0x8b, 0x0c, 0xcb, 0x32, 0x3b, 0x6d, 0x39, 0x73, 0xfa,
+	0x03, 0x28, 0x76, 0xf9, 0x9c, 0x58, 0xcc, 0x61, 0x11, 0x85, 0x87, 0x40, 0xe9, 0xd6, 0xfa, 0x96,
+	0x61, 0x5b, 0xbd, 0x5a, 0xaf, 0x6f, 0xad, 0x90, 0x7a, 0x06, 0xf6, 0x96, 0xd0, 0x9a, 0xde, 0x33,
+	0x5f, 0x19, 0xb2, 0xb4, 0x06, 0x08, 0xa7, 0x21, 0xa7, 0x4f, 0x3d, 0x20, 0xaf, 0x0e, 0x35, 0xaf,
+	0xb1, 0x20, 0xd6, 0x45, 0x66, 0x07, 0x99, 0xbd, 0x6b, 0xbb, 0xdd, 0x41, 0x2f, 0x6b, 0x2d, 0x39,
+	0x05, 0x4b, 0xe0, 0xe9, 0x3a, 0xda, 0x34, 0xaf, 0x9a, 0xb2, 0x04, 0x0f, 0xc0, 0xff, 0xd6, 0xb1,
+	0x56, 0xe7, 0x3b, 0x39, 0x7d, 0xfa, 0x23, 0xd8, 0x5a, 0x7a, 0xb3, 0x38, 0xa5, 0xcb, 0x9a, 0xd9,
+	0xea, 0x23, 0xc3, 0xee, 0x76, 0x5a, 0xa6, 0x7e, 0x6d, 0x5b, 0x2f, 0xcc, 0xae, 0x9c, 0x82, 0x0a,
+	0xd8, 0x5f, 0x01, 0x90, 0xd1, 0x43, 0xd7, 0xb2, 0xc4, 0x4b, 0xaf, 0x20, 0x0d, 0xd3, 0xaa, 0xd5,
+	0x5b, 0x86, 0x9c, 0x3e, 0x25, 0x60, 0x67, 0xe5, 0xc1, 0xe2, 0x1f, 0xd1, 0x78, 0x6d, 0xe8, 0xfd,
+	0x9e, 0xd9, 0x69, 0xdb, 0xc8, 0xb0, 0xfa, 0xad, 0xde, 0x8a, 0x5e, 0x87, 0x40, 0x59, 0x8b, 0xb0,
+	0xfa, 0xba, 0x6e, 0x58, 0x96, 0x2c, 0xfd, 0x23, 0x9a, 0xd4, 0x97, 0xd3, 0xf5, 0xe6, 0xfb, 0xfb,
+	0xb2, 0xf4, 0xe1, 0xbe, 0x2c, 0xfd, 0x75, 0x5f, 0x96, 0xde, 0x3d, 0x94, 0x53, 0x1f, 0x1e, 0xca,
+	0xa9, 0x3f, 0x1e, 0xca, 0xa9, 0xef, 0xd5, 0x47, 0xab, 0x43, 0xdd, 0x90, 0x8d, 0x9d, 0x01, 0xd5,
+	0x2c, 0xf1, 0x8c, 0xb4, 0x31, 0x7b, 0x4b, 0xc2, 0x37, 0xda, 0x2c, 0xfe, 0x91, 0x20, 0xd6, 0x68,
+	0xb0, 0x29, 0xfe, 0x39, 0x7c, 0xfd, 0xf7, 0x00, 0xf6, 0x5b, 0x2c, 0x05, 0x40, 0x08, 0x00, 0x00,
 }
 
 func (m *Schedule) Marshal() (dAtA []byte, err error) {
@@ -249,6 +723,134 @@ func (m *Schedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	if m.DueHeight != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.DueHeight))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x98
+	}
+	if m.Priority != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x90
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0x88
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintSchedule(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x7a
+	}
+	if m.Disabled {
+		i--
+		if m.Disabled {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x70
+	}
+	if m.ConsecutiveFailures != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.ConsecutiveFailures))
+		i--
+		dAtA[i] = 0x68
+	}
+	if len(m.LastError) > 0 {
+		i -= len(m.LastError)
+		copy(dAtA[i:], m.LastError)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.LastError)))
+		i--
+		dAtA[i] = 0x62
+	}
+	if m.LastResult != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.LastResult))
+		i--
+		dAtA[i] = 0x58
+	}
+	if m.MaxFailures != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x50
+	}
+	if m.FailurePolicy != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.FailurePolicy))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.LastExecuteTime != nil {
+		n2, err2 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.LastExecuteTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastExecuteTime):])
+		if err2 != nil {
+			return 0, err2
+		}
+		i -= n2
+		i = encodeVarintSchedule(dAtA, i, uint64(n2))
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.NextExecuteTime != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.NextExecuteTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextExecuteTime):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintSchedule(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x3a
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintSchedule(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if m.LastExecuteHeight != 0 {
 		i = encodeVarintSchedule(dAtA, i, uint64(m.LastExecuteHeight))
 		i--
@@ -303,6 +905,25 @@ func (m *MsgExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.Funds) > 0 {
+		for iNdEx := len(m.Funds) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Funds[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintSchedule(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.GasLimit != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasLimit))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Msg) > 0 {
 		i -= len(m.Msg)
 		copy(dAtA[i:], m.Msg)
@@ -320,7 +941,7 @@ func (m *MsgExecuteContract) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *ScheduleCount) Marshal() (dAtA []byte, err error) {
+func (m *ScheduleExecution) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -330,26 +951,83 @@ func (m *ScheduleCount) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *ScheduleCount) MarshalTo(dAtA []byte) (int, error) {
+func (m *ScheduleExecution) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *ScheduleCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *ScheduleExecution) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if m.Count != 0 {
-		i = encodeVarintSchedule(dAtA, i, uint64(m.Count))
+	if len(m.Error) > 0 {
+		i -= len(m.Error)
+		copy(dAtA[i:], m.Error)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.Error)))
 		i--
-		dAtA[i] = 0x8
+		dAtA[i] = 0x2a
 	}
-	return len(dAtA) - i, nil
-}
-
-func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
-	offset -= sovSchedule(v)
+	if m.GasUsed != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.GasUsed))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Success {
+		i--
+		if m.Success {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.TxHash) > 0 {
+		i -= len(m.TxHash)
+		copy(dAtA[i:], m.TxHash)
+		i = encodeVarintSchedule(dAtA, i, uint64(len(m.TxHash)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleCount) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleCount) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleCount) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Count != 0 {
+		i = encodeVarintSchedule(dAtA, i, uint64(m.Count))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintSchedule(dAtA []byte, offset int, v uint64) int {
+	offset -= sovSchedule(v)
 	base := offset
 	for v >= 1<<7 {
 		dAtA[offset] = uint8(v&0x7f | 0x80)
@@ -381,6 +1059,60 @@ func (m *Schedule) Size() (n int) {
 	if m.LastExecuteHeight != 0 {
 		n += 1 + sovSchedule(uint64(m.LastExecuteHeight))
 	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.NextExecuteTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.NextExecuteTime)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.LastExecuteTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.LastExecuteTime)
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.FailurePolicy != 0 {
+		n += 1 + sovSchedule(uint64(m.FailurePolicy))
+	}
+	if m.MaxFailures != 0 {
+		n += 1 + sovSchedule(uint64(m.MaxFailures))
+	}
+	if m.LastResult != 0 {
+		n += 1 + sovSchedule(uint64(m.LastResult))
+	}
+	l = len(m.LastError)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.ConsecutiveFailures != 0 {
+		n += 1 + sovSchedule(uint64(m.ConsecutiveFailures))
+	}
+	if m.Disabled {
+		n += 2
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	l = m.Deposit.Size()
+	n += 2 + l + sovSchedule(uint64(l))
+	if m.Paused {
+		n += 3
+	}
+	if m.Priority != 0 {
+		n += 2 + sovSchedule(uint64(m.Priority))
+	}
+	if m.DueHeight != 0 {
+		n += 2 + sovSchedule(uint64(m.DueHeight))
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 2 + l + sovSchedule(uint64(l))
+	}
 	return n
 }
 
@@ -398,6 +1130,41 @@ func (m *MsgExecuteContract) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovSchedule(uint64(l))
 	}
+	if m.GasLimit != 0 {
+		n += 1 + sovSchedule(uint64(m.GasLimit))
+	}
+	if len(m.Funds) > 0 {
+		for _, e := range m.Funds {
+			l = e.Size()
+			n += 1 + l + sovSchedule(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *ScheduleExecution) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovSchedule(uint64(m.Height))
+	}
+	l = len(m.TxHash)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
+	if m.Success {
+		n += 2
+	}
+	if m.GasUsed != 0 {
+		n += 1 + sovSchedule(uint64(m.GasUsed))
+	}
+	l = len(m.Error)
+	if l > 0 {
+		n += 1 + l + sovSchedule(uint64(l))
+	}
 	return n
 }
 
@@ -552,59 +1319,45 @@ func (m *Schedule) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
-		default:
-			iNdEx = preIndex
-			skippy, err := skipSchedule(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthSchedule
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowSchedule
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -632,13 +1385,13 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Contract = string(dAtA[iNdEx:postIndex])
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 2:
+		case 7:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field NextExecuteTime", wireType)
 			}
-			var stringLen uint64
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowSchedule
@@ -648,23 +1401,685 @@ func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthSchedule
 			}
-			postIndex := iNdEx + intStringLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthSchedule
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Msg = string(dAtA[iNdEx:postIndex])
+			if m.NextExecuteTime == nil {
+				m.NextExecuteTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.NextExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastExecuteTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.LastExecuteTime == nil {
+				m.LastExecuteTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.LastExecuteTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			m.FailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailurePolicy |= FailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
+			}
+			m.MaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastResult", wireType)
+			}
+			m.LastResult = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LastResult |= ExecutionResult(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LastError", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.LastError = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 13:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ConsecutiveFailures", wireType)
+			}
+			m.ConsecutiveFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ConsecutiveFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 14:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Disabled", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Disabled = bool(v != 0)
+		case 15:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 18:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= SchedulePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 19:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DueHeight", wireType)
+			}
+			m.DueHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DueHeight |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgExecuteContract) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgExecuteContract: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgExecuteContract: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasLimit", wireType)
+			}
+			m.GasLimit = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasLimit |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Funds", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Funds = append(m.Funds, types.Coin{})
+			if err := m.Funds[len(m.Funds)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipSchedule(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleExecution) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowSchedule
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleExecution: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleExecution: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TxHash", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TxHash = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Success", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Success = bool(v != 0)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GasUsed", wireType)
+			}
+			m.GasUsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.GasUsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Error", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowSchedule
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthSchedule
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Error = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
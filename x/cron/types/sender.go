@@ -0,0 +1,39 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+)
+
+// scheduledTxSenderName is the name the sender of scheduled txs is derived from
+const scheduledTxSenderName = "cron_scheduler"
+
+// legacyScheduledTxSender is the address of the secp256k1 key that signed scheduled txs before
+// consensus version 3. The key was embedded in the source, so txs from it can't be trusted.
+var legacyScheduledTxSender = sdk.AccAddress{
+	0x77, 0xef, 0x01, 0xeb, 0x1a, 0x7d, 0xef, 0x1e, 0x76, 0x9b,
+	0x3e, 0x0c, 0x98, 0xd6, 0x9b, 0xe8, 0x73, 0x37, 0xe7, 0x91,
+}
+
+// ScheduledTxSender returns the address that sends the scheduled txs.
+// It's derived like a module account address, so there's no private key for it. Scheduled txs
+// are unsigned and are authenticated by their provenance instead: the chain only accepts the
+// txs it scheduled itself in the previous block, and the enclave only accepts them as part of
+// the verified block.
+func ScheduledTxSender() sdk.AccAddress {
+	return authtypes.NewModuleAddress(scheduledTxSenderName)
+}
+
+// OwnerScheduledTxSender returns the address that sends the scheduled txs of the schedules of owner.
+// Owned schedules are permissionless, so they don't run as ScheduledTxSender, which is reserved for
+// the schedules and sudo calls approved by governance. Like ScheduledTxSender, there's no private key for it.
+func OwnerScheduledTxSender(owner sdk.AccAddress) sdk.AccAddress {
+	return authtypes.NewModuleAddress(fmt.Sprintf("%s/%X", scheduledTxSenderName, owner.Bytes()))
+}
+
+// LegacyScheduledTxSender returns the address that signed scheduled txs before consensus version 3
+func LegacyScheduledTxSender() sdk.AccAddress {
+	return legacyScheduledTxSender
+}
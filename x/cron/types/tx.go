@@ -37,14 +37,86 @@ func (msg *MsgAddSchedule) Validate() error {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "name is invalid")
 	}
 
-	if msg.Period == 0 {
-		return errors.Wrap(sdkerrors.ErrInvalidRequest, "period is invalid")
+	if err := ValidateScheduleTrigger(msg.Period, msg.Interval, msg.CronExpression); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
 	}
 
 	if len(msg.Msgs) == 0 {
 		return errors.Wrap(sdkerrors.ErrInvalidRequest, "msgs should not be empty")
 	}
 
+	if err := ValidateScheduleMsgs(msg.Msgs); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateFailurePolicy(msg.FailurePolicy, msg.MaxFailures); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidatePriority(msg.Priority, false); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgRegisterSchedule{}
+
+func (msg *MsgRegisterSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgRegisterSchedule) Type() string {
+	return "register-schedule"
+}
+
+func (msg *MsgRegisterSchedule) GetSigners() []sdk.AccAddress {
+	owner, err := sdk.AccAddressFromBech32(msg.Owner)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{owner}
+}
+
+func (msg *MsgRegisterSchedule) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgRegisterSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Owner); err != nil {
+		return errors.Wrap(err, "owner is invalid")
+	}
+
+	if msg.Name == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "name is invalid")
+	}
+
+	if err := ValidateScheduleTrigger(msg.Period, msg.Interval, msg.CronExpression); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.Msgs) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "msgs should not be empty")
+	}
+
+	if err := ValidateScheduleMsgs(msg.Msgs); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidateFailurePolicy(msg.FailurePolicy, msg.MaxFailures); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := ValidatePriority(msg.Priority, true); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if err := msg.Deposit.Validate(); err != nil || !msg.Deposit.IsPositive() {
+		return errors.Wrapf(sdkerrors.ErrInvalidCoins, "invalid deposit %s", msg.Deposit)
+	}
+
 	return nil
 }
 
@@ -86,6 +158,126 @@ func (msg *MsgRemoveSchedule) Validate() error {
 
 //----------------------------------------------------------------
 
+var _ sdk.Msg = &MsgUpdateSchedule{}
+
+func (msg *MsgUpdateSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgUpdateSchedule) Type() string {
+	return "update-schedule"
+}
+
+func (msg *MsgUpdateSchedule) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgUpdateSchedule) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgUpdateSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "authority is invalid")
+	}
+
+	if msg.Name == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "name is invalid")
+	}
+
+	if err := ValidateScheduleTrigger(msg.Period, msg.Interval, msg.CronExpression); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	if len(msg.Msgs) == 0 {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "msgs should not be empty")
+	}
+
+	if err := ValidateScheduleMsgs(msg.Msgs); err != nil {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgPauseSchedule{}
+
+func (msg *MsgPauseSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgPauseSchedule) Type() string {
+	return "pause-schedule"
+}
+
+func (msg *MsgPauseSchedule) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgPauseSchedule) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgPauseSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "authority is invalid")
+	}
+
+	if msg.Name == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "name is invalid")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
+var _ sdk.Msg = &MsgResumeSchedule{}
+
+func (msg *MsgResumeSchedule) Route() string {
+	return RouterKey
+}
+
+func (msg *MsgResumeSchedule) Type() string {
+	return "resume-schedule"
+}
+
+func (msg *MsgResumeSchedule) GetSigners() []sdk.AccAddress {
+	authority, err := sdk.AccAddressFromBech32(msg.Authority)
+	if err != nil { // should never happen as valid basic rejects invalid addresses
+		panic(err.Error())
+	}
+	return []sdk.AccAddress{authority}
+}
+
+func (msg *MsgResumeSchedule) GetSignBytes() []byte {
+	return ModuleCdc.MustMarshalJSON(msg)
+}
+
+func (msg *MsgResumeSchedule) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return errors.Wrap(err, "authority is invalid")
+	}
+
+	if msg.Name == "" {
+		return errors.Wrap(sdkerrors.ErrInvalidRequest, "name is invalid")
+	}
+
+	return nil
+}
+
+//----------------------------------------------------------------
+
 var _ sdk.Msg = &MsgUpdateParams{}
 
 func (msg *MsgUpdateParams) Route() string {
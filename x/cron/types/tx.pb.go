@@ -7,23 +7,28 @@ import (
 	context "context"
 	fmt "fmt"
 	_ "github.com/cosmos/cosmos-proto"
+	types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google/protobuf"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -37,10 +42,20 @@ type MsgAddSchedule struct {
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// Name of the schedule
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	// Period in blocks
+	// Period in blocks. Exactly one of `period`, `interval` and `cron_expression` must be set
 	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
-	// Msgs that will be executed every certain number of blocks, specified in the `period` field
+	// Msgs that will be executed when the schedule is due
 	Msgs []MsgExecuteContract `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs"`
+	// Fixed duration of block time between executions
+	Interval *time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// Standard 5 field cron expression (e.g. "0 0 * * *"), evaluated in UTC against block time
+	CronExpression string `protobuf:"bytes,6,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// What happens when an execution fails
+	FailurePolicy FailurePolicy `protobuf:"varint,7,opt,name=failure_policy,json=failurePolicy,proto3,enum=secret.cron.FailurePolicy" json:"failure_policy,omitempty"`
+	// Consecutive failures after which the schedule is disabled, for FAILURE_POLICY_DISABLE
+	MaxFailures uint32 `protobuf:"varint,8,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// Priority tier of the schedule
+	Priority SchedulePriority `protobuf:"varint,9,opt,name=priority,proto3,enum=secret.cron.SchedulePriority" json:"priority,omitempty"`
 }
 
 func (m *MsgAddSchedule) Reset()         { *m = MsgAddSchedule{} }
@@ -104,6 +119,41 @@ func (m *MsgAddSchedule) GetMsgs() []MsgExecuteContract {
 	return nil
 }
 
+func (m *MsgAddSchedule) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *MsgAddSchedule) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *MsgAddSchedule) GetFailurePolicy() FailurePolicy {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return FailurePolicy_FAILURE_POLICY_SKIP
+}
+
+func (m *MsgAddSchedule) GetMaxFailures() uint32 {
+	if m != nil {
+		return m.MaxFailures
+	}
+	return 0
+}
+
+func (m *MsgAddSchedule) GetPriority() SchedulePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return SchedulePriority_SCHEDULE_PRIORITY_NORMAL
+}
+
 // Defines the response structure for executing a MsgAddSchedule message.
 type MsgAddScheduleResponse struct {
 }
@@ -141,9 +191,174 @@ func (m *MsgAddScheduleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgAddScheduleResponse proto.InternalMessageInfo
 
+// The MsgRegisterSchedule request type.
+type MsgRegisterSchedule struct {
+	// The account or contract that owns the schedule
+	Owner string `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	// Name of the schedule
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Period in blocks. Exactly one of `period`, `interval` and `cron_expression` must be set
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// Msgs that will be executed when the schedule is due
+	Msgs []MsgExecuteContract `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs"`
+	// Fixed duration of block time between executions
+	Interval *time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// Standard 5 field cron expression (e.g. "0 0 * * *"), evaluated in UTC against block time
+	CronExpression string `protobuf:"bytes,6,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
+	// What happens when an execution fails
+	FailurePolicy FailurePolicy `protobuf:"varint,7,opt,name=failure_policy,json=failurePolicy,proto3,enum=secret.cron.FailurePolicy" json:"failure_policy,omitempty"`
+	// Consecutive failures after which the schedule is disabled, for FAILURE_POLICY_DISABLE
+	MaxFailures uint32 `protobuf:"varint,8,opt,name=max_failures,json=maxFailures,proto3" json:"max_failures,omitempty"`
+	// Deposit bonded by the owner, at least the `min_deposit` param.
+	// Execution fees are paid from it and the rest is refunded when the schedule is removed
+	Deposit types.Coin `protobuf:"bytes,9,opt,name=deposit,proto3" json:"deposit"`
+	// Priority tier of the schedule. Owned schedules can't have high priority
+	Priority SchedulePriority `protobuf:"varint,10,opt,name=priority,proto3,enum=secret.cron.SchedulePriority" json:"priority,omitempty"`
+}
+
+func (m *MsgRegisterSchedule) Reset()         { *m = MsgRegisterSchedule{} }
+func (m *MsgRegisterSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterSchedule) ProtoMessage()    {}
+func (*MsgRegisterSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{2}
+}
+func (m *MsgRegisterSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterSchedule.Merge(m, src)
+}
+func (m *MsgRegisterSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterSchedule proto.InternalMessageInfo
+
+func (m *MsgRegisterSchedule) GetOwner() string {
+	if m != nil {
+		return m.Owner
+	}
+	return ""
+}
+
+func (m *MsgRegisterSchedule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *MsgRegisterSchedule) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
+}
+
+func (m *MsgRegisterSchedule) GetMsgs() []MsgExecuteContract {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
+}
+
+func (m *MsgRegisterSchedule) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *MsgRegisterSchedule) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+func (m *MsgRegisterSchedule) GetFailurePolicy() FailurePolicy {
+	if m != nil {
+		return m.FailurePolicy
+	}
+	return FailurePolicy_FAILURE_POLICY_SKIP
+}
+
+func (m *MsgRegisterSchedule) GetMaxFailures() uint32 {
+	if m != nil {
+		return m.MaxFailures
+	}
+	return 0
+}
+
+func (m *MsgRegisterSchedule) GetDeposit() types.Coin {
+	if m != nil {
+		return m.Deposit
+	}
+	return types.Coin{}
+}
+
+func (m *MsgRegisterSchedule) GetPriority() SchedulePriority {
+	if m != nil {
+		return m.Priority
+	}
+	return SchedulePriority_SCHEDULE_PRIORITY_NORMAL
+}
+
+// Defines the response structure for executing a MsgRegisterSchedule message.
+type MsgRegisterScheduleResponse struct {
+}
+
+func (m *MsgRegisterScheduleResponse) Reset()         { *m = MsgRegisterScheduleResponse{} }
+func (m *MsgRegisterScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgRegisterScheduleResponse) ProtoMessage()    {}
+func (*MsgRegisterScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{3}
+}
+func (m *MsgRegisterScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgRegisterScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgRegisterScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgRegisterScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgRegisterScheduleResponse.Merge(m, src)
+}
+func (m *MsgRegisterScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgRegisterScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgRegisterScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgRegisterScheduleResponse proto.InternalMessageInfo
+
 // The MsgRemoveSchedule request type.
 type MsgRemoveSchedule struct {
-	// The address of the governance account.
+	// The address of the governance account, the security address or the owner of the schedule.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
 	// Name of the schedule
 	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
@@ -153,7 +368,7 @@ func (m *MsgRemoveSchedule) Reset()         { *m = MsgRemoveSchedule{} }
 func (m *MsgRemoveSchedule) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveSchedule) ProtoMessage()    {}
 func (*MsgRemoveSchedule) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc5dfbc481f4f7b1, []int{2}
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{4}
 }
 func (m *MsgRemoveSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -204,7 +419,7 @@ func (m *MsgRemoveScheduleResponse) Reset()         { *m = MsgRemoveScheduleResp
 func (m *MsgRemoveScheduleResponse) String() string { return proto.CompactTextString(m) }
 func (*MsgRemoveScheduleResponse) ProtoMessage()    {}
 func (*MsgRemoveScheduleResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc5dfbc481f4f7b1, []int{3}
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{5}
 }
 func (m *MsgRemoveScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -233,30 +448,34 @@ func (m *MsgRemoveScheduleResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgRemoveScheduleResponse proto.InternalMessageInfo
 
-// The MsgUpdateParams request type.
-//
-// Since: 0.47
-type MsgUpdateParams struct {
-	// The address of the governance account.
+// The MsgUpdateSchedule request type.
+type MsgUpdateSchedule struct {
+	// The address of the governance account or the owner of the schedule.
 	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
-	// Defines the x/cron parameters to update.
-	//
-	// NOTE: All parameters must be supplied.
-	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// Name of the schedule
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	// Period in blocks. Exactly one of `period`, `interval` and `cron_expression` must be set
+	Period uint64 `protobuf:"varint,3,opt,name=period,proto3" json:"period,omitempty"`
+	// Msgs that will be executed when the schedule is due
+	Msgs []MsgExecuteContract `protobuf:"bytes,4,rep,name=msgs,proto3" json:"msgs"`
+	// Fixed duration of block time between executions
+	Interval *time.Duration `protobuf:"bytes,5,opt,name=interval,proto3,stdduration" json:"interval,omitempty"`
+	// Standard 5 field cron expression (e.g. "0 0 * * *"), evaluated in UTC against block time
+	CronExpression string `protobuf:"bytes,6,opt,name=cron_expression,json=cronExpression,proto3" json:"cron_expression,omitempty"`
 }
 
-func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
-func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParams) ProtoMessage()    {}
-func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc5dfbc481f4f7b1, []int{4}
+func (m *MsgUpdateSchedule) Reset()         { *m = MsgUpdateSchedule{} }
+func (m *MsgUpdateSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateSchedule) ProtoMessage()    {}
+func (*MsgUpdateSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{6}
 }
-func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+func (m *MsgUpdateSchedule) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateSchedule.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -266,50 +485,76 @@ func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, err
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+func (m *MsgUpdateSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateSchedule.Merge(m, src)
 }
-func (m *MsgUpdateParams) XXX_Size() int {
+func (m *MsgUpdateSchedule) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParams) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+func (m *MsgUpdateSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateSchedule.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateSchedule proto.InternalMessageInfo
 
-func (m *MsgUpdateParams) GetAuthority() string {
+func (m *MsgUpdateSchedule) GetAuthority() string {
 	if m != nil {
 		return m.Authority
 	}
 	return ""
 }
 
-func (m *MsgUpdateParams) GetParams() Params {
+func (m *MsgUpdateSchedule) GetName() string {
 	if m != nil {
-		return m.Params
+		return m.Name
 	}
-	return Params{}
+	return ""
 }
 
-// Defines the response structure for executing a MsgUpdateParams message.
-//
-// Since: 0.47
-type MsgUpdateParamsResponse struct {
+func (m *MsgUpdateSchedule) GetPeriod() uint64 {
+	if m != nil {
+		return m.Period
+	}
+	return 0
 }
 
-func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
-func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
-func (*MsgUpdateParamsResponse) ProtoMessage()    {}
-func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_dc5dfbc481f4f7b1, []int{5}
+func (m *MsgUpdateSchedule) GetMsgs() []MsgExecuteContract {
+	if m != nil {
+		return m.Msgs
+	}
+	return nil
 }
-func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+
+func (m *MsgUpdateSchedule) GetInterval() *time.Duration {
+	if m != nil {
+		return m.Interval
+	}
+	return nil
+}
+
+func (m *MsgUpdateSchedule) GetCronExpression() string {
+	if m != nil {
+		return m.CronExpression
+	}
+	return ""
+}
+
+// Defines the response structure for executing a MsgUpdateSchedule message.
+type MsgUpdateScheduleResponse struct {
+}
+
+func (m *MsgUpdateScheduleResponse) Reset()         { *m = MsgUpdateScheduleResponse{} }
+func (m *MsgUpdateScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateScheduleResponse) ProtoMessage()    {}
+func (*MsgUpdateScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{7}
+}
+func (m *MsgUpdateScheduleResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
 }
-func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+func (m *MsgUpdateScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
 	if deterministic {
-		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+		return xxx_messageInfo_MsgUpdateScheduleResponse.Marshal(b, m, deterministic)
 	} else {
 		b = b[:cap(b)]
 		n, err := m.MarshalToSizedBuffer(b)
@@ -319,95 +564,418 @@ func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]b
 		return b[:n], nil
 	}
 }
-func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
-	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+func (m *MsgUpdateScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateScheduleResponse.Merge(m, src)
 }
-func (m *MsgUpdateParamsResponse) XXX_Size() int {
+func (m *MsgUpdateScheduleResponse) XXX_Size() int {
 	return m.Size()
 }
-func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
-	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+func (m *MsgUpdateScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateScheduleResponse.DiscardUnknown(m)
 }
 
-var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+var xxx_messageInfo_MsgUpdateScheduleResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*MsgAddSchedule)(nil), "secret.cron.MsgAddSchedule")
-	proto.RegisterType((*MsgAddScheduleResponse)(nil), "secret.cron.MsgAddScheduleResponse")
-	proto.RegisterType((*MsgRemoveSchedule)(nil), "secret.cron.MsgRemoveSchedule")
-	proto.RegisterType((*MsgRemoveScheduleResponse)(nil), "secret.cron.MsgRemoveScheduleResponse")
-	proto.RegisterType((*MsgUpdateParams)(nil), "secret.cron.MsgUpdateParams")
-	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "secret.cron.MsgUpdateParamsResponse")
+// The MsgPauseSchedule request type.
+type MsgPauseSchedule struct {
+	// The address of the governance account, the security address or the owner of the schedule.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Name of the schedule
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
 }
 
-func init() { proto.RegisterFile("secret/cron/tx.proto", fileDescriptor_dc5dfbc481f4f7b1) }
-
-var fileDescriptor_dc5dfbc481f4f7b1 = []byte{
-	// 528 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x94, 0xcf, 0x8b, 0xd3, 0x40,
-	0x14, 0xc7, 0x3b, 0xdb, 0x5a, 0xe8, 0x54, 0x56, 0x36, 0x5b, 0xba, 0x69, 0x56, 0xb2, 0xa5, 0xca,
-	0x5a, 0x0b, 0x26, 0x58, 0x61, 0xc1, 0xde, 0xb6, 0x22, 0x78, 0x89, 0x4a, 0x8a, 0x20, 0x5e, 0x24,
-	0x4d, 0x86, 0x69, 0x70, 0x93, 0x09, 0xf3, 0xa6, 0x6b, 0xf7, 0x26, 0x1e, 0xd7, 0x8b, 0x7f, 0x86,
-	0x78, 0xea, 0xc1, 0x3f, 0x62, 0x8f, 0x8b, 0x17, 0x3d, 0x89, 0xb4, 0x87, 0xfe, 0x1b, 0x92, 0x5f,
-	0xbb, 0x49, 0x0a, 0x3d, 0x08, 0x7b, 0x49, 0x66, 0xbe, 0x9f, 0x79, 0x6f, 0xbe, 0xef, 0xcd, 0x24,
-	0xb8, 0x01, 0xc4, 0xe6, 0x44, 0xe8, 0x36, 0x67, 0xbe, 0x2e, 0x66, 0x5a, 0xc0, 0x99, 0x60, 0x52,
-	0x3d, 0x56, 0xb5, 0x50, 0x55, 0x76, 0x2c, 0xcf, 0xf5, 0x99, 0x1e, 0x3d, 0x63, 0xae, 0xec, 0xd9,
-	0x0c, 0x3c, 0x06, 0xba, 0x07, 0x54, 0x3f, 0x7d, 0x1c, 0xbe, 0x12, 0xd0, 0x8a, 0xc1, 0xfb, 0x68,
-	0xa6, 0xc7, 0x93, 0x04, 0x35, 0x28, 0xa3, 0x2c, 0xd6, 0xc3, 0x51, 0xa2, 0xca, 0xd9, 0xfd, 0x03,
-	0x8b, 0x5b, 0x5e, 0xba, 0x5e, 0xc9, 0x12, 0xb0, 0x27, 0xc4, 0x99, 0x9e, 0x90, 0x98, 0x75, 0x7e,
-	0x21, 0xbc, 0x6d, 0x00, 0x3d, 0x76, 0x9c, 0x51, 0x02, 0xa4, 0x23, 0x5c, 0xb3, 0xa6, 0x62, 0xc2,
-	0xb8, 0x2b, 0xce, 0x64, 0xd4, 0x46, 0xdd, 0xda, 0x50, 0xfe, 0xf9, 0xe3, 0x51, 0x23, 0xf1, 0x70,
-	0xec, 0x38, 0x9c, 0x00, 0x8c, 0x04, 0x77, 0x7d, 0x6a, 0x5e, 0x2f, 0x95, 0x24, 0x5c, 0xf1, 0x2d,
-	0x8f, 0xc8, 0x5b, 0x61, 0x88, 0x19, 0x8d, 0xa5, 0x26, 0xae, 0x06, 0x84, 0xbb, 0xcc, 0x91, 0xcb,
-	0x6d, 0xd4, 0xad, 0x98, 0xc9, 0x4c, 0x7a, 0x8a, 0x2b, 0x1e, 0x50, 0x90, 0x2b, 0xed, 0x72, 0xb7,
-	0xde, 0x3f, 0xd0, 0x32, 0x5d, 0xd2, 0x0c, 0xa0, 0xcf, 0x67, 0xc4, 0x9e, 0x0a, 0xf2, 0x8c, 0xf9,
-	0x82, 0x5b, 0xb6, 0x18, 0x56, 0x2e, 0xfe, 0x1c, 0x94, 0xcc, 0x28, 0x64, 0x70, 0xf8, 0x79, 0x35,
-	0xef, 0x5d, 0x6f, 0x7b, 0xbe, 0x9a, 0xf7, 0x76, 0xa3, 0xca, 0xf2, 0x65, 0x74, 0x64, 0xdc, 0xcc,
-	0x2b, 0x26, 0x81, 0x80, 0xf9, 0x40, 0x3a, 0xe7, 0x08, 0xef, 0x18, 0x40, 0x4d, 0xe2, 0xb1, 0x53,
-	0x72, 0x13, 0x65, 0x0f, 0x1e, 0xae, 0x7b, 0x6c, 0xa6, 0x1e, 0xf3, 0xdb, 0x76, 0xf6, 0x71, 0x6b,
-	0x4d, 0xbc, 0x72, 0xfa, 0x1d, 0xe1, 0x3b, 0x06, 0xd0, 0x37, 0x81, 0x63, 0x09, 0xf2, 0x3a, 0x3a,
-	0xd3, 0xff, 0xf6, 0x79, 0x84, 0xab, 0xf1, 0xad, 0x88, 0x9c, 0xd6, 0xfb, 0xbb, 0xb9, 0xa6, 0xc7,
-	0xc9, 0x87, 0xb5, 0xb0, 0xd1, 0xdf, 0x56, 0xf3, 0x1e, 0x32, 0x93, 0xd5, 0x83, 0x07, 0xeb, 0xb5,
-	0x34, 0xd2, 0x5a, 0xb2, 0xc6, 0x3a, 0x2d, 0xbc, 0x57, 0x90, 0xd2, 0x3a, 0xfa, 0x5f, 0xb6, 0x70,
-	0xd9, 0x00, 0x2a, 0xbd, 0xc2, 0xf5, 0xec, 0x4d, 0xdb, 0x2f, 0x9e, 0x7b, 0x06, 0x2a, 0xf7, 0x36,
-	0xc0, 0x34, 0xb1, 0xf4, 0x16, 0x6f, 0x17, 0x8e, 0x51, 0x2d, 0x86, 0xe5, 0xb9, 0x72, 0xb8, 0x99,
-	0x5f, 0x65, 0x36, 0xf1, 0xed, 0x5c, 0xdb, 0xef, 0x16, 0xe3, 0xb2, 0x54, 0xb9, 0xbf, 0x89, 0xa6,
-	0x39, 0x95, 0x5b, 0x9f, 0xc2, 0xce, 0x0e, 0x5f, 0x5c, 0x2c, 0x54, 0x74, 0xb9, 0x50, 0xd1, 0xdf,
-	0x85, 0x8a, 0xbe, 0x2e, 0xd5, 0xd2, 0xe5, 0x52, 0x2d, 0xfd, 0x5e, 0xaa, 0xa5, 0x77, 0x1a, 0x75,
-	0xc5, 0x64, 0x3a, 0xd6, 0x6c, 0xe6, 0xe9, 0x60, 0x73, 0x71, 0x62, 0x8d, 0x41, 0x1f, 0x45, 0x99,
-	0x5f, 0x12, 0xf1, 0x91, 0xf1, 0x0f, 0xfa, 0x2c, 0xf9, 0xc1, 0x9c, 0x05, 0x04, 0xc6, 0xd5, 0xe8,
-	0x23, 0x7e, 0xf2, 0x2f, 0x00, 0x00, 0xff, 0xff, 0x23, 0xcd, 0x89, 0x2b, 0x7c, 0x04, 0x00, 0x00,
+func (m *MsgPauseSchedule) Reset()         { *m = MsgPauseSchedule{} }
+func (m *MsgPauseSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseSchedule) ProtoMessage()    {}
+func (*MsgPauseSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{8}
+}
+func (m *MsgPauseSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseSchedule.Merge(m, src)
+}
+func (m *MsgPauseSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseSchedule.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_MsgPauseSchedule proto.InternalMessageInfo
 
-// MsgClient is the client API for Msg service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type MsgClient interface {
-	// Adds new schedule.
-	AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error)
-	// Removes schedule.
-	RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error)
-	// Updates the module parameters.
-	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+func (m *MsgPauseSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
 }
 
-type msgClient struct {
-	cc grpc1.ClientConn
+func (m *MsgPauseSchedule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
 }
 
-func NewMsgClient(cc grpc1.ClientConn) MsgClient {
-	return &msgClient{cc}
+// Defines the response structure for executing a MsgPauseSchedule message.
+type MsgPauseScheduleResponse struct {
 }
 
-func (c *msgClient) AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error) {
+func (m *MsgPauseScheduleResponse) Reset()         { *m = MsgPauseScheduleResponse{} }
+func (m *MsgPauseScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgPauseScheduleResponse) ProtoMessage()    {}
+func (*MsgPauseScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{9}
+}
+func (m *MsgPauseScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgPauseScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgPauseScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgPauseScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgPauseScheduleResponse.Merge(m, src)
+}
+func (m *MsgPauseScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgPauseScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgPauseScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgPauseScheduleResponse proto.InternalMessageInfo
+
+// The MsgResumeSchedule request type.
+type MsgResumeSchedule struct {
+	// The address of the governance account or the owner of the schedule.
+	// The security address can pause schedules but not resume them.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Name of the schedule
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+}
+
+func (m *MsgResumeSchedule) Reset()         { *m = MsgResumeSchedule{} }
+func (m *MsgResumeSchedule) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeSchedule) ProtoMessage()    {}
+func (*MsgResumeSchedule) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{10}
+}
+func (m *MsgResumeSchedule) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeSchedule) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeSchedule.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeSchedule) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeSchedule.Merge(m, src)
+}
+func (m *MsgResumeSchedule) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeSchedule) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeSchedule.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeSchedule proto.InternalMessageInfo
+
+func (m *MsgResumeSchedule) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgResumeSchedule) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+// Defines the response structure for executing a MsgResumeSchedule message.
+type MsgResumeScheduleResponse struct {
+}
+
+func (m *MsgResumeScheduleResponse) Reset()         { *m = MsgResumeScheduleResponse{} }
+func (m *MsgResumeScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgResumeScheduleResponse) ProtoMessage()    {}
+func (*MsgResumeScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{11}
+}
+func (m *MsgResumeScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgResumeScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgResumeScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgResumeScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgResumeScheduleResponse.Merge(m, src)
+}
+func (m *MsgResumeScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgResumeScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgResumeScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgResumeScheduleResponse proto.InternalMessageInfo
+
+// The MsgUpdateParams request type.
+//
+// Since: 0.47
+type MsgUpdateParams struct {
+	// The address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// Defines the x/cron parameters to update.
+	//
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{12}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+func (m *MsgUpdateParams) GetAuthority() string {
+	if m != nil {
+		return m.Authority
+	}
+	return ""
+}
+
+func (m *MsgUpdateParams) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+// Defines the response structure for executing a MsgUpdateParams message.
+//
+// Since: 0.47
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_dc5dfbc481f4f7b1, []int{13}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*MsgAddSchedule)(nil), "secret.cron.MsgAddSchedule")
+	proto.RegisterType((*MsgAddScheduleResponse)(nil), "secret.cron.MsgAddScheduleResponse")
+	proto.RegisterType((*MsgRegisterSchedule)(nil), "secret.cron.MsgRegisterSchedule")
+	proto.RegisterType((*MsgRegisterScheduleResponse)(nil), "secret.cron.MsgRegisterScheduleResponse")
+	proto.RegisterType((*MsgRemoveSchedule)(nil), "secret.cron.MsgRemoveSchedule")
+	proto.RegisterType((*MsgRemoveScheduleResponse)(nil), "secret.cron.MsgRemoveScheduleResponse")
+	proto.RegisterType((*MsgUpdateSchedule)(nil), "secret.cron.MsgUpdateSchedule")
+	proto.RegisterType((*MsgUpdateScheduleResponse)(nil), "secret.cron.MsgUpdateScheduleResponse")
+	proto.RegisterType((*MsgPauseSchedule)(nil), "secret.cron.MsgPauseSchedule")
+	proto.RegisterType((*MsgPauseScheduleResponse)(nil), "secret.cron.MsgPauseScheduleResponse")
+	proto.RegisterType((*MsgResumeSchedule)(nil), "secret.cron.MsgResumeSchedule")
+	proto.RegisterType((*MsgResumeScheduleResponse)(nil), "secret.cron.MsgResumeScheduleResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "secret.cron.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "secret.cron.MsgUpdateParamsResponse")
+}
+
+func init() { proto.RegisterFile("secret/cron/tx.proto", fileDescriptor_dc5dfbc481f4f7b1) }
+
+var fileDescriptor_dc5dfbc481f4f7b1 = []byte{
+	// 908 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x56, 0x41, 0x6f, 0xdc, 0x44,
+	0x14, 0x8e, 0x1b, 0x27, 0xcd, 0xce, 0x36, 0xdb, 0xd6, 0x09, 0xa9, 0xe3, 0x10, 0x67, 0x59, 0x20,
+	0x35, 0x91, 0xb0, 0x95, 0x45, 0xaa, 0xd4, 0x72, 0xca, 0x96, 0x22, 0x2e, 0x0b, 0x2b, 0x47, 0x95,
+	0x10, 0x07, 0x56, 0xb3, 0xf6, 0xc4, 0xb1, 0x58, 0x7b, 0xac, 0x99, 0xf1, 0x76, 0x73, 0x43, 0x9c,
+	0x50, 0x4f, 0xdc, 0xe0, 0x27, 0x20, 0x4e, 0x39, 0xf0, 0x0f, 0xb8, 0xf4, 0x58, 0x71, 0xe2, 0x54,
+	0x50, 0x72, 0xc8, 0x3f, 0xe0, 0x8c, 0xec, 0x19, 0x3b, 0x1e, 0x6f, 0xb4, 0x15, 0x95, 0xaa, 0x5e,
+	0x7a, 0xd9, 0xf5, 0xbc, 0xef, 0xbd, 0x37, 0x6f, 0xbe, 0xf7, 0xf9, 0x79, 0xc0, 0x3a, 0x45, 0x1e,
+	0x41, 0xcc, 0xf1, 0x08, 0x8e, 0x1d, 0x36, 0xb5, 0x13, 0x82, 0x19, 0xd6, 0x9a, 0xdc, 0x6a, 0x67,
+	0x56, 0xe3, 0x36, 0x8c, 0xc2, 0x18, 0x3b, 0xf9, 0x2f, 0xc7, 0x0d, 0xd3, 0xc3, 0x34, 0xc2, 0xd4,
+	0x19, 0x41, 0x8a, 0x9c, 0xc9, 0xfe, 0x08, 0x31, 0xb8, 0xef, 0x78, 0x38, 0x8c, 0x05, 0x7e, 0x47,
+	0xe0, 0x11, 0x0d, 0x9c, 0xc9, 0x7e, 0xf6, 0x27, 0x80, 0x4d, 0x0e, 0x0c, 0xf3, 0x95, 0xc3, 0x17,
+	0x02, 0x5a, 0x0f, 0x70, 0x80, 0xb9, 0x3d, 0x7b, 0x2a, 0x76, 0x0a, 0x30, 0x0e, 0xc6, 0xc8, 0xc9,
+	0x57, 0xa3, 0xf4, 0xc8, 0xf1, 0x53, 0x02, 0x59, 0x88, 0x8b, 0x9d, 0xf4, 0x6a, 0xfd, 0x09, 0x24,
+	0x30, 0x2a, 0xf2, 0x19, 0x55, 0x84, 0x7a, 0xc7, 0xc8, 0x4f, 0xc7, 0x88, 0x63, 0x9d, 0x17, 0x8b,
+	0xa0, 0xd5, 0xa7, 0xc1, 0x81, 0xef, 0x1f, 0x0a, 0x40, 0xbb, 0x07, 0x1a, 0x30, 0x65, 0xc7, 0x98,
+	0x84, 0xec, 0x44, 0x57, 0xda, 0x8a, 0xd5, 0xe8, 0xe9, 0x7f, 0xfe, 0xfe, 0xf1, 0xba, 0xa8, 0xf1,
+	0xc0, 0xf7, 0x09, 0xa2, 0xf4, 0x90, 0x91, 0x30, 0x0e, 0xdc, 0x4b, 0x57, 0x4d, 0x03, 0x6a, 0x0c,
+	0x23, 0xa4, 0x5f, 0xcb, 0x42, 0xdc, 0xfc, 0x59, 0xdb, 0x00, 0xcb, 0x09, 0x22, 0x21, 0xf6, 0xf5,
+	0xc5, 0xb6, 0x62, 0xa9, 0xae, 0x58, 0x69, 0xf7, 0x81, 0x1a, 0xd1, 0x80, 0xea, 0x6a, 0x7b, 0xd1,
+	0x6a, 0x76, 0x77, 0xec, 0x0a, 0xcb, 0x76, 0x9f, 0x06, 0x8f, 0xa6, 0xc8, 0x4b, 0x19, 0x7a, 0x88,
+	0x63, 0x46, 0xa0, 0xc7, 0x7a, 0xea, 0xb3, 0x17, 0x3b, 0x0b, 0x6e, 0x1e, 0xa2, 0x7d, 0x0a, 0x56,
+	0xc2, 0x98, 0x21, 0x32, 0x81, 0x63, 0x7d, 0xa9, 0xad, 0x58, 0xcd, 0xee, 0xa6, 0xcd, 0xa9, 0xb1,
+	0x0b, 0x6a, 0xec, 0xcf, 0x04, 0x35, 0x3d, 0xf5, 0x97, 0xbf, 0x77, 0x14, 0xb7, 0x0c, 0xd0, 0xee,
+	0x82, 0x9b, 0xd9, 0x1e, 0x43, 0x34, 0x4d, 0xb2, 0x43, 0x84, 0x38, 0xd6, 0x97, 0xf3, 0x72, 0x5b,
+	0x99, 0xf9, 0x51, 0x69, 0xd5, 0x0e, 0x40, 0xeb, 0x08, 0x86, 0xe3, 0x94, 0xa0, 0x61, 0x82, 0xc7,
+	0xa1, 0x77, 0xa2, 0x5f, 0x6f, 0x2b, 0x56, 0xab, 0x6b, 0x48, 0xa5, 0x7e, 0xce, 0x5d, 0x06, 0xb9,
+	0x87, 0xbb, 0x7a, 0x54, 0x5d, 0x6a, 0xef, 0x81, 0x1b, 0x11, 0x9c, 0x0e, 0x85, 0x91, 0xea, 0x2b,
+	0x6d, 0xc5, 0x5a, 0x75, 0x9b, 0x11, 0x9c, 0x8a, 0x30, 0xaa, 0xdd, 0x07, 0x2b, 0x09, 0x09, 0x39,
+	0xd3, 0x8d, 0x3c, 0xff, 0xb6, 0x94, 0xbf, 0xe8, 0xc9, 0x40, 0x38, 0xb9, 0xa5, 0xfb, 0x83, 0xdd,
+	0x1f, 0x2e, 0x4e, 0xf7, 0x2e, 0xd9, 0x7f, 0x7a, 0x71, 0xba, 0xb7, 0x96, 0x37, 0x58, 0xee, 0x66,
+	0x47, 0x07, 0x1b, 0xb2, 0xc5, 0x45, 0x34, 0xc1, 0x31, 0x45, 0x9d, 0x9f, 0x55, 0xb0, 0xd6, 0xa7,
+	0x81, 0x8b, 0x82, 0x90, 0x32, 0x44, 0xca, 0xfe, 0xdb, 0x60, 0x09, 0x3f, 0x89, 0x11, 0x79, 0x69,
+	0xef, 0xb9, 0xdb, 0xdb, 0xbe, 0xbf, 0x4a, 0xdf, 0xaf, 0xfb, 0x28, 0xc1, 0x34, 0x64, 0x7a, 0x43,
+	0x1c, 0x45, 0x30, 0x9c, 0xcd, 0x11, 0x5b, 0xcc, 0x11, 0xfb, 0x21, 0x0e, 0x63, 0xc1, 0x41, 0xe1,
+	0x2f, 0x49, 0x06, 0xfc, 0x3f, 0xc9, 0xdc, 0xcd, 0x24, 0xc3, 0x9b, 0x96, 0xc9, 0x45, 0x2f, 0xe4,
+	0x52, 0x57, 0x40, 0x67, 0x1b, 0x6c, 0x5d, 0x61, 0x2e, 0x85, 0xf3, 0x54, 0x01, 0xb7, 0x73, 0x3c,
+	0xc2, 0x13, 0xf4, 0x3a, 0xc6, 0xc6, 0x83, 0x8f, 0x66, 0xc5, 0xbd, 0x71, 0x59, 0x6d, 0x75, 0xdb,
+	0xce, 0x16, 0xd8, 0x9c, 0x31, 0x96, 0x95, 0xfe, 0x71, 0x2d, 0xaf, 0xf4, 0x71, 0xe2, 0x43, 0x86,
+	0xde, 0x0e, 0xb8, 0x8a, 0xd0, 0xe7, 0x52, 0x2c, 0xf3, 0x25, 0x28, 0x96, 0x8d, 0x25, 0xc5, 0x3f,
+	0x2a, 0xe0, 0x56, 0x9f, 0x06, 0x03, 0x98, 0xd2, 0xd7, 0xa3, 0x05, 0x6b, 0xb6, 0xd0, 0x77, 0x8a,
+	0x42, 0xa5, 0x5d, 0x3b, 0x06, 0xd0, 0xeb, 0xb6, 0x59, 0xcd, 0xd2, 0x34, 0x7a, 0x13, 0x9a, 0xad,
+	0x6e, 0x5b, 0x6a, 0xb6, 0x6a, 0x2c, 0x2b, 0xfd, 0x4d, 0x01, 0x37, 0x4b, 0xba, 0x07, 0xf9, 0x77,
+	0xfc, 0x95, 0xeb, 0xbc, 0x07, 0x96, 0xf9, 0x4d, 0x20, 0xaf, 0xb4, 0xd9, 0x5d, 0x93, 0x74, 0xc8,
+	0x93, 0xf7, 0x1a, 0x99, 0xf6, 0x7e, 0xbd, 0x38, 0xdd, 0x53, 0x5c, 0xe1, 0xcd, 0x27, 0x85, 0x7c,
+	0x96, 0x75, 0x59, 0x1c, 0x3c, 0xb6, 0xb3, 0x09, 0xee, 0xd4, 0x4c, 0xc5, 0x39, 0xba, 0xff, 0xaa,
+	0x60, 0xb1, 0x4f, 0x03, 0xed, 0x2b, 0xd0, 0xac, 0xde, 0x2e, 0xb6, 0xea, 0xaf, 0x42, 0x05, 0x34,
+	0xde, 0x9f, 0x03, 0x16, 0x89, 0xb5, 0x6f, 0xc1, 0xad, 0x99, 0x6f, 0x56, 0xbb, 0x1e, 0x58, 0xf7,
+	0x30, 0xac, 0x97, 0x79, 0x94, 0xf9, 0xbf, 0x06, 0xad, 0xda, 0x68, 0x33, 0x67, 0x63, 0xab, 0xb8,
+	0xb1, 0x3b, 0x1f, 0xaf, 0x66, 0xae, 0x8d, 0xa2, 0x99, 0xcc, 0x32, 0x6e, 0xec, 0xce, 0xc7, 0xcb,
+	0xcc, 0x8f, 0xc1, 0xaa, 0xfc, 0x06, 0x6e, 0xd7, 0x03, 0x25, 0xd8, 0xf8, 0x70, 0x2e, 0x2c, 0x53,
+	0x21, 0xbd, 0x31, 0x57, 0x50, 0x51, 0xc5, 0x8d, 0xdd, 0xf9, 0x78, 0x99, 0xd9, 0x05, 0x37, 0x24,
+	0x85, 0xbf, 0x7b, 0xf5, 0x41, 0x39, 0x6a, 0x7c, 0x30, 0x0f, 0x2d, 0x72, 0x1a, 0x4b, 0xdf, 0x67,
+	0x22, 0xee, 0x7d, 0xf1, 0xec, 0xcc, 0x54, 0x9e, 0x9f, 0x99, 0xca, 0x3f, 0x67, 0xa6, 0xf2, 0xd3,
+	0xb9, 0xb9, 0xf0, 0xfc, 0xdc, 0x5c, 0xf8, 0xeb, 0xdc, 0x5c, 0xf8, 0xc6, 0x0e, 0x42, 0x76, 0x9c,
+	0x8e, 0x6c, 0x0f, 0x47, 0x0e, 0xf5, 0x08, 0x1b, 0xc3, 0x11, 0x75, 0x0e, 0xf3, 0xcc, 0x5f, 0x22,
+	0xf6, 0x04, 0x93, 0xef, 0x9c, 0xa9, 0xb8, 0xff, 0x9f, 0x24, 0x88, 0x8e, 0x96, 0xf3, 0x79, 0xfb,
+	0xc9, 0x7f, 0x03, 0x00, 0x13, 0xf2, 0x61, 0x9d, 0x1b, 0x0c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// MsgClient is the client API for Msg service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type MsgClient interface {
+	// Adds new schedule.
+	AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error)
+	// Registers a new schedule owned by the sender, bonding a deposit.
+	RegisterSchedule(ctx context.Context, in *MsgRegisterSchedule, opts ...grpc.CallOption) (*MsgRegisterScheduleResponse, error)
+	// Removes schedule.
+	RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error)
+	// Updates the trigger and msgs of a schedule.
+	UpdateSchedule(ctx context.Context, in *MsgUpdateSchedule, opts ...grpc.CallOption) (*MsgUpdateScheduleResponse, error)
+	// Pauses a schedule.
+	PauseSchedule(ctx context.Context, in *MsgPauseSchedule, opts ...grpc.CallOption) (*MsgPauseScheduleResponse, error)
+	// Resumes a paused schedule.
+	ResumeSchedule(ctx context.Context, in *MsgResumeSchedule, opts ...grpc.CallOption) (*MsgResumeScheduleResponse, error)
+	// Updates the module parameters.
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+}
+
+type msgClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewMsgClient(cc grpc1.ClientConn) MsgClient {
+	return &msgClient{cc}
+}
+
+func (c *msgClient) AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ...grpc.CallOption) (*MsgAddScheduleResponse, error) {
 	out := new(MsgAddScheduleResponse)
 	err := c.cc.Invoke(ctx, "/secret.cron.Msg/AddSchedule", in, out, opts...)
 	if err != nil {
@@ -416,6 +984,15 @@ func (c *msgClient) AddSchedule(ctx context.Context, in *MsgAddSchedule, opts ..
 	return out, nil
 }
 
+func (c *msgClient) RegisterSchedule(ctx context.Context, in *MsgRegisterSchedule, opts ...grpc.CallOption) (*MsgRegisterScheduleResponse, error) {
+	out := new(MsgRegisterScheduleResponse)
+	err := c.cc.Invoke(ctx, "/secret.cron.Msg/RegisterSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, opts ...grpc.CallOption) (*MsgRemoveScheduleResponse, error) {
 	out := new(MsgRemoveScheduleResponse)
 	err := c.cc.Invoke(ctx, "/secret.cron.Msg/RemoveSchedule", in, out, opts...)
@@ -425,6 +1002,33 @@ func (c *msgClient) RemoveSchedule(ctx context.Context, in *MsgRemoveSchedule, o
 	return out, nil
 }
 
+func (c *msgClient) UpdateSchedule(ctx context.Context, in *MsgUpdateSchedule, opts ...grpc.CallOption) (*MsgUpdateScheduleResponse, error) {
+	out := new(MsgUpdateScheduleResponse)
+	err := c.cc.Invoke(ctx, "/secret.cron.Msg/UpdateSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) PauseSchedule(ctx context.Context, in *MsgPauseSchedule, opts ...grpc.CallOption) (*MsgPauseScheduleResponse, error) {
+	out := new(MsgPauseScheduleResponse)
+	err := c.cc.Invoke(ctx, "/secret.cron.Msg/PauseSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) ResumeSchedule(ctx context.Context, in *MsgResumeSchedule, opts ...grpc.CallOption) (*MsgResumeScheduleResponse, error) {
+	out := new(MsgResumeScheduleResponse)
+	err := c.cc.Invoke(ctx, "/secret.cron.Msg/ResumeSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
 	out := new(MsgUpdateParamsResponse)
 	err := c.cc.Invoke(ctx, "/secret.cron.Msg/UpdateParams", in, out, opts...)
@@ -438,8 +1042,16 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 type MsgServer interface {
 	// Adds new schedule.
 	AddSchedule(context.Context, *MsgAddSchedule) (*MsgAddScheduleResponse, error)
+	// Registers a new schedule owned by the sender, bonding a deposit.
+	RegisterSchedule(context.Context, *MsgRegisterSchedule) (*MsgRegisterScheduleResponse, error)
 	// Removes schedule.
 	RemoveSchedule(context.Context, *MsgRemoveSchedule) (*MsgRemoveScheduleResponse, error)
+	// Updates the trigger and msgs of a schedule.
+	UpdateSchedule(context.Context, *MsgUpdateSchedule) (*MsgUpdateScheduleResponse, error)
+	// Pauses a schedule.
+	PauseSchedule(context.Context, *MsgPauseSchedule) (*MsgPauseScheduleResponse, error)
+	// Resumes a paused schedule.
+	ResumeSchedule(context.Context, *MsgResumeSchedule) (*MsgResumeScheduleResponse, error)
 	// Updates the module parameters.
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
@@ -451,9 +1063,21 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) AddSchedule(ctx context.Context, req *MsgAddSchedule) (*MsgAddScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method AddSchedule not implemented")
 }
+func (*UnimplementedMsgServer) RegisterSchedule(ctx context.Context, req *MsgRegisterSchedule) (*MsgRegisterScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisterSchedule not implemented")
+}
 func (*UnimplementedMsgServer) RemoveSchedule(ctx context.Context, req *MsgRemoveSchedule) (*MsgRemoveScheduleResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RemoveSchedule not implemented")
 }
+func (*UnimplementedMsgServer) UpdateSchedule(ctx context.Context, req *MsgUpdateSchedule) (*MsgUpdateScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateSchedule not implemented")
+}
+func (*UnimplementedMsgServer) PauseSchedule(ctx context.Context, req *MsgPauseSchedule) (*MsgPauseScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseSchedule not implemented")
+}
+func (*UnimplementedMsgServer) ResumeSchedule(ctx context.Context, req *MsgResumeSchedule) (*MsgResumeScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ResumeSchedule not implemented")
+}
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
@@ -480,35 +1104,107 @@ func _Msg_AddSchedule_Handler(srv interface{}, ctx context.Context, dec func(int
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgRemoveSchedule)
+func _Msg_RegisterSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRegisterSchedule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).RemoveSchedule(ctx, in)
+		return srv.(MsgServer).RegisterSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/secret.cron.Msg/RemoveSchedule",
+		FullMethod: "/secret.cron.Msg/RegisterSchedule",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(MsgServer).RemoveSchedule(ctx, req.(*MsgRemoveSchedule))
+		return srv.(MsgServer).RegisterSchedule(ctx, req.(*MsgRegisterSchedule))
 	}
 	return interceptor(ctx, in, info, handler)
 }
 
-func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(MsgUpdateParams)
+func _Msg_RemoveSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgRemoveSchedule)
 	if err := dec(in); err != nil {
 		return nil, err
 	}
 	if interceptor == nil {
-		return srv.(MsgServer).UpdateParams(ctx, in)
+		return srv.(MsgServer).RemoveSchedule(ctx, in)
 	}
 	info := &grpc.UnaryServerInfo{
 		Server:     srv,
-		FullMethod: "/secret.cron.Msg/UpdateParams",
+		FullMethod: "/secret.cron.Msg/RemoveSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).RemoveSchedule(ctx, req.(*MsgRemoveSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.cron.Msg/UpdateSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateSchedule(ctx, req.(*MsgUpdateSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_PauseSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgPauseSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).PauseSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.cron.Msg/PauseSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).PauseSchedule(ctx, req.(*MsgPauseSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_ResumeSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgResumeSchedule)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).ResumeSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.cron.Msg/ResumeSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).ResumeSchedule(ctx, req.(*MsgResumeSchedule))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.cron.Msg/UpdateParams",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
 		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
@@ -524,10 +1220,26 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "AddSchedule",
 			Handler:    _Msg_AddSchedule_Handler,
 		},
+		{
+			MethodName: "RegisterSchedule",
+			Handler:    _Msg_RegisterSchedule_Handler,
+		},
 		{
 			MethodName: "RemoveSchedule",
 			Handler:    _Msg_RemoveSchedule_Handler,
 		},
+		{
+			MethodName: "UpdateSchedule",
+			Handler:    _Msg_UpdateSchedule_Handler,
+		},
+		{
+			MethodName: "PauseSchedule",
+			Handler:    _Msg_PauseSchedule_Handler,
+		},
+		{
+			MethodName: "ResumeSchedule",
+			Handler:    _Msg_ResumeSchedule_Handler,
+		},
 		{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
@@ -557,6 +1269,38 @@ func (m *MsgAddSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x48
+	}
+	if m.MaxFailures != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FailurePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailurePolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
 	if len(m.Msgs) > 0 {
 		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
 			{
@@ -616,7 +1360,7 @@ func (m *MsgAddScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveSchedule) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -626,16 +1370,77 @@ func (m *MsgRemoveSchedule) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveSchedule) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
+	if m.Priority != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Priority))
+		i--
+		dAtA[i] = 0x50
+	}
+	{
+		size, err := m.Deposit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	if m.MaxFailures != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.MaxFailures))
+		i--
+		dAtA[i] = 0x40
+	}
+	if m.FailurePolicy != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.FailurePolicy))
+		i--
+		dAtA[i] = 0x38
+	}
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Interval != nil {
+		n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err3 != nil {
+			return 0, err3
+		}
+		i -= n3
+		i = encodeVarintTx(dAtA, i, uint64(n3))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.Msgs) > 0 {
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.Name) > 0 {
 		i -= len(m.Name)
 		copy(dAtA[i:], m.Name)
@@ -643,17 +1448,17 @@ func (m *MsgRemoveSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i--
 		dAtA[i] = 0x12
 	}
-	if len(m.Authority) > 0 {
-		i -= len(m.Authority)
-		copy(dAtA[i:], m.Authority)
-		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Owner)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveScheduleResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRegisterScheduleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -663,12 +1468,12 @@ func (m *MsgRemoveScheduleResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRegisterScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgRemoveScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRegisterScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -676,7 +1481,7 @@ func (m *MsgRemoveScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, erro
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveSchedule) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -686,26 +1491,23 @@ func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveSchedule) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
-		if err != nil {
-			return 0, err
-		}
-		i -= size
-		i = encodeVarintTx(dAtA, i, uint64(size))
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
 	}
-	i--
-	dAtA[i] = 0x12
 	if len(m.Authority) > 0 {
 		i -= len(m.Authority)
 		copy(dAtA[i:], m.Authority)
@@ -716,7 +1518,7 @@ func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+func (m *MsgRemoveScheduleResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -726,12 +1528,12 @@ func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgRemoveScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgRemoveScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
@@ -739,70 +1541,340 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgAddSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgUpdateSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if len(m.CronExpression) > 0 {
+		i -= len(m.CronExpression)
+		copy(dAtA[i:], m.CronExpression)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.CronExpression)))
+		i--
+		dAtA[i] = 0x32
 	}
-	if m.Period != 0 {
-		n += 1 + sovTx(uint64(m.Period))
+	if m.Interval != nil {
+		n4, err4 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.Interval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval):])
+		if err4 != nil {
+			return 0, err4
+		}
+		i -= n4
+		i = encodeVarintTx(dAtA, i, uint64(n4))
+		i--
+		dAtA[i] = 0x2a
 	}
 	if len(m.Msgs) > 0 {
-		for _, e := range m.Msgs {
-			l = e.Size()
-			n += 1 + l + sovTx(uint64(l))
+		for iNdEx := len(m.Msgs) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Msgs[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTx(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
 		}
 	}
-	return n
+	if m.Period != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Period))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgAddScheduleResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgUpdateScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgRemoveSchedule) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgUpdateScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = len(m.Name)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	return n
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgRemoveScheduleResponse) Size() (n int) {
+func (m *MsgPauseSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgPauseScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgPauseScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgPauseScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeSchedule) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeSchedule) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeSchedule) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Name) > 0 {
+		i -= len(m.Name)
+		copy(dAtA[i:], m.Name)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Name)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgResumeScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgResumeScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgResumeScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgAddSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FailurePolicy != 0 {
+		n += 1 + sovTx(uint64(m.FailurePolicy))
+	}
+	if m.MaxFailures != 0 {
+		n += 1 + sovTx(uint64(m.MaxFailures))
+	}
+	if m.Priority != 0 {
+		n += 1 + sovTx(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *MsgAddScheduleResponse) Size() (n int) {
 	if m == nil {
 		return 0
 	}
@@ -811,37 +1883,1093 @@ func (m *MsgRemoveScheduleResponse) Size() (n int) {
 	return n
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
+func (m *MsgRegisterSchedule) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	l = len(m.Authority)
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
 	if l > 0 {
 		n += 1 + l + sovTx(uint64(l))
 	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
-}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.FailurePolicy != 0 {
+		n += 1 + sovTx(uint64(m.FailurePolicy))
+	}
+	if m.MaxFailures != 0 {
+		n += 1 + sovTx(uint64(m.MaxFailures))
+	}
+	l = m.Deposit.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Priority != 0 {
+		n += 1 + sovTx(uint64(m.Priority))
+	}
+	return n
+}
+
+func (m *MsgRegisterScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgRemoveSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgRemoveScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Period != 0 {
+		n += 1 + sovTx(uint64(m.Period))
+	}
+	if len(m.Msgs) > 0 {
+		for _, e := range m.Msgs {
+			l = e.Size()
+			n += 1 + l + sovTx(uint64(l))
+		}
+	}
+	if m.Interval != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.Interval)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.CronExpression)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgUpdateScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgPauseSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgPauseScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgResumeSchedule) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = len(m.Name)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgResumeScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozTx(x uint64) (n int) {
+	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, MsgExecuteContract{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			m.FailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailurePolicy |= FailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
+			}
+			m.MaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= SchedulePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgAddScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgAddScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Period", wireType)
+			}
+			m.Period = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Period |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msgs", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msgs = append(m.Msgs, MsgExecuteContract{})
+			if err := m.Msgs[len(m.Msgs)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FailurePolicy", wireType)
+			}
+			m.FailurePolicy = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.FailurePolicy |= FailurePolicy(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxFailures", wireType)
+			}
+			m.MaxFailures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxFailures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Deposit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Deposit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Priority", wireType)
+			}
+			m.Priority = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Priority |= SchedulePriority(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRegisterScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRegisterScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRegisterScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgRemoveScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgRemoveScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgRemoveScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	return n
-}
-
-func sovTx(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozTx(x uint64) (n int) {
-	return sovTx(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -864,10 +2992,10 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -987,6 +3115,74 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Interval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Interval == nil {
+				m.Interval = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.Interval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CronExpression", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.CronExpression = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -1008,7 +3204,7 @@ func (m *MsgAddSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgUpdateScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1031,10 +3227,10 @@ func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgAddScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgUpdateScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgAddScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgUpdateScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
@@ -1058,7 +3254,7 @@ func (m *MsgAddScheduleResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseSchedule) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1081,10 +3277,10 @@ func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveSchedule: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseSchedule: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -1172,7 +3368,7 @@ func (m *MsgRemoveSchedule) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *MsgRemoveScheduleResponse) Unmarshal(dAtA []byte) error {
+func (m *MsgPauseScheduleResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -1195,10 +3391,174 @@ func (m *MsgRemoveScheduleResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: MsgRemoveScheduleResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: MsgPauseScheduleResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: MsgRemoveScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: MsgPauseScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeSchedule) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeSchedule: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeSchedule: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Name", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Name = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgResumeScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgResumeScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgResumeScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		default:
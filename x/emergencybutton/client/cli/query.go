@@ -19,6 +19,9 @@ func GetQueryCmd() *cobra.Command {
 	}
 	queryCmd.AddCommand(
 		GetCmdParams(),
+		GetCmdHalts(),
+		GetCmdToggles(),
+		GetCmdFlows(),
 	)
 	return queryCmd
 }
@@ -49,3 +52,98 @@ func GetCmdParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdHalts lists everything that is currently halted
+func GetCmdHalts() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "halts",
+		Short: "List the halted channels and modules",
+		Long:  "List the halted channels and modules, and whether the switch halts all IBC packets",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Halts(cmd.Context(), &types.HaltsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdToggles lists the last changes of the switch, channel halts and module halts
+func GetCmdToggles() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "toggles",
+		Short: "List the last changes of the switch, channel halts and module halts",
+		Long:  "List the last changes of the switch, channel halts and module halts, oldest first",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Toggles(cmd.Context(), &types.TogglesRequest{Pagination: pageReq})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "toggles")
+	return cmd
+}
+
+// GetCmdFlows lists the rate limits with their current flows and remaining capacity
+func GetCmdFlows() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "flows [channel-id] [denom]",
+		Short: "List the rate limits with their current flows and remaining capacity",
+		Long:  "List the rate limits with their flows in the rolling window and how much can still be sent and received, optionally filtered by channel and denom",
+		Args:  cobra.RangeArgs(0, 2),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			req := &types.FlowsRequest{}
+			if len(args) > 0 {
+				req.ChannelId = args[0]
+			}
+			if len(args) > 1 {
+				req.Denom = args[1]
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Flows(cmd.Context(), req)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
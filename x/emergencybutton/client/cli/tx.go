@@ -1,6 +1,8 @@
 package cli
 
 import (
+	"fmt"
+
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	"github.com/cosmos/cosmos-sdk/client/tx"
@@ -21,17 +23,28 @@ func GetTxCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		toggleIbcSwitchCmd(),
+		setChannelHaltCmd("halt-channel", true),
+		setChannelHaltCmd("resume-channel", false),
+		setModuleHaltCmd("halt-module", true),
+		setModuleHaltCmd("resume-module", false),
 	)
 	return txCmd
 }
 
+const (
+	flagExpiryHeight   = "expiry-height"
+	flagExpiryDuration = "expiry-duration"
+)
+
 // toggleIbcSwitchCmd will toggle the status of the Switch and turn ibc on or off.
 func toggleIbcSwitchCmd() *cobra.Command {
 	cmd := &cobra.Command{
 		Use:   "toggle",
 		Short: "Toggle the ibc switch on or off",
-		Long:  "Toggle the ibc switch on or off. Only a gov-approved address can do this.",
-		Args:  cobra.ExactArgs(0),
+		Long: `Toggle the ibc switch on or off. Only a gov-approved pauser with the matching role can do this.
+When turning the switch off, --expiry-height and --expiry-duration turn it back on automatically
+at that height or after that duration, whichever comes first.`,
+		Args: cobra.ExactArgs(0),
 		RunE: func(cmd *cobra.Command, _ []string) error {
 			clientCtx, err := client.GetClientTxContext(cmd)
 			if err != nil {
@@ -40,6 +53,108 @@ func toggleIbcSwitchCmd() *cobra.Command {
 
 			msg := types.NewMsgToggleIbcSwitch(clientCtx.GetFromAddress())
 
+			msg.ExpiryHeight, err = cmd.Flags().GetInt64(flagExpiryHeight)
+			if err != nil {
+				return err
+			}
+			if cmd.Flags().Changed(flagExpiryDuration) {
+				duration, err := cmd.Flags().GetDuration(flagExpiryDuration)
+				if err != nil {
+					return err
+				}
+				msg.ExpiryDuration = &duration
+			}
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	cmd.Flags().Int64(flagExpiryHeight, 0, "Height at which the switch is turned back on")
+	cmd.Flags().Duration(flagExpiryDuration, 0, "Duration after which the switch is turned back on, e.g. 6h")
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+var packetDirections = map[string]types.PacketDirection{
+	"send":    types.PacketDirection_PACKET_DIRECTION_SEND,
+	"receive": types.PacketDirection_PACKET_DIRECTION_RECEIVE,
+	"ack":     types.PacketDirection_PACKET_DIRECTION_ACK,
+}
+
+var haltedModules = map[string]types.HaltedModule{
+	"ibc-hooks":          types.HaltedModule_HALTED_MODULE_IBC_HOOKS,
+	"contract-execution": types.HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION,
+}
+
+// setChannelHaltCmd halts or resumes a channel in one direction.
+func setChannelHaltCmd(use string, halted bool) *cobra.Command {
+	action := "Resume"
+	if halted {
+		action = "Halt"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " [port-id] [channel-id] [send|receive|ack]",
+		Short: action + " the packets of a channel in one direction",
+		Long: action + ` the packets of a channel in one direction. Use "*" as the port or the channel to match all of them.
+Only a gov-approved pauser with the matching role or governance can do this.
+Unlike the switch, a channel halt doesn't expire: it stays until the channel is resumed.`,
+		Args: cobra.ExactArgs(3),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			direction, ok := packetDirections[args[2]]
+			if !ok {
+				return fmt.Errorf("invalid direction '%s', expected one of: send, receive, ack", args[2])
+			}
+			halt := types.ChannelHalt{
+				PortId:    wildcard(args[0]),
+				ChannelId: wildcard(args[1]),
+				Direction: direction,
+			}
+			if err := halt.Validate(); err != nil {
+				return err
+			}
+
+			msg := types.NewMsgSetChannelHalt(clientCtx.GetFromAddress(), halt, halted)
+
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
+		},
+	}
+
+	flags.AddTxFlagsToCmd(cmd)
+	return cmd
+}
+
+// setModuleHaltCmd halts or resumes the contract executions of a module.
+func setModuleHaltCmd(use string, halted bool) *cobra.Command {
+	action := "Resume"
+	if halted {
+		action = "Halt"
+	}
+
+	cmd := &cobra.Command{
+		Use:   use + " [ibc-hooks|contract-execution]",
+		Short: action + " contract executions triggered by ibc-hooks, or all contract executions",
+		Long: action + ` contract executions triggered by ibc-hooks, or all contract executions. Only a gov-approved pauser with the matching role or governance can do this.
+Unlike the switch, a module halt doesn't expire: it stays until the module is resumed.`,
+		Args: cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			module, ok := haltedModules[args[0]]
+			if !ok {
+				return fmt.Errorf("invalid module '%s', expected one of: ibc-hooks, contract-execution", args[0])
+			}
+
+			msg := types.NewMsgSetModuleHalt(clientCtx.GetFromAddress(), module, halted)
+
 			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), msg)
 		},
 	}
@@ -47,3 +162,10 @@ func toggleIbcSwitchCmd() *cobra.Command {
 	flags.AddTxFlagsToCmd(cmd)
 	return cmd
 }
+
+func wildcard(arg string) string {
+	if arg == "*" {
+		return ""
+	}
+	return arg
+}
@@ -28,3 +28,33 @@ func (q Querier) Params(grpcCtx context.Context,
 	ctx := sdk.UnwrapSDKContext(grpcCtx)
 	return q.Q.Params(ctx, *req)
 }
+
+func (q Querier) Halts(grpcCtx context.Context,
+	req *types.HaltsRequest,
+) (*types.HaltsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Halts(ctx, *req)
+}
+
+func (q Querier) Toggles(grpcCtx context.Context,
+	req *types.TogglesRequest,
+) (*types.TogglesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Toggles(ctx, *req)
+}
+
+func (q Querier) Flows(grpcCtx context.Context,
+	req *types.FlowsRequest,
+) (*types.FlowsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	ctx := sdk.UnwrapSDKContext(grpcCtx)
+	return q.Q.Flows(ctx, *req)
+}
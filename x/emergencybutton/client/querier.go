@@ -19,3 +19,31 @@ func (q Querier) Params(ctx sdk.Context,
 	params := q.K.GetParams(ctx)
 	return &types.ParamsResponse{Params: params}, nil
 }
+
+func (q Querier) Halts(ctx sdk.Context,
+	_ types.HaltsRequest,
+) (*types.HaltsResponse, error) {
+	params := q.K.GetParams(ctx)
+	return &types.HaltsResponse{
+		IbcHalted:     params.SwitchStatus == types.IbcSwitchStatusOff,
+		ChannelHalts:  params.ChannelHalts,
+		HaltedModules: params.HaltedModules(),
+		SwitchExpiry:  q.K.GetSwitchExpiry(ctx),
+	}, nil
+}
+
+func (q Querier) Flows(ctx sdk.Context,
+	req types.FlowsRequest,
+) (*types.FlowsResponse, error) {
+	return &types.FlowsResponse{RateLimits: q.K.RateLimitStatuses(ctx, req.ChannelId, req.Denom)}, nil
+}
+
+func (q Querier) Toggles(ctx sdk.Context,
+	req types.TogglesRequest,
+) (*types.TogglesResponse, error) {
+	toggles, pageRes, err := q.K.PaginatedToggles(ctx, req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.TogglesResponse{Toggles: toggles, Pagination: pageRes}, nil
+}
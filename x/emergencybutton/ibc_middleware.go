@@ -1,7 +1,6 @@
 package emergencybutton
 
 import (
-	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
@@ -112,9 +111,11 @@ func (im IBCMiddleware) OnRecvPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) exported.Acknowledgement {
-	if im.keeper.GetSwitchStatus(ctx) == types.IbcSwitchStatusOff {
-		println("Returning error!")
-		err := errorsmod.Wrap(types.ErrIbcOff, "Ibc packets are currently paused in the network")
+	if err := im.keeper.CheckPacketHalted(ctx, packet.GetDestPort(), packet.GetDestChannel(), types.PacketDirection_PACKET_DIRECTION_RECEIVE); err != nil {
+		return channeltypes.NewErrorAcknowledgement(err)
+	}
+
+	if err := im.keeper.ReceiveTransfer(ctx, packet); err != nil {
 		return channeltypes.NewErrorAcknowledgement(err)
 	}
 
@@ -128,6 +129,13 @@ func (im IBCMiddleware) OnAcknowledgementPacket(
 	acknowledgement []byte,
 	relayer sdk.AccAddress,
 ) error {
+	// the ack can be relayed again once the channel is resumed
+	if err := im.keeper.CheckPacketHalted(ctx, packet.GetSourcePort(), packet.GetSourceChannel(), types.PacketDirection_PACKET_DIRECTION_ACK); err != nil {
+		return err
+	}
+
+	im.keeper.AcknowledgeTransfer(ctx, packet, acknowledgement)
+
 	return im.app.OnAcknowledgementPacket(ctx, packet, acknowledgement, relayer)
 }
 
@@ -137,6 +145,8 @@ func (im IBCMiddleware) OnTimeoutPacket(
 	packet channeltypes.Packet,
 	relayer sdk.AccAddress,
 ) error {
+	im.keeper.RefundTransfer(ctx, packet)
+
 	return im.app.OnTimeoutPacket(ctx, packet, relayer)
 }
 
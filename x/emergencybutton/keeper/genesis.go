@@ -7,14 +7,22 @@ import (
 )
 
 // InitGenesis initializes the x/emergencybutton's module's state from a provided genesis
-// state, which includes the parameter for the pauser address and for the switch status.
+// state, which includes the parameter for the pauser address and for the switch status,
+// the expiry of the switch, the toggle history and the flows of the rate limits.
 func (i *Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
 	i.SetParams(ctx, genState.Params) //nolint:errcheck
+	i.SetSwitchExpiry(ctx, genState.SwitchExpiry)
+	i.setToggles(ctx, genState.Toggles)
+	i.setFlowsAndPendingSends(ctx, genState.Flows, genState.PendingSends)
 }
 
 // ExportGenesis returns the x/emergencybutton module's exported genesis.
 func (i *Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
 	return &types.GenesisState{
-		Params: i.GetParams(ctx),
+		Params:       i.GetParams(ctx),
+		SwitchExpiry: i.GetSwitchExpiry(ctx),
+		Toggles:      i.GetToggles(ctx),
+		Flows:        i.GetFlows(ctx),
+		PendingSends: i.GetPendingSends(ctx),
 	}
 }
@@ -0,0 +1,56 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+// CheckPacketHalted returns an error if the packets of the given port, channel and direction are halted,
+// either by the switch or by a channel halt.
+func (i *Keeper) CheckPacketHalted(ctx sdk.Context, portID, channelID string, direction types.PacketDirection) error {
+	params := i.GetParams(ctx)
+	if params.SwitchStatus == types.IbcSwitchStatusOff {
+		return errors.Wrap(types.ErrIbcOff, "Ibc packets are currently paused in the network")
+	}
+	if params.IsChannelHalted(portID, channelID, direction) {
+		return errors.Wrapf(types.ErrIbcOff, "%s packets are currently paused on %s/%s", direction, portID, channelID)
+	}
+	return nil
+}
+
+// SetChannelHalt halts or resumes the packets of a channel in one direction.
+func (i *Keeper) SetChannelHalt(ctx sdk.Context, halt types.ChannelHalt, halted bool) error {
+	if err := halt.Validate(); err != nil {
+		return err
+	}
+	params := i.GetParams(ctx)
+	params.SetChannelHalt(halt, halted)
+	return i.SetParams(ctx, params)
+}
+
+// SetModuleHalt halts or resumes the contract executions of a module.
+func (i *Keeper) SetModuleHalt(ctx sdk.Context, module types.HaltedModule, halted bool) error {
+	params := i.GetParams(ctx)
+	if err := params.SetModuleHalt(module, halted); err != nil {
+		return err
+	}
+	return i.SetParams(ctx, params)
+}
+
+// CheckIbcHooksHalted returns an error if contract executions triggered by ibc-hooks are halted.
+func (i *Keeper) CheckIbcHooksHalted(ctx sdk.Context) error {
+	if i.GetParams(ctx).IbcHooksHalted {
+		return errors.Wrap(types.ErrExecutionHalted, "ibc-hooks contract executions are currently paused in the network")
+	}
+	return nil
+}
+
+// CheckContractExecutionHalted returns an error if the execution of all contracts is halted.
+func (i *Keeper) CheckContractExecutionHalted(ctx sdk.Context) error {
+	if i.GetParams(ctx).ContractExecutionHalted {
+		return errors.Wrap(types.ErrExecutionHalted, "contract executions are currently paused in the network")
+	}
+	return nil
+}
@@ -0,0 +1,56 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+func TestCheckPacketHalted(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	send := types.PacketDirection_PACKET_DIRECTION_SEND
+	receive := types.PacketDirection_PACKET_DIRECTION_RECEIVE
+	require.NoError(t, k.CheckPacketHalted(ctx, "transfer", "channel-0", send))
+
+	// a channel halt only applies to its channel and direction
+	halt := types.ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send}
+	require.NoError(t, k.SetChannelHalt(ctx, halt, true))
+	require.ErrorIs(t, k.CheckPacketHalted(ctx, "transfer", "channel-0", send), types.ErrIbcOff)
+	require.NoError(t, k.CheckPacketHalted(ctx, "transfer", "channel-0", receive))
+	require.NoError(t, k.CheckPacketHalted(ctx, "transfer", "channel-1", send))
+	require.NoError(t, k.CheckPacketHalted(ctx, "wasm.secret1", "channel-0", send))
+
+	require.NoError(t, k.SetChannelHalt(ctx, halt, false))
+	require.NoError(t, k.CheckPacketHalted(ctx, "transfer", "channel-0", send))
+
+	// the switch halts every channel and direction
+	k.SetSwitchStatus(ctx, types.IbcSwitchStatusOff)
+	require.ErrorIs(t, k.CheckPacketHalted(ctx, "transfer", "channel-1", receive), types.ErrIbcOff)
+	k.SetSwitchStatus(ctx, types.IbcSwitchStatusOn)
+	require.NoError(t, k.CheckPacketHalted(ctx, "transfer", "channel-1", receive))
+
+	// invalid halts are rejected
+	require.Error(t, k.SetChannelHalt(ctx, types.ChannelHalt{Direction: send}, true))
+}
+
+func TestModuleHalts(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	require.NoError(t, k.CheckContractExecutionHalted(ctx))
+	require.NoError(t, k.CheckIbcHooksHalted(ctx))
+
+	require.NoError(t, k.SetModuleHalt(ctx, types.HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION, true))
+	require.ErrorIs(t, k.CheckContractExecutionHalted(ctx), types.ErrExecutionHalted)
+	require.NoError(t, k.CheckIbcHooksHalted(ctx))
+
+	require.NoError(t, k.SetModuleHalt(ctx, types.HaltedModule_HALTED_MODULE_IBC_HOOKS, true))
+	require.ErrorIs(t, k.CheckIbcHooksHalted(ctx), types.ErrExecutionHalted)
+
+	require.NoError(t, k.SetModuleHalt(ctx, types.HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION, false))
+	require.NoError(t, k.CheckContractExecutionHalted(ctx))
+
+	require.Error(t, k.SetModuleHalt(ctx, types.HaltedModule_HALTED_MODULE_UNSPECIFIED, true))
+}
@@ -1,7 +1,6 @@
 package keeper
 
 import (
-	"cosmossdk.io/errors"
 	storetypes "cosmossdk.io/store/types"
 	codec "github.com/cosmos/cosmos-sdk/codec"
 	sdk "github.com/cosmos/cosmos-sdk/types"
@@ -13,10 +12,11 @@ import (
 )
 
 type Keeper struct {
-	cdc       codec.BinaryCodec
-	channel   porttypes.ICS4Wrapper
-	storeKey  storetypes.StoreKey
-	authority string
+	cdc        codec.BinaryCodec
+	channel    porttypes.ICS4Wrapper
+	bankKeeper types.BankKeeper
+	storeKey   storetypes.StoreKey
+	authority  string
 }
 
 func (i *Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (string, bool) {
@@ -25,33 +25,53 @@ func (i *Keeper) GetAppVersion(ctx sdk.Context, portID, channelID string) (strin
 
 func NewKeeper(
 	channel porttypes.ICS4Wrapper,
+	bankKeeper types.BankKeeper,
 	cdc codec.BinaryCodec,
 	key storetypes.StoreKey,
 	authority string,
 ) Keeper {
 	return Keeper{
-		channel:   channel,
-		authority: authority,
-		cdc:       cdc,
-		storeKey:  key,
+		channel:    channel,
+		bankKeeper: bankKeeper,
+		authority:  authority,
+		cdc:        cdc,
+		storeKey:   key,
 	}
 }
 
 // SendPacket implements the ICS4 interface and is called when sending packets.
-// This method blocks the sending of the packet if the emergencybutton is turned off.
+// This method blocks the sending of the packet if the emergencybutton is turned off, the channel is halted for sends
+// or the transfer exceeds the send quota of its rate limit.
 // If the switcher param is not configured, packets are not blocked and handled by the wrapped IBC app
 func (i *Keeper) SendPacket(ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (uint64, error) {
-	status := i.GetSwitchStatus(ctx)
+	if err := i.CheckPacketHalted(ctx, sourcePort, sourceChannel, types.PacketDirection_PACKET_DIRECTION_SEND); err != nil {
+		return 0, err
+	}
+
+	pending, err := i.SendTransfer(ctx, sourcePort, sourceChannel, data)
+	if err != nil {
+		return 0, err
+	}
 
-	if status == types.IbcSwitchStatusOff {
-		println("Returning error!")
-		return 0, errors.Wrap(types.ErrIbcOff, "Ibc packets are currently paused in the network")
+	sequence, err := i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if err != nil {
+		return 0, err
 	}
 
-	return i.channel.SendPacket(ctx, chanCap, sourcePort, sourceChannel, timeoutHeight, timeoutTimestamp, data)
+	if pending != nil {
+		pending.Sequence = sequence
+		i.setPendingSend(ctx, *pending)
+	}
+	return sequence, nil
 }
 
+// WriteAcknowledgement implements the ICS4 interface. It blocks writing acknowledgements for received packets
+// if the emergencybutton is turned off or the channel is halted for acks.
 func (i *Keeper) WriteAcknowledgement(ctx sdk.Context, chanCap *capabilitytypes.Capability, packet exported.PacketI, ack exported.Acknowledgement) error {
+	if err := i.CheckPacketHalted(ctx, packet.GetDestPort(), packet.GetDestChannel(), types.PacketDirection_PACKET_DIRECTION_ACK); err != nil {
+		return err
+	}
+
 	return i.channel.WriteAcknowledgement(ctx, chanCap, packet, ack)
 }
 
@@ -0,0 +1,71 @@
+package keeper_test
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/log"
+	sdkmath "cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/codec"
+	codectypes "github.com/cosmos/cosmos-sdk/codec/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+// mockBankKeeper returns the supply of the denoms, zero when unset
+type mockBankKeeper struct {
+	supply map[string]sdkmath.Int
+}
+
+func (b mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	amount, ok := b.supply[denom]
+	if !ok {
+		amount = sdkmath.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// mockChannel sends the packets with increasing sequences
+type mockChannel struct {
+	porttypes.ICS4Wrapper
+	sequence uint64
+}
+
+func (c *mockChannel) SendPacket(_ sdk.Context, _ *capabilitytypes.Capability, _, _ string, _ clienttypes.Height, _ uint64, _ []byte) (uint64, error) {
+	c.sequence++
+	return c.sequence, nil
+}
+
+func setupKeeper(t *testing.T) (*keeper.Keeper, sdk.Context) {
+	return setupKeeperWithBank(t, mockBankKeeper{})
+}
+
+func setupKeeperWithBank(t *testing.T, bankKeeper mockBankKeeper) (*keeper.Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	cdc := codec.NewProtoCodec(codectypes.NewInterfaceRegistry())
+	k := keeper.NewKeeper(&mockChannel{}, bankKeeper, cdc, storeKey, authtypes.NewModuleAddress(govtypes.ModuleName).String())
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	require.NoError(t, k.SetParams(ctx, types.DefaultParams()))
+
+	return &k, ctx
+}
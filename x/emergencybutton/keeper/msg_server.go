@@ -6,6 +6,7 @@ import (
 	"cosmossdk.io/errors"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
 )
@@ -23,24 +24,49 @@ func NewMsgServerImpl(keeper Keeper) types.MsgServer {
 func (m msgServer) ToggleIbcSwitch(goCtx context.Context, msg *types.MsgToggleIbcSwitch) (*types.MsgToggleIbcSwitchResponse, error) {
 	ctx := sdk.UnwrapSDKContext(goCtx)
 
-	pauser := m.keeper.GetPauserAddress(ctx)
-	if pauser == "" {
+	params := m.keeper.GetParams(ctx)
+	if !params.HasPausers() {
 		return nil, errors.Wrap(types.ErrPauserUnset, "no address is currently approved to toggle emergency button")
 	}
 
-	if pauser != msg.GetSender() {
-		return nil, errors.Wrap(types.ErrUnauthorizedToggle, "this address is not allowed to toggle emergency button")
+	halted := params.SwitchStatus != types.IbcSwitchStatusOff
+	if halted && !params.CanPause(msg.GetSender()) {
+		return nil, errors.Wrap(types.ErrUnauthorizedToggle, "this address is not allowed to turn off the emergency button")
+	}
+	if !halted && !params.CanUnpause(msg.GetSender()) {
+		return nil, errors.Wrap(types.ErrUnauthorizedToggle, "this address is not allowed to turn on the emergency button")
 	}
 
-	status := m.keeper.GetSwitchStatus(ctx)
+	var expiry *types.SwitchExpiry
+	if msg.ExpiryHeight != 0 || msg.ExpiryDuration != nil {
+		if !halted {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "an expiry can only be set when turning off the emergency button")
+		}
+		if msg.ExpiryHeight < 0 || (msg.ExpiryDuration != nil && *msg.ExpiryDuration <= 0) {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the expiry must be in the future")
+		}
+		expiry = &types.SwitchExpiry{Height: msg.ExpiryHeight}
+		if msg.ExpiryDuration != nil {
+			expiryTime := ctx.BlockTime().Add(*msg.ExpiryDuration)
+			expiry.Time = &expiryTime
+		}
+		if expiry.Expired(ctx.BlockHeight(), ctx.BlockTime()) {
+			return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, "the expiry must be in the future")
+		}
+	}
 
-	if status == types.IbcSwitchStatusOff {
-		m.keeper.SetSwitchStatus(ctx, types.IbcSwitchStatusOn)
-	} else {
+	if halted {
 		m.keeper.SetSwitchStatus(ctx, types.IbcSwitchStatusOff)
+	} else {
+		m.keeper.SetSwitchStatus(ctx, types.IbcSwitchStatusOn)
 	}
+	m.keeper.SetSwitchExpiry(ctx, expiry)
 
-	// todo maybe emit event here?
+	m.keeper.RecordToggle(ctx, types.Toggle{
+		Sender: msg.GetSender(),
+		Halted: halted,
+		Expiry: expiry,
+	})
 
 	return &types.MsgToggleIbcSwitchResponse{}, nil
 }
@@ -51,9 +77,81 @@ func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParam
 	}
 
 	ctx := sdk.UnwrapSDKContext(goCtx)
+	previous := m.keeper.GetSwitchStatus(ctx)
 	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
 		return nil, err
 	}
 
+	m.keeper.PruneFlows(ctx)
+
+	// governance overrides any expiry set by a pauser
+	if req.Params.SwitchStatus != previous {
+		m.keeper.SetSwitchExpiry(ctx, nil)
+		m.keeper.RecordToggle(ctx, types.Toggle{
+			Sender: req.Authority,
+			Halted: req.Params.SwitchStatus == types.IbcSwitchStatusOff,
+		})
+	}
+
 	return &types.MsgUpdateParamsResponse{}, nil
 }
+
+func (m msgServer) SetChannelHalt(goCtx context.Context, msg *types.MsgSetChannelHalt) (*types.MsgSetChannelHaltResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.authorizeHalt(ctx, msg.GetSender(), msg.Halted); err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SetChannelHalt(ctx, msg.Halt, msg.Halted); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	halt := msg.Halt
+	m.keeper.RecordToggle(ctx, types.Toggle{
+		Sender:      msg.GetSender(),
+		Halted:      msg.Halted,
+		ChannelHalt: &halt,
+	})
+
+	return &types.MsgSetChannelHaltResponse{}, nil
+}
+
+func (m msgServer) SetModuleHalt(goCtx context.Context, msg *types.MsgSetModuleHalt) (*types.MsgSetModuleHaltResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	if err := m.authorizeHalt(ctx, msg.GetSender(), msg.Halted); err != nil {
+		return nil, err
+	}
+
+	if err := m.keeper.SetModuleHalt(ctx, msg.Module, msg.Halted); err != nil {
+		return nil, errors.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	m.keeper.RecordToggle(ctx, types.Toggle{
+		Sender: msg.GetSender(),
+		Halted: msg.Halted,
+		Module: msg.Module,
+	})
+
+	return &types.MsgSetModuleHaltResponse{}, nil
+}
+
+// authorizeHalt allows governance, and the pausers with the matching role, to halt and resume channels and modules
+func (m msgServer) authorizeHalt(ctx sdk.Context, sender string, halted bool) error {
+	if sender == m.keeper.authority {
+		return nil
+	}
+
+	params := m.keeper.GetParams(ctx)
+	if !params.HasPausers() {
+		return errors.Wrap(types.ErrPauserUnset, "no address is currently approved to halt channels and modules")
+	}
+	if halted && !params.CanPause(sender) {
+		return errors.Wrap(types.ErrUnauthorizedToggle, "this address is not allowed to halt channels and modules")
+	}
+	if !halted && !params.CanUnpause(sender) {
+		return errors.Wrap(types.ErrUnauthorizedToggle, "this address is not allowed to resume channels and modules")
+	}
+	return nil
+}
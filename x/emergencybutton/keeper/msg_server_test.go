@@ -0,0 +1,83 @@
+package keeper_test
+
+import (
+	"testing"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+func TestPauserRoles(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	msgServer := keeper.NewMsgServerImpl(*k)
+
+	pauser := sdk.AccAddress("pauser______________")
+	unpauser := sdk.AccAddress("unpauser____________")
+	stranger := sdk.AccAddress("stranger____________")
+
+	// nobody can toggle before governance approves a pauser
+	_, err := msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(pauser))
+	require.ErrorIs(t, err, types.ErrPauserUnset)
+
+	params := k.GetParams(ctx)
+	params.Pausers = []types.Pauser{
+		{Address: pauser.String(), CanPause: true},
+		{Address: unpauser.String(), CanUnpause: true},
+	}
+	require.NoError(t, k.SetParams(ctx, params))
+
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(stranger))
+	require.ErrorIs(t, err, types.ErrUnauthorizedToggle)
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(unpauser))
+	require.ErrorIs(t, err, types.ErrUnauthorizedToggle)
+	require.False(t, k.IsHalted(ctx))
+
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(pauser))
+	require.NoError(t, err)
+	require.True(t, k.IsHalted(ctx))
+
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(pauser))
+	require.ErrorIs(t, err, types.ErrUnauthorizedToggle)
+	require.True(t, k.IsHalted(ctx))
+
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(unpauser))
+	require.NoError(t, err)
+	require.False(t, k.IsHalted(ctx))
+
+	// the same roles apply to channel and module halts
+	halt := types.ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: types.PacketDirection_PACKET_DIRECTION_SEND}
+	_, err = msgServer.SetChannelHalt(ctx, types.NewMsgSetChannelHalt(unpauser, halt, true))
+	require.ErrorIs(t, err, types.ErrUnauthorizedToggle)
+	_, err = msgServer.SetChannelHalt(ctx, types.NewMsgSetChannelHalt(pauser, halt, true))
+	require.NoError(t, err)
+	_, err = msgServer.SetChannelHalt(ctx, types.NewMsgSetChannelHalt(pauser, halt, false))
+	require.ErrorIs(t, err, types.ErrUnauthorizedToggle)
+	_, err = msgServer.SetChannelHalt(ctx, types.NewMsgSetChannelHalt(unpauser, halt, false))
+	require.NoError(t, err)
+
+	module := types.HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION
+	_, err = msgServer.SetModuleHalt(ctx, types.NewMsgSetModuleHalt(stranger, module, true))
+	require.ErrorIs(t, err, types.ErrUnauthorizedToggle)
+	_, err = msgServer.SetModuleHalt(ctx, types.NewMsgSetModuleHalt(pauser, module, true))
+	require.NoError(t, err)
+	require.ErrorIs(t, k.CheckContractExecutionHalted(ctx), types.ErrExecutionHalted)
+
+	// governance can always resume
+	_, err = msgServer.SetModuleHalt(ctx, &types.MsgSetModuleHalt{Sender: k.GetAuthority(), Module: module, Halted: false})
+	require.NoError(t, err)
+	require.NoError(t, k.CheckContractExecutionHalted(ctx))
+
+	// the legacy pauser address can both pause and unpause
+	params = k.GetParams(ctx)
+	params.Pausers = nil
+	params.PauserAddress = stranger.String()
+	require.NoError(t, k.SetParams(ctx, params))
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(stranger))
+	require.NoError(t, err)
+	_, err = msgServer.ToggleIbcSwitch(ctx, types.NewMsgToggleIbcSwitch(stranger))
+	require.NoError(t, err)
+	require.False(t, k.IsHalted(ctx))
+}
@@ -0,0 +1,237 @@
+package keeper
+
+import (
+	"cosmossdk.io/errors"
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	ibcfeetypes "github.com/cosmos/ibc-go/v8/modules/apps/29-fee/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+// getFlow returns the flow of a rate limit in its rolling window, without the buckets that expired.
+// The supply is taken again with each new bucket, and while it's zero so that the percentage quotas apply as soon as
+// the denom has a supply.
+func (i *Keeper) getFlow(ctx sdk.Context, limit types.RateLimit) types.Flow {
+	flow := types.NewFlow(limit)
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.FlowKey)
+	if bz := store.Get(types.GetFlowKey(limit.ChannelId, limit.Denom)); bz != nil {
+		i.cdc.MustUnmarshal(bz, &flow)
+	}
+	if flow.Roll(limit, ctx.BlockTime()) || !flow.Supply.IsPositive() {
+		flow.Supply = i.bankKeeper.GetSupply(ctx, limit.Denom).Amount
+	}
+	return flow
+}
+
+func (i *Keeper) setFlow(ctx sdk.Context, flow types.Flow) {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.FlowKey)
+	store.Set(types.GetFlowKey(flow.ChannelId, flow.Denom), i.cdc.MustMarshal(&flow))
+}
+
+// SendTransfer checks that a transfer sent over a channel fits in the send quota of its rate limit, and returns
+// the pending send to record once the packet is sent. It returns nil if the denom isn't rate limited on the channel.
+func (i *Keeper) SendTransfer(ctx sdk.Context, sourcePort, sourceChannel string, data []byte) (*types.PendingSend, error) {
+	if sourcePort != transfertypes.PortID {
+		return nil, nil
+	}
+	transfer, amount, ok := types.UnmarshalTransfer(data)
+	if !ok {
+		return nil, nil
+	}
+	denom := types.SentDenom(transfer)
+	limit, found := i.GetParams(ctx).GetRateLimit(sourceChannel, denom)
+	if !found {
+		return nil, nil
+	}
+
+	flow := i.getFlow(ctx, limit)
+	if capacity, limited := flow.SendCapacity(limit); limited && amount.GT(capacity) {
+		ctx.Logger().Info("ibc transfer rate limited", "channel", sourceChannel, "denom", denom, "amount", amount, "capacity", capacity)
+		return nil, errors.Wrapf(types.ErrRateLimited, "can't send %s%s over %s, only %s can be sent in the last %s",
+			amount, denom, sourceChannel, capacity, limit.Window)
+	}
+	bucketStart := limit.BucketStart(ctx.BlockTime())
+	flow.AddOutflow(bucketStart, amount)
+	i.setFlow(ctx, flow)
+
+	return &types.PendingSend{
+		ChannelId:   sourceChannel,
+		Denom:       denom,
+		Amount:      amount,
+		BucketStart: bucketStart,
+	}, nil
+}
+
+// ReceiveTransfer checks that a transfer received over a channel fits in the receive quota of its rate limit.
+// The inflow is dropped along with the rest of the packet's state changes if the packet fails.
+func (i *Keeper) ReceiveTransfer(ctx sdk.Context, packet channeltypes.Packet) error {
+	if packet.GetDestPort() != transfertypes.PortID {
+		return nil
+	}
+	transfer, amount, ok := types.UnmarshalTransfer(packet.GetData())
+	if !ok {
+		return nil
+	}
+	denom := types.ReceivedDenom(packet.GetSourcePort(), packet.GetSourceChannel(), packet.GetDestPort(), packet.GetDestChannel(), transfer)
+	limit, found := i.GetParams(ctx).GetRateLimit(packet.GetDestChannel(), denom)
+	if !found {
+		return nil
+	}
+
+	flow := i.getFlow(ctx, limit)
+	if capacity, limited := flow.RecvCapacity(limit); limited && amount.GT(capacity) {
+		ctx.Logger().Info("ibc transfer rate limited", "channel", packet.GetDestChannel(), "denom", denom, "amount", amount, "capacity", capacity)
+		return errors.Wrapf(types.ErrRateLimited, "can't receive %s%s over %s, only %s can be received in the last %s",
+			amount, denom, packet.GetDestChannel(), capacity, limit.Window)
+	}
+	flow.AddInflow(limit.BucketStart(ctx.BlockTime()), amount)
+	i.setFlow(ctx, flow)
+	return nil
+}
+
+// AcknowledgeTransfer settles a rate limited transfer once its acknowledgement is received. A failed transfer
+// is taken back from the outflow.
+func (i *Keeper) AcknowledgeTransfer(ctx sdk.Context, packet channeltypes.Packet, acknowledgement []byte) {
+	if transferSucceeded(acknowledgement) {
+		i.deletePendingSend(ctx, packet.GetSourceChannel(), packet.GetSequence())
+		return
+	}
+	i.RefundTransfer(ctx, packet)
+}
+
+// transferSucceeded returns whether the acknowledgement of a transfer is successful. The acknowledgements of fee
+// enabled channels wrap the acknowledgement of the transfer app, since the switch sits on top of the fee middleware.
+func transferSucceeded(acknowledgement []byte) bool {
+	var incentivized ibcfeetypes.IncentivizedAcknowledgement
+	if err := ibcfeetypes.ModuleCdc.UnmarshalJSON(acknowledgement, &incentivized); err == nil && len(incentivized.AppAcknowledgement) > 0 {
+		acknowledgement = incentivized.AppAcknowledgement
+	}
+	var ack channeltypes.Acknowledgement
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(acknowledgement, &ack); err != nil {
+		return false
+	}
+	return ack.Success()
+}
+
+// RefundTransfer takes a failed or timed out transfer back from the outflow, if its bucket is still in the window.
+func (i *Keeper) RefundTransfer(ctx sdk.Context, packet channeltypes.Packet) {
+	pending, found := i.getPendingSend(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !found {
+		return
+	}
+	i.deletePendingSend(ctx, pending.ChannelId, pending.Sequence)
+
+	limit, found := i.GetParams(ctx).GetRateLimit(pending.ChannelId, pending.Denom)
+	if !found {
+		return
+	}
+	flow := i.getFlow(ctx, limit)
+	flow.RemoveOutflow(pending.BucketStart, pending.Amount)
+	i.setFlow(ctx, flow)
+}
+
+func (i *Keeper) setPendingSend(ctx sdk.Context, pending types.PendingSend) {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.PendingSendKey)
+	store.Set(types.GetPendingSendKey(pending.ChannelId, pending.Sequence), i.cdc.MustMarshal(&pending))
+}
+
+func (i *Keeper) getPendingSend(ctx sdk.Context, channelID string, sequence uint64) (types.PendingSend, bool) {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.PendingSendKey)
+	bz := store.Get(types.GetPendingSendKey(channelID, sequence))
+	if bz == nil {
+		return types.PendingSend{}, false
+	}
+	var pending types.PendingSend
+	i.cdc.MustUnmarshal(bz, &pending)
+	return pending, true
+}
+
+func (i *Keeper) deletePendingSend(ctx sdk.Context, channelID string, sequence uint64) {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.PendingSendKey)
+	store.Delete(types.GetPendingSendKey(channelID, sequence))
+}
+
+// RateLimitStatuses returns the rate limits with their flow in the rolling window, optionally filtered by
+// channel and denom.
+func (i *Keeper) RateLimitStatuses(ctx sdk.Context, channelID, denom string) []types.RateLimitStatus {
+	var statuses []types.RateLimitStatus
+	for _, limit := range i.GetParams(ctx).RateLimits {
+		if (channelID != "" && limit.ChannelId != channelID) || (denom != "" && limit.Denom != denom) {
+			continue
+		}
+		flow := i.getFlow(ctx, limit)
+		status := types.RateLimitStatus{RateLimit: limit, Flow: flow}
+		if capacity, limited := flow.SendCapacity(limit); limited {
+			status.SendCapacity = &capacity
+		}
+		if capacity, limited := flow.RecvCapacity(limit); limited {
+			status.RecvCapacity = &capacity
+		}
+		statuses = append(statuses, status)
+	}
+	return statuses
+}
+
+// PruneFlows deletes the flows of the rate limits that were removed from the params.
+func (i *Keeper) PruneFlows(ctx sdk.Context) {
+	params := i.GetParams(ctx)
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.FlowKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var pruned [][]byte
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		i.cdc.MustUnmarshal(iterator.Value(), &flow)
+		if _, found := params.GetRateLimit(flow.ChannelId, flow.Denom); !found {
+			pruned = append(pruned, append([]byte(nil), iterator.Key()...))
+		}
+	}
+	for _, key := range pruned {
+		store.Delete(key)
+	}
+}
+
+// GetFlows returns the stored flows, used by ExportGenesis.
+func (i *Keeper) GetFlows(ctx sdk.Context) []types.Flow {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.FlowKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var flows []types.Flow
+	for ; iterator.Valid(); iterator.Next() {
+		var flow types.Flow
+		i.cdc.MustUnmarshal(iterator.Value(), &flow)
+		flows = append(flows, flow)
+	}
+	return flows
+}
+
+// GetPendingSends returns the rate limited transfers waiting for their acknowledgement, used by ExportGenesis.
+func (i *Keeper) GetPendingSends(ctx sdk.Context) []types.PendingSend {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.PendingSendKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var pendings []types.PendingSend
+	for ; iterator.Valid(); iterator.Next() {
+		var pending types.PendingSend
+		i.cdc.MustUnmarshal(iterator.Value(), &pending)
+		pendings = append(pendings, pending)
+	}
+	return pendings
+}
+
+// setFlowsAndPendingSends restores the state of the rate limits, used by InitGenesis.
+func (i *Keeper) setFlowsAndPendingSends(ctx sdk.Context, flows []types.Flow, pendings []types.PendingSend) {
+	for _, flow := range flows {
+		i.setFlow(ctx, flow)
+	}
+	for _, pending := range pendings {
+		i.setPendingSend(ctx, pending)
+	}
+}
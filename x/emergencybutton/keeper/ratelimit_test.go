@@ -0,0 +1,173 @@
+package keeper_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+func newQuota(maxPercent, maxAmount int64) types.Quota {
+	return types.Quota{MaxPercent: sdkmath.LegacyNewDec(maxPercent), MaxAmount: sdkmath.NewInt(maxAmount)}
+}
+
+func setRateLimit(t *testing.T, k *keeper.Keeper, ctx sdk.Context, limit types.RateLimit) {
+	params := k.GetParams(ctx)
+	params.RateLimits = []types.RateLimit{limit}
+	require.NoError(t, k.SetParams(ctx, params))
+}
+
+func transferData(denom string, amount int64) []byte {
+	return transfertypes.NewFungibleTokenPacketData(denom, sdkmath.NewInt(amount).String(), "sender", "receiver", "").GetBytes()
+}
+
+func sendTransfer(k *keeper.Keeper, ctx sdk.Context, denom string, amount int64) (uint64, error) {
+	return k.SendPacket(ctx, nil, transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0, transferData(denom, amount))
+}
+
+func receivedPacket(denom string, amount int64) channeltypes.Packet {
+	return channeltypes.NewPacket(transferData(denom, amount), 1, transfertypes.PortID, "channel-5", transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 0)
+}
+
+func sentPacket(sequence uint64) channeltypes.Packet {
+	return channeltypes.NewPacket(transferData("uscrt", 1), sequence, transfertypes.PortID, "channel-0", transfertypes.PortID, "channel-5", clienttypes.ZeroHeight(), 0)
+}
+
+func TestSendRateLimit(t *testing.T) {
+	k, ctx := setupKeeperWithBank(t, mockBankKeeper{supply: map[string]sdkmath.Int{"uscrt": sdkmath.NewInt(1000)}})
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+
+	setRateLimit(t, k, ctx, types.RateLimit{
+		Denom:     "uscrt",
+		ChannelId: "channel-0",
+		Window:    time.Hour,
+		SendQuota: newQuota(10, 0),
+		RecvQuota: newQuota(0, 0),
+	})
+
+	_, err := sendTransfer(k, ctx, "uscrt", 101)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+
+	seq1, err := sendTransfer(k, ctx, "uscrt", 60)
+	require.NoError(t, err)
+	seq2, err := sendTransfer(k, ctx, "uscrt", 40)
+	require.NoError(t, err)
+	require.Len(t, k.GetPendingSends(ctx), 2)
+
+	_, err = sendTransfer(k, ctx, "uscrt", 1)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+
+	// other denoms aren't limited
+	_, err = sendTransfer(k, ctx, "ustake", 1000)
+	require.NoError(t, err)
+	require.Len(t, k.GetPendingSends(ctx), 2)
+
+	// a successful transfer keeps its outflow
+	k.AcknowledgeTransfer(ctx, sentPacket(seq1), channeltypes.NewResultAcknowledgement([]byte{1}).Acknowledgement())
+	require.Len(t, k.GetPendingSends(ctx), 1)
+	_, err = sendTransfer(k, ctx, "uscrt", 1)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+
+	// a failed transfer is taken back from the outflow
+	k.AcknowledgeTransfer(ctx, sentPacket(seq2), channeltypes.NewErrorAcknowledgement(errors.New("failed")).Acknowledgement())
+	require.Empty(t, k.GetPendingSends(ctx))
+	seq3, err := sendTransfer(k, ctx, "uscrt", 40)
+	require.NoError(t, err)
+
+	// and so is a timed out transfer
+	k.RefundTransfer(ctx, sentPacket(seq3))
+	require.Empty(t, k.GetPendingSends(ctx))
+	seq4, err := sendTransfer(k, ctx, "uscrt", 40)
+	require.NoError(t, err)
+
+	// the flow expires once the window has passed
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	_, err = sendTransfer(k, ctx, "uscrt", 100)
+	require.NoError(t, err)
+	statuses := k.RateLimitStatuses(ctx, "channel-0", "uscrt")
+	require.Len(t, statuses, 1)
+	require.Equal(t, int64(100), statuses[0].Flow.Outflow.Int64())
+	require.True(t, statuses[0].SendCapacity.IsZero())
+	require.Nil(t, statuses[0].RecvCapacity)
+
+	// a refund of a transfer whose bucket expired doesn't free up capacity
+	k.RefundTransfer(ctx, sentPacket(seq4))
+	_, err = sendTransfer(k, ctx, "uscrt", 1)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+}
+
+func TestReceiveRateLimit(t *testing.T) {
+	denom := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+	bankKeeper := mockBankKeeper{supply: map[string]sdkmath.Int{}}
+	k, ctx := setupKeeperWithBank(t, bankKeeper)
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockTime(start)
+
+	setRateLimit(t, k, ctx, types.RateLimit{
+		Denom:     denom,
+		ChannelId: "channel-0",
+		Window:    time.Hour,
+		SendQuota: newQuota(0, 0),
+		RecvQuota: newQuota(10, 0),
+	})
+
+	// the first transfer of a new denom isn't limited by its percentage quota
+	require.NoError(t, k.ReceiveTransfer(ctx, receivedPacket("uatom", 1000)))
+	bankKeeper.supply[denom] = sdkmath.NewInt(1000)
+
+	// the supply is taken once the denom has one
+	require.ErrorIs(t, k.ReceiveTransfer(ctx, receivedPacket("uatom", 1)), types.ErrRateLimited)
+
+	// the supply is taken again with the next bucket
+	ctx = ctx.WithBlockTime(start.Add(time.Hour))
+	require.NoError(t, k.ReceiveTransfer(ctx, receivedPacket("uatom", 100)))
+	require.ErrorIs(t, k.ReceiveTransfer(ctx, receivedPacket("uatom", 1)), types.ErrRateLimited)
+
+	flows := k.GetFlows(ctx)
+	require.Len(t, flows, 1)
+	require.Equal(t, int64(1000), flows[0].Supply.Int64())
+	require.Equal(t, int64(100), flows[0].Inflow.Int64())
+}
+
+func TestRollingWindow(t *testing.T) {
+	k, ctx := setupKeeperWithBank(t, mockBankKeeper{supply: map[string]sdkmath.Int{"uscrt": sdkmath.NewInt(1000)}})
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	setRateLimit(t, k, ctx, types.RateLimit{
+		Denom:     "uscrt",
+		ChannelId: "channel-0",
+		Window:    time.Hour,
+		SendQuota: newQuota(10, 0),
+		RecvQuota: newQuota(0, 0),
+	})
+
+	_, err := sendTransfer(k, ctx.WithBlockTime(start.Add(10*time.Minute)), "uscrt", 40)
+	require.NoError(t, err)
+	_, err = sendTransfer(k, ctx.WithBlockTime(start.Add(50*time.Minute)), "uscrt", 60)
+	require.NoError(t, err)
+
+	// the quota isn't reset at a window boundary, the flows expire one bucket at a time
+	_, err = sendTransfer(k, ctx.WithBlockTime(start.Add(time.Hour)), "uscrt", 1)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+
+	_, err = sendTransfer(k, ctx.WithBlockTime(start.Add(70*time.Minute)), "uscrt", 41)
+	require.ErrorIs(t, err, types.ErrRateLimited)
+	_, err = sendTransfer(k, ctx.WithBlockTime(start.Add(70*time.Minute)), "uscrt", 40)
+	require.NoError(t, err)
+
+	statuses := k.RateLimitStatuses(ctx.WithBlockTime(start.Add(110*time.Minute)), "channel-0", "uscrt")
+	require.Len(t, statuses, 1)
+	require.Equal(t, int64(40), statuses[0].Flow.Outflow.Int64())
+	require.Len(t, statuses[0].Flow.Buckets, 1)
+	require.Equal(t, int64(60), statuses[0].SendCapacity.Int64())
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+// GetSwitchExpiry returns the expiry of the switch, if it was turned off until it expires.
+func (i *Keeper) GetSwitchExpiry(ctx sdk.Context) *types.SwitchExpiry {
+	bz := ctx.KVStore(i.storeKey).Get(types.SwitchExpiryKey)
+	if bz == nil {
+		return nil
+	}
+	var expiry types.SwitchExpiry
+	i.cdc.MustUnmarshal(bz, &expiry)
+	return &expiry
+}
+
+// SetSwitchExpiry sets the expiry of the switch, or clears it if nil.
+func (i *Keeper) SetSwitchExpiry(ctx sdk.Context, expiry *types.SwitchExpiry) {
+	store := ctx.KVStore(i.storeKey)
+	if expiry == nil {
+		store.Delete(types.SwitchExpiryKey)
+		return
+	}
+	store.Set(types.SwitchExpiryKey, i.cdc.MustMarshal(expiry))
+}
+
+// ExpireSwitch turns the switch back on once its expiry is reached. It's called at the beginning of every block.
+// Only the switch expires, channel and module halts stay until they are resumed.
+func (i *Keeper) ExpireSwitch(ctx sdk.Context) {
+	expiry := i.GetSwitchExpiry(ctx)
+	if expiry == nil {
+		return
+	}
+	if !i.IsHalted(ctx) {
+		// turned back on before expiring
+		i.SetSwitchExpiry(ctx, nil)
+		return
+	}
+	if !expiry.Expired(ctx.BlockHeight(), ctx.BlockTime()) {
+		return
+	}
+
+	i.SetSwitchStatus(ctx, types.IbcSwitchStatusOn)
+	i.SetSwitchExpiry(ctx, nil)
+	i.RecordToggle(ctx, types.Toggle{Halted: false})
+	ctx.Logger().Info("emergency button switch expired, ibc packets are resumed")
+}
+
+// RecordToggle adds a change of the switch, a channel halt or a module halt to the history, and emits it
+// as an event. Only the last MaxToggles changes are kept.
+func (i *Keeper) RecordToggle(ctx sdk.Context, toggle types.Toggle) {
+	store := ctx.KVStore(i.storeKey)
+
+	toggle.Id = i.nextToggleID(ctx)
+	toggle.Height = ctx.BlockHeight()
+	toggle.Time = ctx.BlockTime()
+	i.setToggle(ctx, toggle)
+
+	if toggle.Id >= types.MaxToggles {
+		prefix.NewStore(store, types.ToggleKey).Delete(types.GetToggleKey(toggle.Id - types.MaxToggles))
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventToggled{Toggle: toggle}); err != nil {
+		ctx.Logger().Error("failed to emit toggle event", "error", err)
+	}
+}
+
+func (i *Keeper) nextToggleID(ctx sdk.Context) uint64 {
+	store := ctx.KVStore(i.storeKey)
+	var id uint64
+	if bz := store.Get(types.NextToggleIDKey); bz != nil {
+		id = sdk.BigEndianToUint64(bz)
+	}
+	store.Set(types.NextToggleIDKey, sdk.Uint64ToBigEndian(id+1))
+	return id
+}
+
+func (i *Keeper) setToggle(ctx sdk.Context, toggle types.Toggle) {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.ToggleKey)
+	store.Set(types.GetToggleKey(toggle.Id), i.cdc.MustMarshal(&toggle))
+}
+
+// GetToggles returns the toggle history, oldest first.
+func (i *Keeper) GetToggles(ctx sdk.Context) []types.Toggle {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.ToggleKey)
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var toggles []types.Toggle
+	for ; iterator.Valid(); iterator.Next() {
+		var toggle types.Toggle
+		i.cdc.MustUnmarshal(iterator.Value(), &toggle)
+		toggles = append(toggles, toggle)
+	}
+	return toggles
+}
+
+// PaginatedToggles returns a page of the toggle history, oldest first.
+func (i *Keeper) PaginatedToggles(ctx sdk.Context, pagination *query.PageRequest) ([]types.Toggle, *query.PageResponse, error) {
+	store := prefix.NewStore(ctx.KVStore(i.storeKey), types.ToggleKey)
+
+	var toggles []types.Toggle
+	pageRes, err := query.Paginate(store, pagination, func(_, value []byte) error {
+		var toggle types.Toggle
+		if err := i.cdc.Unmarshal(value, &toggle); err != nil {
+			return err
+		}
+		toggles = append(toggles, toggle)
+		return nil
+	})
+	return toggles, pageRes, err
+}
+
+// setToggles replaces the toggle history, used by InitGenesis.
+func (i *Keeper) setToggles(ctx sdk.Context, toggles []types.Toggle) {
+	var nextID uint64
+	for _, toggle := range toggles {
+		i.setToggle(ctx, toggle)
+		nextID = toggle.Id + 1
+	}
+	ctx.KVStore(i.storeKey).Set(types.NextToggleIDKey, sdk.Uint64ToBigEndian(nextID))
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton"
+	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/types"
+)
+
+func TestExpireSwitch(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	am := emergencybutton.NewAppModule(k, nil)
+
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	ctx = ctx.WithBlockHeight(10).WithBlockTime(start)
+
+	// expiry by height
+	k.SetSwitchStatus(ctx, types.IbcSwitchStatusOff)
+	k.SetSwitchExpiry(ctx, &types.SwitchExpiry{Height: 12})
+
+	ctx = ctx.WithBlockHeight(11)
+	require.NoError(t, am.BeginBlock(ctx))
+	require.True(t, k.IsHalted(ctx))
+	require.NotNil(t, k.GetSwitchExpiry(ctx))
+
+	ctx = ctx.WithBlockHeight(12)
+	require.NoError(t, am.BeginBlock(ctx))
+	require.False(t, k.IsHalted(ctx))
+	require.Nil(t, k.GetSwitchExpiry(ctx))
+
+	toggles := k.GetToggles(ctx)
+	require.Len(t, toggles, 1)
+	require.False(t, toggles[0].Halted)
+	require.Empty(t, toggles[0].Sender)
+	require.Equal(t, int64(12), toggles[0].Height)
+
+	// expiry by time
+	expiryTime := start.Add(time.Hour)
+	k.SetSwitchStatus(ctx, types.IbcSwitchStatusOff)
+	k.SetSwitchExpiry(ctx, &types.SwitchExpiry{Time: &expiryTime})
+
+	ctx = ctx.WithBlockHeight(13).WithBlockTime(start.Add(time.Minute))
+	require.NoError(t, am.BeginBlock(ctx))
+	require.True(t, k.IsHalted(ctx))
+
+	ctx = ctx.WithBlockHeight(14).WithBlockTime(expiryTime)
+	require.NoError(t, am.BeginBlock(ctx))
+	require.False(t, k.IsHalted(ctx))
+	require.Nil(t, k.GetSwitchExpiry(ctx))
+
+	// the expiry is dropped when the switch is turned back on before it's reached
+	k.SetSwitchStatus(ctx, types.IbcSwitchStatusOff)
+	k.SetSwitchExpiry(ctx, &types.SwitchExpiry{Height: 100})
+	k.SetSwitchStatus(ctx, types.IbcSwitchStatusOn)
+
+	ctx = ctx.WithBlockHeight(15)
+	require.NoError(t, am.BeginBlock(ctx))
+	require.Nil(t, k.GetSwitchExpiry(ctx))
+	require.Len(t, k.GetToggles(ctx), 2)
+
+	// channel and module halts don't expire
+	halt := types.ChannelHalt{PortId: "transfer", Direction: types.PacketDirection_PACKET_DIRECTION_SEND}
+	require.NoError(t, k.SetChannelHalt(ctx, halt, true))
+	require.NoError(t, k.SetModuleHalt(ctx, types.HaltedModule_HALTED_MODULE_IBC_HOOKS, true))
+
+	ctx = ctx.WithBlockHeight(1000).WithBlockTime(start.Add(365 * 24 * time.Hour))
+	require.NoError(t, am.BeginBlock(ctx))
+	require.ErrorIs(t, k.CheckPacketHalted(ctx, "transfer", "channel-0", types.PacketDirection_PACKET_DIRECTION_SEND), types.ErrIbcOff)
+	require.ErrorIs(t, k.CheckIbcHooksHalted(ctx), types.ErrExecutionHalted)
+}
+
+func TestRecordTogglePrunes(t *testing.T) {
+	k, ctx := setupKeeper(t)
+
+	for i := 0; i < types.MaxToggles+5; i++ {
+		k.RecordToggle(ctx, types.Toggle{Halted: i%2 == 0})
+	}
+
+	toggles := k.GetToggles(ctx)
+	require.Len(t, toggles, types.MaxToggles)
+	require.Equal(t, uint64(5), toggles[0].Id)
+	require.Equal(t, uint64(types.MaxToggles+4), toggles[len(toggles)-1].Id)
+	for i := 1; i < len(toggles); i++ {
+		require.Equal(t, toggles[i-1].Id+1, toggles[i].Id)
+	}
+}
@@ -7,6 +7,7 @@ import (
 
 	"github.com/scrtlabs/SecretNetwork/x/emergencybutton/client/grpc"
 
+	"cosmossdk.io/core/appmodule"
 	"github.com/grpc-ecosystem/grpc-gateway/runtime"
 
 	"github.com/cosmos/cosmos-sdk/client"
@@ -26,6 +27,7 @@ var (
 	_ module.HasServices         = AppModule{}
 	_ module.HasGenesis          = AppModule{}
 	_ module.HasConsensusVersion = AppModule{}
+	_ appmodule.HasBeginBlocker  = AppModule{}
 )
 
 type AppModuleBasic struct{}
@@ -117,6 +119,12 @@ func (am AppModule) ExportGenesis(ctx sdk.Context, cdc codec.JSONCodec) json.Raw
 	return cdc.MustMarshalJSON(genState)
 }
 
+// BeginBlock turns the switch back on once its expiry is reached.
+func (am AppModule) BeginBlock(c context.Context) error {
+	am.keeper.ExpireSwitch(sdk.UnwrapSDKContext(c))
+	return nil
+}
+
 // ConsensusVersion implements AppModule/ConsensusVersion.
 func (AppModule) ConsensusVersion() uint64 { return 2 }
 
@@ -12,6 +12,8 @@ import (
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&MsgToggleIbcSwitch{}, "emergencybutton/MsgToggleIbcSwitch", nil)
 	cdc.RegisterConcrete(&MsgUpdateParams{}, "emergencybutton/MsgUpdateParams", nil)
+	cdc.RegisterConcrete(&MsgSetChannelHalt{}, "emergencybutton/MsgSetChannelHalt", nil)
+	cdc.RegisterConcrete(&MsgSetModuleHalt{}, "emergencybutton/MsgSetModuleHalt", nil)
 }
 
 // RegisterInterfaces registers interfaces and implementations of the incentives module.
@@ -20,6 +22,8 @@ func RegisterInterfaces(registry cdctypes.InterfaceRegistry) {
 		(*sdk.Msg)(nil),
 		&MsgToggleIbcSwitch{},
 		&MsgUpdateParams{},
+		&MsgSetChannelHalt{},
+		&MsgSetModuleHalt{},
 	)
 
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
@@ -8,4 +8,6 @@ var (
 	ErrIbcOff             = errors.Register(ModuleName, 1, "ibc processing failed")
 	ErrUnauthorizedToggle = errors.Register(ModuleName, 2, "emergency button toggle failed")
 	ErrPauserUnset        = errors.Register(ModuleName, 3, "emergency button toggle failed")
+	ErrExecutionHalted    = errors.Register(ModuleName, 4, "contract execution halted")
+	ErrRateLimited        = errors.Register(ModuleName, 5, "rate limit exceeded")
 )
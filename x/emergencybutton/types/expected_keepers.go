@@ -0,0 +1,12 @@
+package types
+
+import (
+	"context"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// BankKeeper defines the bank methods used to compute the percentage quotas of rate limits.
+type BankKeeper interface {
+	GetSupply(ctx context.Context, denom string) sdk.Coin
+}
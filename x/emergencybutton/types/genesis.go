@@ -1,5 +1,7 @@
 package types
 
+import "fmt"
+
 // DefaultGenesis creates a default GenesisState object.
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -10,5 +12,23 @@ func DefaultGenesis() *GenesisState {
 // Validate performs basic genesis state validation returning an error upon any
 // failure.
 func (gs GenesisState) Validate() error {
+	if len(gs.Toggles) > MaxToggles {
+		return fmt.Errorf("at most %d toggles can be kept, got %d", MaxToggles, len(gs.Toggles))
+	}
+	for i := 1; i < len(gs.Toggles); i++ {
+		if gs.Toggles[i].Id <= gs.Toggles[i-1].Id {
+			return fmt.Errorf("toggles must be sorted by increasing id")
+		}
+	}
+	for _, flow := range gs.Flows {
+		if _, found := gs.Params.GetRateLimit(flow.ChannelId, flow.Denom); !found {
+			return fmt.Errorf("flow of %s over %s has no rate limit", flow.Denom, flow.ChannelId)
+		}
+	}
+	if gs.SwitchExpiry != nil {
+		if err := gs.SwitchExpiry.Validate(); err != nil {
+			return err
+		}
+	}
 	return gs.Params.Validate()
 }
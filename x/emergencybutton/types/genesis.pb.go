@@ -26,6 +26,15 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 // GenesisState - genesis state of x/wasm
 type GenesisState struct {
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// switch_expiry is set when the switch is off until it expires.
+	SwitchExpiry *SwitchExpiry `protobuf:"bytes,2,opt,name=switch_expiry,json=switchExpiry,proto3" json:"switch_expiry,omitempty"`
+	// toggles are the most recent changes, oldest first.
+	Toggles []Toggle `protobuf:"bytes,3,rep,name=toggles,proto3" json:"toggles"`
+	// flows are the flows of the rate limits in their rolling window.
+	Flows []Flow `protobuf:"bytes,4,rep,name=flows,proto3" json:"flows"`
+	// pending_sends are the rate limited transfers waiting for their
+	// acknowledgement.
+	PendingSends []PendingSend `protobuf:"bytes,5,rep,name=pending_sends,json=pendingSends,proto3" json:"pending_sends"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -68,6 +77,34 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetSwitchExpiry() *SwitchExpiry {
+	if m != nil {
+		return m.SwitchExpiry
+	}
+	return nil
+}
+
+func (m *GenesisState) GetToggles() []Toggle {
+	if m != nil {
+		return m.Toggles
+	}
+	return nil
+}
+
+func (m *GenesisState) GetFlows() []Flow {
+	if m != nil {
+		return m.Flows
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingSends() []PendingSend {
+	if m != nil {
+		return m.PendingSends
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "secret.emergencybutton.v1beta1.GenesisState")
 }
@@ -77,21 +114,29 @@ func init() {
 }
 
 var fileDescriptor_2ce0ae39e4ee7c50 = []byte{
-	// 221 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0xd2, 0x29, 0x4e, 0x4d, 0x2e,
-	0x4a, 0x2d, 0xd1, 0x4f, 0xcd, 0x4d, 0x2d, 0x4a, 0x4f, 0xcd, 0x4b, 0xae, 0x4c, 0x2a, 0x2d, 0x29,
-	0xc9, 0xcf, 0xd3, 0x2f, 0x33, 0x4c, 0x4a, 0x2d, 0x49, 0x34, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b, 0x2d,
-	0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0x92, 0x83, 0xa8, 0xd6, 0x43, 0x53, 0xad,
-	0x07, 0x55, 0x2d, 0x25, 0x92, 0x9e, 0x9f, 0x9e, 0x0f, 0x56, 0xaa, 0x0f, 0x62, 0x41, 0x74, 0x49,
-	0x69, 0x13, 0xb0, 0xa3, 0x20, 0xb1, 0x28, 0x31, 0x17, 0x6a, 0x85, 0x52, 0x08, 0x17, 0x8f, 0x3b,
-	0xc4, 0xce, 0xe0, 0x92, 0xc4, 0x92, 0x54, 0x21, 0x17, 0x2e, 0x36, 0x88, 0xbc, 0x04, 0xa3, 0x02,
-	0xa3, 0x06, 0xb7, 0x91, 0x9a, 0x1e, 0x7e, 0x37, 0xe8, 0x05, 0x80, 0x55, 0x3b, 0xb1, 0x9c, 0xb8,
-	0x27, 0xcf, 0x10, 0x04, 0xd5, 0xeb, 0x14, 0x7c, 0xe2, 0x91, 0x1c, 0xe3, 0x85, 0x47, 0x72, 0x8c,
-	0x0f, 0x1e, 0xc9, 0x31, 0x4e, 0x78, 0x2c, 0xc7, 0x70, 0xe1, 0xb1, 0x1c, 0xc3, 0x8d, 0xc7, 0x72,
-	0x0c, 0x51, 0x96, 0xe9, 0x99, 0x25, 0x19, 0xa5, 0x49, 0x7a, 0xc9, 0xf9, 0xb9, 0xfa, 0xc5, 0xc9,
-	0x45, 0x25, 0x39, 0x89, 0x49, 0xc5, 0xfa, 0xc1, 0x60, 0x2b, 0xfc, 0x52, 0x4b, 0xca, 0xf3, 0x8b,
-	0xb2, 0xf5, 0x2b, 0x30, 0x5c, 0x5e, 0x52, 0x59, 0x90, 0x5a, 0x9c, 0xc4, 0x06, 0x76, 0xb1, 0x31,
-	0x20, 0x00, 0x00, 0xff, 0xff, 0x03, 0xc4, 0xee, 0x9e, 0x44, 0x01, 0x00, 0x00,
+	// 349 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x91, 0xbf, 0x4b, 0xc3, 0x40,
+	0x14, 0xc7, 0x13, 0xfb, 0x43, 0x88, 0xed, 0x12, 0x1c, 0x42, 0x87, 0x58, 0x44, 0x44, 0x68, 0x49,
+	0xa8, 0x4e, 0x6e, 0x52, 0xb4, 0x6e, 0xa2, 0x8d, 0x38, 0xb8, 0x94, 0x24, 0x7d, 0x5e, 0x0f, 0x93,
+	0xbb, 0x70, 0xf7, 0x6a, 0xda, 0x3f, 0xc1, 0xcd, 0x3f, 0xab, 0x63, 0x47, 0x27, 0x91, 0xf6, 0x1f,
+	0x11, 0xef, 0x22, 0x8a, 0x82, 0xe9, 0x96, 0x84, 0xcf, 0xf7, 0xf3, 0x7d, 0x2f, 0xcf, 0xea, 0x4a,
+	0x88, 0x05, 0xa0, 0x0f, 0x29, 0x08, 0x02, 0x2c, 0x9e, 0x47, 0x53, 0x44, 0xce, 0xfc, 0xa7, 0x5e,
+	0x04, 0x18, 0xf6, 0x7c, 0x02, 0x0c, 0x24, 0x95, 0x5e, 0x26, 0x38, 0x72, 0xdb, 0xd5, 0xb4, 0xf7,
+	0x8b, 0xf6, 0x0a, 0xba, 0xb5, 0x4b, 0x38, 0xe1, 0x0a, 0xf5, 0x3f, 0x9f, 0x74, 0xaa, 0xd5, 0x29,
+	0xe9, 0xc8, 0x42, 0x11, 0xa6, 0x45, 0x45, 0xcb, 0x2b, 0x81, 0x45, 0x88, 0x90, 0xd0, 0x94, 0xe2,
+	0x86, 0x72, 0xe4, 0x84, 0x24, 0xa0, 0xe1, 0xfd, 0xe7, 0x8a, 0xd5, 0xb8, 0xd4, 0x1b, 0x05, 0x18,
+	0x22, 0xd8, 0xe7, 0x56, 0x5d, 0xb7, 0x3b, 0x66, 0xdb, 0x3c, 0xda, 0x39, 0x3e, 0xf4, 0xfe, 0xdf,
+	0xd0, 0xbb, 0x56, 0x74, 0xbf, 0xba, 0x78, 0xdb, 0x33, 0x86, 0x45, 0xd6, 0xbe, 0xb1, 0x9a, 0x32,
+	0xa7, 0x18, 0x4f, 0x46, 0x30, 0xcb, 0xa8, 0x98, 0x3b, 0x5b, 0x4a, 0xd6, 0x2d, 0x93, 0x05, 0x2a,
+	0x74, 0xa1, 0x32, 0xc3, 0x86, 0xfc, 0xf1, 0x66, 0x0f, 0xac, 0x6d, 0x3d, 0xb9, 0x74, 0x2a, 0xed,
+	0xca, 0x26, 0x93, 0xdd, 0x2a, 0xbc, 0x98, 0xec, 0x2b, 0x6c, 0x9f, 0x59, 0xb5, 0x87, 0x84, 0xe7,
+	0xd2, 0xa9, 0x2a, 0xcb, 0x41, 0x99, 0x65, 0x90, 0xf0, 0xbc, 0x70, 0xe8, 0xa0, 0x7d, 0x67, 0x35,
+	0x33, 0x60, 0x63, 0xca, 0xc8, 0x48, 0x02, 0x1b, 0x4b, 0xa7, 0xa6, 0x4c, 0x9d, 0xd2, 0x3f, 0xa5,
+	0x43, 0x01, 0xb0, 0x71, 0x21, 0x6c, 0x64, 0xdf, 0x9f, 0x64, 0x3f, 0x58, 0xac, 0x5c, 0x73, 0xb9,
+	0x72, 0xcd, 0xf7, 0x95, 0x6b, 0xbe, 0xac, 0x5d, 0x63, 0xb9, 0x76, 0x8d, 0xd7, 0xb5, 0x6b, 0xdc,
+	0x9f, 0x12, 0x8a, 0x93, 0x69, 0xe4, 0xc5, 0x3c, 0xf5, 0x65, 0x2c, 0x30, 0x09, 0x23, 0xe9, 0x07,
+	0xaa, 0xed, 0x0a, 0x30, 0xe7, 0xe2, 0xd1, 0x9f, 0xfd, 0xb9, 0x37, 0xce, 0x33, 0x90, 0x51, 0x5d,
+	0xdd, 0xf9, 0xe4, 0x63, 0x00, 0xbc, 0xa8, 0xc2, 0xf4, 0xd7, 0x02, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +159,60 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.PendingSends) > 0 {
+		for iNdEx := len(m.PendingSends) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingSends[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Flows) > 0 {
+		for iNdEx := len(m.Flows) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Flows[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if len(m.Toggles) > 0 {
+		for iNdEx := len(m.Toggles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Toggles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.SwitchExpiry != nil {
+		{
+			size, err := m.SwitchExpiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintGenesis(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +245,28 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if m.SwitchExpiry != nil {
+		l = m.SwitchExpiry.Size()
+		n += 1 + l + sovGenesis(uint64(l))
+	}
+	if len(m.Toggles) > 0 {
+		for _, e := range m.Toggles {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.Flows) > 0 {
+		for _, e := range m.Flows {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingSends) > 0 {
+		for _, e := range m.PendingSends {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -217,6 +338,144 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwitchExpiry == nil {
+				m.SwitchExpiry = &SwitchExpiry{}
+			}
+			if err := m.SwitchExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Toggles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Toggles = append(m.Toggles, Toggle{})
+			if err := m.Toggles[len(m.Toggles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flows", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Flows = append(m.Flows, Flow{})
+			if err := m.Flows[len(m.Flows)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingSends", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingSends = append(m.PendingSends, PendingSend{})
+			if err := m.PendingSends[len(m.PendingSends)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
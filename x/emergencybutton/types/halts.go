@@ -0,0 +1,112 @@
+package types
+
+import (
+	"fmt"
+
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate checks that the halt names a known direction and at least one of the port and the channel.
+func (h ChannelHalt) Validate() error {
+	if h.Direction == PacketDirection_PACKET_DIRECTION_UNSPECIFIED {
+		return fmt.Errorf("channel halt direction must be set")
+	}
+	if _, ok := PacketDirection_name[int32(h.Direction)]; !ok {
+		return fmt.Errorf("unknown channel halt direction %d", h.Direction)
+	}
+	if h.PortId == "" && h.ChannelId == "" {
+		return fmt.Errorf("channel halt must set a port or a channel, use the switch to halt all of them")
+	}
+	if h.PortId != "" {
+		if err := host.PortIdentifierValidator(h.PortId); err != nil {
+			return err
+		}
+	}
+	if h.ChannelId != "" {
+		if err := host.ChannelIdentifierValidator(h.ChannelId); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// Matches returns whether the halt applies to the packets of the given port, channel and direction.
+func (h ChannelHalt) Matches(portID, channelID string, direction PacketDirection) bool {
+	return h.Direction == direction &&
+		(h.PortId == "" || h.PortId == portID) &&
+		(h.ChannelId == "" || h.ChannelId == channelID)
+}
+
+// IsChannelHalted returns whether a channel halt applies to the packets of the given port, channel and direction.
+func (p Params) IsChannelHalted(portID, channelID string, direction PacketDirection) bool {
+	for _, halt := range p.ChannelHalts {
+		if halt.Matches(portID, channelID, direction) {
+			return true
+		}
+	}
+	return false
+}
+
+// SetChannelHalt adds the halt to the channel halts, or removes it when halted is false.
+func (p *Params) SetChannelHalt(halt ChannelHalt, halted bool) {
+	halts := make([]ChannelHalt, 0, len(p.ChannelHalts)+1)
+	for _, h := range p.ChannelHalts {
+		if h != halt {
+			halts = append(halts, h)
+		}
+	}
+	if halted {
+		halts = append(halts, halt)
+	}
+	p.ChannelHalts = halts
+}
+
+// IsModuleHalted returns whether the contract executions of the module are halted.
+func (p Params) IsModuleHalted(module HaltedModule) bool {
+	switch module {
+	case HaltedModule_HALTED_MODULE_IBC_HOOKS:
+		return p.IbcHooksHalted
+	case HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION:
+		return p.ContractExecutionHalted
+	default:
+		return false
+	}
+}
+
+// SetModuleHalt halts or resumes the contract executions of the module.
+func (p *Params) SetModuleHalt(module HaltedModule, halted bool) error {
+	switch module {
+	case HaltedModule_HALTED_MODULE_IBC_HOOKS:
+		p.IbcHooksHalted = halted
+	case HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION:
+		p.ContractExecutionHalted = halted
+	default:
+		return fmt.Errorf("unknown halted module %d", module)
+	}
+	return nil
+}
+
+// HaltedModules lists the modules whose contract executions are halted.
+func (p Params) HaltedModules() []HaltedModule {
+	var modules []HaltedModule
+	for _, module := range []HaltedModule{HaltedModule_HALTED_MODULE_IBC_HOOKS, HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION} {
+		if p.IsModuleHalted(module) {
+			modules = append(modules, module)
+		}
+	}
+	return modules
+}
+
+func validateChannelHalts(halts []ChannelHalt) error {
+	seen := make(map[ChannelHalt]struct{}, len(halts))
+	for _, halt := range halts {
+		if err := halt.Validate(); err != nil {
+			return err
+		}
+		if _, ok := seen[halt]; ok {
+			return fmt.Errorf("duplicate channel halt %s", halt.String())
+		}
+		seen[halt] = struct{}{}
+	}
+	return nil
+}
@@ -0,0 +1,82 @@
+package types
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestChannelHaltMatches(t *testing.T) {
+	send := PacketDirection_PACKET_DIRECTION_SEND
+	ack := PacketDirection_PACKET_DIRECTION_ACK
+
+	specs := map[string]struct {
+		halt      ChannelHalt
+		portID    string
+		channelID string
+		direction PacketDirection
+		exp       bool
+	}{
+		"same channel and direction": {
+			halt:      ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send},
+			portID:    "transfer",
+			channelID: "channel-0",
+			direction: send,
+			exp:       true,
+		},
+		"other direction": {
+			halt:      ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send},
+			portID:    "transfer",
+			channelID: "channel-0",
+			direction: ack,
+		},
+		"other channel": {
+			halt:      ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send},
+			portID:    "transfer",
+			channelID: "channel-1",
+			direction: send,
+		},
+		"other port": {
+			halt:      ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send},
+			portID:    "icahost",
+			channelID: "channel-0",
+			direction: send,
+		},
+		"any channel of the port": {
+			halt:      ChannelHalt{PortId: "transfer", Direction: send},
+			portID:    "transfer",
+			channelID: "channel-7",
+			direction: send,
+			exp:       true,
+		},
+		"any port of the channel": {
+			halt:      ChannelHalt{ChannelId: "channel-0", Direction: send},
+			portID:    "icahost",
+			channelID: "channel-0",
+			direction: send,
+			exp:       true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			require.Equal(t, spec.exp, spec.halt.Matches(spec.portID, spec.channelID, spec.direction))
+		})
+	}
+}
+
+func TestChannelHaltValidate(t *testing.T) {
+	send := PacketDirection_PACKET_DIRECTION_SEND
+
+	require.NoError(t, ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send}.Validate())
+	require.NoError(t, ChannelHalt{ChannelId: "channel-0", Direction: send}.Validate())
+	require.Error(t, ChannelHalt{PortId: "transfer", ChannelId: "channel-0"}.Validate())
+	require.Error(t, ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: PacketDirection(42)}.Validate())
+	require.Error(t, ChannelHalt{Direction: send}.Validate())
+	require.Error(t, ChannelHalt{ChannelId: "channel 0", Direction: send}.Validate())
+
+	// duplicate halts are rejected in the params
+	halt := ChannelHalt{PortId: "transfer", ChannelId: "channel-0", Direction: send}
+	params := DefaultParams()
+	params.ChannelHalts = []ChannelHalt{halt, halt}
+	require.Error(t, params.Validate())
+}
@@ -1,5 +1,7 @@
 package types
 
+import sdk "github.com/cosmos/cosmos-sdk/types"
+
 const (
 	ModuleName   = "emergencybutton"
 	StoreKey     = "emergencybutton"
@@ -12,11 +14,39 @@ const (
 var (
 	RouterKey = QuerierRoute
 	ParamsKey = []byte{0x01}
+	// SwitchExpiryKey holds the expiry of the switch while it's off until it expires
+	SwitchExpiryKey = []byte{0x02}
+	// ToggleKey holds the last MaxToggles changes by id
+	ToggleKey = []byte{0x03}
+	// NextToggleIDKey holds the id of the next change
+	NextToggleIDKey = []byte{0x04}
+	// FlowKey holds the flows of the rate limits by channel and denom
+	FlowKey = []byte{0x05}
+	// PendingSendKey holds the rate limited transfers waiting for their acknowledgement by channel and sequence
+	PendingSendKey = []byte{0x06}
 )
 
+// MaxToggles is the number of changes kept in the toggle history
+const MaxToggles = 100
+
+// GetToggleKey returns the key of a change in the toggle history
+func GetToggleKey(id uint64) []byte {
+	return sdk.Uint64ToBigEndian(id)
+}
+
 const (
 	// IbcSwitchStatusOff - IBC messages halted
 	IbcSwitchStatusOff string = "off"
 	// IbcSwitchStatusOn - IBC messages enabled
 	IbcSwitchStatusOn string = "on"
 )
+
+// GetFlowKey returns the key of the flow of a denom over a channel
+func GetFlowKey(channelID, denom string) []byte {
+	return []byte(channelID + "/" + denom)
+}
+
+// GetPendingSendKey returns the key of a transfer sent over a channel
+func GetPendingSendKey(channelID string, sequence uint64) []byte {
+	return append([]byte(channelID+"/"), sdk.Uint64ToBigEndian(sequence)...)
+}
@@ -6,11 +6,27 @@ import (
 
 const (
 	TypeMsgToggleIbcSwitch = "toggle_switch"
+	TypeMsgSetChannelHalt  = "set_channel_halt"
+	TypeMsgSetModuleHalt   = "set_module_halt"
 )
 
-var _ sdk.Msg = &MsgToggleIbcSwitch{}
+var (
+	_ sdk.Msg = &MsgToggleIbcSwitch{}
+	_ sdk.Msg = &MsgSetChannelHalt{}
+	_ sdk.Msg = &MsgSetModuleHalt{}
+)
 
 // NewMsgToggleIbcSwitch creates a message to toggle switch
 func NewMsgToggleIbcSwitch(sender sdk.AccAddress) *MsgToggleIbcSwitch {
-	return &MsgToggleIbcSwitch{sender.String()}
+	return &MsgToggleIbcSwitch{Sender: sender.String()}
+}
+
+// NewMsgSetChannelHalt creates a message to halt or resume a channel
+func NewMsgSetChannelHalt(sender sdk.AccAddress, halt ChannelHalt, halted bool) *MsgSetChannelHalt {
+	return &MsgSetChannelHalt{Sender: sender.String(), Halt: halt, Halted: halted}
+}
+
+// NewMsgSetModuleHalt creates a message to halt or resume the contract executions of a module
+func NewMsgSetModuleHalt(sender sdk.AccAddress, module HaltedModule, halted bool) *MsgSetModuleHalt {
+	return &MsgSetModuleHalt{Sender: sender.String(), Module: module, Halted: halted}
 }
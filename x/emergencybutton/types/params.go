@@ -20,7 +20,67 @@ func DefaultParams() Params {
 
 // validate params.
 func (p Params) Validate() error {
-	return validatePauserAddress(p.PauserAddress)
+	if err := validatePauserAddress(p.PauserAddress); err != nil {
+		return err
+	}
+	if err := validatePausers(p.Pausers); err != nil {
+		return err
+	}
+	if err := validateRateLimits(p.RateLimits); err != nil {
+		return err
+	}
+	return validateChannelHalts(p.ChannelHalts)
+}
+
+// HasPausers returns whether any address is allowed to pause or unpause.
+func (p Params) HasPausers() bool {
+	return p.PauserAddress != "" || len(p.Pausers) > 0
+}
+
+// CanPause returns whether the address is allowed to halt the switch, channels and modules.
+func (p Params) CanPause(address string) bool {
+	if address == p.PauserAddress && address != "" {
+		return true
+	}
+	for _, pauser := range p.Pausers {
+		if pauser.Address == address && pauser.CanPause {
+			return true
+		}
+	}
+	return false
+}
+
+// CanUnpause returns whether the address is allowed to resume the switch, channels and modules.
+func (p Params) CanUnpause(address string) bool {
+	if address == p.PauserAddress && address != "" {
+		return true
+	}
+	for _, pauser := range p.Pausers {
+		if pauser.Address == address && pauser.CanUnpause {
+			return true
+		}
+	}
+	return false
+}
+
+func validatePausers(pausers []Pauser) error {
+	seen := make(map[string]struct{}, len(pausers))
+	for _, pauser := range pausers {
+		if err := validatePauserAddress(pauser.Address); err != nil {
+			return err
+		}
+		if pauser.Address == "" {
+			return fmt.Errorf("pauser address must be set")
+		}
+		if !pauser.CanPause && !pauser.CanUnpause {
+			return fmt.Errorf("pauser %s can neither pause nor unpause", pauser.Address)
+		}
+		if _, ok := seen[pauser.Address]; ok {
+			return fmt.Errorf("duplicate pauser %s", pauser.Address)
+		}
+		seen[pauser.Address] = struct{}{}
+	}
+	return nil
 }
 
 func validatePauserAddress(i interface{}) error {
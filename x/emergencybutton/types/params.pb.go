@@ -23,17 +23,224 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
-// Params defines the parameters for the ibc-rate-limit module.
+// PacketDirection defines which packets of a channel a halt applies to.
+type PacketDirection int32
+
+const (
+	PacketDirection_PACKET_DIRECTION_UNSPECIFIED PacketDirection = 0
+	// Packets sent on the channel.
+	PacketDirection_PACKET_DIRECTION_SEND PacketDirection = 1
+	// Packets received on the channel.
+	PacketDirection_PACKET_DIRECTION_RECEIVE PacketDirection = 2
+	// Acknowledgements, both the ones written for received packets and the ones
+	// received for sent packets.
+	PacketDirection_PACKET_DIRECTION_ACK PacketDirection = 3
+)
+
+var PacketDirection_name = map[int32]string{
+	0: "PACKET_DIRECTION_UNSPECIFIED",
+	1: "PACKET_DIRECTION_SEND",
+	2: "PACKET_DIRECTION_RECEIVE",
+	3: "PACKET_DIRECTION_ACK",
+}
+
+var PacketDirection_value = map[string]int32{
+	"PACKET_DIRECTION_UNSPECIFIED": 0,
+	"PACKET_DIRECTION_SEND":        1,
+	"PACKET_DIRECTION_RECEIVE":     2,
+	"PACKET_DIRECTION_ACK":         3,
+}
+
+func (x PacketDirection) String() string {
+	return proto.EnumName(PacketDirection_name, int32(x))
+}
+
+func (PacketDirection) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_18ff8981535da1cc, []int{0}
+}
+
+// HaltedModule defines the contract executions that can be halted.
+type HaltedModule int32
+
+const (
+	HaltedModule_HALTED_MODULE_UNSPECIFIED HaltedModule = 0
+	// Contract executions and callbacks triggered by ibc-hooks.
+	HaltedModule_HALTED_MODULE_IBC_HOOKS HaltedModule = 1
+	// All contract executions in x/compute.
+	HaltedModule_HALTED_MODULE_CONTRACT_EXECUTION HaltedModule = 2
+)
+
+var HaltedModule_name = map[int32]string{
+	0: "HALTED_MODULE_UNSPECIFIED",
+	1: "HALTED_MODULE_IBC_HOOKS",
+	2: "HALTED_MODULE_CONTRACT_EXECUTION",
+}
+
+var HaltedModule_value = map[string]int32{
+	"HALTED_MODULE_UNSPECIFIED":        0,
+	"HALTED_MODULE_IBC_HOOKS":          1,
+	"HALTED_MODULE_CONTRACT_EXECUTION": 2,
+}
+
+func (x HaltedModule) String() string {
+	return proto.EnumName(HaltedModule_name, int32(x))
+}
+
+func (HaltedModule) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_18ff8981535da1cc, []int{1}
+}
+
+// ChannelHalt halts the packets of a channel in one direction.
+type ChannelHalt struct {
+	// The port of the channel on this chain. Empty halts the channel on all
+	// ports.
+	PortId string `protobuf:"bytes,1,opt,name=port_id,json=portId,proto3" json:"port_id,omitempty"`
+	// The channel on this chain. Empty halts all channels of the port.
+	ChannelId string          `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Direction PacketDirection `protobuf:"varint,3,opt,name=direction,proto3,enum=secret.emergencybutton.v1beta1.PacketDirection" json:"direction,omitempty"`
+}
+
+func (m *ChannelHalt) Reset()         { *m = ChannelHalt{} }
+func (m *ChannelHalt) String() string { return proto.CompactTextString(m) }
+func (*ChannelHalt) ProtoMessage()    {}
+func (*ChannelHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18ff8981535da1cc, []int{0}
+}
+func (m *ChannelHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ChannelHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ChannelHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ChannelHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ChannelHalt.Merge(m, src)
+}
+func (m *ChannelHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *ChannelHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_ChannelHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ChannelHalt proto.InternalMessageInfo
+
+func (m *ChannelHalt) GetPortId() string {
+	if m != nil {
+		return m.PortId
+	}
+	return ""
+}
+
+func (m *ChannelHalt) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *ChannelHalt) GetDirection() PacketDirection {
+	if m != nil {
+		return m.Direction
+	}
+	return PacketDirection_PACKET_DIRECTION_UNSPECIFIED
+}
+
+// Pauser is an address allowed to halt or resume IBC packets and contract
+// executions.
+type Pauser struct {
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// can_pause allows halting the switch, channels and modules.
+	CanPause bool `protobuf:"varint,2,opt,name=can_pause,json=canPause,proto3" json:"can_pause,omitempty"`
+	// can_unpause allows resuming the switch, channels and modules.
+	CanUnpause bool `protobuf:"varint,3,opt,name=can_unpause,json=canUnpause,proto3" json:"can_unpause,omitempty"`
+}
+
+func (m *Pauser) Reset()         { *m = Pauser{} }
+func (m *Pauser) String() string { return proto.CompactTextString(m) }
+func (*Pauser) ProtoMessage()    {}
+func (*Pauser) Descriptor() ([]byte, []int) {
+	return fileDescriptor_18ff8981535da1cc, []int{1}
+}
+func (m *Pauser) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Pauser) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Pauser.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Pauser) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Pauser.Merge(m, src)
+}
+func (m *Pauser) XXX_Size() int {
+	return m.Size()
+}
+func (m *Pauser) XXX_DiscardUnknown() {
+	xxx_messageInfo_Pauser.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Pauser proto.InternalMessageInfo
+
+func (m *Pauser) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Pauser) GetCanPause() bool {
+	if m != nil {
+		return m.CanPause
+	}
+	return false
+}
+
+func (m *Pauser) GetCanUnpause() bool {
+	if m != nil {
+		return m.CanUnpause
+	}
+	return false
+}
+
+// Params defines the parameters for the emergencybutton module.
 type Params struct {
 	SwitchStatus  string `protobuf:"bytes,1,opt,name=switch_status,json=switchStatus,proto3" json:"switch_status,omitempty"`
 	PauserAddress string `protobuf:"bytes,2,opt,name=pauser_address,json=pauserAddress,proto3" json:"pauser_address,omitempty"`
+	// channel_halts halts individual channels, on top of the switch that halts
+	// all of them.
+	ChannelHalts []ChannelHalt `protobuf:"bytes,3,rep,name=channel_halts,json=channelHalts,proto3" json:"channel_halts,omitempty"`
+	// ibc_hooks_halted halts contract executions triggered by ibc-hooks.
+	IbcHooksHalted bool `protobuf:"varint,4,opt,name=ibc_hooks_halted,json=ibcHooksHalted,proto3" json:"ibc_hooks_halted,omitempty"`
+	// contract_execution_halted halts the execution of all contracts.
+	ContractExecutionHalted bool `protobuf:"varint,5,opt,name=contract_execution_halted,json=contractExecutionHalted,proto3" json:"contract_execution_halted,omitempty"`
+	// pausers can pause and/or unpause, on top of pauser_address which can do
+	// both.
+	Pausers []Pauser `protobuf:"bytes,6,rep,name=pausers,proto3" json:"pausers,omitempty"`
+	// rate_limits cap the ICS-20 transfers of denoms over channels.
+	RateLimits []RateLimit `protobuf:"bytes,7,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
 func (m *Params) String() string { return proto.CompactTextString(m) }
 func (*Params) ProtoMessage()    {}
 func (*Params) Descriptor() ([]byte, []int) {
-	return fileDescriptor_18ff8981535da1cc, []int{0}
+	return fileDescriptor_18ff8981535da1cc, []int{2}
 }
 func (m *Params) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -76,7 +283,46 @@ func (m *Params) GetPauserAddress() string {
 	return ""
 }
 
+func (m *Params) GetChannelHalts() []ChannelHalt {
+	if m != nil {
+		return m.ChannelHalts
+	}
+	return nil
+}
+
+func (m *Params) GetIbcHooksHalted() bool {
+	if m != nil {
+		return m.IbcHooksHalted
+	}
+	return false
+}
+
+func (m *Params) GetContractExecutionHalted() bool {
+	if m != nil {
+		return m.ContractExecutionHalted
+	}
+	return false
+}
+
+func (m *Params) GetPausers() []Pauser {
+	if m != nil {
+		return m.Pausers
+	}
+	return nil
+}
+
+func (m *Params) GetRateLimits() []RateLimit {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
+	proto.RegisterEnum("secret.emergencybutton.v1beta1.PacketDirection", PacketDirection_name, PacketDirection_value)
+	proto.RegisterEnum("secret.emergencybutton.v1beta1.HaltedModule", HaltedModule_name, HaltedModule_value)
+	proto.RegisterType((*ChannelHalt)(nil), "secret.emergencybutton.v1beta1.ChannelHalt")
+	proto.RegisterType((*Pauser)(nil), "secret.emergencybutton.v1beta1.Pauser")
 	proto.RegisterType((*Params)(nil), "secret.emergencybutton.v1beta1.Params")
 }
 
@@ -85,24 +331,146 @@ func init() {
 }
 
 var fileDescriptor_18ff8981535da1cc = []byte{
-	// 259 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x64, 0x90, 0xb1, 0x4a, 0x03, 0x41,
-	0x10, 0x86, 0xb3, 0x16, 0x01, 0x0f, 0x63, 0x71, 0x08, 0x1e, 0x2a, 0x1b, 0xb1, 0x12, 0x94, 0x5b,
-	0x82, 0x95, 0x9d, 0xc6, 0x5e, 0xc4, 0xeb, 0x6c, 0xc2, 0xee, 0x66, 0xb8, 0x1c, 0xba, 0xd9, 0x65,
-	0x67, 0xce, 0x78, 0x4f, 0xa1, 0x8f, 0x65, 0x99, 0xd2, 0x2a, 0xc8, 0x5d, 0x97, 0xa7, 0x10, 0x77,
-	0x2d, 0x8c, 0x76, 0xc3, 0x7c, 0xdf, 0xfc, 0xf0, 0x4f, 0x72, 0x86, 0xa0, 0x3d, 0x90, 0x00, 0x03,
-	0xbe, 0x84, 0xb9, 0x6e, 0x54, 0x4d, 0x64, 0xe7, 0xe2, 0x79, 0xa4, 0x80, 0xe4, 0x48, 0x38, 0xe9,
-	0xa5, 0xc1, 0xdc, 0x79, 0x4b, 0x36, 0xe5, 0x51, 0xce, 0xff, 0xc8, 0xf9, 0x8f, 0x7c, 0xb0, 0x57,
-	0xda, 0xd2, 0x06, 0x55, 0x7c, 0x4f, 0xf1, 0xea, 0xe4, 0x95, 0x25, 0xfd, 0xbb, 0x10, 0x93, 0x5e,
-	0x25, 0x03, 0x5c, 0x54, 0xa4, 0x67, 0x13, 0x24, 0x49, 0x35, 0x66, 0xec, 0x98, 0x9d, 0x6e, 0x8f,
-	0x0f, 0xd7, 0xab, 0xe1, 0xfe, 0x06, 0x38, 0xb7, 0xa6, 0x22, 0x30, 0x8e, 0x9a, 0xfb, 0x9d, 0x08,
-	0x8a, 0xb0, 0x4f, 0x6f, 0x92, 0x5d, 0x27, 0x6b, 0x04, 0x3f, 0x91, 0xd3, 0xa9, 0x07, 0xc4, 0x6c,
-	0x2b, 0x44, 0x1c, 0xad, 0x57, 0xc3, 0x6c, 0x93, 0xfc, 0xca, 0x18, 0x44, 0x72, 0x1d, 0xc1, 0xb8,
-	0x78, 0x6f, 0x39, 0x5b, 0xb6, 0x9c, 0x7d, 0xb6, 0x9c, 0xbd, 0x75, 0xbc, 0xb7, 0xec, 0x78, 0xef,
-	0xa3, 0xe3, 0xbd, 0x87, 0xcb, 0xb2, 0xa2, 0x59, 0xad, 0x72, 0x6d, 0x8d, 0x40, 0xed, 0xe9, 0x49,
-	0x2a, 0x14, 0x45, 0x68, 0x7d, 0x0b, 0xb4, 0xb0, 0xfe, 0x51, 0xbc, 0xfc, 0xfb, 0x15, 0x35, 0x0e,
-	0x50, 0xf5, 0x43, 0xdb, 0x8b, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0xbc, 0x43, 0x55, 0x4f, 0x52,
-	0x01, 0x00, 0x00,
+	// 746 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x54, 0x4d, 0x6f, 0xe3, 0x44,
+	0x18, 0x8e, 0x9b, 0x25, 0xd9, 0x4c, 0xb6, 0x25, 0x3b, 0x34, 0x8a, 0xd3, 0x6d, 0xed, 0xa8, 0x20,
+	0x28, 0x2d, 0xb2, 0xd5, 0x82, 0x84, 0xe0, 0x44, 0xec, 0x18, 0xc5, 0x6a, 0x9b, 0x44, 0xf9, 0x40,
+	0x08, 0x0e, 0xd6, 0x64, 0x3c, 0x4a, 0xac, 0x26, 0x76, 0xf0, 0x4c, 0x68, 0xfb, 0x07, 0x38, 0x73,
+	0xe0, 0x2f, 0x21, 0xf5, 0xd8, 0x23, 0x27, 0x0b, 0xb5, 0x37, 0xff, 0x03, 0x6e, 0xc8, 0x63, 0x9b,
+	0x3a, 0x89, 0xd8, 0xdc, 0xec, 0xf7, 0xf9, 0x78, 0xdf, 0x79, 0xe6, 0x03, 0x9c, 0x51, 0x82, 0x7d,
+	0xc2, 0x54, 0x32, 0x27, 0xfe, 0x84, 0xb8, 0xf8, 0x7e, 0xbc, 0x64, 0xcc, 0x73, 0xd5, 0x5f, 0xcf,
+	0xc7, 0x84, 0xa1, 0x73, 0x75, 0x81, 0x7c, 0x34, 0xa7, 0xca, 0xc2, 0xf7, 0x98, 0x07, 0xa5, 0x98,
+	0xac, 0xac, 0x91, 0x95, 0x84, 0x7c, 0xb0, 0x3f, 0xf1, 0x26, 0x1e, 0xa7, 0xaa, 0xd1, 0x57, 0xac,
+	0x3a, 0x50, 0xb6, 0xb4, 0xf0, 0x11, 0x23, 0x33, 0x67, 0xee, 0xb0, 0x98, 0x7f, 0xfc, 0xa7, 0x00,
+	0xca, 0xfa, 0x14, 0xb9, 0x2e, 0x99, 0xb5, 0xd1, 0x8c, 0x41, 0x05, 0x14, 0x17, 0x9e, 0xcf, 0x2c,
+	0xc7, 0x16, 0x85, 0x86, 0x70, 0x52, 0xd2, 0xaa, 0x61, 0x20, 0xbf, 0x4d, 0x4a, 0x5f, 0x78, 0x73,
+	0x87, 0x91, 0xf9, 0x82, 0xdd, 0xf7, 0x0b, 0x51, 0xc9, 0xb4, 0xe1, 0xd7, 0x00, 0xe0, 0x58, 0x1e,
+	0x49, 0x76, 0xb8, 0x44, 0x0c, 0x03, 0x79, 0xff, 0xa5, 0x9a, 0x51, 0x95, 0x92, 0xaa, 0x69, 0xc3,
+	0x6b, 0x50, 0xb2, 0x1d, 0x9f, 0x60, 0xe6, 0x78, 0xae, 0x98, 0x6f, 0x08, 0x27, 0x7b, 0x17, 0xaa,
+	0xf2, 0xfe, 0x25, 0x2b, 0x3d, 0x84, 0x6f, 0x08, 0x6b, 0xa5, 0xb2, 0xfe, 0x8b, 0xc3, 0xf1, 0x1f,
+	0x02, 0x28, 0xf4, 0xd0, 0x92, 0x12, 0x1f, 0x8a, 0xa0, 0x88, 0x6c, 0xdb, 0x27, 0x94, 0xc6, 0x4b,
+	0xe8, 0xa7, 0xbf, 0xf0, 0x2b, 0x50, 0xc2, 0xc8, 0xb5, 0x16, 0x11, 0x8f, 0xcf, 0xfa, 0x5a, 0xab,
+	0x85, 0x81, 0xfc, 0xd1, 0x7f, 0xc5, 0xcc, 0xa8, 0xaf, 0x31, 0x72, 0xb9, 0x21, 0xfc, 0x16, 0x94,
+	0x23, 0xc2, 0xd2, 0x8d, 0x75, 0x79, 0xae, 0xab, 0x87, 0x81, 0x5c, 0xcd, 0x94, 0x33, 0x4a, 0x80,
+	0x91, 0x3b, 0x8a, 0xab, 0xc7, 0xff, 0xbc, 0x8a, 0xc6, 0x8a, 0x76, 0x15, 0x7e, 0x07, 0x76, 0xe9,
+	0xad, 0xc3, 0xf0, 0xd4, 0xa2, 0x0c, 0xb1, 0x65, 0x32, 0x9c, 0xf6, 0x2e, 0x0c, 0xe4, 0xda, 0x0a,
+	0x90, 0xb1, 0x7a, 0x13, 0x03, 0x03, 0x5e, 0x87, 0x3a, 0xd8, 0xe3, 0xae, 0xbe, 0x95, 0xae, 0x2f,
+	0xce, 0xfb, 0x30, 0x0c, 0x64, 0x71, 0x15, 0xc9, 0x78, 0xec, 0xc6, 0x48, 0x33, 0xc9, 0xe0, 0x17,
+	0xb0, 0x9b, 0x6e, 0xcd, 0x14, 0xcd, 0x18, 0x15, 0xf3, 0x8d, 0xfc, 0x49, 0xf9, 0xe2, 0x6c, 0x5b,
+	0xf6, 0x99, 0x43, 0xa2, 0xc9, 0x0f, 0x81, 0x9c, 0x8b, 0xe6, 0x5e, 0x71, 0xca, 0xce, 0x8d, 0x5f,
+	0xd8, 0x14, 0xb6, 0x41, 0xc5, 0x19, 0x63, 0x6b, 0xea, 0x79, 0x37, 0x94, 0x53, 0x89, 0x2d, 0xbe,
+	0xe2, 0x29, 0x4a, 0x61, 0x20, 0x1f, 0xac, 0x63, 0x19, 0x9f, 0x3d, 0x67, 0x8c, 0xdb, 0x11, 0xd4,
+	0xe6, 0x08, 0xc4, 0xa0, 0x8e, 0x3d, 0x97, 0xf9, 0x08, 0x33, 0x8b, 0xdc, 0x11, 0xbc, 0x8c, 0xf6,
+	0x3e, 0xb5, 0xfc, 0x80, 0x5b, 0x7e, 0x16, 0x06, 0xf2, 0xc7, 0xff, 0x4b, 0xca, 0x78, 0xd7, 0x52,
+	0x92, 0x91, 0x72, 0x92, 0x26, 0x3f, 0x83, 0x62, 0x1c, 0x19, 0x15, 0x0b, 0x3c, 0x9b, 0x4f, 0xb7,
+	0x9f, 0xcb, 0x88, 0xae, 0xd5, 0x93, 0x58, 0xde, 0x26, 0xf2, 0x4c, 0xb3, 0xd4, 0x11, 0x3a, 0xa0,
+	0x1c, 0x5d, 0x41, 0x8b, 0xdf, 0x41, 0x2a, 0x16, 0x79, 0x83, 0xcf, 0xb7, 0x35, 0xe8, 0x23, 0x46,
+	0xae, 0x22, 0x85, 0x76, 0x94, 0xf4, 0xa8, 0x66, 0x5c, 0xb2, 0x67, 0xcf, 0x4f, 0x99, 0xf4, 0xf4,
+	0x37, 0x01, 0x7c, 0xb8, 0x76, 0x63, 0x60, 0x03, 0x1c, 0xf6, 0x9a, 0xfa, 0xa5, 0x31, 0xb4, 0x5a,
+	0x66, 0xdf, 0xd0, 0x87, 0x66, 0xb7, 0x63, 0x8d, 0x3a, 0x83, 0x9e, 0xa1, 0x9b, 0xdf, 0x9b, 0x46,
+	0xab, 0x92, 0x83, 0x75, 0x50, 0xdd, 0x60, 0x0c, 0x8c, 0x4e, 0xab, 0x22, 0xc0, 0x43, 0x20, 0x6e,
+	0x40, 0x7d, 0x43, 0x37, 0xcc, 0x1f, 0x8c, 0xca, 0x0e, 0x14, 0xc1, 0xfe, 0x06, 0xda, 0xd4, 0x2f,
+	0x2b, 0xf9, 0xd3, 0x05, 0x78, 0x13, 0x47, 0x7b, 0xed, 0xd9, 0xcb, 0x19, 0x81, 0x47, 0xa0, 0xde,
+	0x6e, 0x5e, 0x0d, 0x8d, 0x96, 0x75, 0xdd, 0x6d, 0x8d, 0xae, 0x8c, 0xb5, 0x09, 0xde, 0x81, 0xda,
+	0x2a, 0x6c, 0x6a, 0xba, 0xd5, 0xee, 0x76, 0x2f, 0x07, 0x15, 0x01, 0x7e, 0x02, 0x1a, 0xab, 0xa0,
+	0xde, 0xed, 0x0c, 0xfb, 0x4d, 0x7d, 0x68, 0x19, 0x3f, 0x1a, 0xfa, 0x28, 0xea, 0x5a, 0xd9, 0xd1,
+	0x06, 0x0f, 0x4f, 0x92, 0xf0, 0xf8, 0x24, 0x09, 0x7f, 0x3f, 0x49, 0xc2, 0xef, 0xcf, 0x52, 0xee,
+	0xf1, 0x59, 0xca, 0xfd, 0xf5, 0x2c, 0xe5, 0x7e, 0xfa, 0x66, 0xe2, 0xb0, 0xe9, 0x72, 0xac, 0x60,
+	0x6f, 0xae, 0x52, 0xec, 0xb3, 0x19, 0x1a, 0x53, 0x75, 0xc0, 0xd3, 0xef, 0x10, 0x76, 0xeb, 0xf9,
+	0x37, 0xea, 0xdd, 0xc6, 0xe3, 0xc9, 0xee, 0x17, 0x84, 0x8e, 0x0b, 0xfc, 0xc5, 0xfc, 0xf2, 0xdf,
+	0x01, 0x00, 0x90, 0x24, 0x0c, 0xa3, 0xc6, 0x05, 0x00, 0x00,
+}
+
+func (m *ChannelHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ChannelHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ChannelHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Direction != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Direction))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PortId) > 0 {
+		i -= len(m.PortId)
+		copy(dAtA[i:], m.PortId)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.PortId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Pauser) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Pauser) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Pauser) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CanUnpause {
+		i--
+		if m.CanUnpause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.CanPause {
+		i--
+		if m.CanPause {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
 func (m *Params) Marshal() (dAtA []byte, err error) {
@@ -125,6 +493,68 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if len(m.Pausers) > 0 {
+		for iNdEx := len(m.Pausers) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Pausers[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.ContractExecutionHalted {
+		i--
+		if m.ContractExecutionHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.IbcHooksHalted {
+		i--
+		if m.IbcHooksHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.ChannelHalts) > 0 {
+		for iNdEx := len(m.ChannelHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
 	if len(m.PauserAddress) > 0 {
 		i -= len(m.PauserAddress)
 		copy(dAtA[i:], m.PauserAddress)
@@ -153,6 +583,45 @@ func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
 	dAtA[offset] = uint8(v)
 	return base
 }
+func (m *ChannelHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PortId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.Direction != 0 {
+		n += 1 + sovParams(uint64(m.Direction))
+	}
+	return n
+}
+
+func (m *Pauser) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.CanPause {
+		n += 2
+	}
+	if m.CanUnpause {
+		n += 2
+	}
+	return n
+}
+
 func (m *Params) Size() (n int) {
 	if m == nil {
 		return 0
@@ -167,6 +636,30 @@ func (m *Params) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovParams(uint64(l))
 	}
+	if len(m.ChannelHalts) > 0 {
+		for _, e := range m.ChannelHalts {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.IbcHooksHalted {
+		n += 2
+	}
+	if m.ContractExecutionHalted {
+		n += 2
+	}
+	if len(m.Pausers) > 0 {
+		for _, e := range m.Pausers {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -176,7 +669,7 @@ func sovParams(x uint64) (n int) {
 func sozParams(x uint64) (n int) {
 	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *ChannelHalt) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -199,15 +692,15 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: ChannelHalt: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: ChannelHalt: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwitchStatus", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field PortId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -235,11 +728,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.SwitchStatus = string(dAtA[iNdEx:postIndex])
+			m.PortId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field PauserAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -267,7 +760,404 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.PauserAddress = string(dAtA[iNdEx:postIndex])
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Direction", wireType)
+			}
+			m.Direction = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Direction |= PacketDirection(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Pauser) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Pauser: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Pauser: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanPause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanPause = bool(v != 0)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CanUnpause", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.CanUnpause = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SwitchStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauserAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PauserAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelHalts = append(m.ChannelHalts, ChannelHalt{})
+			if err := m.ChannelHalts[len(m.ChannelHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcHooksHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcHooksHalted = bool(v != 0)
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ContractExecutionHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.ContractExecutionHalted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pausers", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Pausers = append(m.Pausers, Pauser{})
+			if err := m.Pausers[len(m.Pausers)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RateLimits = append(m.RateLimits, RateLimit{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
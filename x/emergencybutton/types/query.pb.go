@@ -5,7 +5,10 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -112,9 +115,383 @@ func (m *ParamsResponse) GetParams() Params {
 	return Params{}
 }
 
+// HaltsRequest is the request type for the Query/Halts RPC method.
+type HaltsRequest struct {
+}
+
+func (m *HaltsRequest) Reset()         { *m = HaltsRequest{} }
+func (m *HaltsRequest) String() string { return proto.CompactTextString(m) }
+func (*HaltsRequest) ProtoMessage()    {}
+func (*HaltsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{2}
+}
+func (m *HaltsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HaltsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HaltsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltsRequest.Merge(m, src)
+}
+func (m *HaltsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *HaltsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltsRequest proto.InternalMessageInfo
+
+// HaltsResponse is the response type for the Query/Halts RPC method.
+type HaltsResponse struct {
+	// ibc_halted is true when the switch halts all IBC packets.
+	IbcHalted bool `protobuf:"varint,1,opt,name=ibc_halted,json=ibcHalted,proto3" json:"ibc_halted,omitempty"`
+	// channel_halts lists the halted channels.
+	ChannelHalts []ChannelHalt `protobuf:"bytes,2,rep,name=channel_halts,json=channelHalts,proto3" json:"channel_halts"`
+	// halted_modules lists the modules whose contract executions are halted.
+	HaltedModules []HaltedModule `protobuf:"varint,3,rep,packed,name=halted_modules,json=haltedModules,proto3,enum=secret.emergencybutton.v1beta1.HaltedModule" json:"halted_modules,omitempty"`
+	// switch_expiry is set when the switch is off until it expires.
+	SwitchExpiry *SwitchExpiry `protobuf:"bytes,4,opt,name=switch_expiry,json=switchExpiry,proto3" json:"switch_expiry,omitempty"`
+}
+
+func (m *HaltsResponse) Reset()         { *m = HaltsResponse{} }
+func (m *HaltsResponse) String() string { return proto.CompactTextString(m) }
+func (*HaltsResponse) ProtoMessage()    {}
+func (*HaltsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{3}
+}
+func (m *HaltsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *HaltsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_HaltsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *HaltsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HaltsResponse.Merge(m, src)
+}
+func (m *HaltsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *HaltsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_HaltsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HaltsResponse proto.InternalMessageInfo
+
+func (m *HaltsResponse) GetIbcHalted() bool {
+	if m != nil {
+		return m.IbcHalted
+	}
+	return false
+}
+
+func (m *HaltsResponse) GetChannelHalts() []ChannelHalt {
+	if m != nil {
+		return m.ChannelHalts
+	}
+	return nil
+}
+
+func (m *HaltsResponse) GetHaltedModules() []HaltedModule {
+	if m != nil {
+		return m.HaltedModules
+	}
+	return nil
+}
+
+func (m *HaltsResponse) GetSwitchExpiry() *SwitchExpiry {
+	if m != nil {
+		return m.SwitchExpiry
+	}
+	return nil
+}
+
+// TogglesRequest is the request type for the Query/Toggles RPC method.
+type TogglesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *TogglesRequest) Reset()         { *m = TogglesRequest{} }
+func (m *TogglesRequest) String() string { return proto.CompactTextString(m) }
+func (*TogglesRequest) ProtoMessage()    {}
+func (*TogglesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{4}
+}
+func (m *TogglesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TogglesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TogglesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TogglesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TogglesRequest.Merge(m, src)
+}
+func (m *TogglesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *TogglesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_TogglesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TogglesRequest proto.InternalMessageInfo
+
+func (m *TogglesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// TogglesResponse is the response type for the Query/Toggles RPC method.
+type TogglesResponse struct {
+	Toggles    []Toggle            `protobuf:"bytes,1,rep,name=toggles,proto3" json:"toggles"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *TogglesResponse) Reset()         { *m = TogglesResponse{} }
+func (m *TogglesResponse) String() string { return proto.CompactTextString(m) }
+func (*TogglesResponse) ProtoMessage()    {}
+func (*TogglesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{5}
+}
+func (m *TogglesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TogglesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TogglesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TogglesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TogglesResponse.Merge(m, src)
+}
+func (m *TogglesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *TogglesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_TogglesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TogglesResponse proto.InternalMessageInfo
+
+func (m *TogglesResponse) GetToggles() []Toggle {
+	if m != nil {
+		return m.Toggles
+	}
+	return nil
+}
+
+func (m *TogglesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// FlowsRequest is the request type for the Query/Flows RPC method.
+type FlowsRequest struct {
+	// channel_id filters the rate limits by channel, if set.
+	ChannelId string `protobuf:"bytes,1,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	// denom filters the rate limits by denom, if set.
+	Denom string `protobuf:"bytes,2,opt,name=denom,proto3" json:"denom,omitempty"`
+}
+
+func (m *FlowsRequest) Reset()         { *m = FlowsRequest{} }
+func (m *FlowsRequest) String() string { return proto.CompactTextString(m) }
+func (*FlowsRequest) ProtoMessage()    {}
+func (*FlowsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{6}
+}
+func (m *FlowsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowsRequest.Merge(m, src)
+}
+func (m *FlowsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowsRequest proto.InternalMessageInfo
+
+func (m *FlowsRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *FlowsRequest) GetDenom() string {
+	if m != nil {
+		return m.Denom
+	}
+	return ""
+}
+
+// RateLimitStatus is a rate limit with its flow in the rolling window.
+type RateLimitStatus struct {
+	RateLimit RateLimit `protobuf:"bytes,1,opt,name=rate_limit,json=rateLimit,proto3" json:"rate_limit"`
+	Flow      Flow      `protobuf:"bytes,2,opt,name=flow,proto3" json:"flow"`
+	// send_capacity is the amount that can still be sent in the window, unset
+	// when sends are not limited.
+	SendCapacity *cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=send_capacity,json=sendCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"send_capacity,omitempty"`
+	// recv_capacity is the amount that can still be received in the window,
+	// unset when receives are not limited.
+	RecvCapacity *cosmossdk_io_math.Int `protobuf:"bytes,4,opt,name=recv_capacity,json=recvCapacity,proto3,customtype=cosmossdk.io/math.Int" json:"recv_capacity,omitempty"`
+}
+
+func (m *RateLimitStatus) Reset()         { *m = RateLimitStatus{} }
+func (m *RateLimitStatus) String() string { return proto.CompactTextString(m) }
+func (*RateLimitStatus) ProtoMessage()    {}
+func (*RateLimitStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{7}
+}
+func (m *RateLimitStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RateLimitStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RateLimitStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RateLimitStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RateLimitStatus.Merge(m, src)
+}
+func (m *RateLimitStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *RateLimitStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_RateLimitStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RateLimitStatus proto.InternalMessageInfo
+
+func (m *RateLimitStatus) GetRateLimit() RateLimit {
+	if m != nil {
+		return m.RateLimit
+	}
+	return RateLimit{}
+}
+
+func (m *RateLimitStatus) GetFlow() Flow {
+	if m != nil {
+		return m.Flow
+	}
+	return Flow{}
+}
+
+// FlowsResponse is the response type for the Query/Flows RPC method.
+type FlowsResponse struct {
+	RateLimits []RateLimitStatus `protobuf:"bytes,1,rep,name=rate_limits,json=rateLimits,proto3" json:"rate_limits"`
+}
+
+func (m *FlowsResponse) Reset()         { *m = FlowsResponse{} }
+func (m *FlowsResponse) String() string { return proto.CompactTextString(m) }
+func (*FlowsResponse) ProtoMessage()    {}
+func (*FlowsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_bd1ea45b2674f0f9, []int{8}
+}
+func (m *FlowsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *FlowsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_FlowsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *FlowsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_FlowsResponse.Merge(m, src)
+}
+func (m *FlowsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *FlowsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_FlowsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_FlowsResponse proto.InternalMessageInfo
+
+func (m *FlowsResponse) GetRateLimits() []RateLimitStatus {
+	if m != nil {
+		return m.RateLimits
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*ParamsRequest)(nil), "secret.emergencybutton.v1beta1.ParamsRequest")
 	proto.RegisterType((*ParamsResponse)(nil), "secret.emergencybutton.v1beta1.ParamsResponse")
+	proto.RegisterType((*HaltsRequest)(nil), "secret.emergencybutton.v1beta1.HaltsRequest")
+	proto.RegisterType((*HaltsResponse)(nil), "secret.emergencybutton.v1beta1.HaltsResponse")
+	proto.RegisterType((*TogglesRequest)(nil), "secret.emergencybutton.v1beta1.TogglesRequest")
+	proto.RegisterType((*TogglesResponse)(nil), "secret.emergencybutton.v1beta1.TogglesResponse")
+	proto.RegisterType((*FlowsRequest)(nil), "secret.emergencybutton.v1beta1.FlowsRequest")
+	proto.RegisterType((*RateLimitStatus)(nil), "secret.emergencybutton.v1beta1.RateLimitStatus")
+	proto.RegisterType((*FlowsResponse)(nil), "secret.emergencybutton.v1beta1.FlowsResponse")
 }
 
 func init() {
@@ -122,26 +499,58 @@ func init() {
 }
 
 var fileDescriptor_bd1ea45b2674f0f9 = []byte{
-	// 298 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x90, 0x3f, 0x4b, 0x03, 0x31,
-	0x18, 0xc6, 0x2f, 0xa2, 0x1d, 0x22, 0x2a, 0x1c, 0x0e, 0x52, 0x24, 0xd5, 0x0e, 0x2a, 0x8a, 0x09,
-	0xad, 0x93, 0x6b, 0x71, 0x16, 0x6d, 0xc1, 0xc1, 0x2d, 0x77, 0xbc, 0xc4, 0x62, 0x9b, 0xf7, 0x9a,
-	0xbc, 0xa7, 0x76, 0xf5, 0x13, 0x14, 0xdc, 0xfc, 0x44, 0x1d, 0x0b, 0x2e, 0x4e, 0x22, 0xad, 0x1f,
-	0x44, 0x7a, 0x39, 0x17, 0x05, 0xdb, 0x2d, 0x7f, 0x7e, 0xcf, 0x9b, 0x5f, 0x1e, 0x7e, 0xec, 0x21,
-	0x75, 0x40, 0x0a, 0xfa, 0xe0, 0x0c, 0xd8, 0x74, 0x98, 0xe4, 0x44, 0x68, 0xd5, 0x43, 0x23, 0x01,
-	0xd2, 0x0d, 0x35, 0xc8, 0xc1, 0x0d, 0x65, 0xe6, 0x90, 0x30, 0x16, 0x81, 0x95, 0xbf, 0x58, 0x59,
-	0xb2, 0xd5, 0x6d, 0x83, 0x06, 0x0b, 0x54, 0xcd, 0x57, 0x21, 0x55, 0xdd, 0x35, 0x88, 0xa6, 0x07,
-	0x4a, 0x67, 0x5d, 0xa5, 0xad, 0x45, 0xd2, 0xd4, 0x45, 0xeb, 0xcb, 0xdb, 0x93, 0x05, 0xef, 0x67,
-	0xda, 0xe9, 0x7e, 0x09, 0xd7, 0xb7, 0xf8, 0xc6, 0x55, 0xb1, 0x6f, 0xc3, 0x20, 0x07, 0x4f, 0xf5,
-	0x1b, 0xbe, 0xf9, 0x73, 0xe0, 0x33, 0xb4, 0x1e, 0xe2, 0x0b, 0x5e, 0x09, 0x91, 0x1d, 0xb6, 0xc7,
-	0x8e, 0xd6, 0x9b, 0x07, 0xf2, 0x7f, 0x69, 0x19, 0xf2, 0xad, 0xd5, 0xf1, 0x47, 0x2d, 0x6a, 0x97,
-	0xd9, 0xe6, 0x2b, 0xe3, 0x6b, 0xd7, 0xf3, 0x9f, 0xc7, 0x23, 0xc6, 0x2b, 0x01, 0x89, 0x4f, 0x97,
-	0x1b, 0x55, 0xba, 0x55, 0xe5, 0xb2, 0x78, 0x30, 0xaf, 0x1f, 0x3e, 0xbf, 0x7d, 0xbd, 0xac, 0xec,
-	0xc7, 0xb5, 0x05, 0x5d, 0xb4, 0x3a, 0xe3, 0xa9, 0x60, 0x93, 0xa9, 0x60, 0x9f, 0x53, 0xc1, 0x46,
-	0x33, 0x11, 0x4d, 0x66, 0x22, 0x7a, 0x9f, 0x89, 0xe8, 0xf6, 0xdc, 0x74, 0xe9, 0x2e, 0x4f, 0x64,
-	0x8a, 0x7d, 0xe5, 0x53, 0x47, 0x3d, 0x9d, 0x78, 0xd5, 0x29, 0x2c, 0x2e, 0x81, 0x1e, 0xd1, 0xdd,
-	0xab, 0xa7, 0x3f, 0xd3, 0x69, 0x98, 0x81, 0x4f, 0x2a, 0x45, 0xc3, 0x67, 0xdf, 0x01, 0x00, 0x00,
-	0xff, 0xff, 0xf9, 0xa6, 0xbe, 0x3e, 0x10, 0x02, 0x00, 0x00,
+	// 806 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x55, 0x3f, 0x6f, 0xf3, 0x44,
+	0x1c, 0x8e, 0x93, 0xb4, 0x2f, 0xf9, 0x35, 0x49, 0xa5, 0xd3, 0x8b, 0x14, 0x22, 0x5e, 0x37, 0x58,
+	0xa8, 0x0d, 0x2d, 0xb1, 0xd5, 0x30, 0xb1, 0x30, 0xb4, 0x50, 0x5a, 0xa9, 0x54, 0xd4, 0x41, 0x15,
+	0x62, 0x89, 0xce, 0xce, 0xe1, 0x58, 0xb5, 0x7d, 0xae, 0xef, 0xd2, 0x34, 0x2b, 0x33, 0x43, 0x25,
+	0xf8, 0x04, 0x7c, 0x00, 0x26, 0x56, 0xf6, 0x6e, 0x54, 0xb0, 0x20, 0x86, 0x0a, 0xb5, 0x7c, 0x10,
+	0xe4, 0xbb, 0xb3, 0x93, 0x82, 0xc0, 0x7e, 0xb7, 0xdc, 0xf9, 0x79, 0x9e, 0x7b, 0x7e, 0x7f, 0x03,
+	0xbb, 0x8c, 0xb8, 0x09, 0xe1, 0x16, 0x09, 0x49, 0xe2, 0x91, 0xc8, 0x5d, 0x38, 0x33, 0xce, 0x69,
+	0x64, 0x5d, 0xef, 0x3b, 0x84, 0xe3, 0x7d, 0xeb, 0x6a, 0x46, 0x92, 0x85, 0x19, 0x27, 0x94, 0x53,
+	0xa4, 0x4b, 0xac, 0xf9, 0x0f, 0xac, 0xa9, 0xb0, 0xdd, 0x97, 0x1e, 0xf5, 0xa8, 0x80, 0x5a, 0xe9,
+	0x2f, 0xc9, 0xea, 0xbe, 0xe5, 0x52, 0x16, 0x52, 0x36, 0x96, 0x1f, 0xe4, 0x41, 0x7d, 0x7a, 0xdb,
+	0xa3, 0xd4, 0x0b, 0x88, 0x85, 0x63, 0xdf, 0xc2, 0x51, 0x44, 0x39, 0xe6, 0x3e, 0x8d, 0xb2, 0xaf,
+	0xbb, 0x12, 0x6b, 0x39, 0x98, 0x11, 0xe9, 0x23, 0x77, 0x15, 0x63, 0xcf, 0x8f, 0x04, 0x58, 0x61,
+	0xf7, 0x0a, 0xc2, 0x88, 0x71, 0x82, 0xc3, 0x4c, 0xd8, 0x2c, 0x00, 0x27, 0x98, 0x93, 0xc0, 0x0f,
+	0x7d, 0x5e, 0x52, 0x9c, 0x53, 0xcf, 0x0b, 0x88, 0x04, 0x1b, 0x9b, 0xd0, 0xfa, 0x5c, 0x3c, 0x66,
+	0x93, 0xab, 0x19, 0x61, 0xdc, 0xb8, 0x80, 0x76, 0x76, 0xc1, 0x62, 0x1a, 0x31, 0x82, 0x3e, 0x86,
+	0x75, 0xe9, 0xa7, 0xa3, 0xf5, 0xb4, 0xfe, 0xc6, 0x70, 0xdb, 0xfc, 0xff, 0xc4, 0x9a, 0x92, 0x7f,
+	0x50, 0xbf, 0x7b, 0xd8, 0xaa, 0xd8, 0x8a, 0x6b, 0xb4, 0xa1, 0x79, 0x8c, 0x03, 0x9e, 0xbf, 0xf3,
+	0x63, 0x15, 0x5a, 0xea, 0x42, 0xbd, 0xf3, 0x0a, 0xc0, 0x77, 0xdc, 0xf1, 0x14, 0x07, 0x9c, 0x4c,
+	0xc4, 0x5b, 0x6f, 0xd8, 0x0d, 0xdf, 0x71, 0x8f, 0xc5, 0x05, 0xba, 0x80, 0x96, 0x3b, 0xc5, 0x51,
+	0x44, 0x02, 0x01, 0x61, 0x9d, 0x6a, 0xaf, 0xd6, 0xdf, 0x18, 0xee, 0x15, 0xb9, 0x39, 0x94, 0xa4,
+	0x54, 0x45, 0x59, 0x6a, 0xba, 0xcb, 0x2b, 0x86, 0x46, 0xd0, 0x96, 0x4f, 0x8e, 0x43, 0x3a, 0x99,
+	0x05, 0x84, 0x75, 0x6a, 0xbd, 0x5a, 0xbf, 0x3d, 0x7c, 0xbf, 0x48, 0x58, 0xfa, 0xfa, 0x4c, 0x90,
+	0xec, 0xd6, 0x74, 0xe5, 0xc4, 0xd0, 0x39, 0xb4, 0xd8, 0xdc, 0xe7, 0xee, 0x74, 0x4c, 0x6e, 0x62,
+	0x3f, 0x59, 0x74, 0xea, 0x22, 0x75, 0x85, 0x9a, 0x23, 0x41, 0xfa, 0x44, 0x70, 0xec, 0x26, 0x5b,
+	0x39, 0x19, 0x5f, 0x42, 0xfb, 0x0b, 0x51, 0xb9, 0x2c, 0x85, 0xe8, 0x08, 0x60, 0xd9, 0x59, 0x79,
+	0x71, 0x54, 0xcb, 0xa6, 0x6d, 0x68, 0xca, 0x71, 0x58, 0xd6, 0xc5, 0x23, 0x8a, 0x6b, 0xaf, 0x30,
+	0x8d, 0x1f, 0x34, 0xd8, 0xcc, 0xa5, 0x55, 0x31, 0x8e, 0xe0, 0x85, 0xec, 0x93, 0xb4, 0xea, 0xb5,
+	0x32, 0x55, 0x97, 0x0a, 0x2a, 0xc5, 0x19, 0x19, 0x7d, 0xfa, 0xcc, 0x63, 0x55, 0x78, 0xdc, 0x29,
+	0xf4, 0x28, 0x4d, 0x3c, 0x33, 0x79, 0x08, 0xcd, 0xa3, 0x80, 0xce, 0xf3, 0xe0, 0x5f, 0x01, 0x64,
+	0xed, 0xe0, 0xcb, 0x6e, 0x69, 0xd8, 0x0d, 0x75, 0x73, 0x32, 0x41, 0x2f, 0x61, 0x6d, 0x42, 0x22,
+	0x1a, 0x8a, 0x27, 0x1b, 0xb6, 0x3c, 0x18, 0x3f, 0x57, 0x61, 0xd3, 0xc6, 0x9c, 0x9c, 0xa6, 0xe3,
+	0x32, 0xe2, 0x98, 0xcf, 0x18, 0x3a, 0x03, 0x48, 0x27, 0x68, 0x2c, 0x46, 0x48, 0x65, 0xf1, 0xbd,
+	0xa2, 0x60, 0x73, 0x11, 0x15, 0x6f, 0x23, 0xc9, 0x2e, 0xd0, 0x47, 0x50, 0xff, 0x3a, 0xa0, 0x73,
+	0x15, 0xeb, 0xbb, 0x45, 0x4a, 0x69, 0x50, 0x4a, 0x44, 0xf0, 0xd0, 0x29, 0xb4, 0x18, 0x89, 0x26,
+	0x63, 0x17, 0xc7, 0xd8, 0xf5, 0xf9, 0xa2, 0x53, 0x4b, 0x23, 0x38, 0xd8, 0xf9, 0xe3, 0x61, 0xeb,
+	0x4d, 0x99, 0x37, 0x36, 0xb9, 0x34, 0x7d, 0x6a, 0x85, 0x98, 0x4f, 0xcd, 0x93, 0x88, 0xff, 0xfa,
+	0xd3, 0x00, 0x54, 0x42, 0x4f, 0x22, 0x6e, 0x37, 0x53, 0xf6, 0xa1, 0x22, 0xa7, 0x6a, 0x09, 0x71,
+	0xaf, 0x97, 0x6a, 0xf5, 0xd7, 0x54, 0x4b, 0xd9, 0x99, 0x9a, 0xe1, 0x41, 0x4b, 0x15, 0x41, 0xb5,
+	0xc9, 0x05, 0x6c, 0x2c, 0x93, 0x97, 0xb5, 0x8a, 0x55, 0x3a, 0x7b, 0xb2, 0x04, 0x2a, 0x7c, 0xc8,
+	0x73, 0xc8, 0x86, 0xbf, 0xd4, 0x61, 0xed, 0x3c, 0x6d, 0x0c, 0x74, 0xab, 0xc1, 0xba, 0x5c, 0x28,
+	0x68, 0x50, 0x6e, 0xf1, 0xa8, 0x0e, 0xe9, 0x9a, 0x65, 0xe1, 0x32, 0x16, 0x63, 0xe7, 0x9b, 0xdf,
+	0xfe, 0xfa, 0xae, 0xfa, 0x0e, 0xda, 0x2a, 0x58, 0xcb, 0xe8, 0x5b, 0x0d, 0xd6, 0xe4, 0xee, 0x28,
+	0xb5, 0x23, 0x72, 0x43, 0x83, 0x92, 0x68, 0xe5, 0x67, 0x5b, 0xf8, 0xe9, 0x21, 0xfd, 0x3f, 0xfd,
+	0x88, 0x3d, 0x88, 0xbe, 0xd7, 0xe0, 0x85, 0x1a, 0x5f, 0x64, 0x96, 0x9b, 0xd2, 0xdc, 0x92, 0x55,
+	0x1a, 0xaf, 0x4c, 0xf5, 0x85, 0x29, 0x03, 0xf5, 0x0a, 0xfe, 0x5e, 0x64, 0x96, 0x44, 0xb3, 0x14,
+	0x67, 0x69, 0x75, 0xb0, 0xbb, 0x83, 0x92, 0xe8, 0xd2, 0x59, 0x4a, 0xa7, 0x8a, 0x1d, 0x8c, 0xee,
+	0x1e, 0x75, 0xed, 0xfe, 0x51, 0xd7, 0xfe, 0x7c, 0xd4, 0xb5, 0xdb, 0x27, 0xbd, 0x72, 0xff, 0xa4,
+	0x57, 0x7e, 0x7f, 0xd2, 0x2b, 0x5f, 0x7d, 0xe8, 0xf9, 0x7c, 0x3a, 0x73, 0x4c, 0x97, 0x86, 0x16,
+	0x73, 0x13, 0x1e, 0x60, 0x87, 0x59, 0x23, 0xe1, 0xe1, 0x8c, 0xf0, 0x39, 0x4d, 0x2e, 0xad, 0x9b,
+	0x7f, 0x89, 0xf3, 0x45, 0x4c, 0x98, 0xb3, 0x2e, 0xfe, 0x44, 0x3f, 0xf8, 0x7b, 0x00, 0xb9, 0xd6,
+	0x15, 0xe4, 0x97, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -159,6 +568,14 @@ type QueryClient interface {
 	// Params defines a gRPC query method that returns the emergencybutton
 	// module's parameters.
 	Params(ctx context.Context, in *ParamsRequest, opts ...grpc.CallOption) (*ParamsResponse, error)
+	// Halts returns everything that is currently halted.
+	Halts(ctx context.Context, in *HaltsRequest, opts ...grpc.CallOption) (*HaltsResponse, error)
+	// Toggles returns the most recent changes of the switch and the halts,
+	// oldest first.
+	Toggles(ctx context.Context, in *TogglesRequest, opts ...grpc.CallOption) (*TogglesResponse, error)
+	// Flows returns the current flows of the rate limits and their remaining
+	// capacity.
+	Flows(ctx context.Context, in *FlowsRequest, opts ...grpc.CallOption) (*FlowsResponse, error)
 }
 
 type queryClient struct {
@@ -178,11 +595,46 @@ func (c *queryClient) Params(ctx context.Context, in *ParamsRequest, opts ...grp
 	return out, nil
 }
 
+func (c *queryClient) Halts(ctx context.Context, in *HaltsRequest, opts ...grpc.CallOption) (*HaltsResponse, error) {
+	out := new(HaltsResponse)
+	err := c.cc.Invoke(ctx, "/secret.emergencybutton.v1beta1.Query/Halts", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Toggles(ctx context.Context, in *TogglesRequest, opts ...grpc.CallOption) (*TogglesResponse, error) {
+	out := new(TogglesResponse)
+	err := c.cc.Invoke(ctx, "/secret.emergencybutton.v1beta1.Query/Toggles", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Flows(ctx context.Context, in *FlowsRequest, opts ...grpc.CallOption) (*FlowsResponse, error) {
+	out := new(FlowsResponse)
+	err := c.cc.Invoke(ctx, "/secret.emergencybutton.v1beta1.Query/Flows", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // QueryServer is the server API for Query service.
 type QueryServer interface {
 	// Params defines a gRPC query method that returns the emergencybutton
 	// module's parameters.
 	Params(context.Context, *ParamsRequest) (*ParamsResponse, error)
+	// Halts returns everything that is currently halted.
+	Halts(context.Context, *HaltsRequest) (*HaltsResponse, error)
+	// Toggles returns the most recent changes of the switch and the halts,
+	// oldest first.
+	Toggles(context.Context, *TogglesRequest) (*TogglesResponse, error)
+	// Flows returns the current flows of the rate limits and their remaining
+	// capacity.
+	Flows(context.Context, *FlowsRequest) (*FlowsResponse, error)
 }
 
 // UnimplementedQueryServer can be embedded to have forward compatible implementations.
@@ -192,6 +644,15 @@ type UnimplementedQueryServer struct {
 func (*UnimplementedQueryServer) Params(ctx context.Context, req *ParamsRequest) (*ParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
 }
+func (*UnimplementedQueryServer) Halts(ctx context.Context, req *HaltsRequest) (*HaltsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Halts not implemented")
+}
+func (*UnimplementedQueryServer) Toggles(ctx context.Context, req *TogglesRequest) (*TogglesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Toggles not implemented")
+}
+func (*UnimplementedQueryServer) Flows(ctx context.Context, req *FlowsRequest) (*FlowsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Flows not implemented")
+}
 
 func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
 	s.RegisterService(&_Query_serviceDesc, srv)
@@ -215,32 +676,98 @@ func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interf
 	return interceptor(ctx, in, info, handler)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "secret.emergencybutton.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "secret/emergencybutton/v1beta1/query.proto",
-}
-
-func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
+func _Query_Halts_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(HaltsRequest)
+	if err := dec(in); err != nil {
 		return nil, err
 	}
-	return dAtA[:n], nil
-}
-
-func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+	if interceptor == nil {
+		return srv.(QueryServer).Halts(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.emergencybutton.v1beta1.Query/Halts",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Halts(ctx, req.(*HaltsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Toggles_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(TogglesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Toggles(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.emergencybutton.v1beta1.Query/Toggles",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Toggles(ctx, req.(*TogglesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Flows_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(FlowsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Flows(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.emergencybutton.v1beta1.Query/Flows",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Flows(ctx, req.(*FlowsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.emergencybutton.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Halts",
+			Handler:    _Query_Halts_Handler,
+		},
+		{
+			MethodName: "Toggles",
+			Handler:    _Query_Toggles_Handler,
+		},
+		{
+			MethodName: "Flows",
+			Handler:    _Query_Flows_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/emergencybutton/v1beta1/query.proto",
+}
+
+func (m *ParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
 func (m *ParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
@@ -279,49 +806,1209 @@ func (m *ParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 		i -= size
 		i = encodeVarintQuery(dAtA, i, uint64(size))
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
-}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *HaltsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *HaltsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *HaltsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *HaltsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SwitchExpiry != nil {
+		{
+			size, err := m.SwitchExpiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.HaltedModules) > 0 {
+		dAtA4 := make([]byte, len(m.HaltedModules)*10)
+		var j3 int
+		for _, num := range m.HaltedModules {
+			for num >= 1<<7 {
+				dAtA4[j3] = uint8(uint64(num)&0x7f | 0x80)
+				num >>= 7
+				j3++
+			}
+			dAtA4[j3] = uint8(num)
+			j3++
+		}
+		i -= j3
+		copy(dAtA[i:], dAtA4[:j3])
+		i = encodeVarintQuery(dAtA, i, uint64(j3))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelHalts) > 0 {
+		for iNdEx := len(m.ChannelHalts) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.ChannelHalts[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.IbcHalted {
+		i--
+		if m.IbcHalted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TogglesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TogglesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TogglesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TogglesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TogglesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TogglesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Toggles) > 0 {
+		for iNdEx := len(m.Toggles) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Toggles[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RateLimitStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RateLimitStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RateLimitStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RecvCapacity != nil {
+		{
+			size := m.RecvCapacity.Size()
+			i -= size
+			if _, err := m.RecvCapacity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.SendCapacity != nil {
+		{
+			size := m.SendCapacity.Size()
+			i -= size
+			if _, err := m.SendCapacity.MarshalTo(dAtA[i:]); err != nil {
+				return 0, err
+			}
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Flow.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.RateLimit.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *FlowsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *FlowsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *FlowsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for iNdEx := len(m.RateLimits) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RateLimits[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *ParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *HaltsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *HaltsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.IbcHalted {
+		n += 2
+	}
+	if len(m.ChannelHalts) > 0 {
+		for _, e := range m.ChannelHalts {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if len(m.HaltedModules) > 0 {
+		l = 0
+		for _, e := range m.HaltedModules {
+			l += sovQuery(uint64(e))
+		}
+		n += 1 + sovQuery(uint64(l)) + l
+	}
+	if m.SwitchExpiry != nil {
+		l = m.SwitchExpiry.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TogglesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *TogglesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Toggles) > 0 {
+		for _, e := range m.Toggles {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FlowsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RateLimitStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.RateLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Flow.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	if m.SendCapacity != nil {
+		l = m.SendCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RecvCapacity != nil {
+		l = m.RecvCapacity.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *FlowsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.RateLimits) > 0 {
+		for _, e := range m.RateLimits {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HaltsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HaltsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HaltsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *HaltsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: HaltsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: HaltsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field IbcHalted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.IbcHalted = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelHalts", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelHalts = append(m.ChannelHalts, ChannelHalt{})
+			if err := m.ChannelHalts[len(m.ChannelHalts)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType == 0 {
+				var v HaltedModule
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					v |= HaltedModule(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				m.HaltedModules = append(m.HaltedModules, v)
+			} else if wireType == 2 {
+				var packedLen int
+				for shift := uint(0); ; shift += 7 {
+					if shift >= 64 {
+						return ErrIntOverflowQuery
+					}
+					if iNdEx >= l {
+						return io.ErrUnexpectedEOF
+					}
+					b := dAtA[iNdEx]
+					iNdEx++
+					packedLen |= int(b&0x7F) << shift
+					if b < 0x80 {
+						break
+					}
+				}
+				if packedLen < 0 {
+					return ErrInvalidLengthQuery
+				}
+				postIndex := iNdEx + packedLen
+				if postIndex < 0 {
+					return ErrInvalidLengthQuery
+				}
+				if postIndex > l {
+					return io.ErrUnexpectedEOF
+				}
+				var elementCount int
+				if elementCount != 0 && len(m.HaltedModules) == 0 {
+					m.HaltedModules = make([]HaltedModule, 0, elementCount)
+				}
+				for iNdEx < postIndex {
+					var v HaltedModule
+					for shift := uint(0); ; shift += 7 {
+						if shift >= 64 {
+							return ErrIntOverflowQuery
+						}
+						if iNdEx >= l {
+							return io.ErrUnexpectedEOF
+						}
+						b := dAtA[iNdEx]
+						iNdEx++
+						v |= HaltedModule(b&0x7F) << shift
+						if b < 0x80 {
+							break
+						}
+					}
+					m.HaltedModules = append(m.HaltedModules, v)
+				}
+			} else {
+				return fmt.Errorf("proto: wrong wireType = %d for field HaltedModules", wireType)
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SwitchExpiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.SwitchExpiry == nil {
+				m.SwitchExpiry = &SwitchExpiry{}
+			}
+			if err := m.SwitchExpiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TogglesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TogglesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TogglesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *TogglesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: TogglesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: TogglesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Toggles", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Toggles = append(m.Toggles, Toggle{})
+			if err := m.Toggles[len(m.Toggles)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return nil
 }
-func (m *ParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *FlowsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: FlowsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: FlowsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	var l int
-	_ = l
-	return n
-}
 
-func (m *ParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *RateLimitStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -344,12 +2031,150 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: RateLimitStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: RateLimitStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimit", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.RateLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Flow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Flow.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SendCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.SendCapacity = &v
+			if err := m.SendCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecvCapacity", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			var v cosmossdk_io_math.Int
+			m.RecvCapacity = &v
+			if err := m.RecvCapacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -371,7 +2196,7 @@ func (m *ParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *FlowsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -394,15 +2219,15 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: ParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: FlowsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: ParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: FlowsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RateLimits", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -429,7 +2254,8 @@ func (m *ParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RateLimits = append(m.RateLimits, RateLimitStatus{})
+			if err := m.RateLimits[len(m.RateLimits)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,215 @@
+package types
+
+import (
+	"fmt"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// Validate checks the limits of the quota.
+func (q Quota) Validate() error {
+	if q.MaxPercent.IsNil() || q.MaxPercent.IsNegative() || q.MaxPercent.GT(sdkmath.LegacyNewDec(100)) {
+		return fmt.Errorf("max percent must be between 0 and 100, got %s", q.MaxPercent)
+	}
+	if q.MaxAmount.IsNil() || q.MaxAmount.IsNegative() {
+		return fmt.Errorf("max amount must not be negative, got %s", q.MaxAmount)
+	}
+	return nil
+}
+
+// IsLimited returns whether the quota has any limit.
+func (q Quota) IsLimited() bool {
+	return q.MaxPercent.IsPositive() || q.MaxAmount.IsPositive()
+}
+
+// Limit returns the stricter limit of the quota for the given supply, and false if the quota has no limit.
+// A percentage quota doesn't limit a denom without supply, otherwise the first transfer of a new IBC denom
+// could never be received.
+func (q Quota) Limit(supply sdkmath.Int) (sdkmath.Int, bool) {
+	if !q.IsLimited() {
+		return sdkmath.ZeroInt(), false
+	}
+	if !q.MaxPercent.IsPositive() || !supply.IsPositive() {
+		if !q.MaxAmount.IsPositive() {
+			return sdkmath.ZeroInt(), false
+		}
+		return q.MaxAmount, true
+	}
+	limit := q.MaxPercent.MulInt(supply).QuoInt64(100).TruncateInt()
+	if q.MaxAmount.IsPositive() && q.MaxAmount.LT(limit) {
+		limit = q.MaxAmount
+	}
+	return limit, true
+}
+
+// Validate checks the denom, channel, window and quotas of the rate limit.
+func (r RateLimit) Validate() error {
+	if err := sdk.ValidateDenom(r.Denom); err != nil {
+		return err
+	}
+	if err := host.ChannelIdentifierValidator(r.ChannelId); err != nil {
+		return err
+	}
+	if r.Window <= 0 {
+		return fmt.Errorf("rate limit window of %s over %s must be positive", r.Denom, r.ChannelId)
+	}
+	if err := r.SendQuota.Validate(); err != nil {
+		return err
+	}
+	if err := r.RecvQuota.Validate(); err != nil {
+		return err
+	}
+	if !r.SendQuota.IsLimited() && !r.RecvQuota.IsLimited() {
+		return fmt.Errorf("rate limit of %s over %s has no quota", r.Denom, r.ChannelId)
+	}
+	return nil
+}
+
+// GetRateLimit returns the rate limit of a denom over a channel.
+func (p Params) GetRateLimit(channelID, denom string) (RateLimit, bool) {
+	for _, limit := range p.RateLimits {
+		if limit.ChannelId == channelID && limit.Denom == denom {
+			return limit, true
+		}
+	}
+	return RateLimit{}, false
+}
+
+func validateRateLimits(limits []RateLimit) error {
+	seen := make(map[string]struct{}, len(limits))
+	for _, limit := range limits {
+		if err := limit.Validate(); err != nil {
+			return err
+		}
+		key := string(GetFlowKey(limit.ChannelId, limit.Denom))
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate rate limit of %s over %s", limit.Denom, limit.ChannelId)
+		}
+		seen[key] = struct{}{}
+	}
+	return nil
+}
+
+// FlowBuckets is the number of buckets the window of a rate limit is split in. The flows of a bucket expire with it,
+// so the quotas apply to any period as long as the window, up to the duration of a bucket.
+const FlowBuckets = 10
+
+// BucketStart returns the start of the bucket of the rate limit that a time falls in.
+func (r RateLimit) BucketStart(t time.Time) time.Time {
+	return t.Truncate(r.Window / FlowBuckets)
+}
+
+// NewFlow returns the flow of a rate limit without any transfer.
+func NewFlow(limit RateLimit) Flow {
+	return Flow{
+		Denom:     limit.Denom,
+		ChannelId: limit.ChannelId,
+		Inflow:    sdkmath.ZeroInt(),
+		Outflow:   sdkmath.ZeroInt(),
+		Supply:    sdkmath.ZeroInt(),
+	}
+}
+
+// Roll drops the buckets that left the window of the rate limit at the given time from the flow, and returns whether
+// the time falls in a new bucket.
+func (f *Flow) Roll(limit RateLimit, now time.Time) bool {
+	current := limit.BucketStart(now)
+	var buckets []FlowBucket
+	for _, bucket := range f.Buckets {
+		if bucket.Start.Add(limit.Window).After(current) {
+			buckets = append(buckets, bucket)
+			continue
+		}
+		f.Inflow = f.Inflow.Sub(bucket.Inflow)
+		f.Outflow = f.Outflow.Sub(bucket.Outflow)
+	}
+	f.Buckets = buckets
+	return len(buckets) == 0 || !buckets[len(buckets)-1].Start.Equal(current)
+}
+
+// AddInflow adds a received amount to the bucket starting at start.
+func (f *Flow) AddInflow(start time.Time, amount sdkmath.Int) {
+	bucket := f.bucket(start)
+	bucket.Inflow = bucket.Inflow.Add(amount)
+	f.Inflow = f.Inflow.Add(amount)
+}
+
+// AddOutflow adds a sent amount to the bucket starting at start.
+func (f *Flow) AddOutflow(start time.Time, amount sdkmath.Int) {
+	bucket := f.bucket(start)
+	bucket.Outflow = bucket.Outflow.Add(amount)
+	f.Outflow = f.Outflow.Add(amount)
+}
+
+// RemoveOutflow takes a sent amount back from the bucket starting at start, if it's still in the window.
+func (f *Flow) RemoveOutflow(start time.Time, amount sdkmath.Int) {
+	for i := range f.Buckets {
+		bucket := &f.Buckets[i]
+		if !bucket.Start.Equal(start) {
+			continue
+		}
+		amount = sdkmath.MinInt(amount, bucket.Outflow)
+		bucket.Outflow = bucket.Outflow.Sub(amount)
+		f.Outflow = f.Outflow.Sub(amount)
+		return
+	}
+}
+
+// bucket returns the bucket starting at start, adding it after the others if it's a new one.
+func (f *Flow) bucket(start time.Time) *FlowBucket {
+	if n := len(f.Buckets); n > 0 && f.Buckets[n-1].Start.Equal(start) {
+		return &f.Buckets[n-1]
+	}
+	f.Buckets = append(f.Buckets, FlowBucket{Start: start, Inflow: sdkmath.ZeroInt(), Outflow: sdkmath.ZeroInt()})
+	return &f.Buckets[len(f.Buckets)-1]
+}
+
+// SendCapacity returns how much can still be sent in the rolling window, and false if sends are not limited.
+func (f Flow) SendCapacity(limit RateLimit) (sdkmath.Int, bool) {
+	quota, limited := limit.SendQuota.Limit(f.Supply)
+	if !limited {
+		return sdkmath.ZeroInt(), false
+	}
+	return sdkmath.MaxInt(quota.Sub(f.Outflow).Add(f.Inflow), sdkmath.ZeroInt()), true
+}
+
+// RecvCapacity returns how much can still be received in the rolling window, and false if receives are not limited.
+func (f Flow) RecvCapacity(limit RateLimit) (sdkmath.Int, bool) {
+	quota, limited := limit.RecvQuota.Limit(f.Supply)
+	if !limited {
+		return sdkmath.ZeroInt(), false
+	}
+	return sdkmath.MaxInt(quota.Sub(f.Inflow).Add(f.Outflow), sdkmath.ZeroInt()), true
+}
+
+// UnmarshalTransfer returns the ICS-20 transfer of a packet, and false if it isn't one.
+func UnmarshalTransfer(data []byte) (transfertypes.FungibleTokenPacketData, sdkmath.Int, bool) {
+	var transfer transfertypes.FungibleTokenPacketData
+	if err := transfertypes.ModuleCdc.UnmarshalJSON(data, &transfer); err != nil || transfer.Denom == "" {
+		return transfer, sdkmath.Int{}, false
+	}
+	amount, ok := sdkmath.NewIntFromString(transfer.Amount)
+	if !ok || !amount.IsPositive() {
+		return transfer, sdkmath.Int{}, false
+	}
+	return transfer, amount, true
+}
+
+// SentDenom returns the denom on this chain of a transfer sent over a channel.
+func SentDenom(transfer transfertypes.FungibleTokenPacketData) string {
+	return transfertypes.ParseDenomTrace(transfer.Denom).IBCDenom()
+}
+
+// ReceivedDenom returns the denom on this chain of a transfer received over a channel.
+func ReceivedDenom(sourcePort, sourceChannel, destPort, destChannel string, transfer transfertypes.FungibleTokenPacketData) string {
+	if transfertypes.ReceiverChainIsSource(sourcePort, sourceChannel, transfer.Denom) {
+		unprefixed := transfer.Denom[len(transfertypes.GetDenomPrefix(sourcePort, sourceChannel)):]
+		return transfertypes.ParseDenomTrace(unprefixed).IBCDenom()
+	}
+	prefixed := transfertypes.GetDenomPrefix(destPort, destChannel) + transfer.Denom
+	return transfertypes.ParseDenomTrace(prefixed).IBCDenom()
+}
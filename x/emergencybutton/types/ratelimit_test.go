@@ -0,0 +1,146 @@
+package types
+
+import (
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+)
+
+func newQuota(maxPercent, maxAmount int64) Quota {
+	return Quota{MaxPercent: sdkmath.LegacyNewDec(maxPercent), MaxAmount: sdkmath.NewInt(maxAmount)}
+}
+
+func TestQuotaLimit(t *testing.T) {
+	specs := map[string]struct {
+		quota      Quota
+		supply     int64
+		expLimit   int64
+		expLimited bool
+	}{
+		"no limit": {
+			quota:  newQuota(0, 0),
+			supply: 1000,
+		},
+		"percent of supply": {
+			quota:      newQuota(10, 0),
+			supply:     1000,
+			expLimit:   100,
+			expLimited: true,
+		},
+		"percent is truncated": {
+			quota:      newQuota(10, 0),
+			supply:     1009,
+			expLimit:   100,
+			expLimited: true,
+		},
+		"amount": {
+			quota:      newQuota(0, 50),
+			supply:     1000,
+			expLimit:   50,
+			expLimited: true,
+		},
+		"stricter amount": {
+			quota:      newQuota(10, 50),
+			supply:     1000,
+			expLimit:   50,
+			expLimited: true,
+		},
+		"stricter percent": {
+			quota:      newQuota(10, 500),
+			supply:     1000,
+			expLimit:   100,
+			expLimited: true,
+		},
+		"percent without supply": {
+			quota:  newQuota(10, 0),
+			supply: 0,
+		},
+		"amount applies without supply": {
+			quota:      newQuota(10, 50),
+			supply:     0,
+			expLimit:   50,
+			expLimited: true,
+		},
+	}
+	for name, spec := range specs {
+		t.Run(name, func(t *testing.T) {
+			limit, limited := spec.quota.Limit(sdkmath.NewInt(spec.supply))
+			require.Equal(t, spec.expLimited, limited)
+			require.Equal(t, sdkmath.NewInt(spec.expLimit).String(), limit.String())
+		})
+	}
+}
+
+func TestFlowCapacity(t *testing.T) {
+	limit := RateLimit{
+		Denom:     "uscrt",
+		ChannelId: "channel-0",
+		Window:    time.Hour,
+		SendQuota: newQuota(10, 0),
+		RecvQuota: newQuota(0, 0),
+	}
+	flow := NewFlow(limit)
+	flow.Supply = sdkmath.NewInt(1000)
+
+	capacity, limited := flow.SendCapacity(limit)
+	require.True(t, limited)
+	require.Equal(t, int64(100), capacity.Int64())
+	_, limited = flow.RecvCapacity(limit)
+	require.False(t, limited)
+
+	// the net flow counts, so receives free up send capacity
+	flow.Outflow = sdkmath.NewInt(80)
+	capacity, _ = flow.SendCapacity(limit)
+	require.Equal(t, int64(20), capacity.Int64())
+	flow.Inflow = sdkmath.NewInt(30)
+	capacity, _ = flow.SendCapacity(limit)
+	require.Equal(t, int64(50), capacity.Int64())
+
+	// the capacity doesn't go below zero when the quota was lowered
+	flow.Outflow = sdkmath.NewInt(500)
+	capacity, _ = flow.SendCapacity(limit)
+	require.True(t, capacity.IsZero())
+
+	limit.RecvQuota = newQuota(0, 100)
+	capacity, limited = flow.RecvCapacity(limit)
+	require.True(t, limited)
+	require.Equal(t, int64(570), capacity.Int64())
+}
+
+func TestFlowBuckets(t *testing.T) {
+	limit := RateLimit{Denom: "uscrt", ChannelId: "channel-0", Window: time.Hour}
+	start := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	require.Equal(t, start.Add(6*time.Minute), limit.BucketStart(start.Add(11*time.Minute)))
+
+	flow := NewFlow(limit)
+	require.True(t, flow.Roll(limit, start))
+	flow.AddOutflow(limit.BucketStart(start), sdkmath.NewInt(60))
+	flow.AddInflow(limit.BucketStart(start), sdkmath.NewInt(10))
+	require.False(t, flow.Roll(limit, start.Add(time.Minute)))
+	flow.AddOutflow(limit.BucketStart(start.Add(time.Minute)), sdkmath.NewInt(20))
+	require.Len(t, flow.Buckets, 1)
+
+	require.True(t, flow.Roll(limit, start.Add(30*time.Minute)))
+	flow.AddOutflow(limit.BucketStart(start.Add(30*time.Minute)), sdkmath.NewInt(15))
+	require.Len(t, flow.Buckets, 2)
+	require.Equal(t, int64(95), flow.Outflow.Int64())
+
+	// a refund is taken back from its bucket, up to its outflow
+	flow.RemoveOutflow(start.Add(30*time.Minute), sdkmath.NewInt(100))
+	require.Equal(t, int64(80), flow.Outflow.Int64())
+	require.True(t, flow.Buckets[1].Outflow.IsZero())
+
+	// the first bucket is still in the window until an hour after it started
+	require.True(t, flow.Roll(limit, start.Add(59*time.Minute)))
+	require.Equal(t, int64(80), flow.Outflow.Int64())
+	require.True(t, flow.Roll(limit, start.Add(time.Hour)))
+	require.Len(t, flow.Buckets, 1)
+	require.True(t, flow.Outflow.IsZero())
+	require.True(t, flow.Inflow.IsZero())
+
+	// the refund of an expired bucket is ignored
+	flow.RemoveOutflow(start, sdkmath.NewInt(10))
+	require.True(t, flow.Outflow.IsZero())
+}
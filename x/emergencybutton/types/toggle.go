@@ -0,0 +1,25 @@
+package types
+
+import (
+	"fmt"
+	"time"
+)
+
+// Validate checks that the expiry is set to a height, a time or both.
+func (e SwitchExpiry) Validate() error {
+	if e.Height < 0 {
+		return fmt.Errorf("expiry height must not be negative, got %d", e.Height)
+	}
+	if e.Height == 0 && e.Time == nil {
+		return fmt.Errorf("expiry must have a height or a time")
+	}
+	return nil
+}
+
+// Expired returns whether the expiry is reached at the given height or time, whichever comes first.
+func (e SwitchExpiry) Expired(height int64, blockTime time.Time) bool {
+	if e.Height > 0 && height >= e.Height {
+		return true
+	}
+	return e.Time != nil && !blockTime.Before(*e.Time)
+}
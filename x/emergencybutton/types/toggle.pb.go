@@ -0,0 +1,882 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/emergencybutton/v1beta1/toggle.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google/protobuf"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// SwitchExpiry defines when a switch that was turned off is turned back on.
+type SwitchExpiry struct {
+	// height is the block height at which the switch is turned back on, zero
+	// when it doesn't expire by height.
+	Height int64 `protobuf:"varint,1,opt,name=height,proto3" json:"height,omitempty"`
+	// time is the block time at which the switch is turned back on, unset when
+	// it doesn't expire by time.
+	Time *time.Time `protobuf:"bytes,2,opt,name=time,proto3,stdtime" json:"time,omitempty"`
+}
+
+func (m *SwitchExpiry) Reset()         { *m = SwitchExpiry{} }
+func (m *SwitchExpiry) String() string { return proto.CompactTextString(m) }
+func (*SwitchExpiry) ProtoMessage()    {}
+func (*SwitchExpiry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22686f22568108a5, []int{0}
+}
+func (m *SwitchExpiry) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SwitchExpiry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SwitchExpiry.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SwitchExpiry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SwitchExpiry.Merge(m, src)
+}
+func (m *SwitchExpiry) XXX_Size() int {
+	return m.Size()
+}
+func (m *SwitchExpiry) XXX_DiscardUnknown() {
+	xxx_messageInfo_SwitchExpiry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SwitchExpiry proto.InternalMessageInfo
+
+func (m *SwitchExpiry) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *SwitchExpiry) GetTime() *time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return nil
+}
+
+// Toggle records a change of the switch, of a channel halt or of a module
+// halt.
+type Toggle struct {
+	// id increases with every change.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// sender is the pauser or governance account that made the change. It's
+	// empty when the switch was turned back on because it expired.
+	Sender string    `protobuf:"bytes,2,opt,name=sender,proto3" json:"sender,omitempty"`
+	Height int64     `protobuf:"varint,3,opt,name=height,proto3" json:"height,omitempty"`
+	Time   time.Time `protobuf:"bytes,4,opt,name=time,proto3,stdtime" json:"time"`
+	// halted is true when the change halted and false when it resumed.
+	Halted bool `protobuf:"varint,5,opt,name=halted,proto3" json:"halted,omitempty"`
+	// channel_halt is set when a channel was halted or resumed.
+	ChannelHalt *ChannelHalt `protobuf:"bytes,6,opt,name=channel_halt,json=channelHalt,proto3" json:"channel_halt,omitempty"`
+	// module is set when the contract executions of a module were halted or
+	// resumed. The switch was toggled when neither channel_halt nor module is
+	// set.
+	Module HaltedModule `protobuf:"varint,7,opt,name=module,proto3,enum=secret.emergencybutton.v1beta1.HaltedModule" json:"module,omitempty"`
+	// expiry is set when the switch was turned off until it expires.
+	Expiry *SwitchExpiry `protobuf:"bytes,8,opt,name=expiry,proto3" json:"expiry,omitempty"`
+}
+
+func (m *Toggle) Reset()         { *m = Toggle{} }
+func (m *Toggle) String() string { return proto.CompactTextString(m) }
+func (*Toggle) ProtoMessage()    {}
+func (*Toggle) Descriptor() ([]byte, []int) {
+	return fileDescriptor_22686f22568108a5, []int{1}
+}
+func (m *Toggle) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Toggle) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Toggle.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Toggle) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Toggle.Merge(m, src)
+}
+func (m *Toggle) XXX_Size() int {
+	return m.Size()
+}
+func (m *Toggle) XXX_DiscardUnknown() {
+	xxx_messageInfo_Toggle.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Toggle proto.InternalMessageInfo
+
+func (m *Toggle) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *Toggle) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *Toggle) GetHeight() int64 {
+	if m != nil {
+		return m.Height
+	}
+	return 0
+}
+
+func (m *Toggle) GetTime() time.Time {
+	if m != nil {
+		return m.Time
+	}
+	return time.Time{}
+}
+
+func (m *Toggle) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+func (m *Toggle) GetChannelHalt() *ChannelHalt {
+	if m != nil {
+		return m.ChannelHalt
+	}
+	return nil
+}
+
+func (m *Toggle) GetModule() HaltedModule {
+	if m != nil {
+		return m.Module
+	}
+	return HaltedModule_HALTED_MODULE_UNSPECIFIED
+}
+
+func (m *Toggle) GetExpiry() *SwitchExpiry {
+	if m != nil {
+		return m.Expiry
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*SwitchExpiry)(nil), "secret.emergencybutton.v1beta1.SwitchExpiry")
+	proto.RegisterType((*Toggle)(nil), "secret.emergencybutton.v1beta1.Toggle")
+}
+
+func init() {
+	proto.RegisterFile("secret/emergencybutton/v1beta1/toggle.proto", fileDescriptor_22686f22568108a5)
+}
+
+var fileDescriptor_22686f22568108a5 = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x92, 0xb1, 0x6f, 0xd4, 0x30,
+	0x14, 0xc6, 0xcf, 0xd7, 0x23, 0x1c, 0x6e, 0xd5, 0x21, 0x42, 0x28, 0xba, 0x21, 0x17, 0x75, 0x8a,
+	0x54, 0x64, 0xab, 0x85, 0x01, 0xd6, 0x02, 0x52, 0x17, 0x3a, 0xf8, 0x3a, 0x21, 0x24, 0xe4, 0x24,
+	0x0f, 0x27, 0x22, 0x89, 0x23, 0xe7, 0x85, 0xf6, 0xfe, 0x8b, 0xfe, 0x59, 0x1d, 0x3b, 0x32, 0x01,
+	0xba, 0xfb, 0x1f, 0x98, 0x51, 0x1c, 0x57, 0x3d, 0x40, 0x22, 0x5b, 0xbe, 0xe8, 0xfb, 0xbe, 0xf7,
+	0xb3, 0x9f, 0xe9, 0x71, 0x0b, 0xa9, 0x01, 0xe4, 0x50, 0x81, 0x51, 0x50, 0xa7, 0xeb, 0xa4, 0x43,
+	0xd4, 0x35, 0xff, 0x7a, 0x92, 0x00, 0xca, 0x13, 0x8e, 0x5a, 0xa9, 0x12, 0x58, 0x63, 0x34, 0x6a,
+	0x3f, 0x1c, 0xcc, 0xec, 0x2f, 0x33, 0x73, 0xe6, 0xc5, 0x53, 0xa5, 0x95, 0xb6, 0x56, 0xde, 0x7f,
+	0x0d, 0xa9, 0xc5, 0x52, 0x69, 0xad, 0x4a, 0xe0, 0x56, 0x25, 0xdd, 0x67, 0x8e, 0x45, 0x05, 0x2d,
+	0xca, 0xaa, 0x71, 0x86, 0x31, 0x86, 0x46, 0x1a, 0x59, 0xb5, 0x83, 0xf9, 0xe8, 0x23, 0x3d, 0x58,
+	0x5d, 0x15, 0x98, 0xe6, 0xef, 0xae, 0x9b, 0xc2, 0xac, 0xfd, 0x67, 0xd4, 0xcb, 0xa1, 0x50, 0x39,
+	0x06, 0x24, 0x22, 0xf1, 0x9e, 0x70, 0xca, 0x7f, 0x49, 0x67, 0xfd, 0x9c, 0x60, 0x1a, 0x91, 0x78,
+	0xff, 0x74, 0xc1, 0x06, 0x08, 0x76, 0x0f, 0xc1, 0x2e, 0xef, 0x21, 0xce, 0x66, 0x37, 0x3f, 0x96,
+	0x44, 0x58, 0xf7, 0xd1, 0xaf, 0x29, 0xf5, 0x2e, 0xed, 0x91, 0xfd, 0x43, 0x3a, 0x2d, 0x32, 0x5b,
+	0x3a, 0x13, 0xd3, 0x22, 0xeb, 0x07, 0xb5, 0x50, 0x67, 0x60, 0x6c, 0xe5, 0x13, 0xe1, 0xd4, 0x0e,
+	0xc0, 0xde, 0x1f, 0x00, 0xaf, 0x1c, 0xc0, 0x6c, 0x14, 0x60, 0x7e, 0xfb, 0x7d, 0x39, 0x79, 0x80,
+	0xb0, 0x8d, 0xb2, 0x44, 0xc8, 0x82, 0x47, 0x11, 0x89, 0xe7, 0xc2, 0x29, 0xff, 0x82, 0x1e, 0xa4,
+	0xb9, 0xac, 0x6b, 0x28, 0x3f, 0xf5, 0x7f, 0x02, 0xcf, 0x36, 0x1f, 0xb3, 0xff, 0x6f, 0x85, 0xbd,
+	0x19, 0x32, 0xe7, 0xb2, 0x44, 0xb1, 0x9f, 0x3e, 0x08, 0xff, 0x2d, 0xf5, 0x2a, 0x9d, 0x75, 0x25,
+	0x04, 0x8f, 0x23, 0x12, 0x1f, 0x9e, 0x3e, 0x1f, 0x6b, 0x3a, 0xb7, 0x1c, 0xef, 0x6d, 0x46, 0xb8,
+	0x6c, 0xdf, 0x02, 0x76, 0x15, 0xc1, 0xdc, 0xf2, 0x8c, 0xb6, 0xec, 0xae, 0x4f, 0xb8, 0xec, 0xd9,
+	0xea, 0x76, 0x13, 0x92, 0xbb, 0x4d, 0x48, 0x7e, 0x6e, 0x42, 0x72, 0xb3, 0x0d, 0x27, 0x77, 0xdb,
+	0x70, 0xf2, 0x6d, 0x1b, 0x4e, 0x3e, 0xbc, 0x56, 0x05, 0xe6, 0x5d, 0xc2, 0x52, 0x5d, 0xf1, 0x36,
+	0x35, 0x58, 0xca, 0xa4, 0xe5, 0x2b, 0x3b, 0xe2, 0x02, 0xf0, 0x4a, 0x9b, 0x2f, 0xfc, 0xfa, 0x9f,
+	0xa7, 0x83, 0xeb, 0x06, 0xda, 0xc4, 0xb3, 0x97, 0xfd, 0xe2, 0xf7, 0x00, 0xca, 0x7c, 0xdc, 0x06,
+	0xe5, 0x02, 0x00, 0x00,
+}
+
+func (m *SwitchExpiry) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SwitchExpiry) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SwitchExpiry) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Time != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintToggle(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Height != 0 {
+		i = encodeVarintToggle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Toggle) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Toggle) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Toggle) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Expiry != nil {
+		{
+			size, err := m.Expiry.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToggle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x42
+	}
+	if m.Module != 0 {
+		i = encodeVarintToggle(dAtA, i, uint64(m.Module))
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ChannelHalt != nil {
+		{
+			size, err := m.ChannelHalt.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintToggle(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x32
+	}
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x28
+	}
+	n4, err4 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.Time, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time):])
+	if err4 != nil {
+		return 0, err4
+	}
+	i -= n4
+	i = encodeVarintToggle(dAtA, i, uint64(n4))
+	i--
+	dAtA[i] = 0x22
+	if m.Height != 0 {
+		i = encodeVarintToggle(dAtA, i, uint64(m.Height))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintToggle(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintToggle(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintToggle(dAtA []byte, offset int, v uint64) int {
+	offset -= sovToggle(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *SwitchExpiry) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Height != 0 {
+		n += 1 + sovToggle(uint64(m.Height))
+	}
+	if m.Time != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.Time)
+		n += 1 + l + sovToggle(uint64(l))
+	}
+	return n
+}
+
+func (m *Toggle) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovToggle(uint64(m.Id))
+	}
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovToggle(uint64(l))
+	}
+	if m.Height != 0 {
+		n += 1 + sovToggle(uint64(m.Height))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.Time)
+	n += 1 + l + sovToggle(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	if m.ChannelHalt != nil {
+		l = m.ChannelHalt.Size()
+		n += 1 + l + sovToggle(uint64(l))
+	}
+	if m.Module != 0 {
+		n += 1 + sovToggle(uint64(m.Module))
+	}
+	if m.Expiry != nil {
+		l = m.Expiry.Size()
+		n += 1 + l + sovToggle(uint64(l))
+	}
+	return n
+}
+
+func sovToggle(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozToggle(x uint64) (n int) {
+	return sovToggle(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *SwitchExpiry) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToggle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SwitchExpiry: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SwitchExpiry: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToggle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Time == nil {
+				m.Time = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToggle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Toggle) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowToggle
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Toggle: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Toggle: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthToggle
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Height", wireType)
+			}
+			m.Height = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Height |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Time", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToggle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.Time, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelHalt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToggle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ChannelHalt == nil {
+				m.ChannelHalt = &ChannelHalt{}
+			}
+			if err := m.ChannelHalt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			m.Module = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Module |= HaltedModule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Expiry", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthToggle
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Expiry == nil {
+				m.Expiry = &SwitchExpiry{}
+			}
+			if err := m.Expiry.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipToggle(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthToggle
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipToggle(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowToggle
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowToggle
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthToggle
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupToggle
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthToggle
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthToggle        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowToggle          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupToggle = fmt.Errorf("proto: unexpected end of group")
+)
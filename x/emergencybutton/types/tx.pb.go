@@ -11,18 +11,22 @@ import (
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google/protobuf"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,9 +35,15 @@ var _ = math.Inf
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
 // MsgToggleIbcSwitch represents a message to toggle the emergencybutton status
-// by the defined pauser.
+// by the defined pausers.
 type MsgToggleIbcSwitch struct {
 	Sender string `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	// expiry_height turns the switch back on at this block height. It can only
+	// be set when turning the switch off.
+	ExpiryHeight int64 `protobuf:"varint,2,opt,name=expiry_height,json=expiryHeight,proto3" json:"expiry_height,omitempty"`
+	// expiry_duration turns the switch back on after this duration of block
+	// time. It can only be set when turning the switch off.
+	ExpiryDuration *time.Duration `protobuf:"bytes,3,opt,name=expiry_duration,json=expiryDuration,proto3,stdduration" json:"expiry_duration,omitempty"`
 }
 
 func (m *MsgToggleIbcSwitch) Reset()         { *m = MsgToggleIbcSwitch{} }
@@ -76,6 +86,20 @@ func (m *MsgToggleIbcSwitch) GetSender() string {
 	return ""
 }
 
+func (m *MsgToggleIbcSwitch) GetExpiryHeight() int64 {
+	if m != nil {
+		return m.ExpiryHeight
+	}
+	return 0
+}
+
+func (m *MsgToggleIbcSwitch) GetExpiryDuration() *time.Duration {
+	if m != nil {
+		return m.ExpiryDuration
+	}
+	return nil
+}
+
 // MsgToggleIbcSwitchResponse defines the response type for the toggle.
 type MsgToggleIbcSwitchResponse struct {
 }
@@ -205,11 +229,217 @@ func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
 
+// MsgSetChannelHalt represents a message to halt or resume a channel by the
+// defined pausers or by governance. Unlike the switch, a channel halt has no
+// expiry: it stays until it is resumed.
+type MsgSetChannelHalt struct {
+	Sender string      `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Halt   ChannelHalt `protobuf:"bytes,2,opt,name=halt,proto3" json:"halt"`
+	// halted halts the channel when true and resumes it when false.
+	Halted bool `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (m *MsgSetChannelHalt) Reset()         { *m = MsgSetChannelHalt{} }
+func (m *MsgSetChannelHalt) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelHalt) ProtoMessage()    {}
+func (*MsgSetChannelHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72649c7fc51bf646, []int{4}
+}
+func (m *MsgSetChannelHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelHalt.Merge(m, src)
+}
+func (m *MsgSetChannelHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelHalt proto.InternalMessageInfo
+
+func (m *MsgSetChannelHalt) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetChannelHalt) GetHalt() ChannelHalt {
+	if m != nil {
+		return m.Halt
+	}
+	return ChannelHalt{}
+}
+
+func (m *MsgSetChannelHalt) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+// MsgSetChannelHaltResponse defines the response type for SetChannelHalt.
+type MsgSetChannelHaltResponse struct {
+}
+
+func (m *MsgSetChannelHaltResponse) Reset()         { *m = MsgSetChannelHaltResponse{} }
+func (m *MsgSetChannelHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetChannelHaltResponse) ProtoMessage()    {}
+func (*MsgSetChannelHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72649c7fc51bf646, []int{5}
+}
+func (m *MsgSetChannelHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetChannelHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetChannelHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetChannelHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetChannelHaltResponse.Merge(m, src)
+}
+func (m *MsgSetChannelHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetChannelHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetChannelHaltResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetChannelHaltResponse proto.InternalMessageInfo
+
+// MsgSetModuleHalt represents a message to halt or resume the contract
+// executions of a module by the defined pausers or by governance. Unlike the
+// switch, a module halt has no expiry: it stays until it is resumed.
+type MsgSetModuleHalt struct {
+	Sender string       `protobuf:"bytes,1,opt,name=sender,proto3" json:"sender,omitempty"`
+	Module HaltedModule `protobuf:"varint,2,opt,name=module,proto3,enum=secret.emergencybutton.v1beta1.HaltedModule" json:"module,omitempty"`
+	// halted halts the module when true and resumes it when false.
+	Halted bool `protobuf:"varint,3,opt,name=halted,proto3" json:"halted,omitempty"`
+}
+
+func (m *MsgSetModuleHalt) Reset()         { *m = MsgSetModuleHalt{} }
+func (m *MsgSetModuleHalt) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModuleHalt) ProtoMessage()    {}
+func (*MsgSetModuleHalt) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72649c7fc51bf646, []int{6}
+}
+func (m *MsgSetModuleHalt) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModuleHalt) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModuleHalt.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetModuleHalt) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModuleHalt.Merge(m, src)
+}
+func (m *MsgSetModuleHalt) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModuleHalt) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModuleHalt.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetModuleHalt proto.InternalMessageInfo
+
+func (m *MsgSetModuleHalt) GetSender() string {
+	if m != nil {
+		return m.Sender
+	}
+	return ""
+}
+
+func (m *MsgSetModuleHalt) GetModule() HaltedModule {
+	if m != nil {
+		return m.Module
+	}
+	return HaltedModule_HALTED_MODULE_UNSPECIFIED
+}
+
+func (m *MsgSetModuleHalt) GetHalted() bool {
+	if m != nil {
+		return m.Halted
+	}
+	return false
+}
+
+// MsgSetModuleHaltResponse defines the response type for SetModuleHalt.
+type MsgSetModuleHaltResponse struct {
+}
+
+func (m *MsgSetModuleHaltResponse) Reset()         { *m = MsgSetModuleHaltResponse{} }
+func (m *MsgSetModuleHaltResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgSetModuleHaltResponse) ProtoMessage()    {}
+func (*MsgSetModuleHaltResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_72649c7fc51bf646, []int{7}
+}
+func (m *MsgSetModuleHaltResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgSetModuleHaltResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgSetModuleHaltResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgSetModuleHaltResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgSetModuleHaltResponse.Merge(m, src)
+}
+func (m *MsgSetModuleHaltResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgSetModuleHaltResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgSetModuleHaltResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgSetModuleHaltResponse proto.InternalMessageInfo
+
 func init() {
 	proto.RegisterType((*MsgToggleIbcSwitch)(nil), "secret.emergencybutton.v1beta1.MsgToggleIbcSwitch")
 	proto.RegisterType((*MsgToggleIbcSwitchResponse)(nil), "secret.emergencybutton.v1beta1.MsgToggleIbcSwitchResponse")
 	proto.RegisterType((*MsgUpdateParams)(nil), "secret.emergencybutton.v1beta1.MsgUpdateParams")
 	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "secret.emergencybutton.v1beta1.MsgUpdateParamsResponse")
+	proto.RegisterType((*MsgSetChannelHalt)(nil), "secret.emergencybutton.v1beta1.MsgSetChannelHalt")
+	proto.RegisterType((*MsgSetChannelHaltResponse)(nil), "secret.emergencybutton.v1beta1.MsgSetChannelHaltResponse")
+	proto.RegisterType((*MsgSetModuleHalt)(nil), "secret.emergencybutton.v1beta1.MsgSetModuleHalt")
+	proto.RegisterType((*MsgSetModuleHaltResponse)(nil), "secret.emergencybutton.v1beta1.MsgSetModuleHaltResponse")
 }
 
 func init() {
@@ -217,33 +447,47 @@ func init() {
 }
 
 var fileDescriptor_72649c7fc51bf646 = []byte{
-	// 416 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x4d, 0x8f, 0xd2, 0x40,
-	0x18, 0xee, 0xa0, 0x92, 0x30, 0x18, 0x49, 0x1a, 0x22, 0xd0, 0x98, 0x4a, 0x7a, 0x50, 0x82, 0xb1,
-	0x13, 0x30, 0xd1, 0xc0, 0x4d, 0xe2, 0xc5, 0x03, 0xc6, 0xb4, 0x7a, 0xf1, 0x62, 0xfa, 0x31, 0x19,
-	0x1a, 0x69, 0xa7, 0x99, 0x19, 0xbe, 0x6e, 0xea, 0xd1, 0x93, 0xff, 0xc0, 0xbf, 0xc0, 0xc1, 0x1f,
-	0xc1, 0x91, 0x78, 0xf2, 0xb4, 0xd9, 0xc0, 0x81, 0xbf, 0xb1, 0xa1, 0x33, 0x84, 0x5d, 0x48, 0x96,
-	0xe5, 0xd4, 0xbe, 0x79, 0x9f, 0xaf, 0x79, 0xf2, 0xc2, 0xe7, 0x1c, 0x07, 0x0c, 0x0b, 0x84, 0x63,
-	0xcc, 0x08, 0x4e, 0x82, 0x99, 0x3f, 0x12, 0x82, 0x26, 0x68, 0xdc, 0xf2, 0xb1, 0xf0, 0x5a, 0x48,
-	0x4c, 0xed, 0x94, 0x51, 0x41, 0x75, 0x53, 0x02, 0xed, 0x03, 0xa0, 0xad, 0x80, 0x46, 0x99, 0x50,
-	0x42, 0x33, 0x28, 0xda, 0xfe, 0x49, 0x96, 0x51, 0x0b, 0x28, 0x8f, 0x29, 0xff, 0x2a, 0x17, 0x72,
-	0x50, 0xab, 0x8a, 0x9c, 0x50, 0xcc, 0x09, 0x1a, 0xb7, 0xb6, 0x1f, 0xb5, 0x78, 0x71, 0x22, 0x52,
-	0xea, 0x31, 0x2f, 0x56, 0x2a, 0x56, 0x07, 0xea, 0x7d, 0x4e, 0x3e, 0x51, 0x42, 0x86, 0xf8, 0xbd,
-	0x1f, 0xb8, 0x93, 0x48, 0x04, 0x03, 0xfd, 0x31, 0xcc, 0x73, 0x9c, 0x84, 0x98, 0x55, 0x41, 0x1d,
-	0x34, 0x0a, 0x8e, 0x9a, 0xba, 0xc5, 0x9f, 0x9b, 0x79, 0x53, 0x0d, 0xd6, 0x13, 0x68, 0x1c, 0x53,
-	0x1d, 0xcc, 0x53, 0x9a, 0x70, 0x6c, 0xfd, 0x01, 0xb0, 0xd4, 0xe7, 0xe4, 0x73, 0x1a, 0x7a, 0x02,
-	0x7f, 0xcc, 0x2c, 0xf5, 0xd7, 0xb0, 0xe0, 0x8d, 0xc4, 0x80, 0xb2, 0x48, 0xcc, 0xa4, 0x72, 0xaf,
-	0xfa, 0xef, 0xef, 0xcb, 0xb2, 0x7a, 0xd7, 0xdb, 0x30, 0x64, 0x98, 0x73, 0x57, 0xb0, 0x28, 0x21,
-	0xce, 0x1e, 0xaa, 0xbf, 0x83, 0x79, 0x19, 0xba, 0x9a, 0xab, 0x83, 0x46, 0xb1, 0xfd, 0xcc, 0xbe,
-	0xbd, 0x4c, 0x5b, 0xfa, 0xf5, 0xee, 0x2f, 0x2e, 0x9e, 0x6a, 0x8e, 0xe2, 0x76, 0x1f, 0x6d, 0xc3,
-	0xef, 0x55, 0xad, 0x1a, 0xac, 0x1c, 0x04, 0xdc, 0x85, 0x6f, 0xff, 0xca, 0xc1, 0x7b, 0x7d, 0x4e,
-	0xf4, 0x1f, 0x00, 0x96, 0x0e, 0xbb, 0x69, 0x9f, 0x32, 0x3f, 0x2e, 0xc5, 0xe8, 0x9e, 0xcf, 0xd9,
-	0x65, 0xd1, 0xa7, 0xf0, 0xe1, 0x8d, 0x12, 0xd1, 0x1d, 0xb4, 0xae, 0x13, 0x8c, 0x37, 0x67, 0x12,
-	0x76, 0xce, 0xc6, 0x83, 0xef, 0x9b, 0x79, 0x13, 0xf4, 0xdc, 0xc5, 0xca, 0x04, 0xcb, 0x95, 0x09,
-	0x2e, 0x57, 0x26, 0xf8, 0xbd, 0x36, 0xb5, 0xe5, 0xda, 0xd4, 0xfe, 0xaf, 0x4d, 0xed, 0x4b, 0x87,
-	0x44, 0x62, 0x30, 0xf2, 0xed, 0x80, 0xc6, 0x88, 0x07, 0x4c, 0x0c, 0x3d, 0x9f, 0x23, 0x37, 0x33,
-	0xfb, 0x80, 0xc5, 0x84, 0xb2, 0x6f, 0x68, 0x7a, 0x74, 0x86, 0x62, 0x96, 0x62, 0xee, 0xe7, 0xb3,
-	0xf3, 0x7b, 0x75, 0x15, 0x00, 0x00, 0xff, 0xff, 0x56, 0xa8, 0x28, 0x3b, 0x40, 0x03, 0x00, 0x00,
+	// 630 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x94, 0x3f, 0x6f, 0xd3, 0x4e,
+	0x18, 0xc7, 0x73, 0xbf, 0xe6, 0x17, 0xd1, 0x6b, 0x9b, 0x82, 0x55, 0xd1, 0xc4, 0x20, 0xb7, 0x0a,
+	0x12, 0x54, 0x2d, 0xf8, 0x48, 0x90, 0x80, 0x76, 0x23, 0x14, 0x29, 0x0c, 0x41, 0xc8, 0x81, 0x85,
+	0xa5, 0xf2, 0x9f, 0xe3, 0x6c, 0xe1, 0xf8, 0xac, 0xbb, 0x73, 0x9b, 0x08, 0x09, 0x01, 0x0b, 0x2b,
+	0x0b, 0x82, 0x8d, 0x95, 0xb1, 0x03, 0x2f, 0xa2, 0x63, 0xc5, 0xc4, 0x04, 0xa8, 0x1d, 0xfa, 0x36,
+	0x50, 0x7c, 0xe7, 0xb4, 0x49, 0x44, 0x93, 0x4c, 0xf6, 0x73, 0xf7, 0x7c, 0xbf, 0xcf, 0xe7, 0xb9,
+	0x7b, 0x74, 0xf0, 0x06, 0xc7, 0x2e, 0xc3, 0x02, 0xe1, 0x36, 0x66, 0x04, 0x47, 0x6e, 0xd7, 0x49,
+	0x84, 0xa0, 0x11, 0xda, 0xad, 0x3a, 0x58, 0xd8, 0x55, 0x24, 0x3a, 0x66, 0xcc, 0xa8, 0xa0, 0x9a,
+	0x21, 0x13, 0xcd, 0xa1, 0x44, 0x53, 0x25, 0xea, 0x4b, 0x84, 0x12, 0x9a, 0xa6, 0xa2, 0xde, 0x9f,
+	0x54, 0xe9, 0x06, 0xa1, 0x94, 0x84, 0x18, 0xa5, 0x91, 0x93, 0xbc, 0x44, 0x5e, 0xc2, 0x6c, 0x11,
+	0xd0, 0x48, 0xed, 0x97, 0x5d, 0xca, 0xdb, 0x94, 0xef, 0x48, 0xa1, 0x0c, 0xd4, 0xd6, 0xb2, 0x8c,
+	0x50, 0x9b, 0x13, 0xb4, 0x5b, 0xed, 0x7d, 0xd4, 0xc6, 0xc6, 0x18, 0xe4, 0xd8, 0x66, 0x76, 0x5b,
+	0xb9, 0x54, 0xbe, 0x01, 0xa8, 0x35, 0x39, 0x79, 0x46, 0x09, 0x09, 0xf1, 0x63, 0xc7, 0x6d, 0xed,
+	0x05, 0xc2, 0xf5, 0xb5, 0xcb, 0xb0, 0xc0, 0x71, 0xe4, 0x61, 0x56, 0x02, 0xab, 0x60, 0x6d, 0xd6,
+	0x52, 0x91, 0x76, 0x0d, 0x2e, 0xe0, 0x4e, 0x1c, 0xb0, 0xee, 0x8e, 0x8f, 0x03, 0xe2, 0x8b, 0xd2,
+	0x7f, 0xab, 0x60, 0x6d, 0xc6, 0x9a, 0x97, 0x8b, 0x8d, 0x74, 0x4d, 0x6b, 0xc0, 0x45, 0x95, 0x94,
+	0x75, 0x53, 0x9a, 0x59, 0x05, 0x6b, 0x73, 0xb5, 0xb2, 0x29, 0xdb, 0x35, 0xb3, 0x76, 0xcd, 0x6d,
+	0x95, 0x50, 0xcf, 0x7f, 0xf9, 0xbd, 0x02, 0xac, 0xa2, 0xd4, 0x65, 0xab, 0x5b, 0x73, 0xef, 0x4f,
+	0xf6, 0xd7, 0x55, 0xed, 0xca, 0x55, 0xa8, 0x8f, 0x92, 0x5a, 0x98, 0xc7, 0x34, 0xe2, 0xb8, 0xf2,
+	0x15, 0xc0, 0xc5, 0x26, 0x27, 0xcf, 0x63, 0xcf, 0x16, 0xf8, 0x69, 0xda, 0xa2, 0x76, 0x17, 0xce,
+	0xda, 0x89, 0xf0, 0x29, 0x0b, 0x44, 0x57, 0x36, 0x52, 0x2f, 0xfd, 0xf8, 0x7e, 0x6b, 0x49, 0x9d,
+	0xe3, 0x03, 0xcf, 0x63, 0x98, 0xf3, 0x96, 0x60, 0x41, 0x44, 0xac, 0xd3, 0x54, 0x6d, 0x1b, 0x16,
+	0xe4, 0x21, 0xa5, 0xed, 0xcd, 0xd5, 0xae, 0x9b, 0xe7, 0x5f, 0xae, 0x29, 0xeb, 0xd5, 0xf3, 0x07,
+	0xbf, 0x56, 0x72, 0x96, 0xd2, 0x6e, 0x15, 0x7b, 0xf0, 0xa7, 0xae, 0x95, 0x32, 0x5c, 0x1e, 0x02,
+	0xec, 0xc3, 0x7f, 0x06, 0xf0, 0x52, 0x93, 0x93, 0x16, 0x16, 0x0f, 0x7d, 0x3b, 0x8a, 0x70, 0xd8,
+	0xb0, 0x43, 0xf1, 0xcf, 0x4b, 0x78, 0x04, 0xf3, 0xbe, 0x1d, 0x0a, 0x05, 0xb7, 0x31, 0x0e, 0xee,
+	0x8c, 0xa5, 0x22, 0xcc, 0xfb, 0xca, 0xbe, 0xf7, 0xc5, 0x5e, 0x7a, 0x3b, 0x17, 0x2c, 0x15, 0x0d,
+	0x1e, 0xfa, 0x15, 0x58, 0x1e, 0x01, 0xeb, 0x63, 0x7f, 0x02, 0xf0, 0xa2, 0xdc, 0x6d, 0x52, 0x2f,
+	0x09, 0xf1, 0xb9, 0xd4, 0xdb, 0xb0, 0xd0, 0x4e, 0xb3, 0x52, 0xee, 0x62, 0xed, 0xe6, 0x38, 0xee,
+	0x46, 0x8a, 0x23, 0x9d, 0x2d, 0xa5, 0x9d, 0x0c, 0x5a, 0x87, 0xa5, 0x61, 0xac, 0x8c, 0xb9, 0xf6,
+	0x21, 0x0f, 0x67, 0x9a, 0x9c, 0x68, 0xef, 0x00, 0x5c, 0x1c, 0x9e, 0xfa, 0xda, 0x38, 0xa4, 0xd1,
+	0xf9, 0xd3, 0xb7, 0xa6, 0xd7, 0x64, 0x2c, 0x5a, 0x07, 0xce, 0x0f, 0xcc, 0x2b, 0x9a, 0xc0, 0xeb,
+	0xac, 0x40, 0xbf, 0x37, 0xa5, 0xa0, 0x5f, 0xf9, 0x0d, 0x2c, 0x0e, 0x0d, 0x5b, 0x75, 0x02, 0xab,
+	0x41, 0x89, 0xbe, 0x39, 0xb5, 0xa4, 0x5f, 0xff, 0x35, 0x5c, 0x18, 0x9c, 0x9a, 0xdb, 0x93, 0x79,
+	0x9d, 0x2a, 0xf4, 0xfb, 0xd3, 0x2a, 0xb2, 0xe2, 0xfa, 0xff, 0x6f, 0x4f, 0xf6, 0xd7, 0x41, 0xbd,
+	0x75, 0x70, 0x64, 0x80, 0xc3, 0x23, 0x03, 0xfc, 0x39, 0x32, 0xc0, 0xc7, 0x63, 0x23, 0x77, 0x78,
+	0x6c, 0xe4, 0x7e, 0x1e, 0x1b, 0xb9, 0x17, 0x9b, 0x24, 0x10, 0x7e, 0xe2, 0x98, 0x2e, 0x6d, 0x23,
+	0xee, 0x32, 0x11, 0xda, 0x0e, 0x47, 0xad, 0xb4, 0xda, 0x13, 0x2c, 0xf6, 0x28, 0x7b, 0x85, 0x3a,
+	0x23, 0xcf, 0xab, 0xe8, 0xc6, 0x98, 0x3b, 0x85, 0xf4, 0x69, 0xbb, 0xf3, 0x77, 0x00, 0x47, 0xe8,
+	0xc3, 0x1d, 0x38, 0x06, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -262,6 +506,10 @@ type MsgClient interface {
 	// emergencybutton.
 	ToggleIbcSwitch(ctx context.Context, in *MsgToggleIbcSwitch, opts ...grpc.CallOption) (*MsgToggleIbcSwitchResponse, error)
 	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
+	// SetChannelHalt halts or resumes the packets of a channel in one direction.
+	SetChannelHalt(ctx context.Context, in *MsgSetChannelHalt, opts ...grpc.CallOption) (*MsgSetChannelHaltResponse, error)
+	// SetModuleHalt halts or resumes the contract executions of a module.
+	SetModuleHalt(ctx context.Context, in *MsgSetModuleHalt, opts ...grpc.CallOption) (*MsgSetModuleHaltResponse, error)
 }
 
 type msgClient struct {
@@ -290,12 +538,34 @@ func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts
 	return out, nil
 }
 
+func (c *msgClient) SetChannelHalt(ctx context.Context, in *MsgSetChannelHalt, opts ...grpc.CallOption) (*MsgSetChannelHaltResponse, error) {
+	out := new(MsgSetChannelHaltResponse)
+	err := c.cc.Invoke(ctx, "/secret.emergencybutton.v1beta1.Msg/SetChannelHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) SetModuleHalt(ctx context.Context, in *MsgSetModuleHalt, opts ...grpc.CallOption) (*MsgSetModuleHaltResponse, error) {
+	out := new(MsgSetModuleHaltResponse)
+	err := c.cc.Invoke(ctx, "/secret.emergencybutton.v1beta1.Msg/SetModuleHalt", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// ToggleIbcSwitch defines a method for toggling the status of the
 	// emergencybutton.
 	ToggleIbcSwitch(context.Context, *MsgToggleIbcSwitch) (*MsgToggleIbcSwitchResponse, error)
 	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
+	// SetChannelHalt halts or resumes the packets of a channel in one direction.
+	SetChannelHalt(context.Context, *MsgSetChannelHalt) (*MsgSetChannelHaltResponse, error)
+	// SetModuleHalt halts or resumes the contract executions of a module.
+	SetModuleHalt(context.Context, *MsgSetModuleHalt) (*MsgSetModuleHaltResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -308,6 +578,12 @@ func (*UnimplementedMsgServer) ToggleIbcSwitch(ctx context.Context, req *MsgTogg
 func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
 }
+func (*UnimplementedMsgServer) SetChannelHalt(ctx context.Context, req *MsgSetChannelHalt) (*MsgSetChannelHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetChannelHalt not implemented")
+}
+func (*UnimplementedMsgServer) SetModuleHalt(ctx context.Context, req *MsgSetModuleHalt) (*MsgSetModuleHaltResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method SetModuleHalt not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -349,6 +625,42 @@ func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_SetChannelHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetChannelHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetChannelHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.emergencybutton.v1beta1.Msg/SetChannelHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetChannelHalt(ctx, req.(*MsgSetChannelHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_SetModuleHalt_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgSetModuleHalt)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).SetModuleHalt(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.emergencybutton.v1beta1.Msg/SetModuleHalt",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).SetModuleHalt(ctx, req.(*MsgSetModuleHalt))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.emergencybutton.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -361,6 +673,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UpdateParams",
 			Handler:    _Msg_UpdateParams_Handler,
 		},
+		{
+			MethodName: "SetChannelHalt",
+			Handler:    _Msg_SetChannelHalt_Handler,
+		},
+		{
+			MethodName: "SetModuleHalt",
+			Handler:    _Msg_SetModuleHalt_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/emergencybutton/v1beta1/tx.proto",
@@ -386,6 +706,21 @@ func (m *MsgToggleIbcSwitch) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.ExpiryDuration != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(*m.ExpiryDuration, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpiryDuration):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintTx(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.ExpiryHeight != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.ExpiryHeight))
+		i--
+		dAtA[i] = 0x10
+	}
 	if len(m.Sender) > 0 {
 		i -= len(m.Sender)
 		copy(dAtA[i:], m.Sender)
@@ -482,64 +817,267 @@ func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
-	offset -= sovTx(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgSetChannelHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *MsgToggleIbcSwitch) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *MsgSetChannelHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetChannelHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.Sender)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
 	}
-	return n
+	{
+		size, err := m.Halt.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintTx(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
 }
 
-func (m *MsgToggleIbcSwitchResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *MsgSetChannelHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	var l int
-	_ = l
-	return n
+	return dAtA[:n], nil
 }
 
-func (m *MsgUpdateParams) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.Authority)
-	if l > 0 {
-		n += 1 + l + sovTx(uint64(l))
-	}
-	l = m.Params.Size()
-	n += 1 + l + sovTx(uint64(l))
-	return n
+func (m *MsgSetChannelHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MsgUpdateParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+func (m *MsgSetChannelHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	return n
+	return len(dAtA) - i, nil
 }
 
-func sovTx(x uint64) (n int) {
+func (m *MsgSetModuleHalt) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModuleHalt) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModuleHalt) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Halted {
+		i--
+		if m.Halted {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Module != 0 {
+		i = encodeVarintTx(dAtA, i, uint64(m.Module))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintTx(dAtA, i, uint64(len(m.Sender)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgSetModuleHaltResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgSetModuleHaltResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgSetModuleHaltResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintTx(dAtA []byte, offset int, v uint64) int {
+	offset -= sovTx(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MsgToggleIbcSwitch) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.ExpiryHeight != 0 {
+		n += 1 + sovTx(uint64(m.ExpiryHeight))
+	}
+	if m.ExpiryDuration != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(*m.ExpiryDuration)
+		n += 1 + l + sovTx(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgToggleIbcSwitchResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovTx(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetChannelHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	l = m.Halt.Size()
+	n += 1 + l + sovTx(uint64(l))
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetChannelHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgSetModuleHalt) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovTx(uint64(l))
+	}
+	if m.Module != 0 {
+		n += 1 + sovTx(uint64(m.Module))
+	}
+	if m.Halted {
+		n += 2
+	}
+	return n
+}
+
+func (m *MsgSetModuleHaltResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func sovTx(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
 func sozTx(x uint64) (n int) {
@@ -606,6 +1144,61 @@ func (m *MsgToggleIbcSwitch) Unmarshal(dAtA []byte) error {
 			}
 			m.Sender = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryHeight", wireType)
+			}
+			m.ExpiryHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ExpiryHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiryDuration", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ExpiryDuration == nil {
+				m.ExpiryDuration = new(time.Duration)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(m.ExpiryDuration, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTx(dAtA[iNdEx:])
@@ -842,6 +1435,362 @@ func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgSetChannelHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Halt.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetChannelHaltResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetChannelHaltResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetChannelHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetModuleHalt) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetModuleHalt: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetModuleHalt: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTx
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTx
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
+			}
+			m.Module = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Module |= HaltedModule(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Halted", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTx
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Halted = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgSetModuleHaltResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTx
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgSetModuleHaltResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgSetModuleHaltResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTx(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTx
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipTx(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"strings"
 
+	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/client/flags"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	"github.com/cosmos/cosmos-sdk/version"
@@ -38,6 +39,7 @@ func GetQueryCmd() *cobra.Command {
 
 	cmd.AddCommand(
 		GetCmdWasmSender(),
+		GetCmdPendingCallbacks(),
 	)
 	return cmd
 }
@@ -74,3 +76,62 @@ $ %s query ibc-hooks wasm-hooks-sender channel-42 juno12smx2wdlyttvyzvzg54y2vnqw
 
 	return cmd
 }
+
+const (
+	flagContract = "contract"
+	flagChannel  = "channel"
+)
+
+// GetCmdPendingCallbacks lists the packet callbacks waiting for an ack or a timeout
+func GetCmdPendingCallbacks() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "pending-callbacks",
+		Short: "List the packet callbacks waiting for an ack or a timeout",
+		Long: strings.TrimSpace(
+			fmt.Sprintf(`List the packet callbacks waiting for an ack or a timeout, optionally filtered by contract and channel.
+Example:
+$ %s query %s pending-callbacks --contract secret1... --channel channel-0
+`,
+				version.AppName, types.ModuleName,
+			),
+		),
+		Args: cobra.NoArgs,
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+			contract, err := cmd.Flags().GetString(flagContract)
+			if err != nil {
+				return err
+			}
+			channel, err := cmd.Flags().GetString(flagChannel)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.PendingCallbacks(cmd.Context(), &types.QueryPendingCallbacksRequest{
+				Contract:   contract,
+				ChannelId:  channel,
+				Pagination: pageReq,
+			})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+
+	cmd.Flags().String(flagContract, "", "Only list the callbacks of this contract")
+	cmd.Flags().String(flagChannel, "", "Only list the callbacks of the packets sent on this channel")
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "pending-callbacks")
+	return cmd
+}
@@ -0,0 +1,157 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"cosmossdk.io/store/prefix"
+	storetypes "cosmossdk.io/store/types"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+var _ types.QueryServer = Keeper{}
+
+// forwardKeyPrefix is the prefix of the keys of the in-flight forwards, see GetForwardKey.
+// The keys of the callbacks have no prefix, so they are told apart from the in-flight forwards by it.
+var forwardKeyPrefix = []byte("forward/")
+
+// parsePacketKey returns the channel and the sequence of a key built by GetPacketKey
+func parsePacketKey(key []byte) (string, uint64, error) {
+	k := string(key)
+	i := strings.LastIndex(k, "::")
+	if i < 0 {
+		return "", 0, fmt.Errorf("invalid packet key %s", k)
+	}
+	sequence, err := strconv.ParseUint(k[i+2:], 10, 64)
+	if err != nil {
+		return "", 0, fmt.Errorf("invalid packet key %s: %w", k, err)
+	}
+	return k[:i], sequence, nil
+}
+
+// GetPacketCallbacks returns all the pending callbacks
+func (k Keeper) GetPacketCallbacks(ctx sdk.Context) []types.PacketCallback {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+	defer iterator.Close()
+
+	var callbacks []types.PacketCallback
+	for ; iterator.Valid(); iterator.Next() {
+		if bytes.HasPrefix(iterator.Key(), forwardKeyPrefix) {
+			continue
+		}
+		channel, sequence, err := parsePacketKey(iterator.Key())
+		if err != nil {
+			panic(err)
+		}
+		callbacks = append(callbacks, types.PacketCallback{ChannelId: channel, Sequence: sequence, Contract: string(iterator.Value())})
+	}
+	return callbacks
+}
+
+// PaginatedPacketCallbacks returns a page of the pending callbacks, filtered by contract and channel if they are set
+func (k Keeper) PaginatedPacketCallbacks(ctx sdk.Context, contract, channel string, pagination *query.PageRequest) ([]types.PacketCallback, *query.PageResponse, error) {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	if channel != "" {
+		store = prefix.NewStore(store, []byte(channel+"::"))
+	}
+
+	var callbacks []types.PacketCallback
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		if channel == "" && bytes.HasPrefix(key, forwardKeyPrefix) {
+			return false, nil
+		}
+		if contract != "" && string(value) != contract {
+			return false, nil
+		}
+		if !accumulate {
+			return true, nil
+		}
+
+		callback := types.PacketCallback{ChannelId: channel, Contract: string(value)}
+		var err error
+		if channel == "" {
+			callback.ChannelId, callback.Sequence, err = parsePacketKey(key)
+		} else {
+			callback.Sequence, err = strconv.ParseUint(string(key), 10, 64)
+		}
+		if err != nil {
+			return false, err
+		}
+		callbacks = append(callbacks, callback)
+		return true, nil
+	})
+	return callbacks, pageRes, err
+}
+
+// PruneChannelCallbacks deletes the callbacks of a channel whose packets aren't pending anymore and returns them
+func (k Keeper) PruneChannelCallbacks(ctx sdk.Context, channel string, isPending func(sequence uint64) bool) []types.PacketCallback {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), []byte(channel+"::"))
+	iterator := storetypes.KVStorePrefixIterator(store, []byte{})
+
+	var (
+		callbacks []types.PacketCallback
+		keys      [][]byte
+	)
+	for ; iterator.Valid(); iterator.Next() {
+		sequence, err := strconv.ParseUint(string(iterator.Key()), 10, 64)
+		if err != nil {
+			panic(err)
+		}
+		if isPending(sequence) {
+			continue
+		}
+		callbacks = append(callbacks, types.PacketCallback{ChannelId: channel, Sequence: sequence, Contract: string(iterator.Value())})
+		keys = append(keys, append([]byte(nil), iterator.Key()...))
+	}
+	iterator.Close()
+
+	for _, key := range keys {
+		store.Delete(key)
+	}
+	return callbacks
+}
+
+// GetInFlightForwards returns all the pending forwarded transfers
+func (k Keeper) GetInFlightForwards(ctx sdk.Context) []types.InFlightForward {
+	store := runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx))
+	iterator := storetypes.KVStorePrefixIterator(store, forwardKeyPrefix)
+	defer iterator.Close()
+
+	var forwards []types.InFlightForward
+	for ; iterator.Valid(); iterator.Next() {
+		var forward types.InFlightForward
+		if err := forward.Unmarshal(iterator.Value()); err != nil {
+			panic(err)
+		}
+		forwards = append(forwards, forward)
+	}
+	return forwards
+}
+
+// PendingCallbacks implements the Query/PendingCallbacks gRPC method
+func (k Keeper) PendingCallbacks(c context.Context, req *types.QueryPendingCallbacksRequest) (*types.QueryPendingCallbacksResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "empty request")
+	}
+	if req.Contract != "" {
+		if _, err := sdk.AccAddressFromBech32(req.Contract); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	callbacks, pageRes, err := k.PaginatedPacketCallbacks(sdk.UnwrapSDKContext(c), req.Contract, req.ChannelId, req.Pagination)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+	return &types.QueryPendingCallbacksResponse{Callbacks: callbacks, Pagination: pageRes}, nil
+}
@@ -0,0 +1,191 @@
+package keeper
+
+import (
+	"testing"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+func setupKeeper(t *testing.T) (Keeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	k := NewKeeper(runtime.NewKVStoreService(storeKey))
+	ctx := sdk.NewContext(stateStore, tmproto.Header{}, false, log.NewNopLogger())
+	return k, ctx
+}
+
+func TestParsePacketKey(t *testing.T) {
+	channel, sequence, err := parsePacketKey(GetPacketKey("channel-7", 42))
+	require.NoError(t, err)
+	require.Equal(t, "channel-7", channel)
+	require.Equal(t, uint64(42), sequence)
+
+	channel, sequence, err = parsePacketKey(GetForwardKey("channel-7", 42)[len(forwardKeyPrefix):])
+	require.NoError(t, err)
+	require.Equal(t, "channel-7", channel)
+	require.Equal(t, uint64(42), sequence)
+
+	for _, key := range []string{"", "channel-7", "channel-7::", "channel-7::seq", "channel-7::-1"} {
+		_, _, err := parsePacketKey([]byte(key))
+		require.Error(t, err, key)
+	}
+}
+
+func TestGenesisRoundTrip(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	packet := channeltypes.NewPacket([]byte(`{"amount":"40"}`), 5, "transfer", "channel-2", "transfer", "channel-3", clienttypes.ZeroHeight(), 1)
+
+	genState := types.GenesisState{
+		Callbacks: []types.PacketCallback{
+			{ChannelId: "channel-0", Sequence: 1, Contract: contract},
+			{ChannelId: "channel-0", Sequence: 2, Contract: contract},
+			{ChannelId: "channel-1", Sequence: 1, Contract: contract},
+		},
+		InFlightForwards: []types.InFlightForward{
+			{ChannelId: "channel-0", Sequence: 3, Packet: packet, Ack: []byte(`{}`)},
+		},
+	}
+	require.NoError(t, genState.Validate())
+
+	k.InitGenesis(ctx, genState)
+	require.Equal(t, contract, k.GetPacketCallback(ctx, "channel-0", 2))
+	forward, ok := k.GetInFlightForward(ctx, "channel-0", 3)
+	require.True(t, ok)
+	require.Equal(t, packet, forward.Packet)
+
+	// the in-flight forwards aren't exported as callbacks
+	exported := k.ExportGenesis(ctx)
+	require.Equal(t, genState, *exported)
+
+	k2, ctx2 := setupKeeper(t)
+	k2.InitGenesis(ctx2, *exported)
+	require.Equal(t, exported, k2.ExportGenesis(ctx2))
+
+	k3, ctx3 := setupKeeper(t)
+	k3.InitGenesis(ctx3, *types.DefaultGenesis())
+	require.Equal(t, types.DefaultGenesis(), k3.ExportGenesis(ctx3))
+}
+
+func TestPaginatedPacketCallbacks(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	other := sdk.AccAddress([]byte("other_contract______")).String()
+
+	k.StorePacketCallback(ctx, "channel-1", 1, contract)
+	k.StorePacketCallback(ctx, "channel-1", 2, other)
+	k.StorePacketCallback(ctx, "channel-1", 3, contract)
+	k.StorePacketCallback(ctx, "channel-10", 1, contract)
+	k.StorePacketCallback(ctx, "channel-2", 1, other)
+	k.StoreInFlightForward(ctx, types.InFlightForward{ChannelId: "channel-1", Sequence: 4})
+
+	for _, tc := range []struct {
+		name     string
+		contract string
+		channel  string
+		expected []types.PacketCallback
+	}{
+		{
+			// in the order of the keys, "channel-10::" comes before "channel-1::"
+			name: "all",
+			expected: []types.PacketCallback{
+				{ChannelId: "channel-10", Sequence: 1, Contract: contract},
+				{ChannelId: "channel-1", Sequence: 1, Contract: contract},
+				{ChannelId: "channel-1", Sequence: 2, Contract: other},
+				{ChannelId: "channel-1", Sequence: 3, Contract: contract},
+				{ChannelId: "channel-2", Sequence: 1, Contract: other},
+			},
+		},
+		{
+			name:     "by contract",
+			contract: contract,
+			expected: []types.PacketCallback{
+				{ChannelId: "channel-10", Sequence: 1, Contract: contract},
+				{ChannelId: "channel-1", Sequence: 1, Contract: contract},
+				{ChannelId: "channel-1", Sequence: 3, Contract: contract},
+			},
+		},
+		{
+			name:    "by channel",
+			channel: "channel-1",
+			expected: []types.PacketCallback{
+				{ChannelId: "channel-1", Sequence: 1, Contract: contract},
+				{ChannelId: "channel-1", Sequence: 2, Contract: other},
+				{ChannelId: "channel-1", Sequence: 3, Contract: contract},
+			},
+		},
+		{
+			name:     "by contract and channel",
+			contract: other,
+			channel:  "channel-1",
+			expected: []types.PacketCallback{
+				{ChannelId: "channel-1", Sequence: 2, Contract: other},
+			},
+		},
+		{name: "unknown channel", channel: "channel-3"},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			callbacks, pageRes, err := k.PaginatedPacketCallbacks(ctx, tc.contract, tc.channel, &query.PageRequest{CountTotal: true})
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, callbacks)
+			require.Equal(t, uint64(len(tc.expected)), pageRes.Total)
+		})
+	}
+
+	// the pages of a filtered query only hold the matching callbacks
+	callbacks, pageRes, err := k.PaginatedPacketCallbacks(ctx, contract, "", &query.PageRequest{Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{
+		{ChannelId: "channel-10", Sequence: 1, Contract: contract},
+		{ChannelId: "channel-1", Sequence: 1, Contract: contract},
+	}, callbacks)
+	require.NotNil(t, pageRes.NextKey)
+
+	callbacks, pageRes, err = k.PaginatedPacketCallbacks(ctx, contract, "", &query.PageRequest{Key: pageRes.NextKey, Limit: 2})
+	require.NoError(t, err)
+	require.Equal(t, []types.PacketCallback{
+		{ChannelId: "channel-1", Sequence: 3, Contract: contract},
+	}, callbacks)
+	require.Nil(t, pageRes.NextKey)
+}
+
+func TestPruneChannelCallbacks(t *testing.T) {
+	k, ctx := setupKeeper(t)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	k.StorePacketCallback(ctx, "channel-1", 1, contract)
+	k.StorePacketCallback(ctx, "channel-1", 2, contract)
+	k.StorePacketCallback(ctx, "channel-1", 3, contract)
+	k.StorePacketCallback(ctx, "channel-10", 1, contract)
+
+	// only the callbacks of the channel whose packets aren't pending are pruned
+	pruned := k.PruneChannelCallbacks(ctx, "channel-1", func(sequence uint64) bool { return sequence == 2 })
+	require.Equal(t, []types.PacketCallback{
+		{ChannelId: "channel-1", Sequence: 1, Contract: contract},
+		{ChannelId: "channel-1", Sequence: 3, Contract: contract},
+	}, pruned)
+	require.Equal(t, []types.PacketCallback{
+		{ChannelId: "channel-10", Sequence: 1, Contract: contract},
+		{ChannelId: "channel-1", Sequence: 2, Contract: contract},
+	}, k.GetPacketCallbacks(ctx))
+
+	require.Empty(t, k.PruneChannelCallbacks(ctx, "channel-1", func(uint64) bool { return true }))
+}
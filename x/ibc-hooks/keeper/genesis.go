@@ -0,0 +1,26 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+// InitGenesis initializes the x/ibc-hooks module's state from a provided genesis state,
+// which includes the pending packet callbacks and in-flight forwards.
+func (k Keeper) InitGenesis(ctx sdk.Context, genState types.GenesisState) {
+	for _, callback := range genState.Callbacks {
+		k.StorePacketCallback(ctx, callback.ChannelId, callback.Sequence, callback.Contract)
+	}
+	for _, forward := range genState.InFlightForwards {
+		k.StoreInFlightForward(ctx, forward)
+	}
+}
+
+// ExportGenesis returns the x/ibc-hooks module's exported genesis.
+func (k Keeper) ExportGenesis(ctx sdk.Context) *types.GenesisState {
+	return &types.GenesisState{
+		Callbacks:        k.GetPacketCallbacks(ctx),
+		InFlightForwards: k.GetInFlightForwards(ctx),
+	}
+}
@@ -62,6 +62,48 @@ func (k Keeper) DeletePacketCallback(ctx sdk.Context, channel string, packetSequ
 	}
 }
 
+func GetForwardKey(channel string, packetSequence uint64) []byte {
+	return []byte(fmt.Sprintf("forward/%s::%d", channel, packetSequence))
+}
+
+// StoreInFlightForward stores a forwarded transfer until it is acked or timed out
+func (k Keeper) StoreInFlightForward(ctx sdk.Context, forward types.InFlightForward) {
+	bz, err := forward.Marshal()
+	if err != nil {
+		panic(err)
+	}
+	store := k.storeService.OpenKVStore(ctx)
+	err = store.Set(GetForwardKey(forward.ChannelId, forward.Sequence), bz)
+	if err != nil {
+		ctx.Logger().Error("store in-flight forward", "store", err.Error())
+	}
+}
+
+// GetInFlightForward returns the forwarded transfer sent with a sequence on a channel, if there is one
+func (k Keeper) GetInFlightForward(ctx sdk.Context, channel string, packetSequence uint64) (types.InFlightForward, bool) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(GetForwardKey(channel, packetSequence))
+	if bz == nil {
+		return types.InFlightForward{}, false
+	}
+	var forward types.InFlightForward
+	if err := forward.Unmarshal(bz); err != nil {
+		panic(err)
+	}
+	return forward, true
+}
+
+// DeleteInFlightForward deletes the forwarded transfer from storage once it has been acked or timed out
+func (k Keeper) DeleteInFlightForward(ctx sdk.Context, channel string, packetSequence uint64) {
+	store := k.storeService.OpenKVStore(ctx)
+	err := store.Delete(GetForwardKey(channel, packetSequence))
+	if err != nil {
+		ctx.Logger().Error("delete in-flight forward", "store", err.Error())
+	}
+}
+
+// DeriveIntermediateSender derives an address on this chain from the channel and the remote sender of a packet.
+// The enclave derives it the same way for the ibc_hooks_origin of the env, keep both in sync.
 func DeriveIntermediateSender(channel, originalSender, bech32Prefix string) (string, error) {
 	senderStr := fmt.Sprintf("%s/%s", channel, originalSender)
 	senderHash32 := address.Hash(types.SenderPrefix, []byte(senderStr))
@@ -0,0 +1,16 @@
+package types
+
+const (
+	EventTypeCallbackRegistered = "ibc-callback-registered"
+	EventTypeCallbackCompleted  = "ibc-callback-completed"
+	EventTypeCallbackPruned     = "ibc-callback-pruned"
+
+	AttributeKeyContract = "contract"
+	AttributeKeyChannel  = "channel"
+	AttributeKeySequence = "sequence"
+	AttributeKeyResult   = "result"
+
+	AttributeValueAckSuccess = "ack_success"
+	AttributeValueAckError   = "ack_error"
+	AttributeValueTimeout    = "timeout"
+)
@@ -0,0 +1,48 @@
+package types
+
+import (
+	"fmt"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
+)
+
+// DefaultGenesis returns the default genesis state, without any pending callback
+func DefaultGenesis() *GenesisState {
+	return &GenesisState{}
+}
+
+// Validate performs basic genesis state validation returning an error upon any
+// failure.
+func (gs GenesisState) Validate() error {
+	seen := make(map[string]bool)
+	for _, callback := range gs.Callbacks {
+		if err := host.ChannelIdentifierValidator(callback.ChannelId); err != nil {
+			return err
+		}
+		if _, err := sdk.AccAddressFromBech32(callback.Contract); err != nil {
+			return fmt.Errorf("invalid contract of the callback of %s/%d: %w", callback.ChannelId, callback.Sequence, err)
+		}
+		key := fmt.Sprintf("%s/%d", callback.ChannelId, callback.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate callback for %s", key)
+		}
+		seen[key] = true
+	}
+
+	seen = make(map[string]bool)
+	for _, forward := range gs.InFlightForwards {
+		if err := host.ChannelIdentifierValidator(forward.ChannelId); err != nil {
+			return err
+		}
+		if err := forward.Packet.ValidateBasic(); err != nil {
+			return fmt.Errorf("invalid packet of the forward of %s/%d: %w", forward.ChannelId, forward.Sequence, err)
+		}
+		key := fmt.Sprintf("%s/%d", forward.ChannelId, forward.Sequence)
+		if seen[key] {
+			return fmt.Errorf("duplicate in-flight forward for %s", key)
+		}
+		seen[key] = true
+	}
+	return nil
+}
@@ -0,0 +1,781 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/ibchooks/v1/query.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// QueryPendingCallbacksRequest is the request type for the
+// Query/PendingCallbacks RPC method.
+type QueryPendingCallbacksRequest struct {
+	// contract filters the callbacks by contract, if set.
+	Contract string `protobuf:"bytes,1,opt,name=contract,proto3" json:"contract,omitempty"`
+	// channel_id filters the callbacks by channel, if set.
+	ChannelId  string             `protobuf:"bytes,2,opt,name=channel_id,json=channelId,proto3" json:"channel_id,omitempty"`
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingCallbacksRequest) Reset()         { *m = QueryPendingCallbacksRequest{} }
+func (m *QueryPendingCallbacksRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbacksRequest) ProtoMessage()    {}
+func (*QueryPendingCallbacksRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_383b8bdb5f7206b2, []int{0}
+}
+func (m *QueryPendingCallbacksRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbacksRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbacksRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbacksRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbacksRequest.Merge(m, src)
+}
+func (m *QueryPendingCallbacksRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbacksRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbacksRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbacksRequest proto.InternalMessageInfo
+
+func (m *QueryPendingCallbacksRequest) GetContract() string {
+	if m != nil {
+		return m.Contract
+	}
+	return ""
+}
+
+func (m *QueryPendingCallbacksRequest) GetChannelId() string {
+	if m != nil {
+		return m.ChannelId
+	}
+	return ""
+}
+
+func (m *QueryPendingCallbacksRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingCallbacksResponse is the response type for the
+// Query/PendingCallbacks RPC method.
+type QueryPendingCallbacksResponse struct {
+	Callbacks  []PacketCallback    `protobuf:"bytes,1,rep,name=callbacks,proto3" json:"callbacks"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingCallbacksResponse) Reset()         { *m = QueryPendingCallbacksResponse{} }
+func (m *QueryPendingCallbacksResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingCallbacksResponse) ProtoMessage()    {}
+func (*QueryPendingCallbacksResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_383b8bdb5f7206b2, []int{1}
+}
+func (m *QueryPendingCallbacksResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingCallbacksResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingCallbacksResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingCallbacksResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingCallbacksResponse.Merge(m, src)
+}
+func (m *QueryPendingCallbacksResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingCallbacksResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingCallbacksResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingCallbacksResponse proto.InternalMessageInfo
+
+func (m *QueryPendingCallbacksResponse) GetCallbacks() []PacketCallback {
+	if m != nil {
+		return m.Callbacks
+	}
+	return nil
+}
+
+func (m *QueryPendingCallbacksResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*QueryPendingCallbacksRequest)(nil), "secret.ibchooks.v1.QueryPendingCallbacksRequest")
+	proto.RegisterType((*QueryPendingCallbacksResponse)(nil), "secret.ibchooks.v1.QueryPendingCallbacksResponse")
+}
+
+func init() { proto.RegisterFile("secret/ibchooks/v1/query.proto", fileDescriptor_383b8bdb5f7206b2) }
+
+var fileDescriptor_383b8bdb5f7206b2 = []byte{
+	// 430 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0xd2, 0x4d, 0x6e, 0x13, 0x31,
+	0x14, 0x07, 0xf0, 0x38, 0x05, 0x44, 0xdc, 0x0d, 0xb2, 0x58, 0x44, 0x51, 0x6b, 0x46, 0x59, 0x94,
+	0x08, 0x09, 0x9b, 0x49, 0x6f, 0x50, 0xa4, 0x22, 0x16, 0xa0, 0x10, 0x76, 0x6c, 0x2a, 0x8f, 0xf3,
+	0xe4, 0x58, 0x99, 0xfa, 0x4d, 0xc7, 0x4e, 0xa0, 0x5b, 0x4e, 0x80, 0xc4, 0x01, 0x10, 0x4b, 0x76,
+	0x1c, 0xa3, 0xcb, 0x4a, 0x6c, 0x58, 0x21, 0x94, 0x70, 0x10, 0x34, 0xe3, 0x49, 0x3f, 0x20, 0x80,
+	0xd8, 0xcd, 0xf8, 0xff, 0xde, 0xf3, 0x6f, 0xec, 0xa1, 0xdc, 0x83, 0x2e, 0x21, 0x48, 0x9b, 0xe9,
+	0x29, 0xe2, 0xcc, 0xcb, 0x45, 0x2a, 0x4f, 0xe6, 0x50, 0x9e, 0x8a, 0xa2, 0xc4, 0x80, 0x8c, 0xc5,
+	0x5c, 0xac, 0x73, 0xb1, 0x48, 0x7b, 0x77, 0x0d, 0x1a, 0xac, 0x63, 0x59, 0x3d, 0xc5, 0xca, 0xde,
+	0x8e, 0x41, 0x34, 0x39, 0x48, 0x55, 0x58, 0xa9, 0x9c, 0xc3, 0xa0, 0x82, 0x45, 0xe7, 0x9b, 0xf4,
+	0x81, 0x46, 0x7f, 0x8c, 0x5e, 0x66, 0xca, 0x43, 0xdc, 0x40, 0x2e, 0xd2, 0x0c, 0x82, 0x4a, 0x65,
+	0xa1, 0x8c, 0x75, 0x75, 0x71, 0x53, 0x9b, 0x6c, 0x30, 0x19, 0x70, 0xe0, 0x6d, 0x33, 0xad, 0xff,
+	0x91, 0xd0, 0x9d, 0x17, 0xd5, 0x90, 0x11, 0xb8, 0x89, 0x75, 0xe6, 0xb1, 0xca, 0xf3, 0x4c, 0xe9,
+	0x99, 0x1f, 0xc3, 0xc9, 0x1c, 0x7c, 0x60, 0x3d, 0x7a, 0x5b, 0xa3, 0x0b, 0xa5, 0xd2, 0xa1, 0x4b,
+	0x12, 0x32, 0xe8, 0x8c, 0x2f, 0xde, 0xd9, 0x2e, 0xa5, 0x7a, 0xaa, 0x9c, 0x83, 0xfc, 0xc8, 0x4e,
+	0xba, 0xed, 0x3a, 0xed, 0x34, 0x2b, 0x4f, 0x27, 0xec, 0x90, 0xd2, 0x4b, 0x51, 0x77, 0x2b, 0x21,
+	0x83, 0xed, 0xe1, 0x9e, 0x88, 0x7c, 0x51, 0xf1, 0x45, 0x3c, 0x9f, 0x86, 0x2f, 0x46, 0xca, 0x40,
+	0xb3, 0xed, 0xf8, 0x4a, 0x67, 0xff, 0x33, 0xa1, 0xbb, 0x7f, 0x30, 0xfa, 0x02, 0x9d, 0x07, 0x76,
+	0x48, 0x3b, 0x7a, 0xbd, 0xd8, 0x25, 0xc9, 0xd6, 0x60, 0x7b, 0xd8, 0x17, 0xbf, 0x9f, 0xb7, 0x18,
+	0x29, 0x3d, 0x83, 0xb0, 0xee, 0x3f, 0xb8, 0x71, 0xf6, 0xed, 0x5e, 0x6b, 0x7c, 0xd9, 0xca, 0x9e,
+	0x5c, 0x13, 0xb7, 0x6b, 0xf1, 0xfd, 0x7f, 0x8a, 0x23, 0xe2, 0x2a, 0x79, 0xf8, 0x89, 0xd0, 0x9b,
+	0x35, 0x99, 0x7d, 0x20, 0xf4, 0xce, 0xaf, 0x6e, 0xf6, 0x68, 0x13, 0xee, 0x6f, 0xd7, 0xd0, 0x4b,
+	0xff, 0xa3, 0x23, 0x7a, 0xfa, 0x7b, 0x6f, 0xbf, 0xfc, 0x78, 0xdf, 0x4e, 0x18, 0xbf, 0x76, 0xfd,
+	0x45, 0x2c, 0x3f, 0xba, 0xf8, 0xe8, 0x83, 0x67, 0x67, 0x4b, 0x4e, 0xce, 0x97, 0x9c, 0x7c, 0x5f,
+	0x72, 0xf2, 0x6e, 0xc5, 0x5b, 0xe7, 0x2b, 0xde, 0xfa, 0xba, 0xe2, 0xad, 0x57, 0xfb, 0xc6, 0x86,
+	0xe9, 0x3c, 0x13, 0x1a, 0x8f, 0xa5, 0xd7, 0x65, 0xc8, 0x55, 0xe6, 0xe5, 0xcb, 0xda, 0xf1, 0x1c,
+	0xc2, 0x6b, 0x2c, 0x67, 0xf2, 0x4d, 0x35, 0xfc, 0x61, 0x9c, 0x1e, 0x4e, 0x0b, 0xf0, 0xd9, 0xad,
+	0xfa, 0xc7, 0xda, 0xff, 0x39, 0x00, 0x9f, 0xcb, 0xa5, 0x60, 0x10, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// PendingCallbacks returns the packet callbacks waiting for an ack or a
+	// timeout.
+	PendingCallbacks(ctx context.Context, in *QueryPendingCallbacksRequest, opts ...grpc.CallOption) (*QueryPendingCallbacksResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) PendingCallbacks(ctx context.Context, in *QueryPendingCallbacksRequest, opts ...grpc.CallOption) (*QueryPendingCallbacksResponse, error) {
+	out := new(QueryPendingCallbacksResponse)
+	err := c.cc.Invoke(ctx, "/secret.ibchooks.v1.Query/PendingCallbacks", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// PendingCallbacks returns the packet callbacks waiting for an ack or a
+	// timeout.
+	PendingCallbacks(context.Context, *QueryPendingCallbacksRequest) (*QueryPendingCallbacksResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) PendingCallbacks(ctx context.Context, req *QueryPendingCallbacksRequest) (*QueryPendingCallbacksResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingCallbacks not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_PendingCallbacks_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingCallbacksRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingCallbacks(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.ibchooks.v1.Query/PendingCallbacks",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingCallbacks(ctx, req.(*QueryPendingCallbacksRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.ibchooks.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PendingCallbacks",
+			Handler:    _Query_PendingCallbacks_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/ibchooks/v1/query.proto",
+}
+
+func (m *QueryPendingCallbacksRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbacksRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbacksRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ChannelId) > 0 {
+		i -= len(m.ChannelId)
+		copy(dAtA[i:], m.ChannelId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ChannelId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Contract) > 0 {
+		i -= len(m.Contract)
+		copy(dAtA[i:], m.Contract)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Contract)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingCallbacksResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingCallbacksResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingCallbacksResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Callbacks) > 0 {
+		for iNdEx := len(m.Callbacks) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Callbacks[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryPendingCallbacksRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Contract)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ChannelId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingCallbacksResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Callbacks) > 0 {
+		for _, e := range m.Callbacks {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryPendingCallbacksRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbacksRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbacksRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Contract", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Contract = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChannelId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ChannelId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingCallbacksResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingCallbacksResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingCallbacksResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Callbacks", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Callbacks = append(m.Callbacks, PacketCallback{})
+			if err := m.Callbacks[len(m.Callbacks)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipQuery(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthQuery
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupQuery
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthQuery
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthQuery        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowQuery          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupQuery = fmt.Errorf("proto: unexpected end of group")
+)
@@ -1,8 +1,14 @@
 package ibc_hooks
 
 import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"strconv"
+	"strings"
+	"time"
 
 	errorsmod "cosmossdk.io/errors"
 	"cosmossdk.io/math"
@@ -14,6 +20,7 @@ import (
 	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
 	ibcclienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
 	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	host "github.com/cosmos/ibc-go/v8/modules/core/24-host"
 	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
 
 	computetypes "github.com/scrtlabs/SecretNetwork/go-cosmwasm/types"
@@ -21,12 +28,56 @@ import (
 )
 
 type ContractAck struct {
+	// ContractResult is the data returned by the last call
 	ContractResult []byte `json:"contract_result"`
 	IbcAck         []byte `json:"ibc_ack"`
+	// CallResults is set when the memo has several calls
+	CallResults []CallResult `json:"call_results,omitempty"`
+	// ForwardSequence is the sequence of the forwarded transfer, if any
+	ForwardSequence uint64 `json:"forward_sequence,omitempty"`
+	// ForwardError is set when the forwarded transfer failed but the funds couldn't be refunded by the origin chain,
+	// see resolveForward
+	ForwardError string `json:"forward_error,omitempty"`
+}
+
+type CallResult struct {
+	Contract string `json:"contract"`
+	Data     []byte `json:"data"`
+}
+
+// ExecutionHalter can halt the contract executions triggered by the hooks, see x/emergencybutton
+type ExecutionHalter interface {
+	CheckIbcHooksHalted(ctx sdk.Context) error
+}
+
+// TransferKeeper sends the forwarded transfers and tracks the funds escrowed back when a forward fails
+type TransferKeeper interface {
+	Transfer(goCtx context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error)
+	GetTotalEscrowForDenom(ctx sdk.Context, denom string) sdk.Coin
+	SetTotalEscrowForDenom(ctx sdk.Context, coin sdk.Coin)
+}
+
+// ChannelKeeper tells which packets of a closed channel are still pending, and gives the capability to acknowledge
+// the incoming packets of the forwards
+type ChannelKeeper interface {
+	GetPacketCommitment(ctx sdk.Context, portID, channelID string, sequence uint64) []byte
+	LookupModuleByChannel(ctx sdk.Context, portID, channelID string) (string, *capabilitytypes.Capability, error)
+}
+
+// BankKeeper measures the outputs of the calls and takes back the funds of the failed forwards
+type BankKeeper interface {
+	GetBalance(ctx context.Context, addr sdk.AccAddress, denom string) sdk.Coin
+	SendCoins(ctx context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error
+	SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error
+	BurnCoins(ctx context.Context, moduleName string, amounts sdk.Coins) error
 }
 
 type WasmHooks struct {
 	ContractKeeper      *compute.Keeper
+	Halter              ExecutionHalter
+	TransferKeeper      TransferKeeper
+	BankKeeper          BankKeeper
+	ChannelKeeper       ChannelKeeper
 	ibcHooksKeeper      *keeper.Keeper
 	bech32PrefixAccAddr string
 }
@@ -54,23 +105,36 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	}
 
 	// Validate the memo
-	isWasmRouted, contractAddr, msgBytes, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
+	isWasmRouted, wasmMemo, err := ValidateAndParseMemo(data.GetMemo(), data.Receiver)
 	if !isWasmRouted {
 		return im.App.OnRecvPacket(ctx, packet, relayer)
 	}
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation, err.Error())
 	}
-	if msgBytes == nil || contractAddr == nil { // This should never happen
+	if len(wasmMemo.Calls) == 0 { // This should never happen
 		return NewEmitErrorAcknowledgement(ctx, types.ErrMsgValidation)
 	}
+	if err := h.checkHalted(ctx); err != nil {
+		return NewEmitErrorAcknowledgement(ctx, err)
+	}
+	if wasmMemo.Forward != nil && (h.TransferKeeper == nil || h.BankKeeper == nil || h.ChannelKeeper == nil) {
+		return NewEmitErrorAcknowledgement(ctx, types.ErrForward, "forwarding is not configured")
+	}
+
+	// The outputs of the calls are what the zero sender holds of the forwarded denom on top of its current balance
+	var forwardBalance sdk.Coin
+	if wasmMemo.Forward != nil {
+		forwardBalance = h.BankKeeper.GetBalance(ctx, compute.ZeroSender, wasmMemo.Forward.Denom)
+	}
 
 	// The funds sent on this packet need to be transferred to the intermediary account for the sender.
 	// For this, we override the ICS20 packet's Receiver (essentially hijacking the funds to this new address)
 	// and execute the underlying OnRecvPacket() call (which should eventually land on the transfer app's
 	// relay.go and send the funds to the intermediary account.
 	//
-	// If that succeeds, we make the contract call
+	// If that succeeds, we make the contract calls
+	incoming := packet
 	data.Receiver = compute.ZeroSender.String()
 	bz, err := json.Marshal(data)
 	if err != nil {
@@ -95,28 +159,289 @@ func (h WasmHooks) OnRecvPacketOverride(im IBCMiddleware, ctx sdk.Context, packe
 	denom := MustExtractDenomFromPacketOnRecv(packet)
 	funds := sdk.NewCoins(sdk.NewCoin(denom, amount))
 
-	// Execute the contract
-	execMsg := compute.MsgExecuteContract{
-		// Sender is ignored by the enclave, the contract sees a null msg.sender
-		Sender:    compute.ZeroSender,
-		Contract:  contractAddr,
-		Msg:       msgBytes,
-		SentFunds: funds,
+	// Run the calls in order, the funds are sent with the first one.
+	// Any failing call fails the whole packet, the transfer and the previous calls are reverted with it.
+	fullAck := ContractAck{IbcAck: ack.Acknowledgement()}
+	for i, call := range wasmMemo.Calls {
+		callFunds := sdk.NewCoins()
+		if i == 0 {
+			callFunds = funds
+		}
+
+		var result CallResult
+		if call.Instantiate != nil {
+			contractAddr, data, err := h.instantiateWasmContract(ctx, call.Instantiate, callFunds)
+			if err != nil {
+				return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, fmt.Sprintf("call %d: %s", i, err))
+			}
+			result = CallResult{Contract: contractAddr.String(), Data: data}
+		} else {
+			execMsg := compute.MsgExecuteContract{
+				// Sender is ignored by the enclave, the contract sees a null msg.sender
+				Sender:    compute.ZeroSender,
+				Contract:  call.Contract,
+				Msg:       call.Msg,
+				SentFunds: callFunds,
+			}
+			response, err := h.execWasmMsg(ctx, &execMsg, computetypes.HandleTypeIbcWasmHooksIncomingTransfer)
+			if err != nil {
+				return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, fmt.Sprintf("call %d: %s", i, err))
+			}
+			result = CallResult{Contract: call.Contract.String(), Data: response.Data}
+		}
+
+		fullAck.ContractResult = result.Data
+		if len(wasmMemo.Calls) > 1 {
+			fullAck.CallResults = append(fullAck.CallResults, result)
+		}
 	}
-	response, err := h.execWasmMsg(ctx, &execMsg, computetypes.HandleTypeIbcWasmHooksIncomingTransfer)
-	if err != nil {
-		return NewEmitErrorAcknowledgement(ctx, types.ErrWasmError, err.Error())
+
+	if wasmMemo.Forward != nil {
+		sequence, err := h.forwardOutputs(ctx, *wasmMemo.Forward, forwardBalance)
+		if err != nil {
+			return NewEmitErrorAcknowledgement(ctx, types.ErrForward, err.Error())
+		}
+		fullAck.ForwardSequence = sequence
 	}
 
-	fullAck := ContractAck{ContractResult: response.Data, IbcAck: ack.Acknowledgement()}
 	bz, err = json.Marshal(fullAck)
 	if err != nil {
 		return NewEmitErrorAcknowledgement(ctx, types.ErrBadResponse, err.Error())
 	}
 
+	if fullAck.ForwardSequence != 0 {
+		// The packet is acknowledged asynchronously once the forwarded transfer is, see resolveForward
+		h.ibcHooksKeeper.StoreInFlightForward(ctx, types.InFlightForward{
+			ChannelId: wasmMemo.Forward.Channel,
+			Sequence:  fullAck.ForwardSequence,
+			Packet:    incoming,
+			Ack:       bz,
+		})
+		return nil
+	}
+
 	return channeltypes.NewResultAcknowledgement(bz)
 }
 
+// instantiateWasmContract instantiates a contract on behalf of the zero sender, sending it funds
+func (h WasmHooks) instantiateWasmContract(ctx sdk.Context, instantiate *InstantiateMemo, funds sdk.Coins) (sdk.AccAddress, []byte, error) {
+	codeInfo, err := h.ContractKeeper.GetCodeInfo(ctx, instantiate.CodeID)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	codeHash := hex.EncodeToString(codeInfo.CodeHash)
+	if !strings.EqualFold(codeHash, instantiate.CodeHash) {
+		return nil, nil, fmt.Errorf("the code hash of code %d is %s", instantiate.CodeID, codeHash)
+	}
+
+	// The enclave expects the init msg to be prefixed with the code hash, as it is for encrypted msgs
+	initMsg := append([]byte(codeHash), instantiate.Msg...)
+	msg := compute.MsgInstantiateContract{
+		// Sender is ignored by the enclave, the contract sees a null msg.sender
+		Sender:    compute.ZeroSender,
+		CodeID:    instantiate.CodeID,
+		Label:     instantiate.Label,
+		InitMsg:   initMsg,
+		InitFunds: funds,
+		Admin:     instantiate.Admin,
+	}
+	if err := msg.ValidateBasic(); err != nil {
+		return nil, nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
+	}
+
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		// See execWasmMsg, the enclave can't verify the packet before the light client is updated
+		ctx.GasMeter().ConsumeGas(300_000, "add gas to relayer simulation")
+		return compute.ZeroSender, []byte{0}, nil
+	}
+
+	var admin sdk.AccAddress
+	if instantiate.Admin != "" {
+		admin, err = sdk.AccAddressFromBech32(instantiate.Admin)
+		if err != nil {
+			return nil, nil, err
+		}
+	}
+
+	return h.ContractKeeper.Instantiate(ctx, msg.CodeID, msg.Sender, admin, msg.InitMsg, msg.Label, msg.InitFunds, nil)
+}
+
+// forwardOutputs transfers what the calls paid to the zero sender of the forwarded denom
+func (h WasmHooks) forwardOutputs(ctx sdk.Context, forward ForwardMemo, balanceBefore sdk.Coin) (uint64, error) {
+	if ctx.IsCheckTx() || ctx.IsReCheckTx() {
+		// The calls were bypassed in the mempool, so there are no outputs to forward
+		return 0, nil
+	}
+
+	outputs := h.BankKeeper.GetBalance(ctx, compute.ZeroSender, forward.Denom).Sub(balanceBefore)
+	if !outputs.IsPositive() {
+		return 0, fmt.Errorf("the calls didn't output any %s", forward.Denom)
+	}
+
+	timeout, err := forward.TimeoutDuration()
+	if err != nil {
+		return 0, err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		transfertypes.PortID,
+		forward.Channel,
+		outputs,
+		compute.ZeroSender.String(),
+		forward.Receiver,
+		ibcclienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(timeout).UnixNano()),
+		forward.Memo,
+	)
+	res, err := h.TransferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return 0, err
+	}
+	return res.Sequence, nil
+}
+
+// resolveForward acknowledges the incoming packet of a forwarded transfer once the transfer is acked or timed out.
+// failure is empty if the transfer succeeded, in which case the ack of the calls is written.
+//
+// If it failed, the transfer app refunded the zero sender. When the refund is what the incoming packet received,
+// the receive is undone and the packet gets an error ack, so the origin chain refunds the sender. Otherwise the calls
+// converted the funds and can't be reverted anymore: the packet gets the ack of the calls with the failure, and the
+// refund is transferred back to the sender of the packet, again until it succeeds.
+func (h WasmHooks) resolveForward(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, failure string) error {
+	forward, ok := h.ibcHooksKeeper.GetInFlightForward(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	if !ok {
+		return nil
+	}
+	h.ibcHooksKeeper.DeleteInFlightForward(ctx, packet.GetSourceChannel(), packet.GetSequence())
+
+	if failure == "" {
+		if forward.Returning {
+			return nil
+		}
+		return h.writeAcknowledgement(im, ctx, forward.Packet, channeltypes.NewResultAcknowledgement(forward.Ack))
+	}
+
+	refund, err := outgoingCoin(packet)
+	if err != nil {
+		return err
+	}
+	if forward.Returning {
+		return h.returnOutputs(ctx, forward.Packet, refund)
+	}
+
+	// The amount is the same on both chains, only the denom of the incoming packet is represented differently here
+	received, err := outgoingCoin(forward.Packet)
+	if err != nil {
+		return err
+	}
+	received.Denom = MustExtractDenomFromPacketOnRecv(forward.Packet)
+	if refund.Denom == received.Denom && refund.Amount.Equal(received.Amount) {
+		if err := h.undoReceive(ctx, forward.Packet, refund); err != nil {
+			return err
+		}
+		return h.writeAcknowledgement(im, ctx, forward.Packet, NewEmitErrorAcknowledgement(ctx, types.ErrForward, failure))
+	}
+
+	var ack ContractAck
+	if err := json.Unmarshal(forward.Ack, &ack); err != nil {
+		return err
+	}
+	ack.ForwardError = failure
+	bz, err := json.Marshal(ack)
+	if err != nil {
+		return err
+	}
+	if err := h.writeAcknowledgement(im, ctx, forward.Packet, channeltypes.NewResultAcknowledgement(bz)); err != nil {
+		return err
+	}
+	return h.returnOutputs(ctx, forward.Packet, refund)
+}
+
+// writeAcknowledgement writes the ack of an incoming packet that was acknowledged asynchronously
+func (h WasmHooks) writeAcknowledgement(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, ack ibcexported.Acknowledgement) error {
+	_, chanCap, err := h.ChannelKeeper.LookupModuleByChannel(ctx, packet.GetDestPort(), packet.GetDestChannel())
+	if err != nil {
+		return errorsmod.Wrap(err, "could not retrieve the capability of the channel")
+	}
+	return im.ICS4Middleware.WriteAcknowledgement(ctx, chanCap, packet, ack)
+}
+
+// undoReceive takes back from the zero sender the funds of an incoming packet, escrowing them again or burning the
+// vouchers minted by the transfer app on receive
+func (h WasmHooks) undoReceive(ctx sdk.Context, packet channeltypes.Packet, coin sdk.Coin) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return err
+	}
+
+	if transfertypes.ReceiverChainIsSource(packet.GetSourcePort(), packet.GetSourceChannel(), data.Denom) {
+		escrow := transfertypes.GetEscrowAddress(packet.GetDestPort(), packet.GetDestChannel())
+		if err := h.BankKeeper.SendCoins(ctx, compute.ZeroSender, escrow, sdk.NewCoins(coin)); err != nil {
+			return err
+		}
+		total := h.TransferKeeper.GetTotalEscrowForDenom(ctx, coin.Denom)
+		h.TransferKeeper.SetTotalEscrowForDenom(ctx, total.Add(coin))
+		return nil
+	}
+
+	if err := h.BankKeeper.SendCoinsFromAccountToModule(ctx, compute.ZeroSender, transfertypes.ModuleName, sdk.NewCoins(coin)); err != nil {
+		return err
+	}
+	return h.BankKeeper.BurnCoins(ctx, transfertypes.ModuleName, sdk.NewCoins(coin))
+}
+
+// returnOutputs transfers the refund of a failed forward back to the sender of its incoming packet
+func (h WasmHooks) returnOutputs(ctx sdk.Context, packet channeltypes.Packet, outputs sdk.Coin) error {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return err
+	}
+
+	msg := transfertypes.NewMsgTransfer(
+		packet.GetDestPort(),
+		packet.GetDestChannel(),
+		outputs,
+		compute.ZeroSender.String(),
+		data.Sender,
+		ibcclienttypes.ZeroHeight(),
+		uint64(ctx.BlockTime().Add(DefaultForwardTimeout).UnixNano()),
+		"",
+	)
+	res, err := h.TransferKeeper.Transfer(ctx, msg)
+	if err != nil {
+		return err
+	}
+
+	h.ibcHooksKeeper.StoreInFlightForward(ctx, types.InFlightForward{
+		ChannelId: packet.GetDestChannel(),
+		Sequence:  res.Sequence,
+		Packet:    packet,
+		Returning: true,
+	})
+	return nil
+}
+
+// outgoingCoin returns the funds of a packet sent by this chain, as represented in this chain
+func outgoingCoin(packet channeltypes.Packet) (sdk.Coin, error) {
+	var data transfertypes.FungibleTokenPacketData
+	if err := json.Unmarshal(packet.GetData(), &data); err != nil {
+		return sdk.Coin{}, err
+	}
+	amount, ok := math.NewIntFromString(data.Amount)
+	if !ok {
+		return sdk.Coin{}, errorsmod.Wrap(types.ErrInvalidPacket, "Amount is not an int")
+	}
+	return sdk.NewCoin(transfertypes.ParseDenomTrace(data.Denom).IBCDenom(), amount), nil
+}
+
+func (h WasmHooks) checkHalted(ctx sdk.Context) error {
+	if h.Halter == nil {
+		return nil
+	}
+	return h.Halter.CheckIbcHooksHalted(ctx)
+}
+
 func (h WasmHooks) execWasmMsg(ctx sdk.Context, execMsg *compute.MsgExecuteContract, handleType computetypes.HandleType) (*sdk.Result, error) {
 	if err := execMsg.ValidateBasic(); err != nil {
 		return nil, fmt.Errorf(types.ErrBadExecutionMsg, err.Error())
@@ -179,10 +504,56 @@ func jsonStringHasKey(memo, key string) (found bool, jsonObject map[string]inter
 	return true, jsonObject
 }
 
-func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, contractAddr sdk.AccAddress, msgBytes []byte, err error) {
+// MaxWasmCalls is the maximum number of calls in the memo of an incoming transfer
+const MaxWasmCalls = 10
+
+// DefaultForwardTimeout is the timeout of a forwarded transfer when the memo doesn't set one
+const DefaultForwardTimeout = 10 * time.Minute
+
+type (
+	// WasmMemo is the parsed `wasm` object of the memo of an incoming transfer
+	WasmMemo struct {
+		// Calls are run in order, the transferred funds are sent with the first one
+		Calls []WasmCall
+		// Forward re-transfers the outputs of the calls, if set
+		Forward *ForwardMemo
+	}
+
+	// WasmCall executes a contract, or instantiates one if Instantiate is set
+	WasmCall struct {
+		Contract    sdk.AccAddress
+		Msg         []byte
+		Instantiate *InstantiateMemo
+	}
+
+	// InstantiateMemo instantiates a contract of CodeID. CodeHash is the hex encoded hash of its code, the enclave
+	// only runs the code it's given and checks that hash, the admin and the msg against the memo.
+	InstantiateMemo struct {
+		CodeID   uint64          `json:"code_id"`
+		CodeHash string          `json:"code_hash"`
+		Label    string          `json:"label"`
+		Msg      json.RawMessage `json:"msg"`
+		Admin    string          `json:"admin,omitempty"`
+	}
+
+	// ForwardMemo re-transfers the funds of denom that the calls paid to the zero sender. The incoming packet is
+	// acknowledged once the forwarded transfer is acked or timed out.
+	ForwardMemo struct {
+		Channel  string `json:"channel"`
+		Receiver string `json:"receiver"`
+		Denom    string `json:"denom"`
+		// Timeout is a duration, e.g. "10m"
+		Timeout string `json:"timeout,omitempty"`
+		Memo    string `json:"memo,omitempty"`
+	}
+)
+
+// ValidateAndParseMemo parses the `wasm` object of the memo of an incoming transfer. It holds either a single call,
+// as `contract` and `msg` or as `instantiate`, or an ordered list of `calls`, and optionally a `forward`.
+func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, wasmMemo WasmMemo, err error) {
 	isWasmRouted, metadata := jsonStringHasKey(memo, "wasm")
 	if !isWasmRouted {
-		return isWasmRouted, sdk.AccAddress{}, nil, nil
+		return isWasmRouted, WasmMemo{}, nil
 	}
 
 	wasmRaw := metadata["wasm"]
@@ -190,52 +561,164 @@ func ValidateAndParseMemo(memo string, receiver string) (isWasmRouted bool, cont
 	// Make sure the wasm key is a map. If it isn't, ignore this packet
 	wasm, ok := wasmRaw.(map[string]interface{})
 	if !ok {
-		return isWasmRouted, sdk.AccAddress{}, nil,
+		return isWasmRouted, WasmMemo{},
 			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, "wasm metadata is not a valid JSON map object")
 	}
 
+	if callsRaw, ok := wasm["calls"]; ok {
+		if wasm["contract"] != nil || wasm["msg"] != nil || wasm["instantiate"] != nil {
+			return isWasmRouted, WasmMemo{},
+				fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["calls"] can't be set with a single call`)
+		}
+		calls, ok := callsRaw.([]interface{})
+		if !ok || len(calls) == 0 || len(calls) > MaxWasmCalls {
+			return isWasmRouted, WasmMemo{},
+				fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, fmt.Sprintf(`wasm["calls"] must be a list of 1 to %d calls`, MaxWasmCalls))
+		}
+		for i, callRaw := range calls {
+			call, ok := callRaw.(map[string]interface{})
+			if !ok {
+				return isWasmRouted, WasmMemo{},
+					fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, fmt.Sprintf(`wasm["calls"][%d] is not a map object`, i))
+			}
+			wasmCall, err := parseWasmCall(call)
+			if err != nil {
+				return isWasmRouted, WasmMemo{},
+					fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, fmt.Sprintf(`wasm["calls"][%d]: %s`, i, err))
+			}
+			wasmMemo.Calls = append(wasmMemo.Calls, wasmCall)
+		}
+	} else {
+		wasmCall, err := parseWasmCall(wasm)
+		if err != nil {
+			return isWasmRouted, WasmMemo{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+		}
+		wasmMemo.Calls = []WasmCall{wasmCall}
+	}
+
+	// The funds are sent to the contract of the first call, so it should be the receiver for the packet to be valid.
+	// When the first call instantiates a contract, the funds are sent to the zero sender instead.
+	first := wasmMemo.Calls[0]
+	if first.Instantiate != nil && receiver != compute.ZeroSender.String() {
+		return isWasmRouted, WasmMemo{},
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, fmt.Sprintf("the receiver of the packet should be %s when the first call instantiates a contract", compute.ZeroSender))
+	}
+	if first.Instantiate == nil && first.Contract.String() != receiver {
+		return isWasmRouted, WasmMemo{},
+			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `the contract of the first call should be the same as the receiver of the packet`)
+	}
+
+	if forwardRaw, ok := wasm["forward"]; ok {
+		forward, err := parseForward(forwardRaw)
+		if err != nil {
+			return isWasmRouted, WasmMemo{}, fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+		}
+		wasmMemo.Forward = forward
+	}
+
+	return isWasmRouted, wasmMemo, nil
+}
+
+// parseWasmCall parses a call, either `contract` and `msg` or `instantiate`.
+func parseWasmCall(call map[string]interface{}) (WasmCall, error) {
+	if instantiateRaw, ok := call["instantiate"]; ok {
+		if call["contract"] != nil || call["msg"] != nil {
+			return WasmCall{}, fmt.Errorf(`"instantiate" can't be set with "contract" or "msg"`)
+		}
+		bz, err := json.Marshal(instantiateRaw)
+		if err != nil {
+			return WasmCall{}, err
+		}
+		var instantiate InstantiateMemo
+		if err := json.Unmarshal(bz, &instantiate); err != nil {
+			return WasmCall{}, fmt.Errorf(`"instantiate" is not a valid instantiate object: %s`, err)
+		}
+		if instantiate.CodeID == 0 || instantiate.Label == "" {
+			return WasmCall{}, fmt.Errorf(`"instantiate" requires "code_id" and "label"`)
+		}
+		if codeHash, err := hex.DecodeString(instantiate.CodeHash); err != nil || len(codeHash) != sha256.Size {
+			return WasmCall{}, fmt.Errorf(`"instantiate"["code_hash"] is not a hex encoded sha256 hash`)
+		}
+		var initMsg map[string]interface{}
+		if err := json.Unmarshal(instantiate.Msg, &initMsg); err != nil || initMsg == nil {
+			return WasmCall{}, fmt.Errorf(`"instantiate"["msg"] is not a map object`)
+		}
+		if instantiate.Admin != "" {
+			if _, err := sdk.AccAddressFromBech32(instantiate.Admin); err != nil {
+				return WasmCall{}, fmt.Errorf(`"instantiate"["admin"] is not a valid bech32 address`)
+			}
+		}
+		return WasmCall{Instantiate: &instantiate}, nil
+	}
+
 	// Get the contract
-	contract, ok := wasm["contract"].(string)
+	contract, ok := call["contract"].(string)
 	if !ok {
-		// The tokens will be returned
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `Could not find key wasm["contract"]`)
+		return WasmCall{}, fmt.Errorf(`Could not find key "contract"`)
 	}
 
-	contractAddr, err = sdk.AccAddressFromBech32(contract)
+	contractAddr, err := sdk.AccAddressFromBech32(contract)
 	if err != nil {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] is not a valid bech32 address`)
-	}
-
-	// The contract and the receiver should be the same for the packet to be valid
-	if contract != receiver {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["contract"] should be the same as the receiver of the packet`)
+		return WasmCall{}, fmt.Errorf(`"contract" is not a valid bech32 address`)
 	}
 
 	// Ensure the message key is provided
-	if wasm["msg"] == nil {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `Could not find key wasm["msg"]`)
+	if call["msg"] == nil {
+		return WasmCall{}, fmt.Errorf(`Could not find key "msg"`)
 	}
 
 	// Make sure the msg key is a map. If it isn't, return an error
-	_, ok = wasm["msg"].(map[string]interface{})
-	if !ok {
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, `wasm["msg"] is not a map object`)
+	if _, ok := call["msg"].(map[string]interface{}); !ok {
+		return WasmCall{}, fmt.Errorf(`"msg" is not a map object`)
 	}
 
 	// Get the message string by serializing the map
-	msgBytes, err = json.Marshal(wasm["msg"])
+	msgBytes, err := json.Marshal(call["msg"])
 	if err != nil {
-		// The tokens will be returned
-		return isWasmRouted, sdk.AccAddress{}, nil,
-			fmt.Errorf(types.ErrBadMetadataFormatMsg, memo, err.Error())
+		return WasmCall{}, err
 	}
 
-	return isWasmRouted, contractAddr, msgBytes, nil
+	return WasmCall{Contract: contractAddr, Msg: msgBytes}, nil
+}
+
+// parseForward parses and validates the `forward` object of the memo.
+func parseForward(forwardRaw interface{}) (*ForwardMemo, error) {
+	if _, ok := forwardRaw.(map[string]interface{}); !ok {
+		return nil, fmt.Errorf(`wasm["forward"] is not a map object`)
+	}
+	bz, err := json.Marshal(forwardRaw)
+	if err != nil {
+		return nil, err
+	}
+	var forward ForwardMemo
+	if err := json.Unmarshal(bz, &forward); err != nil {
+		return nil, fmt.Errorf(`wasm["forward"] is not a valid forward object: %s`, err)
+	}
+	if err := host.ChannelIdentifierValidator(forward.Channel); err != nil {
+		return nil, fmt.Errorf(`wasm["forward"]["channel"] is not a valid channel: %s`, err)
+	}
+	if forward.Receiver == "" {
+		return nil, fmt.Errorf(`wasm["forward"]["receiver"] is required`)
+	}
+	if err := sdk.ValidateDenom(forward.Denom); err != nil {
+		return nil, fmt.Errorf(`wasm["forward"]["denom"] is not a valid denom: %s`, err)
+	}
+	if _, err := forward.TimeoutDuration(); err != nil {
+		return nil, err
+	}
+	return &forward, nil
+}
+
+// TimeoutDuration returns the timeout of the forwarded transfer.
+func (f ForwardMemo) TimeoutDuration() (time.Duration, error) {
+	if f.Timeout == "" {
+		return DefaultForwardTimeout, nil
+	}
+	timeout, err := time.ParseDuration(f.Timeout)
+	if err != nil || timeout <= 0 {
+		return 0, fmt.Errorf(`wasm["forward"]["timeout"] is not a positive duration`)
+	}
+	return timeout, nil
 }
 
 func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap *capabilitytypes.Capability, sourcePort string, sourceChannel string, timeoutHeight ibcclienttypes.Height, timeoutTimestamp uint64, data []byte) (sequence uint64, err error) {
@@ -265,9 +748,60 @@ func (h WasmHooks) SendPacketOverride(i ICS4Middleware, ctx sdk.Context, chanCap
 	}
 
 	h.ibcHooksKeeper.StorePacketCallback(ctx, sourceChannel, seq, contract)
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackRegistered,
+		sdk.NewAttribute(types.AttributeKeyContract, contract),
+		sdk.NewAttribute(types.AttributeKeyChannel, sourceChannel),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(seq, 10)),
+	))
 	return seq, nil
 }
 
+// emitCallbackCompleted emits an event once the callback of a packet has been processed
+func emitCallbackCompleted(ctx sdk.Context, packet channeltypes.Packet, contract, result string) {
+	ctx.EventManager().EmitEvent(sdk.NewEvent(
+		types.EventTypeCallbackCompleted,
+		sdk.NewAttribute(types.AttributeKeyContract, contract),
+		sdk.NewAttribute(types.AttributeKeyChannel, packet.GetSourceChannel()),
+		sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(packet.GetSequence(), 10)),
+		sdk.NewAttribute(types.AttributeKeyResult, result),
+	))
+}
+
+// OnChanCloseInitAfterHook prunes the callbacks of the packets of a closed channel that aren't pending anymore
+func (h WasmHooks) OnChanCloseInitAfterHook(ctx sdk.Context, portID, channelID string, err error) {
+	if err == nil {
+		h.pruneChannelCallbacks(ctx, portID, channelID)
+	}
+}
+
+// OnChanCloseConfirmAfterHook prunes the callbacks of the packets of a closed channel that aren't pending anymore
+func (h WasmHooks) OnChanCloseConfirmAfterHook(ctx sdk.Context, portID, channelID string, err error) {
+	if err == nil {
+		h.pruneChannelCallbacks(ctx, portID, channelID)
+	}
+}
+
+// pruneChannelCallbacks deletes the callbacks of a closed channel whose packets have no commitment anymore, as they
+// won't be acked or timed out. The packets that still have one can be timed out on close, which calls their contracts
+// and deletes their callbacks in OnTimeoutPacketOverride.
+func (h WasmHooks) pruneChannelCallbacks(ctx sdk.Context, portID, channelID string) {
+	if h.ibcHooksKeeper == nil || h.ChannelKeeper == nil {
+		return
+	}
+	isPending := func(sequence uint64) bool {
+		return len(h.ChannelKeeper.GetPacketCommitment(ctx, portID, channelID, sequence)) > 0
+	}
+	for _, callback := range h.ibcHooksKeeper.PruneChannelCallbacks(ctx, channelID, isPending) {
+		ctx.EventManager().EmitEvent(sdk.NewEvent(
+			types.EventTypeCallbackPruned,
+			sdk.NewAttribute(types.AttributeKeyContract, callback.Contract),
+			sdk.NewAttribute(types.AttributeKeyChannel, callback.ChannelId),
+			sdk.NewAttribute(types.AttributeKeySequence, strconv.FormatUint(callback.Sequence, 10)),
+		))
+	}
+}
+
 type (
 	IbcLifecycleComplete struct {
 		IbcLifecycleCompleteContainer `json:"ibc_lifecycle_complete"`
@@ -302,6 +836,15 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return nil
 	}
 
+	failure := ""
+	var ackErr channeltypes.Acknowledgement_Error
+	if IsAckError(acknowledgement) && json.Unmarshal(acknowledgement, &ackErr) == nil {
+		failure = fmt.Sprintf("the forwarded transfer failed: %s", ackErr.Error)
+	}
+	if err := h.resolveForward(im, ctx, packet, failure); err != nil {
+		return errorsmod.Wrap(err, "Forward ack error")
+	}
+
 	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	if contract == "" {
 		// No callback configured
@@ -312,6 +855,10 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 	if err != nil {
 		return errorsmod.Wrap(err, "Ack callback error") // The callback configured is not a bech32. Error out
 	}
+	// the ack can be relayed again once the hooks are resumed
+	if err := h.checkHalted(ctx); err != nil {
+		return err
+	}
 
 	success := false
 	if !IsAckError(acknowledgement) {
@@ -354,9 +901,16 @@ func (h WasmHooks) OnAcknowledgementPacketOverride(im IBCMiddleware, ctx sdk.Con
 		return errorsmod.Wrap(err, "Ack callback error")
 	}
 	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	result := types.AttributeValueAckSuccess
+	if !success {
+		result = types.AttributeValueAckError
+	}
+	emitCallbackCompleted(ctx, packet, contract, result)
 	return nil
 }
 
+// OnTimeoutPacketOverride calls the contract of the packet with its timeout and deletes the callback. The callbacks
+// of the pending packets of a closed channel are kept until then: they are timed out on close, which lands here too.
 func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, packet channeltypes.Packet, relayer sdk.AccAddress) error {
 	err := im.App.OnTimeoutPacket(ctx, packet, relayer)
 	if err != nil {
@@ -368,6 +922,10 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		return nil
 	}
 
+	if err := h.resolveForward(im, ctx, packet, "the forwarded transfer timed out"); err != nil {
+		return errorsmod.Wrap(err, "Forward timeout error")
+	}
+
 	contract := h.ibcHooksKeeper.GetPacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
 	if contract == "" {
 		// No callback configured
@@ -378,6 +936,10 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 	if err != nil {
 		return errorsmod.Wrap(err, "Timeout callback error") // The callback configured is not a bech32. Error out
 	}
+	// the timeout can be relayed again once the hooks are resumed
+	if err := h.checkHalted(ctx); err != nil {
+		return err
+	}
 
 	// Execute the contract
 	msg, err := json.Marshal(IbcLifecycleComplete{
@@ -414,6 +976,7 @@ func (h WasmHooks) OnTimeoutPacketOverride(im IBCMiddleware, ctx sdk.Context, pa
 		})
 	}
 	h.ibcHooksKeeper.DeletePacketCallback(ctx, packet.GetSourceChannel(), packet.GetSequence())
+	emitCallbackCompleted(ctx, packet, contract, types.AttributeValueTimeout)
 	return nil
 }
 
@@ -0,0 +1,397 @@
+package ibc_hooks
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/log"
+	"cosmossdk.io/math"
+	"cosmossdk.io/store"
+	"cosmossdk.io/store/metrics"
+	storetypes "cosmossdk.io/store/types"
+	tmproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	dbm "github.com/cosmos/cosmos-db"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	capabilitytypes "github.com/cosmos/ibc-go/modules/capability/types"
+	transfertypes "github.com/cosmos/ibc-go/v8/modules/apps/transfer/types"
+	clienttypes "github.com/cosmos/ibc-go/v8/modules/core/02-client/types"
+	channeltypes "github.com/cosmos/ibc-go/v8/modules/core/04-channel/types"
+	porttypes "github.com/cosmos/ibc-go/v8/modules/core/05-port/types"
+	ibcexported "github.com/cosmos/ibc-go/v8/modules/core/exported"
+	"github.com/stretchr/testify/require"
+
+	"github.com/scrtlabs/SecretNetwork/x/compute"
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/keeper"
+	"github.com/scrtlabs/SecretNetwork/x/ibc-hooks/types"
+)
+
+var codeHash = strings.Repeat("ab", 32)
+
+// mockBankKeeper holds the balances and records the sends
+type mockBankKeeper struct {
+	balances map[string]math.Int
+	sent     []sdk.Coins
+	burnt    []sdk.Coins
+}
+
+func (b *mockBankKeeper) GetBalance(_ context.Context, addr sdk.AccAddress, denom string) sdk.Coin {
+	amount, ok := b.balances[addr.String()+denom]
+	if !ok {
+		amount = math.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+func (b *mockBankKeeper) SendCoins(_ context.Context, fromAddr, toAddr sdk.AccAddress, amt sdk.Coins) error {
+	for _, coin := range amt {
+		b.balances[fromAddr.String()+coin.Denom] = b.GetBalance(nil, fromAddr, coin.Denom).Amount.Sub(coin.Amount)
+		b.balances[toAddr.String()+coin.Denom] = b.GetBalance(nil, toAddr, coin.Denom).Amount.Add(coin.Amount)
+	}
+	b.sent = append(b.sent, amt)
+	return nil
+}
+
+func (b *mockBankKeeper) SendCoinsFromAccountToModule(ctx context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	return b.SendCoins(ctx, senderAddr, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (b *mockBankKeeper) BurnCoins(_ context.Context, moduleName string, amounts sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName)
+	for _, coin := range amounts {
+		b.balances[addr.String()+coin.Denom] = b.GetBalance(nil, addr, coin.Denom).Amount.Sub(coin.Amount)
+	}
+	b.burnt = append(b.burnt, amounts)
+	return nil
+}
+
+// mockTransferKeeper records the transfers and returns increasing sequences
+type mockTransferKeeper struct {
+	transfers []*transfertypes.MsgTransfer
+	escrowed  map[string]math.Int
+}
+
+func (t *mockTransferKeeper) Transfer(_ context.Context, msg *transfertypes.MsgTransfer) (*transfertypes.MsgTransferResponse, error) {
+	t.transfers = append(t.transfers, msg)
+	return &transfertypes.MsgTransferResponse{Sequence: uint64(len(t.transfers))}, nil
+}
+
+func (t *mockTransferKeeper) GetTotalEscrowForDenom(_ sdk.Context, denom string) sdk.Coin {
+	amount, ok := t.escrowed[denom]
+	if !ok {
+		amount = math.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+func (t *mockTransferKeeper) SetTotalEscrowForDenom(_ sdk.Context, coin sdk.Coin) {
+	t.escrowed[coin.Denom] = coin.Amount
+}
+
+// mockChannelKeeper holds the commitments of the packets that are still pending
+type mockChannelKeeper struct {
+	commitments map[uint64][]byte
+}
+
+func (c mockChannelKeeper) GetPacketCommitment(_ sdk.Context, _, _ string, sequence uint64) []byte {
+	return c.commitments[sequence]
+}
+
+func (c mockChannelKeeper) LookupModuleByChannel(_ sdk.Context, _, _ string) (string, *capabilitytypes.Capability, error) {
+	return transfertypes.ModuleName, &capabilitytypes.Capability{}, nil
+}
+
+// mockICS4Wrapper records the acks written asynchronously
+type mockICS4Wrapper struct {
+	porttypes.ICS4Wrapper
+	acks map[uint64]ibcexported.Acknowledgement
+}
+
+func (w *mockICS4Wrapper) WriteAcknowledgement(_ sdk.Context, _ *capabilitytypes.Capability, packet ibcexported.PacketI, ack ibcexported.Acknowledgement) error {
+	w.acks[packet.GetSequence()] = ack
+	return nil
+}
+
+// mockTransferApp is the transfer app under the middleware, it accepts the channel closes and timeouts
+type mockTransferApp struct {
+	porttypes.IBCModule
+}
+
+func (mockTransferApp) OnChanCloseConfirm(_ sdk.Context, _, _ string) error {
+	return nil
+}
+
+func (mockTransferApp) OnTimeoutPacket(_ sdk.Context, _ channeltypes.Packet, _ sdk.AccAddress) error {
+	return nil
+}
+
+func setupHooks(t *testing.T) (WasmHooks, *mockBankKeeper, *mockTransferKeeper, sdk.Context) {
+	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
+
+	db := dbm.NewMemDB()
+	stateStore := store.NewCommitMultiStore(db, log.NewNopLogger(), metrics.NewNoOpMetrics())
+	stateStore.MountStoreWithDB(storeKey, storetypes.StoreTypeIAVL, db)
+	require.NoError(t, stateStore.LoadLatestVersion())
+
+	ibcHooksKeeper := keeper.NewKeeper(runtime.NewKVStoreService(storeKey))
+	bankKeeper := &mockBankKeeper{balances: map[string]math.Int{}}
+	transferKeeper := &mockTransferKeeper{escrowed: map[string]math.Int{}}
+
+	hooks := NewWasmHooks(&ibcHooksKeeper, nil, "secret")
+	hooks.BankKeeper = bankKeeper
+	hooks.TransferKeeper = transferKeeper
+
+	ctx := sdk.NewContext(stateStore, tmproto.Header{Time: time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)}, false, log.NewNopLogger())
+	return hooks, bankKeeper, transferKeeper, ctx
+}
+
+func TestValidateAndParseMemo(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+	other := sdk.AccAddress([]byte("other_contract______")).String()
+	zeroSender := compute.ZeroSender.String()
+
+	execute := func(contract string) string {
+		return fmt.Sprintf(`{"contract":%q,"msg":{"swap":{}}}`, contract)
+	}
+	instantiate := fmt.Sprintf(`{"instantiate":{"code_id":1,"code_hash":%q,"label":"label","msg":{"init":{}}}}`, codeHash)
+	tooManyCalls := strings.TrimSuffix(strings.Repeat(execute(contract)+",", MaxWasmCalls+1), ",")
+
+	for _, tc := range []struct {
+		name     string
+		memo     string
+		receiver string
+		routed   bool
+		calls    int
+		forward  bool
+		err      string
+	}{
+		{name: "no memo", memo: "", receiver: contract},
+		{name: "no wasm key", memo: `{"forward":{}}`, receiver: contract},
+		{name: "wasm is not a map", memo: `{"wasm":"contract"}`, receiver: contract, routed: true, err: "not a valid JSON map"},
+		{name: "execute", memo: `{"wasm":` + execute(contract) + `}`, receiver: contract, routed: true, calls: 1},
+		{name: "execute of another receiver", memo: `{"wasm":` + execute(other) + `}`, receiver: contract, routed: true, err: "same as the receiver"},
+		{name: "execute without msg", memo: fmt.Sprintf(`{"wasm":{"contract":%q}}`, contract), receiver: contract, routed: true, err: `Could not find key "msg"`},
+		{name: "execute with a msg that is not a map", memo: fmt.Sprintf(`{"wasm":{"contract":%q,"msg":"swap"}}`, contract), receiver: contract, routed: true, err: `"msg" is not a map object`},
+		{name: "instantiate", memo: `{"wasm":` + instantiate + `}`, receiver: zeroSender, routed: true, calls: 1},
+		{name: "instantiate to a contract", memo: `{"wasm":` + instantiate + `}`, receiver: contract, routed: true, err: "should be " + zeroSender},
+		{name: "instantiate without code hash", memo: `{"wasm":{"instantiate":{"code_id":1,"label":"label","msg":{}}}}`, receiver: zeroSender, routed: true, err: `"instantiate"["code_hash"] is not`},
+		{name: "instantiate with a short code hash", memo: `{"wasm":{"instantiate":{"code_id":1,"code_hash":"abcd","label":"label","msg":{}}}}`, receiver: zeroSender, routed: true, err: `"instantiate"["code_hash"] is not`},
+		{name: "instantiate without label", memo: fmt.Sprintf(`{"wasm":{"instantiate":{"code_id":1,"code_hash":%q,"msg":{}}}}`, codeHash), receiver: zeroSender, routed: true, err: `requires "code_id" and "label"`},
+		{name: "instantiate with an invalid admin", memo: fmt.Sprintf(`{"wasm":{"instantiate":{"code_id":1,"code_hash":%q,"label":"label","msg":{},"admin":"admin"}}}`, codeHash), receiver: zeroSender, routed: true, err: `"instantiate"["admin"]`},
+		{name: "instantiate with a contract", memo: fmt.Sprintf(`{"wasm":{"contract":%q,"instantiate":{}}}`, contract), receiver: zeroSender, routed: true, err: `can't be set`},
+		{name: "calls", memo: `{"wasm":{"calls":[` + execute(contract) + `,` + instantiate + `,` + execute(other) + `]}}`, receiver: contract, routed: true, calls: 3},
+		{name: "calls starting with an instantiate", memo: `{"wasm":{"calls":[` + instantiate + `,` + execute(contract) + `]}}`, receiver: zeroSender, routed: true, calls: 2},
+		{name: "calls of another receiver", memo: `{"wasm":{"calls":[` + execute(other) + `,` + execute(contract) + `]}}`, receiver: contract, routed: true, err: "same as the receiver"},
+		{name: "calls with a single call", memo: fmt.Sprintf(`{"wasm":{"contract":%q,"calls":[%s]}}`, contract, execute(contract)), receiver: contract, routed: true, err: "can't be set with a single call"},
+		{name: "no calls", memo: `{"wasm":{"calls":[]}}`, receiver: contract, routed: true, err: "must be a list"},
+		{name: "too many calls", memo: `{"wasm":{"calls":[` + tooManyCalls + `]}}`, receiver: contract, routed: true, err: "must be a list"},
+		{name: "call that is not a map", memo: `{"wasm":{"calls":["call"]}}`, receiver: contract, routed: true, err: `wasm["calls"][0] is not a map object`},
+		{
+			name:     "forward",
+			memo:     fmt.Sprintf(`{"wasm":{"calls":[%s],"forward":{"channel":"channel-1","receiver":"cosmos1receiver","denom":"uscrt"}}}`, execute(contract)),
+			receiver: contract, routed: true, calls: 1, forward: true,
+		},
+		{
+			name:     "forward without receiver",
+			memo:     fmt.Sprintf(`{"wasm":{"calls":[%s],"forward":{"channel":"channel-1","denom":"uscrt"}}}`, execute(contract)),
+			receiver: contract, routed: true, err: `wasm["forward"]["receiver"]`,
+		},
+		{
+			name:     "forward with an invalid timeout",
+			memo:     fmt.Sprintf(`{"wasm":{"calls":[%s],"forward":{"channel":"channel-1","receiver":"cosmos1receiver","denom":"uscrt","timeout":"-1m"}}}`, execute(contract)),
+			receiver: contract, routed: true, err: `wasm["forward"]["timeout"]`,
+		},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			routed, wasmMemo, err := ValidateAndParseMemo(tc.memo, tc.receiver)
+			require.Equal(t, tc.routed, routed)
+			if tc.err != "" {
+				require.ErrorContains(t, err, tc.err)
+				return
+			}
+			require.NoError(t, err)
+			require.Len(t, wasmMemo.Calls, tc.calls)
+			require.Equal(t, tc.forward, wasmMemo.Forward != nil)
+		})
+	}
+}
+
+func TestValidateAndParseMemoCalls(t *testing.T) {
+	contract := sdk.AccAddress([]byte("contract____________"))
+	admin := sdk.AccAddress([]byte("admin_______________")).String()
+
+	memo := fmt.Sprintf(
+		`{"wasm":{"calls":[{"contract":%q,"msg":{"swap":{}}},{"instantiate":{"code_id":2,"code_hash":%q,"label":"label","msg":{"init":{}},"admin":%q}}]}}`,
+		contract.String(), codeHash, admin,
+	)
+	_, wasmMemo, err := ValidateAndParseMemo(memo, contract.String())
+	require.NoError(t, err)
+
+	require.Equal(t, []WasmCall{
+		{Contract: contract, Msg: []byte(`{"swap":{}}`)},
+		{Instantiate: &InstantiateMemo{
+			CodeID:   2,
+			CodeHash: codeHash,
+			Label:    "label",
+			Msg:      json.RawMessage(`{"init":{}}`),
+			Admin:    admin,
+		}},
+	}, wasmMemo.Calls)
+}
+
+func TestForwardOutputs(t *testing.T) {
+	hooks, bankKeeper, transferKeeper, ctx := setupHooks(t)
+	forward := ForwardMemo{Channel: "channel-1", Receiver: "cosmos1receiver", Denom: "uscrt", Memo: "memo"}
+
+	balanceBefore := sdk.NewInt64Coin("uscrt", 100)
+	bankKeeper.balances[compute.ZeroSender.String()+"uscrt"] = math.NewInt(100)
+
+	// the calls didn't output anything
+	_, err := hooks.forwardOutputs(ctx, forward, balanceBefore)
+	require.ErrorContains(t, err, "didn't output any uscrt")
+	require.Empty(t, transferKeeper.transfers)
+
+	// only the outputs are forwarded, not the balance from before the calls
+	bankKeeper.balances[compute.ZeroSender.String()+"uscrt"] = math.NewInt(150)
+	sequence, err := hooks.forwardOutputs(ctx, forward, balanceBefore)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), sequence)
+
+	require.Len(t, transferKeeper.transfers, 1)
+	msg := transferKeeper.transfers[0]
+	require.Equal(t, transfertypes.PortID, msg.SourcePort)
+	require.Equal(t, "channel-1", msg.SourceChannel)
+	require.Equal(t, sdk.NewInt64Coin("uscrt", 50), msg.Token)
+	require.Equal(t, compute.ZeroSender.String(), msg.Sender)
+	require.Equal(t, "cosmos1receiver", msg.Receiver)
+	require.Equal(t, "memo", msg.Memo)
+	require.Equal(t, uint64(ctx.BlockTime().Add(DefaultForwardTimeout).UnixNano()), msg.TimeoutTimestamp)
+
+	// the calls are bypassed in the mempool, so nothing is forwarded
+	sequence, err = hooks.forwardOutputs(ctx.WithIsCheckTx(true), forward, balanceBefore)
+	require.NoError(t, err)
+	require.Zero(t, sequence)
+	require.Len(t, transferKeeper.transfers, 1)
+}
+
+func TestResolveForward(t *testing.T) {
+	hooks, bankKeeper, transferKeeper, ctx := setupHooks(t)
+	hooks.ChannelKeeper = mockChannelKeeper{}
+	ics4Wrapper := &mockICS4Wrapper{acks: map[uint64]ibcexported.Acknowledgement{}}
+	ics4 := NewICS4Middleware(ics4Wrapper, hooks)
+	im := NewIBCMiddleware(mockTransferApp{}, &ics4)
+	zeroSender := compute.ZeroSender.String()
+
+	// incoming is a packet received on channel-0 from channel-9, forwarded is the transfer of its outputs on channel-1
+	incoming := func(sequence uint64, denom string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, "40", "cosmos1sender", zeroSender, "")
+		return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "channel-9", transfertypes.PortID, "channel-0", clienttypes.ZeroHeight(), 1)
+	}
+	forwarded := func(sequence uint64, denom, amount string) channeltypes.Packet {
+		data := transfertypes.NewFungibleTokenPacketData(denom, amount, zeroSender, "cosmos1receiver", "")
+		return channeltypes.NewPacket(data.GetBytes(), sequence, transfertypes.PortID, "channel-1", transfertypes.PortID, "channel-5", clienttypes.ZeroHeight(), 1)
+	}
+	store := func(sequence uint64, packet channeltypes.Packet) {
+		hooks.ibcHooksKeeper.StoreInFlightForward(ctx, types.InFlightForward{ChannelId: "channel-1", Sequence: sequence, Packet: packet, Ack: []byte(`{"forward_sequence":1}`)})
+	}
+	voucher := transfertypes.ParseDenomTrace("transfer/channel-0/uatom").IBCDenom()
+
+	// a packet that isn't a forward is ignored
+	require.NoError(t, hooks.resolveForward(im, ctx, forwarded(1, "uscrt", "40"), ""))
+	require.Empty(t, ics4Wrapper.acks)
+
+	// the incoming packet gets the ack of the calls once the forward succeeds
+	store(1, incoming(11, "uatom"))
+	require.NoError(t, hooks.resolveForward(im, ctx, forwarded(1, voucher, "40"), ""))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte(`{"forward_sequence":1}`)), ics4Wrapper.acks[11])
+	_, ok := hooks.ibcHooksKeeper.GetInFlightForward(ctx, "channel-1", 1)
+	require.False(t, ok)
+
+	// the refund of a forward of the received vouchers is burnt, and the origin chain refunds the sender with an error ack
+	bankKeeper.balances[zeroSender+voucher] = math.NewInt(40)
+	store(2, incoming(12, "uatom"))
+	require.NoError(t, hooks.resolveForward(im, ctx, forwarded(2, "transfer/channel-0/uatom", "40"), "failed"))
+	require.False(t, ics4Wrapper.acks[12].Success())
+	require.Equal(t, []sdk.Coins{sdk.NewCoins(sdk.NewInt64Coin(voucher, 40))}, bankKeeper.burnt)
+	require.True(t, bankKeeper.GetBalance(ctx, compute.ZeroSender, voucher).IsZero())
+
+	// the refund of a forward of received native funds is escrowed again
+	bankKeeper.balances[zeroSender+"uscrt"] = math.NewInt(40)
+	store(3, incoming(13, "transfer/channel-9/uscrt"))
+	require.NoError(t, hooks.resolveForward(im, ctx, forwarded(3, "uscrt", "40"), "timed out"))
+	require.False(t, ics4Wrapper.acks[13].Success())
+	escrow := transfertypes.GetEscrowAddress(transfertypes.PortID, "channel-0")
+	require.Equal(t, math.NewInt(40), bankKeeper.GetBalance(ctx, escrow, "uscrt").Amount)
+	require.Equal(t, math.NewInt(40), transferKeeper.escrowed["uscrt"])
+
+	// the calls converted the funds, so the packet gets the ack of the calls with the failure,
+	// and the refund is returned to the sender until it succeeds
+	store(4, incoming(14, "uatom"))
+	require.NoError(t, hooks.resolveForward(im, ctx, forwarded(4, "uscrt", "25"), "failed"))
+	require.Equal(t, channeltypes.NewResultAcknowledgement([]byte(`{"contract_result":null,"ibc_ack":null,"forward_sequence":1,"forward_error":"failed"}`)), ics4Wrapper.acks[14])
+	require.Len(t, transferKeeper.transfers, 1)
+	msg := transferKeeper.transfers[0]
+	require.Equal(t, "channel-0", msg.SourceChannel)
+	require.Equal(t, sdk.NewInt64Coin("uscrt", 25), msg.Token)
+	require.Equal(t, "cosmos1sender", msg.Receiver)
+
+	returned, ok := hooks.ibcHooksKeeper.GetInFlightForward(ctx, "channel-0", 1)
+	require.True(t, ok)
+	require.True(t, returned.Returning)
+
+	returnedPacket := forwarded(1, "uscrt", "25")
+	returnedPacket.SourceChannel = "channel-0"
+	require.NoError(t, hooks.resolveForward(im, ctx, returnedPacket, "timed out"))
+	require.Len(t, transferKeeper.transfers, 2)
+	returnedPacket.Sequence = 2
+	require.NoError(t, hooks.resolveForward(im, ctx, returnedPacket, ""))
+	require.Empty(t, hooks.ibcHooksKeeper.GetInFlightForwards(ctx))
+	require.Len(t, ics4Wrapper.acks, 4)
+}
+
+func TestTimeoutOnClosePrunesCallback(t *testing.T) {
+	hooks, _, _, ctx := setupHooks(t)
+	hooks.ContractKeeper = &compute.Keeper{}
+	hooks.ChannelKeeper = mockChannelKeeper{commitments: map[uint64][]byte{1: []byte("commitment"), 2: []byte("commitment")}}
+	ics4 := NewICS4Middleware(nil, hooks)
+	im := NewIBCMiddleware(mockTransferApp{}, &ics4)
+	contract := sdk.AccAddress([]byte("contract____________")).String()
+
+	hooks.ibcHooksKeeper.StorePacketCallback(ctx, "channel-1", 1, contract)
+	hooks.ibcHooksKeeper.StorePacketCallback(ctx, "channel-1", 2, contract)
+	hooks.ibcHooksKeeper.StorePacketCallback(ctx, "channel-1", 3, contract)
+
+	// closing the channel keeps the callbacks of its pending packets, which can still be timed out on close,
+	// and prunes the one whose packet has no commitment anymore
+	require.NoError(t, im.OnChanCloseConfirm(ctx, transfertypes.PortID, "channel-1"))
+	require.Len(t, hooks.ibcHooksKeeper.GetPacketCallbacks(ctx), 2)
+	require.Empty(t, hooks.ibcHooksKeeper.GetPacketCallback(ctx, "channel-1", 3))
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCallbackPruned, events[0].Type)
+	sequence, ok := events[0].GetAttribute(types.AttributeKeySequence)
+	require.True(t, ok)
+	require.Equal(t, "3", sequence.Value)
+
+	// the timeout calls the contract, which is bypassed in CheckTx, and prunes the callback
+	ctx = ctx.WithIsCheckTx(true).WithEventManager(sdk.NewEventManager())
+	data := transfertypes.NewFungibleTokenPacketData("uscrt", "40", contract, "cosmos1receiver", fmt.Sprintf(`{"ibc_callback":%q}`, contract))
+	packet := channeltypes.Packet{Sequence: 1, SourcePort: transfertypes.PortID, SourceChannel: "channel-1", Data: data.GetBytes()}
+	require.NoError(t, im.OnTimeoutPacket(ctx, packet, nil))
+
+	require.Empty(t, hooks.ibcHooksKeeper.GetPacketCallback(ctx, "channel-1", 1))
+	require.Equal(t, contract, hooks.ibcHooksKeeper.GetPacketCallback(ctx, "channel-1", 2))
+
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	require.Equal(t, types.EventTypeCallbackCompleted, events[0].Type)
+	result, ok := events[0].GetAttribute(types.AttributeKeyResult)
+	require.True(t, ok)
+	require.Equal(t, types.AttributeValueTimeout, result.Value)
+}
@@ -41,8 +41,10 @@ var (
 	ExportGenesis               = keeper.ExportGenesis
 	NewKeeper                   = keeper.NewKeeper
 	NewQuerier                  = keeper.NewQuerier
+	DefaultParams               = types.DefaultParams
 	GetGenesisStateFromAppState = keeper.GetGenesisStateFromAppState
 	IsHexString                 = keeper.IsHexString
+	NewQueryClient              = types.NewQueryClient
 	// variable aliases
 	ModuleCdc               = types.ModuleCdc
 	DefaultCodespace        = types.DefaultCodespace
@@ -62,4 +64,8 @@ type (
 	MasterKey            = types.MasterKey
 	Key                  = types.Key
 	RegistrationNodeInfo = types.RegistrationNodeInfo
+	Params               = types.Params
+	MsgReattest          = types.MsgReattest
+	MsgUpdateParams      = types.MsgUpdateParams
+	QueryClient          = types.QueryClient
 )
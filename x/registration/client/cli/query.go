@@ -29,6 +29,11 @@ func GetQueryCmd() *cobra.Command {
 	queryCmd.AddCommand(
 		GetCmdEncryptedSeed(),
 		GetCmdMasterParams(),
+		GetCmdRegisteredNodes(),
+		GetCmdMachineSwaps(),
+		GetCmdMachineWhitelist(),
+		GetCmdParams(),
+		GetCmdStaleNodes(),
 	)
 	return queryCmd
 }
@@ -131,3 +136,167 @@ func GetCmdMasterParams() *cobra.Command {
 	flags.AddQueryFlagsToCmd(cmd)
 	return cmd
 }
+
+// GetCmdRegisteredNodes lists the registered nodes with their attestation fields
+func GetCmdRegisteredNodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "registered-nodes",
+		Short: "List the registered nodes",
+		Long:  "List the registered nodes, with the MRENCLAVE, MRSIGNER and TCB status of their attestation and the height they registered at",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.RegisteredNodes(
+				context.Background(),
+				&types.QueryRegisteredNodesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "registered nodes")
+	return cmd
+}
+
+// GetCmdMachineSwaps lists the machine swaps recorded by node registrations
+func GetCmdMachineSwaps() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "machine-swaps",
+		Short: "List the machine swaps recorded by node registrations",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MachineSwaps(
+				context.Background(),
+				&types.QueryMachineSwapsRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "machine swaps")
+	return cmd
+}
+
+// GetCmdMachineWhitelist lists the machines added to the whitelist on-chain
+func GetCmdMachineWhitelist() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "machine-whitelist",
+		Short: "List the machines added to the whitelist on-chain",
+		Long:  "List the machines added to the whitelist on-chain. Machines whitelisted by the enclave itself aren't listed",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.MachineWhitelist(
+				context.Background(),
+				&types.QueryMachineWhitelistRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "whitelisted machines")
+	return cmd
+}
+
+// GetCmdParams shows the attestation policy
+func GetCmdParams() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "params",
+		Short: "Get the attestation policy nodes must comply with",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.Params(context.Background(), &types.QueryParamsRequest{})
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(&res.Params)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	return cmd
+}
+
+// GetCmdStaleNodes lists the nodes that must re-attest
+func GetCmdStaleNodes() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "stale-nodes",
+		Short: "List the nodes that must re-attest",
+		Long:  "List the registered nodes whose attestation is older than the maximum attestation age, or doesn't comply with the attestation policy",
+		Args:  cobra.ExactArgs(0),
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			clientCtx, err := client.GetClientQueryContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			pageReq, err := client.ReadPageRequest(cmd.Flags())
+			if err != nil {
+				return err
+			}
+
+			queryClient := types.NewQueryClient(clientCtx)
+			res, err := queryClient.StaleNodes(
+				context.Background(),
+				&types.QueryStaleNodesRequest{Pagination: pageReq},
+			)
+			if err != nil {
+				return err
+			}
+
+			return clientCtx.PrintProto(res)
+		},
+	}
+	flags.AddQueryFlagsToCmd(cmd)
+	flags.AddPaginationFlagsToCmd(cmd, "stale nodes")
+	return cmd
+}
@@ -22,6 +22,7 @@ func GetTxCmd() *cobra.Command {
 	}
 	txCmd.AddCommand(
 		AuthenticateNodeCmd(),
+		ReattestNodeCmd(),
 	)
 	return txCmd
 }
@@ -69,3 +70,37 @@ func AuthenticateNodeCmd() *cobra.Command {
 
 	return cmd
 }
+
+// ReattestNodeCmd refreshes the attestation of a registered node
+func ReattestNodeCmd() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "reattest attestation_file",
+		Short: "Upload a fresh certificate for a registered node",
+		Long:  "Upload a fresh certificate for a registered node, to keep its attestation compliant with the attestation policy",
+		Args:  cobra.ExactArgs(1),
+		RunE: func(cmd *cobra.Command, args []string) error {
+			clientCtx, err := client.GetClientTxContext(cmd)
+			if err != nil {
+				return err
+			}
+
+			cert, err := os.ReadFile(args[0])
+			if err != nil {
+				return err
+			}
+
+			msg := types.MsgReattest{
+				Sender:      clientCtx.GetFromAddress(),
+				Certificate: cert,
+			}
+			err = msg.ValidateBasic()
+			if err != nil {
+				return xerrors.Errorf("Validtaion on input has failed: %v", err)
+			}
+			return tx.GenerateOrBroadcastTxCLI(clientCtx, cmd.Flags(), &msg)
+		},
+	}
+	flags.AddTxFlagsToCmd(cmd)
+
+	return cmd
+}
@@ -18,6 +18,9 @@ func InitGenesis(ctx sdk.Context, keeper Keeper, data types.GenesisState) {
 				panic(err)
 			}
 		}
+		if err := keeper.SetParams(ctx, data.Params); err != nil {
+			panic(err)
+		}
 	} else {
 		panic("Cannot start without MasterKey set")
 	}
@@ -29,6 +32,7 @@ func ExportGenesis(ctx sdk.Context, keeper Keeper) *types.GenesisState {
 
 	genState.NodeExchMasterKey = keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
 	genState.IoMasterKey = keeper.GetMasterKey(ctx, types.MasterIoKeyId)
+	genState.Params = keeper.GetParams(ctx)
 
 	keeper.ListRegistrationInfo(
 		ctx,
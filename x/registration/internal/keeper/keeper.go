@@ -8,6 +8,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"time"
 
 	"cosmossdk.io/core/store"
 	errorsmod "cosmossdk.io/errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/cosmos/cosmos-sdk/codec"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/cosmos/gogoproto/proto"
 	ics23 "github.com/cosmos/ics23/go"
 	"github.com/scrtlabs/SecretNetwork/go-cosmwasm/api"
@@ -33,10 +35,12 @@ type Keeper struct {
 	router       baseapp.MessageRouter
 	queryer      ABCIQueryer
 	coldDataSet  bool
+	// the address capable of executing a MsgUpdateParams message. Typically, this should be the x/gov module account.
+	authority string
 }
 
 // NewKeeper creates a new contract Keeper instance
-func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, router baseapp.MessageRouter, enclave EnclaveInterface, homeDir string, bootstrap bool, q ABCIQueryer) Keeper {
+func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, router baseapp.MessageRouter, enclave EnclaveInterface, homeDir string, bootstrap bool, q ABCIQueryer, authority string) Keeper {
 	if !bootstrap {
 		InitializeNode(homeDir, enclave)
 	}
@@ -48,6 +52,7 @@ func NewKeeper(cdc codec.Codec, storeService store.KVStoreService, router baseap
 		enclave:      enclave,
 		queryer:      q,
 		coldDataSet:  false,
+		authority:    authority,
 	}
 }
 
@@ -138,6 +143,13 @@ func InitializeNode(homeDir string, enclave EnclaveInterface) {
 	}
 }
 
+// machine entries are either a whitelisted machine id, or the owner, machine id and replaced machine id of a swap
+const (
+	machineOwnerLen    = 32
+	machineIDLen       = 20
+	machineSwapInfoLen = machineOwnerLen + 2*machineIDLen
+)
+
 func (k Keeper) AddMachineSwapInfo(ctx sdk.Context, data []byte) error {
 	store := k.storeService.OpenKVStore(ctx)
 
@@ -167,6 +179,47 @@ func (k Keeper) OnNewMachine(ctx sdk.Context, id []byte) error {
 	return k.AddMachineSwapInfo(ctx, id)
 }
 
+// PaginatedMachineSwaps returns a page of the machine swaps recorded by node registrations
+func (k Keeper) PaginatedMachineSwaps(ctx sdk.Context, pagination *query.PageRequest) ([]types.MachineSwap, *query.PageResponse, error) {
+	var swaps []types.MachineSwap
+	pageRes, err := k.paginateMachineInfo(ctx, pagination, machineSwapInfoLen, func(index uint32, value []byte) {
+		swap := types.MachineSwap{
+			Index:     index,
+			Owner:     hex.EncodeToString(value[:machineOwnerLen]),
+			MachineId: hex.EncodeToString(value[machineOwnerLen : machineOwnerLen+machineIDLen]),
+		}
+		if replaced := value[machineOwnerLen+machineIDLen:]; !bytes.Equal(replaced, make([]byte, machineIDLen)) {
+			swap.ReplacedMachineId = hex.EncodeToString(replaced)
+		}
+		swaps = append(swaps, swap)
+	})
+	return swaps, pageRes, err
+}
+
+// PaginatedMachineWhitelist returns a page of the machines added to the whitelist by OnNewMachine
+func (k Keeper) PaginatedMachineWhitelist(ctx sdk.Context, pagination *query.PageRequest) ([]types.WhitelistedMachine, *query.PageResponse, error) {
+	var machines []types.WhitelistedMachine
+	pageRes, err := k.paginateMachineInfo(ctx, pagination, machineIDLen, func(index uint32, value []byte) {
+		machines = append(machines, types.WhitelistedMachine{Index: index, MachineId: hex.EncodeToString(value)})
+	})
+	return machines, pageRes, err
+}
+
+// paginateMachineInfo pages through the machine entries of the given length. Whitelisted machines and machine swaps
+// share the same index, and are told apart by their length
+func (k Keeper) paginateMachineInfo(ctx sdk.Context, pagination *query.PageRequest, length int, cb func(uint32, []byte)) (*query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RegistrationMachinePrefix)
+	return query.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		if len(value) != length || len(key) != 4 {
+			return false, nil
+		}
+		if accumulate {
+			cb(binary.BigEndian.Uint32(key), value)
+		}
+		return true, nil
+	})
+}
+
 type ABCIQueryer interface {
 	Query(ctx context.Context, req *abci.RequestQuery) (*abci.ResponseQuery, error)
 }
@@ -341,7 +394,7 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate, replac
 		encSeed = make([]byte, 32)
 	} else {
 
-		publicKey_, err := ra.VerifyCombinedCert(certificate)
+		publicKey_, err := ra.VerifyCombinedCertWithPolicy(certificate, k.GetParams(ctx).AttestationPolicy())
 		if err != nil {
 			return nil, errorsmod.Wrap(types.ErrAuthenticateFailed, err.Error())
 		}
@@ -379,9 +432,28 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate, replac
 
 	}
 
+	// re-registering or re-attesting a node keeps the height it first registered at.
+	// Only a certificate with a newer attestation report replaces the one on file, an older one can't make the
+	// attestation look any fresher
+	registrationHeight := ctx.BlockHeight()
+	attestationHeight := ctx.BlockHeight()
+	if publicKey != nil {
+		if existing := k.getRegistrationInfo(ctx, publicKey); existing != nil {
+			if existing.RegistrationHeight != 0 {
+				registrationHeight = existing.RegistrationHeight
+			}
+			if !isNewerAttestation(certificate, existing.Certificate) {
+				certificate = existing.Certificate
+				attestationHeight = existing.AttestationHeight
+			}
+		}
+	}
+
 	regInfo := types.RegistrationNodeInfo{
-		Certificate:   certificate,
-		EncryptedSeed: encSeed,
+		Certificate:        certificate,
+		EncryptedSeed:      encSeed,
+		RegistrationHeight: registrationHeight,
+		AttestationHeight:  attestationHeight,
 	}
 
 	var err error
@@ -400,6 +472,50 @@ func (k Keeper) RegisterNode(ctx sdk.Context, certificate ra.Certificate, replac
 	return encSeed, nil
 }
 
+// Reattest refreshes the certificate of a registered node, which goes through the same verification as a registration.
+// The attestation report of the certificate must be newer than the one of the certificate on file
+func (k Keeper) Reattest(ctx sdk.Context, certificate ra.Certificate) ([]byte, error) {
+	publicKey, err := ra.VerifyCombinedCert(certificate)
+	if err != nil {
+		return nil, errorsmod.Wrap(types.ErrAuthenticateFailed, err.Error())
+	}
+
+	existing := k.getRegistrationInfo(ctx, publicKey)
+	if existing == nil {
+		return nil, errorsmod.Wrapf(types.ErrNotFound, "node %s is not registered", hex.EncodeToString(publicKey))
+	}
+	if !isNewerAttestation(certificate, existing.Certificate) {
+		return nil, errorsmod.Wrapf(types.ErrInvalid, "the attestation report of node %s is not newer than the one on file", hex.EncodeToString(publicKey))
+	}
+
+	if _, err := k.RegisterNode(ctx, certificate, ""); err != nil {
+		return nil, err
+	}
+	return publicKey, nil
+}
+
+// isNewerAttestation returns true if the attestation report of the certificate was issued after the one of the
+// certificate on file. Certificates without a report time are never newer
+func isNewerAttestation(certificate ra.Certificate, onFile ra.Certificate) bool {
+	reportTime := attestationReportTime(certificate)
+	return !reportTime.IsZero() && reportTime.After(attestationReportTime(onFile))
+}
+
+// attestationReportTime returns the time the attestation report of the certificate was issued at, or the zero time
+// if it has none or doesn't parse
+func attestationReportTime(certificate ra.Certificate) time.Time {
+	info, err := ra.ParseCombinedCert(certificate)
+	if err != nil {
+		return time.Time{}
+	}
+	return info.ReportTime
+}
+
+// GetAuthority returns the x/registration module's authority.
+func (k Keeper) GetAuthority() string {
+	return k.authority
+}
+
 // returns true when simulation mode used by gas=auto queries
 func isSimulationMode(ctx sdk.Context) bool {
 	return ctx.GasMeter().Limit() == 0 && ctx.BlockHeight() != 0
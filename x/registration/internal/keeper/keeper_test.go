@@ -87,6 +87,38 @@ func TestKeeper_RegisterNode(t *testing.T) {
 
 	regKeeper.SetRegistrationInfo(ctx, regInfo)
 
+	ctx = ctx.WithBlockHeight(10)
 	_, err = regKeeper.RegisterNode(ctx, cert, "")
 	require.NoError(t, err)
+
+	publicKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+	require.Equal(t, int64(10), regKeeper.getRegistrationInfo(ctx, publicKey).AttestationHeight)
+
+	// registering again with the certificate on file doesn't refresh the attestation
+	ctx = ctx.WithBlockHeight(20)
+	_, err = regKeeper.RegisterNode(ctx, cert, "")
+	require.NoError(t, err)
+	require.Equal(t, int64(10), regKeeper.getRegistrationInfo(ctx, publicKey).AttestationHeight)
+
+	// nor can it re-attest the node
+	_, err = regKeeper.Reattest(ctx, cert)
+	require.ErrorIs(t, err, types.ErrInvalid)
+	require.Equal(t, int64(10), regKeeper.getRegistrationInfo(ctx, publicKey).AttestationHeight)
+}
+
+func TestIsNewerAttestation(t *testing.T) {
+	cert := dcapCombinedCert(t)
+	newerCert := dcapCombinedCert(t, "2021-04-30T08:46:22Z")
+	swCert, err := os.ReadFile("../../testdata/attestation_cert_sw.combined")
+	require.NoError(t, err)
+
+	require.True(t, isNewerAttestation(newerCert, cert))
+	// an older report, or the same one, can't refresh the attestation
+	require.False(t, isNewerAttestation(cert, newerCert))
+	require.False(t, isNewerAttestation(cert, cert))
+	// certificates without a report time are never newer, but any report is newer than none
+	require.False(t, isNewerAttestation(swCert, cert))
+	require.False(t, isNewerAttestation(swCert, swCert))
+	require.True(t, isNewerAttestation(cert, swCert))
 }
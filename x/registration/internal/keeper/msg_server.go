@@ -6,7 +6,9 @@ import (
 	"encoding/json"
 	"fmt"
 
+	errorsmod "cosmossdk.io/errors"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 )
@@ -67,3 +69,41 @@ func (m msgServer) RegisterAuth(goCtx context.Context, msg *types.RaAuthenticate
 		Events: string(events),
 	}, nil
 }
+
+func (m msgServer) Reattest(goCtx context.Context, msg *types.MsgReattest) (*types.MsgReattestResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	err := msg.ValidateBasic()
+	if err != nil {
+		return nil, err
+	}
+
+	pubkey, err := m.keeper.Reattest(ctx, msg.Certificate)
+	if err != nil {
+		return nil, err
+	}
+
+	ctx.EventManager().EmitEvents(sdk.Events{
+		sdk.NewEvent(
+			sdk.EventTypeMessage,
+			sdk.NewAttribute(sdk.AttributeKeyModule, m.module),
+			sdk.NewAttribute(AttributeSigner, msg.Sender.String()),
+			sdk.NewAttribute(AttributeNodeID, fmt.Sprintf("0x%s", hex.EncodeToString(pubkey))),
+		),
+	})
+
+	return &types.MsgReattestResponse{}, nil
+}
+
+func (m msgServer) UpdateParams(goCtx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
+	if m.keeper.authority != req.Authority {
+		return nil, errorsmod.Wrapf(govtypes.ErrInvalidSigner, "invalid authority; expected %s, got %s", m.keeper.authority, req.Authority)
+	}
+
+	ctx := sdk.UnwrapSDKContext(goCtx)
+	if err := m.keeper.SetParams(ctx, req.Params); err != nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, err.Error())
+	}
+
+	return &types.MsgUpdateParamsResponse{}, nil
+}
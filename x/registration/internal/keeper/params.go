@@ -0,0 +1,104 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/store/prefix"
+	"github.com/cosmos/cosmos-sdk/runtime"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+// GetParams returns the attestation policy, which accepts everything until governance sets one
+func (k Keeper) GetParams(ctx sdk.Context) (params types.Params) {
+	store := k.storeService.OpenKVStore(ctx)
+	bz, _ := store.Get(types.ParamsKey)
+	if bz == nil {
+		return types.DefaultParams()
+	}
+
+	k.cdc.MustUnmarshal(bz, &params)
+	return params
+}
+
+// SetParams sets the attestation policy. It applies to new registrations and re-attestations, registered nodes that
+// don't comply with it are reported as stale
+func (k Keeper) SetParams(ctx sdk.Context, params types.Params) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+
+	store := k.storeService.OpenKVStore(ctx)
+	bz, err := k.cdc.Marshal(&params)
+	if err != nil {
+		return err
+	}
+
+	return store.Set(types.ParamsKey, bz)
+}
+
+// PaginatedStaleNodes returns a page of the registered nodes whose attestation report is too old or doesn't comply with
+// the attestation policy
+func (k Keeper) PaginatedStaleNodes(ctx sdk.Context, pagination *query.PageRequest) ([]types.StaleNode, *query.PageResponse, error) {
+	params := k.GetParams(ctx)
+	policy := params.AttestationPolicy()
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RegistrationStorePrefix)
+
+	var nodes []types.StaleNode
+	pageRes, err := query.FilteredPaginate(store, pagination, func(key, value []byte, accumulate bool) (bool, error) {
+		var regInfo types.RegistrationNodeInfo
+		if err := k.cdc.Unmarshal(value, &regInfo); err != nil {
+			return false, err
+		}
+
+		attestationHeight := regInfo.AttestationHeight
+		if attestationHeight == 0 {
+			attestationHeight = regInfo.RegistrationHeight
+		}
+
+		info, parseErr := ra.ParseCombinedCert(regInfo.Certificate)
+		reasons := staleReasons(ctx.BlockTime(), params, policy, info, parseErr)
+		if len(reasons) == 0 {
+			return false, nil
+		}
+		if accumulate {
+			node := types.StaleNode{
+				PubKey:            append([]byte(nil), key...),
+				AttestationHeight: attestationHeight,
+				Reasons:           reasons,
+			}
+			if parseErr == nil && !info.ReportTime.IsZero() {
+				node.ReportTime = &info.ReportTime
+			}
+			nodes = append(nodes, node)
+		}
+		return true, nil
+	})
+	return nodes, pageRes, err
+}
+
+// staleReasons checks the parsed certificate of a node, which is only checked if a max attestation age or a policy is
+// set. The age of the attestation is measured from the time its report was issued at
+func staleReasons(now time.Time, params types.Params, policy ra.AttestationPolicy, info *ra.AttestationInfo, parseErr error) []string {
+	if params.MaxAttestationAge == 0 && policy.IsEmpty() {
+		return nil
+	}
+	if parseErr != nil {
+		return []string{fmt.Sprintf("certificate can't be checked against the policy: %s", parseErr)}
+	}
+
+	var reasons []string
+
+	if params.MaxAttestationAge > 0 {
+		if info.ReportTime.IsZero() {
+			reasons = append(reasons, "attestation report has no time, its age can't be checked")
+		} else if age := int64(now.Sub(info.ReportTime) / time.Second); age > params.MaxAttestationAge {
+			reasons = append(reasons, fmt.Sprintf("attestation report is %d seconds old, the maximum is %d", age, params.MaxAttestationAge))
+		}
+	}
+
+	return append(reasons, policy.Violations(info)...)
+}
@@ -71,6 +71,54 @@ func (q GrpcQuerier) EncryptedSeed(c context.Context, req *types.QueryEncryptedS
 	return &types.QueryEncryptedSeedResponse{EncryptedSeed: rsp}, nil
 }
 
+func (q GrpcQuerier) RegisteredNodes(c context.Context, req *types.QueryRegisteredNodesRequest) (*types.QueryRegisteredNodesResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "empty request")
+	}
+	nodes, pageRes, err := q.keeper.PaginatedRegisteredNodes(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryRegisteredNodesResponse{Nodes: nodes, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) MachineSwaps(c context.Context, req *types.QueryMachineSwapsRequest) (*types.QueryMachineSwapsResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "empty request")
+	}
+	swaps, pageRes, err := q.keeper.PaginatedMachineSwaps(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMachineSwapsResponse{Swaps: swaps, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) MachineWhitelist(c context.Context, req *types.QueryMachineWhitelistRequest) (*types.QueryMachineWhitelistResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "empty request")
+	}
+	machines, pageRes, err := q.keeper.PaginatedMachineWhitelist(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryMachineWhitelistResponse{Machines: machines, Pagination: pageRes}, nil
+}
+
+func (q GrpcQuerier) Params(c context.Context, _ *types.QueryParamsRequest) (*types.QueryParamsResponse, error) {
+	return &types.QueryParamsResponse{Params: q.keeper.GetParams(sdk.UnwrapSDKContext(c))}, nil
+}
+
+func (q GrpcQuerier) StaleNodes(c context.Context, req *types.QueryStaleNodesRequest) (*types.QueryStaleNodesResponse, error) {
+	if req == nil {
+		return nil, errorsmod.Wrap(types.ErrInvalid, "empty request")
+	}
+	nodes, pageRes, err := q.keeper.PaginatedStaleNodes(sdk.UnwrapSDKContext(c), req.Pagination)
+	if err != nil {
+		return nil, err
+	}
+	return &types.QueryStaleNodesResponse{Nodes: nodes, Pagination: pageRes}, nil
+}
+
 func queryMasterKey(ctx sdk.Context, keeper Keeper) (*types.GenesisState, error) {
 	ioKey := keeper.GetMasterKey(ctx, types.MasterIoKeyId)
 	nodeKey := keeper.GetMasterKey(ctx, types.MasterNodeKeyId)
@@ -1,10 +1,12 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/hex"
 	"fmt"
 	"os"
 	"testing"
+	"time"
 
 	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
@@ -104,6 +106,145 @@ func TestNewQuerier_ValidNodeID(t *testing.T) {
 	require.Equal(t, res.EncryptedSeed, regInfo.EncryptedSeed)
 }
 
+func TestNewQuerier_RegisteredNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	querier := NewQuerier(keeper)
+
+	cert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+
+	regInfo := types.RegistrationNodeInfo{
+		Certificate:        cert,
+		EncryptedSeed:      []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		RegistrationHeight: 42,
+	}
+	require.NoError(t, keeper.SetRegistrationInfo(ctx, regInfo))
+
+	pubKey, err := ra.VerifyRaCert(regInfo.Certificate)
+	require.NoError(t, err)
+
+	res, err := querier.RegisteredNodes(ctx, &types.QueryRegisteredNodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 1)
+	require.Equal(t, pubKey, res.Nodes[0].PubKey)
+	require.Equal(t, int64(42), res.Nodes[0].RegistrationHeight)
+}
+
+func TestNewQuerier_MachineSwaps(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+
+	querier := NewQuerier(keeper)
+
+	machine := bytes.Repeat([]byte{0x01}, 20)
+	owner := bytes.Repeat([]byte{0x02}, 32)
+	newMachine := bytes.Repeat([]byte{0x03}, 20)
+
+	require.NoError(t, keeper.OnNewMachine(ctx, machine))
+	require.NoError(t, keeper.AddMachineSwapInfo(ctx, append(append(append([]byte(nil), owner...), newMachine...), machine...)))
+	// re-registering on the same machine leaves the replaced machine id empty
+	require.NoError(t, keeper.AddMachineSwapInfo(ctx, append(append(append([]byte(nil), owner...), newMachine...), make([]byte, 20)...)))
+
+	whitelist, err := querier.MachineWhitelist(ctx, &types.QueryMachineWhitelistRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.WhitelistedMachine{{Index: 0, MachineId: hex.EncodeToString(machine)}}, whitelist.Machines)
+
+	swaps, err := querier.MachineSwaps(ctx, &types.QueryMachineSwapsRequest{})
+	require.NoError(t, err)
+	require.Equal(t, []types.MachineSwap{
+		{Index: 1, Owner: hex.EncodeToString(owner), MachineId: hex.EncodeToString(newMachine), ReplacedMachineId: hex.EncodeToString(machine)},
+		{Index: 2, Owner: hex.EncodeToString(owner), MachineId: hex.EncodeToString(newMachine)},
+	}, swaps.Swaps)
+}
+
+func TestNewQuerier_StaleNodes(t *testing.T) {
+	tempDir, err := os.MkdirTemp("", "wasm")
+	require.NoError(t, err)
+	defer os.RemoveAll(tempDir)
+	ctx, keeper := CreateTestInput(t, false, tempDir, true)
+	ctx = ctx.WithBlockHeight(100)
+
+	querier := NewQuerier(keeper)
+
+	// the report of the dcap certificate was issued at 2021-03-31T08:46:22Z, 100 seconds before the block time
+	cert := dcapCombinedCert(t)
+	reportTime := time.Date(2021, 3, 31, 8, 46, 22, 0, time.UTC)
+	ctx = ctx.WithBlockTime(reportTime.Add(100 * time.Second))
+
+	pubKey, err := ra.VerifyCombinedCert(cert)
+	require.NoError(t, err)
+	regInfo := types.RegistrationNodeInfo{
+		Certificate:        cert,
+		EncryptedSeed:      []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+		RegistrationHeight: 10,
+		AttestationHeight:  60,
+	}
+	require.NoError(t, keeper.SetRegistrationInfo_Verified(ctx, regInfo, pubKey))
+
+	// the default policy never expires
+	res, err := querier.StaleNodes(ctx, &types.QueryStaleNodesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Nodes)
+
+	params := types.DefaultParams()
+	params.MaxAttestationAge = 200
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	res, err = querier.StaleNodes(ctx, &types.QueryStaleNodesRequest{})
+	require.NoError(t, err)
+	require.Empty(t, res.Nodes)
+
+	// the age is measured from the report, not from the height the certificate was submitted at
+	params.MaxAttestationAge = 50
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	res, err = querier.StaleNodes(ctx, &types.QueryStaleNodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 1)
+	require.Equal(t, int64(60), res.Nodes[0].AttestationHeight)
+	require.Equal(t, reportTime, *res.Nodes[0].ReportTime)
+	require.Equal(t, []string{"attestation report is 100 seconds old, the maximum is 50"}, res.Nodes[0].Reasons)
+
+	// a legacy certificate can't prove its age or that it complies with the policy
+	legacyCert, err := os.ReadFile("../../testdata/attestation_cert_sw")
+	require.NoError(t, err)
+	require.NoError(t, keeper.SetRegistrationInfo(ctx, types.RegistrationNodeInfo{
+		Certificate:   legacyCert,
+		EncryptedSeed: []byte("aaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaaa"),
+	}))
+	legacyPubKey, err := ra.VerifyRaCert(legacyCert)
+	require.NoError(t, err)
+
+	params.MaxAttestationAge = 200
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	res, err = querier.StaleNodes(ctx, &types.QueryStaleNodesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Nodes, 1)
+	require.Equal(t, legacyPubKey, res.Nodes[0].PubKey)
+	require.Nil(t, res.Nodes[0].ReportTime)
+	require.Contains(t, res.Nodes[0].Reasons[0], "can't be checked against the policy")
+
+	params.MaxAttestationAge = 0
+	params.MinIsvSvn = 1
+	require.NoError(t, keeper.SetParams(ctx, params))
+
+	res, err = querier.StaleNodes(ctx, &types.QueryStaleNodesRequest{})
+	require.NoError(t, err)
+	require.NotEmpty(t, res.Nodes)
+	for _, node := range res.Nodes {
+		if bytes.Equal(node.PubKey, legacyPubKey) {
+			require.Contains(t, node.Reasons[0], "can't be checked against the policy")
+		}
+	}
+}
+
 // func TestNewQuerier_Seed(t *testing.T) {
 // 	tempDir, err := os.MkdirTemp("", "wasm")
 // 	require.NoError(t, err)
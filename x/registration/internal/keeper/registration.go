@@ -4,6 +4,7 @@ import (
 	"cosmossdk.io/store/prefix"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
 	"github.com/scrtlabs/SecretNetwork/x/registration/internal/types"
 	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
 )
@@ -55,6 +56,34 @@ func (k Keeper) ListRegistrationInfo(ctx sdk.Context, cb func([]byte, types.Regi
 	}
 }
 
+// PaginatedRegisteredNodes returns a page of the registered nodes, with the attestation fields of their certificates
+func (k Keeper) PaginatedRegisteredNodes(ctx sdk.Context, pagination *query.PageRequest) ([]types.RegisteredNode, *query.PageResponse, error) {
+	store := prefix.NewStore(runtime.KVStoreAdapter(k.storeService.OpenKVStore(ctx)), types.RegistrationStorePrefix)
+
+	var nodes []types.RegisteredNode
+	pageRes, err := query.Paginate(store, pagination, func(key, value []byte) error {
+		var regInfo types.RegistrationNodeInfo
+		if err := k.cdc.Unmarshal(value, &regInfo); err != nil {
+			return err
+		}
+
+		node := types.RegisteredNode{
+			PubKey:             append([]byte(nil), key...),
+			RegistrationHeight: regInfo.RegistrationHeight,
+		}
+		// nodes registered from genesis may hold legacy certificates that don't parse, they're still listed
+		if info, err := ra.ParseCombinedCert(regInfo.Certificate); err == nil {
+			node.AttestationType = info.Type
+			node.MrEnclave = info.MrEnclave
+			node.MrSigner = info.MrSigner
+			node.TcbStatus = info.TcbStatus
+		}
+		nodes = append(nodes, node)
+		return nil
+	})
+	return nodes, pageRes, err
+}
+
 func (k Keeper) SetRegistrationInfo(ctx sdk.Context, certificate types.RegistrationNodeInfo) error {
 	publicKey, err := ra.VerifyRaCert(certificate.Certificate)
 	if err != nil {
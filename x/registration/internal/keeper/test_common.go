@@ -1,7 +1,9 @@
 package keeper
 
 import (
+	"bytes"
 	"encoding/base64"
+	"encoding/binary"
 	"encoding/json"
 	"os"
 	"testing"
@@ -19,10 +21,12 @@ import (
 	"github.com/cosmos/cosmos-sdk/client"
 	"github.com/cosmos/cosmos-sdk/x/auth"
 	"github.com/cosmos/cosmos-sdk/x/auth/tx"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
 	"github.com/cosmos/cosmos-sdk/x/bank"
 	"github.com/cosmos/cosmos-sdk/x/distribution"
 	"github.com/cosmos/cosmos-sdk/x/gov"
 	govclient "github.com/cosmos/cosmos-sdk/x/gov/client"
+	govtypes "github.com/cosmos/cosmos-sdk/x/gov/types"
 	"github.com/cosmos/cosmos-sdk/x/mint"
 	paramsclient "github.com/cosmos/cosmos-sdk/x/params/client"
 	"github.com/cosmos/cosmos-sdk/x/slashing"
@@ -70,6 +74,24 @@ func CreateTestSeedConfig(t *testing.T) []byte {
 	return cfgBytes
 }
 
+// dcapCombinedCert returns a combined certificate with the test dcap quote and its collateral, whose TCB info was
+// issued at 2021-03-31T08:46:22Z. The issue date is replaced with issueDate if it's set
+func dcapCombinedCert(t *testing.T, issueDate ...string) []byte {
+	quote, err := os.ReadFile("../../testdata/attestation_dcap.quote")
+	require.NoError(t, err)
+	collateral, err := os.ReadFile("../../testdata/attestation_dcap.collateral")
+	require.NoError(t, err)
+	if len(issueDate) > 0 {
+		collateral = bytes.Replace(collateral, []byte("2021-03-31T08:46:22Z"), []byte(issueDate[0]), 1)
+	}
+
+	// the legacy format, without an EPID certificate
+	cert := make([]byte, 12)
+	binary.LittleEndian.PutUint32(cert[4:], uint32(len(quote)))
+	binary.LittleEndian.PutUint32(cert[8:], uint32(len(collateral)))
+	return append(append(cert, quote...), collateral...)
+}
+
 var ModuleBasics = module.NewBasicManager(
 	auth.AppModuleBasic{},
 	bank.AppModuleBasic{},
@@ -136,7 +158,7 @@ func CreateTestInput(t *testing.T, isCheckTx bool, tempDir string, bootstrap boo
 	router := baseapp.NewMsgServiceRouter()
 
 	// Load default wasm config
-	keeper := NewKeeper(cdc, runtime.NewKVStoreService(keys[regtypes.StoreKey]), router, registrationmock.MockEnclaveApi{}, tempDir, bootstrap, &baseapp.BaseApp{})
+	keeper := NewKeeper(cdc, runtime.NewKVStoreService(keys[regtypes.StoreKey]), router, registrationmock.MockEnclaveApi{}, tempDir, bootstrap, &baseapp.BaseApp{}, authtypes.NewModuleAddress(govtypes.ModuleName).String())
 
 	return ctx, keeper
 }
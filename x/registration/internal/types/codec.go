@@ -10,12 +10,16 @@ import (
 // RegisterCodec registers the account types and interface
 func RegisterLegacyAminoCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&RaAuthenticate{}, "reg/authenticate", nil)
+	cdc.RegisterConcrete(&MsgReattest{}, "reg/reattest", nil)
+	cdc.RegisterConcrete(&MsgUpdateParams{}, "reg/MsgUpdateParams", nil)
 }
 
 func RegisterInterfaces(registry types.InterfaceRegistry) {
 	registry.RegisterImplementations(
 		(*sdk.Msg)(nil),
 		&RaAuthenticate{},
+		&MsgReattest{},
+		&MsgUpdateParams{},
 	)
 	msgservice.RegisterMsgServiceDesc(registry, &_Msg_serviceDesc)
 }
@@ -12,5 +12,5 @@ func ValidateGenesis(data GenesisState) error {
 	//	return ErrCertificateInvalid
 	//}
 
-	return nil
+	return data.Params.Validate()
 }
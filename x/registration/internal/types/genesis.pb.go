@@ -27,6 +27,7 @@ type GenesisState struct {
 	Registration      []*RegistrationNodeInfo `protobuf:"bytes,1,rep,name=registration,proto3" json:"reg_info"`
 	NodeExchMasterKey *MasterKey              `protobuf:"bytes,2,opt,name=node_exch_master_key,json=nodeExchMasterKey,proto3" json:"node_exch_key"`
 	IoMasterKey       *MasterKey              `protobuf:"bytes,3,opt,name=io_master_key,json=ioMasterKey,proto3" json:"io_exch_key"`
+	Params            Params                  `protobuf:"bytes,4,opt,name=params,proto3" json:"params"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -71,29 +72,31 @@ func init() {
 }
 
 var fileDescriptor_ce4400b3c39a810a = []byte{
-	// 342 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x91, 0xcf, 0x6a, 0xea, 0x40,
-	0x14, 0xc6, 0x13, 0x85, 0xcb, 0x25, 0x51, 0x2e, 0x06, 0x17, 0xe2, 0x85, 0x51, 0x2e, 0xdc, 0xd6,
-	0x6e, 0x12, 0xb4, 0x0f, 0x50, 0x10, 0x4a, 0x29, 0xa5, 0x2e, 0x74, 0xd7, 0x2e, 0xc2, 0x24, 0x1e,
-	0xc7, 0xa9, 0x3a, 0x47, 0x66, 0xa6, 0xad, 0x79, 0x8b, 0x3e, 0x46, 0x1f, 0xa2, 0x0f, 0xe0, 0xd2,
-	0x65, 0x57, 0xd2, 0xc6, 0x9d, 0x4f, 0x51, 0x4c, 0xa4, 0xc6, 0x4d, 0xa0, 0xbb, 0xf9, 0xf3, 0x3b,
-	0xdf, 0xef, 0xc0, 0x67, 0x9d, 0x29, 0x08, 0x25, 0x68, 0x4f, 0x02, 0xe3, 0x4a, 0x4b, 0xaa, 0x39,
-	0x0a, 0xef, 0xa9, 0x1d, 0x80, 0xa6, 0x6d, 0x8f, 0x81, 0x00, 0xc5, 0x95, 0x3b, 0x97, 0xa8, 0xd1,
-	0xf9, 0x9b, 0xa2, 0x6e, 0x16, 0x75, 0xf7, 0x68, 0xbd, 0xca, 0x90, 0x61, 0xc2, 0x79, 0xbb, 0x53,
-	0x3a, 0x52, 0x3f, 0xcd, 0x4b, 0xd7, 0xd1, 0x1c, 0xf6, 0xd9, 0xf5, 0xff, 0x79, 0xe0, 0x4c, 0xb1,
-	0x14, 0xfb, 0xf7, 0x56, 0xb0, 0x4a, 0x57, 0xe9, 0x52, 0x03, 0x4d, 0x35, 0x38, 0xa1, 0x55, 0xca,
-	0x8e, 0xd4, 0xcc, 0x66, 0xb1, 0x65, 0x77, 0xda, 0x6e, 0xce, 0xaa, 0x6e, 0x3f, 0xf3, 0xd8, 0xc3,
-	0x21, 0x5c, 0x8b, 0x11, 0x76, 0x4b, 0xdb, 0x75, 0xe3, 0xb7, 0x04, 0xe6, 0x73, 0x31, 0xc2, 0xfe,
-	0x51, 0xa8, 0xf3, 0x60, 0x55, 0x05, 0x0e, 0xc1, 0x87, 0x45, 0x38, 0xf6, 0x67, 0x54, 0x69, 0x90,
-	0xfe, 0x04, 0xa2, 0x5a, 0xa1, 0x69, 0xb6, 0xec, 0xce, 0x49, 0xae, 0xec, 0x36, 0xc1, 0x6f, 0x20,
-	0xea, 0x56, 0xb6, 0xeb, 0x46, 0xf9, 0x90, 0x33, 0x81, 0xa8, 0x5f, 0xd9, 0x5d, 0x2f, 0x17, 0xe1,
-	0xf8, 0x9b, 0x72, 0xee, 0xad, 0x32, 0xc7, 0xac, 0xa4, 0xf8, 0x23, 0xc9, 0x9f, 0xed, 0xba, 0x61,
-	0x73, 0x3c, 0x28, 0x6c, 0x8e, 0x87, 0x5f, 0xba, 0xfc, 0x24, 0xc6, 0x6b, 0x4c, 0xcc, 0x65, 0x4c,
-	0xcc, 0x55, 0x4c, 0xcc, 0x8f, 0x98, 0x98, 0x2f, 0x1b, 0x62, 0xac, 0x36, 0xc4, 0x78, 0xdf, 0x10,
-	0xe3, 0xee, 0x82, 0x71, 0x3d, 0x7e, 0x0c, 0xdc, 0x10, 0x67, 0x9e, 0x0a, 0xa5, 0x9e, 0xd2, 0x40,
-	0x79, 0x83, 0x44, 0xdd, 0x03, 0xfd, 0x8c, 0x72, 0xe2, 0x2d, 0x8e, 0x4b, 0xe2, 0x42, 0x83, 0x14,
-	0x74, 0x9a, 0xd6, 0x19, 0xfc, 0x4a, 0x8a, 0x3a, 0xff, 0x0a, 0x00, 0x00, 0xff, 0xff, 0xbb, 0xe8,
-	0xf1, 0x91, 0x58, 0x02, 0x00, 0x00,
+	// 371 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xc1, 0x6a, 0xe2, 0x40,
+	0x18, 0xc7, 0x13, 0x15, 0x59, 0x12, 0x65, 0x31, 0x78, 0x10, 0x17, 0x26, 0xb2, 0xcb, 0xee, 0xba,
+	0x97, 0x04, 0xdd, 0x07, 0x28, 0x0d, 0x94, 0x52, 0x4a, 0xa5, 0xc4, 0x5b, 0x7b, 0x08, 0x93, 0xf8,
+	0x19, 0xa7, 0x9a, 0x8c, 0xcc, 0x4c, 0x5b, 0xf3, 0x16, 0x7d, 0x8c, 0x3e, 0x8a, 0x47, 0x8f, 0x3d,
+	0x85, 0x36, 0xde, 0x7c, 0x89, 0x16, 0x93, 0x50, 0xe3, 0x25, 0xd0, 0x5b, 0x32, 0xfc, 0xfe, 0xbf,
+	0xff, 0xcc, 0x7c, 0xa3, 0xfc, 0xe3, 0xe0, 0x31, 0x10, 0x26, 0x03, 0x9f, 0x70, 0xc1, 0xb0, 0x20,
+	0x34, 0x34, 0x1f, 0x06, 0x2e, 0x08, 0x3c, 0x30, 0x7d, 0x08, 0x81, 0x13, 0x6e, 0x2c, 0x19, 0x15,
+	0x54, 0xfb, 0x91, 0xa1, 0x46, 0x11, 0x35, 0x72, 0xb4, 0xdb, 0xf6, 0xa9, 0x4f, 0x53, 0xce, 0xdc,
+	0x7f, 0x65, 0x91, 0xee, 0xdf, 0x32, 0xbb, 0x88, 0x96, 0x90, 0xbb, 0xbb, 0xbf, 0xcb, 0xc0, 0x80,
+	0xfb, 0x39, 0xd6, 0x2f, 0xc3, 0x96, 0x98, 0xe1, 0x20, 0x17, 0xfe, 0x7c, 0xaf, 0x28, 0x8d, 0xf3,
+	0x6c, 0xfb, 0x63, 0x81, 0x05, 0x68, 0x9e, 0xd2, 0x28, 0xa6, 0x3a, 0x72, 0xaf, 0xda, 0x57, 0x87,
+	0x03, 0xa3, 0xe4, 0x50, 0x86, 0x5d, 0x58, 0x1c, 0xd1, 0x09, 0x5c, 0x84, 0x53, 0x6a, 0x35, 0x76,
+	0xb1, 0xfe, 0x8d, 0x81, 0xef, 0x90, 0x70, 0x4a, 0xed, 0x23, 0xa9, 0x76, 0xa7, 0xb4, 0x43, 0x3a,
+	0x01, 0x07, 0x56, 0xde, 0xcc, 0x09, 0x30, 0x17, 0xc0, 0x9c, 0x39, 0x44, 0x9d, 0x4a, 0x4f, 0xee,
+	0xab, 0xc3, 0x3f, 0xa5, 0x65, 0x57, 0x29, 0x7e, 0x09, 0x91, 0xd5, 0xda, 0xc5, 0x7a, 0xf3, 0xe0,
+	0x99, 0x43, 0x64, 0xb7, 0xf6, 0xbf, 0x67, 0x2b, 0x6f, 0xf6, 0x49, 0x69, 0xb7, 0x4a, 0x93, 0xd0,
+	0x62, 0x49, 0xf5, 0x4b, 0x25, 0xdf, 0x77, 0xb1, 0xae, 0x12, 0x7a, 0xa8, 0x50, 0x09, 0x3d, 0xc8,
+	0x4f, 0x95, 0x7a, 0x76, 0x9d, 0x9d, 0x5a, 0x6a, 0xfd, 0x55, 0x6a, 0xbd, 0x4e, 0x51, 0xab, 0xb6,
+	0x8e, 0x75, 0xc9, 0xce, 0x83, 0x16, 0x5e, 0xbf, 0x21, 0xe9, 0x39, 0x41, 0xf2, 0x3a, 0x41, 0xf2,
+	0x26, 0x41, 0xf2, 0x6b, 0x82, 0xe4, 0xa7, 0x2d, 0x92, 0x36, 0x5b, 0x24, 0xbd, 0x6c, 0x91, 0x74,
+	0x73, 0xe2, 0x13, 0x31, 0xbb, 0x77, 0x0d, 0x8f, 0x06, 0x26, 0xf7, 0x98, 0x58, 0x60, 0x97, 0x9b,
+	0xe3, 0xb4, 0x67, 0x04, 0xe2, 0x91, 0xb2, 0xb9, 0xb9, 0x3a, 0x1e, 0x35, 0x09, 0x05, 0xb0, 0x10,
+	0x2f, 0xb2, 0xb7, 0xe3, 0xd6, 0xd3, 0x59, 0xff, 0xff, 0x18, 0x00, 0x85, 0x4e, 0xb4, 0x00, 0xc5,
+	0x02, 0x00, 0x00,
 }
 
 func (this *GenesisState) Equal(that interface{}) bool {
@@ -129,6 +132,9 @@ func (this *GenesisState) Equal(that interface{}) bool {
 	if !this.IoMasterKey.Equal(that1.IoMasterKey) {
 		return false
 	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
 	return true
 }
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -151,6 +157,16 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x22
 	if m.IoMasterKey != nil {
 		{
 			size, err := m.IoMasterKey.MarshalToSizedBuffer(dAtA[:i])
@@ -223,6 +239,8 @@ func (m *GenesisState) Size() (n int) {
 		l = m.IoMasterKey.Size()
 		n += 1 + l + sovGenesis(uint64(l))
 	}
+	l = m.Params.Size()
+	n += 1 + l + sovGenesis(uint64(l))
 	return n
 }
 
@@ -367,6 +385,39 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -22,6 +22,7 @@ var (
 	RegistrationMasterKeyPrefix = []byte{0x02}
 	RegistrationMachinePrefix   = []byte{0x03}
 	RegistrationMachineIndex    = []byte{0x04}
+	ParamsKey                   = []byte{0x05}
 )
 
 func RegistrationKeyPrefix(key []byte) []byte {
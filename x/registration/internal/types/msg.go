@@ -37,6 +37,41 @@ func (msg RaAuthenticate) GetSigners() []sdk.AccAddress {
 	return []sdk.AccAddress{msg.Sender}
 }
 
+func (msg MsgReattest) Route() string {
+	return RouterKey
+}
+
+func (msg MsgReattest) Type() string {
+	return "node-reattest"
+}
+
+func (msg MsgReattest) ValidateBasic() error {
+	if err := sdk.VerifyAddressFormat(msg.Sender); err != nil {
+		return err
+	}
+
+	if len(msg.Certificate) == 0 {
+		return sdkerrors.ErrInvalidRequest.Wrap("Attestation certificate cannot be empty")
+	}
+
+	return validateCertificate(msg.Certificate)
+}
+
+func (msg MsgReattest) GetSignBytes() []byte {
+	return sdk.MustSortJSON(ModuleCdc.MustMarshalJSON(&msg))
+}
+
+func (msg MsgReattest) GetSigners() []sdk.AccAddress {
+	return []sdk.AccAddress{msg.Sender}
+}
+
+func (msg MsgUpdateParams) ValidateBasic() error {
+	if _, err := sdk.AccAddressFromBech32(msg.Authority); err != nil {
+		return sdkerrors.ErrInvalidAddress.Wrapf("invalid authority: %s", err)
+	}
+	return msg.Params.Validate()
+}
+
 func validateCertificate(cert ra.Certificate) error {
 	// todo: add public key verification
 	_, err := ra.VerifyCombinedCert(cert)
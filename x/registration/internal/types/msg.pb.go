@@ -7,8 +7,10 @@ import (
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	github_com_cosmos_cosmos_sdk_types "github.com/cosmos/cosmos-sdk/types"
 	_ "github.com/cosmos/cosmos-sdk/types/msgservice"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -109,6 +111,158 @@ func (m *RaAuthenticateResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_RaAuthenticateResponse proto.InternalMessageInfo
 
+// MsgReattest replaces the certificate of a registered node with a fresh one
+type MsgReattest struct {
+	Sender      github_com_cosmos_cosmos_sdk_types.AccAddress                                   `protobuf:"bytes,1,opt,name=sender,proto3,casttype=github.com/cosmos/cosmos-sdk/types.AccAddress" json:"sender,omitempty"`
+	Certificate github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,2,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"ra_cert"`
+}
+
+func (m *MsgReattest) Reset()         { *m = MsgReattest{} }
+func (m *MsgReattest) String() string { return proto.CompactTextString(m) }
+func (*MsgReattest) ProtoMessage()    {}
+func (*MsgReattest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{2}
+}
+func (m *MsgReattest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReattest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReattest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReattest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReattest.Merge(m, src)
+}
+func (m *MsgReattest) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReattest) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReattest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReattest proto.InternalMessageInfo
+
+type MsgReattestResponse struct {
+}
+
+func (m *MsgReattestResponse) Reset()         { *m = MsgReattestResponse{} }
+func (m *MsgReattestResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgReattestResponse) ProtoMessage()    {}
+func (*MsgReattestResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{3}
+}
+func (m *MsgReattestResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgReattestResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgReattestResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgReattestResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgReattestResponse.Merge(m, src)
+}
+func (m *MsgReattestResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgReattestResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgReattestResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgReattestResponse proto.InternalMessageInfo
+
+// MsgUpdateParams updates the attestation policy of the registration module
+type MsgUpdateParams struct {
+	// authority is the address of the governance account.
+	Authority string `protobuf:"bytes,1,opt,name=authority,proto3" json:"authority,omitempty"`
+	// NOTE: All parameters must be supplied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+}
+
+func (m *MsgUpdateParams) Reset()         { *m = MsgUpdateParams{} }
+func (m *MsgUpdateParams) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParams) ProtoMessage()    {}
+func (*MsgUpdateParams) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{4}
+}
+func (m *MsgUpdateParams) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParams) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParams.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParams) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParams.Merge(m, src)
+}
+func (m *MsgUpdateParams) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParams) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParams.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParams proto.InternalMessageInfo
+
+type MsgUpdateParamsResponse struct {
+}
+
+func (m *MsgUpdateParamsResponse) Reset()         { *m = MsgUpdateParamsResponse{} }
+func (m *MsgUpdateParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*MsgUpdateParamsResponse) ProtoMessage()    {}
+func (*MsgUpdateParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_91e653c4cfa6dfea, []int{5}
+}
+func (m *MsgUpdateParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MsgUpdateParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MsgUpdateParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MsgUpdateParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MsgUpdateParamsResponse.Merge(m, src)
+}
+func (m *MsgUpdateParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *MsgUpdateParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_MsgUpdateParamsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MsgUpdateParamsResponse proto.InternalMessageInfo
+
 type MasterKey struct {
 	Bytes []byte `protobuf:"bytes,1,opt,name=bytes,proto3" json:"bytes,omitempty"`
 }
@@ -117,7 +271,7 @@ func (m *MasterKey) Reset()         { *m = MasterKey{} }
 func (m *MasterKey) String() string { return proto.CompactTextString(m) }
 func (*MasterKey) ProtoMessage()    {}
 func (*MasterKey) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91e653c4cfa6dfea, []int{2}
+	return fileDescriptor_91e653c4cfa6dfea, []int{6}
 }
 func (m *MasterKey) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -154,7 +308,7 @@ func (m *Key) Reset()         { *m = Key{} }
 func (m *Key) String() string { return proto.CompactTextString(m) }
 func (*Key) ProtoMessage()    {}
 func (*Key) Descriptor() ([]byte, []int) {
-	return fileDescriptor_91e653c4cfa6dfea, []int{3}
+	return fileDescriptor_91e653c4cfa6dfea, []int{7}
 }
 func (m *Key) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -186,6 +340,10 @@ var xxx_messageInfo_Key proto.InternalMessageInfo
 func init() {
 	proto.RegisterType((*RaAuthenticate)(nil), "secret.registration.v1beta1.RaAuthenticate")
 	proto.RegisterType((*RaAuthenticateResponse)(nil), "secret.registration.v1beta1.RaAuthenticateResponse")
+	proto.RegisterType((*MsgReattest)(nil), "secret.registration.v1beta1.MsgReattest")
+	proto.RegisterType((*MsgReattestResponse)(nil), "secret.registration.v1beta1.MsgReattestResponse")
+	proto.RegisterType((*MsgUpdateParams)(nil), "secret.registration.v1beta1.MsgUpdateParams")
+	proto.RegisterType((*MsgUpdateParamsResponse)(nil), "secret.registration.v1beta1.MsgUpdateParamsResponse")
 	proto.RegisterType((*MasterKey)(nil), "secret.registration.v1beta1.MasterKey")
 	proto.RegisterType((*Key)(nil), "secret.registration.v1beta1.Key")
 }
@@ -195,37 +353,50 @@ func init() {
 }
 
 var fileDescriptor_91e653c4cfa6dfea = []byte{
-	// 479 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x52, 0x3d, 0x6f, 0xd3, 0x50,
-	0x14, 0xb5, 0x1b, 0x9a, 0x2a, 0x8f, 0x0a, 0xd0, 0x53, 0x55, 0x42, 0x90, 0xec, 0x10, 0x09, 0xa9,
-	0x2a, 0xd4, 0x56, 0xe8, 0xc6, 0x82, 0x52, 0x58, 0x2a, 0x14, 0x90, 0x1e, 0x1b, 0x03, 0xd1, 0xf3,
-	0xf3, 0xc5, 0xb1, 0x12, 0xfb, 0x59, 0xef, 0xde, 0x06, 0xb2, 0xa0, 0x8a, 0x89, 0x91, 0x3f, 0x80,
-	0xc4, 0xc8, 0xd8, 0x9f, 0xd1, 0xb1, 0x23, 0x53, 0x04, 0xc9, 0x50, 0xa9, 0x3f, 0xa1, 0x13, 0xf2,
-	0x07, 0x22, 0x59, 0x90, 0xb2, 0xd8, 0xf7, 0xea, 0x1c, 0x9f, 0x7b, 0xcf, 0xf1, 0x65, 0x0f, 0x11,
-	0x94, 0x01, 0xf2, 0x0d, 0x44, 0x31, 0x92, 0x91, 0x14, 0xeb, 0xd4, 0x9f, 0x74, 0x03, 0x20, 0xd9,
-	0xf5, 0x13, 0x8c, 0xbc, 0xcc, 0x68, 0xd2, 0xfc, 0x7e, 0x49, 0xf3, 0x96, 0x69, 0x5e, 0x45, 0x6b,
-	0xed, 0x44, 0x3a, 0xd2, 0x05, 0xcf, 0xcf, 0xab, 0xf2, 0x93, 0xd6, 0x5d, 0xa5, 0x31, 0xd1, 0x98,
-	0x8b, 0xf8, 0x93, 0x25, 0xad, 0xce, 0xb7, 0x0d, 0x76, 0x4b, 0xc8, 0xde, 0x09, 0x0d, 0x21, 0xa5,
-	0x58, 0x49, 0x02, 0x7e, 0xcc, 0xea, 0x08, 0x69, 0x08, 0xa6, 0x69, 0xb7, 0xed, 0xbd, 0xed, 0xa3,
-	0xee, 0xf5, 0xcc, 0x3d, 0x88, 0x62, 0x1a, 0x9e, 0x04, 0x9e, 0xd2, 0x89, 0x5f, 0x49, 0x95, 0xaf,
-	0x03, 0x0c, 0x47, 0x3e, 0x4d, 0x33, 0x40, 0xaf, 0xa7, 0x54, 0x2f, 0x0c, 0x0d, 0x20, 0x8a, 0x4a,
-	0x80, 0x9f, 0xda, 0xec, 0xa6, 0x02, 0x43, 0xf1, 0xfb, 0x42, 0xba, 0xb9, 0x51, 0x08, 0xbe, 0xbb,
-	0x9a, 0xb9, 0x5b, 0x46, 0x0e, 0x72, 0xe4, 0x7a, 0xe6, 0xbe, 0x5e, 0xd2, 0x46, 0x65, 0x68, 0x2c,
-	0x03, 0xf4, 0xdf, 0x14, 0x16, 0x5f, 0x01, 0x7d, 0xd0, 0x66, 0xe4, 0x7f, 0x5c, 0x8d, 0xc4, 0x40,
-	0xa2, 0x09, 0x06, 0x92, 0x08, 0x90, 0x4a, 0xfb, 0xcf, 0xff, 0x4d, 0x11, 0xcb, 0x23, 0xf9, 0x63,
-	0xc6, 0x0d, 0x64, 0x63, 0xa9, 0x60, 0x90, 0x48, 0x35, 0x8c, 0x53, 0x18, 0xc4, 0x61, 0xb3, 0xd6,
-	0xb6, 0xf7, 0x1a, 0xe2, 0x4e, 0x85, 0xf4, 0x4b, 0xe0, 0x38, 0x7c, 0x7a, 0xfb, 0xcb, 0x77, 0xd7,
-	0xfa, 0x7c, 0x79, 0xb6, 0x5f, 0x39, 0xe8, 0xbc, 0x60, 0xbb, 0xab, 0xf1, 0x08, 0xc0, 0x4c, 0xa7,
-	0x08, 0x9c, 0xb3, 0x1b, 0xa1, 0x24, 0x59, 0x84, 0xd4, 0x10, 0x45, 0xcd, 0x77, 0x59, 0x1d, 0x26,
-	0x90, 0x12, 0x16, 0x4e, 0x1b, 0xa2, 0xea, 0x3a, 0x0f, 0x58, 0xa3, 0x2f, 0x91, 0xc0, 0xbc, 0x84,
-	0x29, 0xdf, 0x61, 0x9b, 0xc1, 0x94, 0x00, 0xcb, 0x78, 0x45, 0xd9, 0x74, 0xda, 0xac, 0x96, 0x83,
-	0xf7, 0x58, 0x6d, 0x04, 0xd3, 0x2a, 0xf9, 0xad, 0xab, 0x99, 0x9b, 0xb7, 0x22, 0x7f, 0x3c, 0xf9,
-	0xc4, 0x6a, 0x7d, 0x8c, 0x78, 0xc6, 0xb6, 0x45, 0x11, 0x06, 0x98, 0x7c, 0x2f, 0xfe, 0xc8, 0xfb,
-	0xcf, 0x39, 0x78, 0xab, 0xcb, 0xb7, 0x0e, 0xd7, 0x20, 0xff, 0x75, 0xda, 0xda, 0x3c, 0xbd, 0x3c,
-	0xdb, 0xb7, 0x8f, 0xe4, 0xf9, 0x6f, 0xc7, 0xfa, 0x31, 0x77, 0xec, 0xf3, 0xb9, 0x63, 0x5f, 0xcc,
-	0x1d, 0xfb, 0xd7, 0xdc, 0xb1, 0xbf, 0x2e, 0x1c, 0xeb, 0x62, 0xe1, 0x58, 0x3f, 0x17, 0x8e, 0xf5,
-	0xf6, 0xd9, 0xda, 0x7f, 0x32, 0x4e, 0x09, 0x4c, 0x2a, 0xc7, 0xe5, 0x09, 0x05, 0xf5, 0xe2, 0x28,
-	0x0f, 0xff, 0x04, 0x00, 0x00, 0xff, 0xff, 0xf6, 0x9c, 0xf4, 0x2c, 0x09, 0x03, 0x00, 0x00,
+	// 674 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xdc, 0x54, 0xcf, 0x4f, 0x13, 0x5b,
+	0x14, 0xee, 0x50, 0x28, 0xaf, 0x97, 0xe6, 0xf1, 0xde, 0xc0, 0x83, 0x32, 0x2f, 0x99, 0xd6, 0x31,
+	0x9a, 0x06, 0x61, 0x46, 0xc0, 0xb8, 0x60, 0x63, 0x5a, 0xdd, 0x10, 0x53, 0x35, 0x43, 0xdc, 0xb8,
+	0xb0, 0xb9, 0x9d, 0x39, 0x4c, 0x27, 0x30, 0x3f, 0x72, 0xef, 0x01, 0xed, 0x8e, 0xb8, 0x32, 0xae,
+	0xfc, 0x07, 0x4c, 0x5c, 0xba, 0x64, 0xc1, 0x1f, 0xc1, 0x92, 0xb8, 0x32, 0x2e, 0x1a, 0x2d, 0x0b,
+	0x12, 0xfe, 0x04, 0x12, 0x13, 0x33, 0x73, 0x2f, 0x65, 0xca, 0xa2, 0xca, 0xd6, 0xcd, 0x74, 0xee,
+	0x9c, 0xef, 0x7c, 0xe7, 0x7c, 0xdf, 0x39, 0xb7, 0xe4, 0x16, 0x07, 0x87, 0x01, 0x5a, 0x0c, 0x3c,
+	0x9f, 0x23, 0xa3, 0xe8, 0x47, 0xa1, 0xb5, 0xb7, 0xd2, 0x06, 0xa4, 0x2b, 0x56, 0xc0, 0x3d, 0x33,
+	0x66, 0x11, 0x46, 0xea, 0xff, 0x02, 0x66, 0x66, 0x61, 0xa6, 0x84, 0x69, 0xb3, 0x5e, 0xe4, 0x45,
+	0x29, 0xce, 0x4a, 0xde, 0x44, 0x8a, 0x36, 0xef, 0x44, 0x3c, 0x88, 0x78, 0x42, 0x62, 0xed, 0x65,
+	0xb8, 0xb4, 0x05, 0x11, 0x68, 0x89, 0x0c, 0x71, 0x90, 0xa1, 0x7f, 0x69, 0xe0, 0x87, 0x91, 0x95,
+	0x3e, 0xe5, 0xa7, 0xda, 0xa8, 0x06, 0x63, 0xca, 0x68, 0x20, 0x93, 0x8d, 0x0f, 0x63, 0xe4, 0x6f,
+	0x9b, 0xd6, 0x77, 0xb1, 0x03, 0x21, 0xfa, 0x0e, 0x45, 0x50, 0x37, 0x48, 0x81, 0x43, 0xe8, 0x02,
+	0x2b, 0x2b, 0x55, 0xa5, 0x56, 0x6a, 0xac, 0x9c, 0xf7, 0x2a, 0xcb, 0x9e, 0x8f, 0x9d, 0xdd, 0xb6,
+	0xe9, 0x44, 0x81, 0x2c, 0x2e, 0x7f, 0x96, 0xb9, 0xbb, 0x6d, 0x61, 0x37, 0x06, 0x6e, 0xd6, 0x1d,
+	0xa7, 0xee, 0xba, 0x0c, 0x38, 0xb7, 0x25, 0x81, 0xba, 0xaf, 0x90, 0x29, 0x07, 0x18, 0xfa, 0x5b,
+	0x29, 0x75, 0x79, 0x2c, 0x25, 0x7c, 0x79, 0xd6, 0xab, 0x4c, 0x32, 0xda, 0x4a, 0x22, 0xe7, 0xbd,
+	0xca, 0xd3, 0x0c, 0x37, 0x77, 0x18, 0xee, 0xd0, 0x36, 0xb7, 0x36, 0x53, 0x01, 0x4f, 0x00, 0x5f,
+	0x45, 0x6c, 0xdb, 0x7a, 0x3d, 0xac, 0x84, 0x41, 0x10, 0x21, 0xb4, 0x28, 0x22, 0x70, 0x14, 0xb6,
+	0x3e, 0xbc, 0xac, 0x62, 0x67, 0x4b, 0xaa, 0x4b, 0x44, 0x65, 0x10, 0xef, 0x50, 0x07, 0x5a, 0x01,
+	0x75, 0x3a, 0x7e, 0x08, 0x2d, 0xdf, 0x2d, 0xe7, 0xab, 0x4a, 0xad, 0x68, 0xff, 0x23, 0x23, 0x4d,
+	0x11, 0xd8, 0x70, 0xd7, 0xa7, 0xdf, 0x7e, 0xac, 0xe4, 0xde, 0x9c, 0x1e, 0x2c, 0x4a, 0x05, 0xc6,
+	0x23, 0x32, 0x37, 0x6c, 0x8f, 0x0d, 0x3c, 0x8e, 0x42, 0x0e, 0xaa, 0x4a, 0xc6, 0x5d, 0x8a, 0x34,
+	0x35, 0xa9, 0x68, 0xa7, 0xef, 0xea, 0x1c, 0x29, 0xc0, 0x1e, 0x84, 0xc8, 0x53, 0xa5, 0x45, 0x5b,
+	0x9e, 0x8c, 0x1f, 0x0a, 0x99, 0x6a, 0x72, 0xcf, 0x06, 0xd1, 0xf2, 0x9f, 0x65, 0xf1, 0x7a, 0xf5,
+	0x8a, 0x69, 0xef, 0x4e, 0x0f, 0x16, 0x4b, 0x0c, 0x3c, 0x8b, 0x49, 0xbd, 0xc6, 0x7f, 0x64, 0x26,
+	0x23, 0xff, 0xc2, 0x42, 0xe3, 0x50, 0x21, 0xd3, 0x4d, 0xee, 0x3d, 0x8f, 0x5d, 0x8a, 0xf0, 0x2c,
+	0x5d, 0x4b, 0xf5, 0x3e, 0x29, 0xd2, 0x5d, 0xec, 0x44, 0xcc, 0xc7, 0xae, 0xf0, 0xb6, 0x51, 0xfe,
+	0x7c, 0xb8, 0x3c, 0x2b, 0x57, 0x5e, 0xca, 0xdf, 0x44, 0xe6, 0x87, 0x9e, 0x7d, 0x09, 0x55, 0xeb,
+	0xa4, 0x20, 0x16, 0x3b, 0x75, 0x60, 0x6a, 0xf5, 0xa6, 0x39, 0xe2, 0xf6, 0x99, 0xa2, 0x58, 0x63,
+	0xfc, 0xa8, 0x57, 0xc9, 0xd9, 0x32, 0x71, 0xfd, 0x76, 0xa2, 0xe1, 0x92, 0x32, 0x91, 0x31, 0x93,
+	0xc8, 0xb8, 0xd2, 0xa2, 0xb1, 0x40, 0xe6, 0xaf, 0x7c, 0x1a, 0x28, 0xba, 0x41, 0x8a, 0x4d, 0xca,
+	0x11, 0xd8, 0x63, 0xe8, 0xaa, 0xb3, 0x64, 0xa2, 0xdd, 0x45, 0xe0, 0x62, 0xc8, 0xb6, 0x38, 0x18,
+	0x55, 0x92, 0x4f, 0x82, 0x0b, 0x24, 0xbf, 0x0d, 0x5d, 0x39, 0xff, 0xc9, 0xb3, 0x5e, 0x25, 0x39,
+	0xda, 0xc9, 0x63, 0xf5, 0xeb, 0x18, 0xc9, 0x37, 0xb9, 0xa7, 0xc6, 0xa4, 0x64, 0xa7, 0xcd, 0x03,
+	0x4b, 0x36, 0x50, 0xbd, 0x33, 0x52, 0xd2, 0xf0, 0x9a, 0x6a, 0x6b, 0xd7, 0x00, 0x0f, 0x76, 0x7a,
+	0x8b, 0xfc, 0x35, 0xd8, 0xd1, 0xda, 0x48, 0x82, 0xcc, 0x38, 0xb5, 0xbb, 0xbf, 0x8b, 0x1c, 0xd4,
+	0x61, 0xa4, 0x34, 0x34, 0xf4, 0xa5, 0x5f, 0x31, 0x64, 0xd1, 0xda, 0xbd, 0xeb, 0xa0, 0x2f, 0x6a,
+	0x6a, 0x13, 0xfb, 0xa7, 0x07, 0x8b, 0x4a, 0x83, 0x1e, 0x7d, 0xd7, 0x73, 0x9f, 0xfa, 0xba, 0x72,
+	0xd4, 0xd7, 0x95, 0xe3, 0xbe, 0xae, 0x7c, 0xeb, 0xeb, 0xca, 0xfb, 0x13, 0x3d, 0x77, 0x7c, 0xa2,
+	0xe7, 0xbe, 0x9c, 0xe8, 0xb9, 0x17, 0x0f, 0xae, 0x7d, 0x59, 0xfc, 0x10, 0x81, 0x85, 0x74, 0x47,
+	0xdc, 0xd2, 0x76, 0x21, 0xfd, 0x6b, 0x5d, 0xfb, 0x39, 0x00, 0x97, 0xa6, 0x2b, 0x3a, 0x27, 0x06,
+	0x00, 0x00,
 }
 
 func (this *RaAuthenticate) Equal(that interface{}) bool {
@@ -285,6 +456,102 @@ func (this *RaAuthenticateResponse) Equal(that interface{}) bool {
 	}
 	return true
 }
+func (this *MsgReattest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgReattest)
+	if !ok {
+		that2, ok := that.(MsgReattest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.Sender, that1.Sender) {
+		return false
+	}
+	if !bytes.Equal(this.Certificate, that1.Certificate) {
+		return false
+	}
+	return true
+}
+func (this *MsgReattestResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgReattestResponse)
+	if !ok {
+		that2, ok := that.(MsgReattestResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParams) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParams)
+	if !ok {
+		that2, ok := that.(MsgUpdateParams)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Authority != that1.Authority {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *MsgUpdateParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MsgUpdateParamsResponse)
+	if !ok {
+		that2, ok := that.(MsgUpdateParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
 func (this *MasterKey) Equal(that interface{}) bool {
 	if that == nil {
 		return this == nil
@@ -348,6 +615,10 @@ const _ = grpc.SupportPackageIsVersion4
 type MsgClient interface {
 	// Register and authenticate new node
 	RegisterAuth(ctx context.Context, in *RaAuthenticate, opts ...grpc.CallOption) (*RaAuthenticateResponse, error)
+	// Refresh the attestation of a registered node
+	Reattest(ctx context.Context, in *MsgReattest, opts ...grpc.CallOption) (*MsgReattestResponse, error)
+	// UpdateParams updates the attestation policy
+	UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error)
 }
 
 type msgClient struct {
@@ -367,10 +638,32 @@ func (c *msgClient) RegisterAuth(ctx context.Context, in *RaAuthenticate, opts .
 	return out, nil
 }
 
+func (c *msgClient) Reattest(ctx context.Context, in *MsgReattest, opts ...grpc.CallOption) (*MsgReattestResponse, error) {
+	out := new(MsgReattestResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/Reattest", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *msgClient) UpdateParams(ctx context.Context, in *MsgUpdateParams, opts ...grpc.CallOption) (*MsgUpdateParamsResponse, error) {
+	out := new(MsgUpdateParamsResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Msg/UpdateParams", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // MsgServer is the server API for Msg service.
 type MsgServer interface {
 	// Register and authenticate new node
 	RegisterAuth(context.Context, *RaAuthenticate) (*RaAuthenticateResponse, error)
+	// Refresh the attestation of a registered node
+	Reattest(context.Context, *MsgReattest) (*MsgReattestResponse, error)
+	// UpdateParams updates the attestation policy
+	UpdateParams(context.Context, *MsgUpdateParams) (*MsgUpdateParamsResponse, error)
 }
 
 // UnimplementedMsgServer can be embedded to have forward compatible implementations.
@@ -380,6 +673,12 @@ type UnimplementedMsgServer struct {
 func (*UnimplementedMsgServer) RegisterAuth(ctx context.Context, req *RaAuthenticate) (*RaAuthenticateResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method RegisterAuth not implemented")
 }
+func (*UnimplementedMsgServer) Reattest(ctx context.Context, req *MsgReattest) (*MsgReattestResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Reattest not implemented")
+}
+func (*UnimplementedMsgServer) UpdateParams(ctx context.Context, req *MsgUpdateParams) (*MsgUpdateParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateParams not implemented")
+}
 
 func RegisterMsgServer(s grpc1.Server, srv MsgServer) {
 	s.RegisterService(&_Msg_serviceDesc, srv)
@@ -403,6 +702,42 @@ func _Msg_RegisterAuth_Handler(srv interface{}, ctx context.Context, dec func(in
 	return interceptor(ctx, in, info, handler)
 }
 
+func _Msg_Reattest_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgReattest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).Reattest(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/Reattest",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).Reattest(ctx, req.(*MsgReattest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Msg_UpdateParams_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(MsgUpdateParams)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(MsgServer).UpdateParams(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Msg/UpdateParams",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(MsgServer).UpdateParams(ctx, req.(*MsgUpdateParams))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _Msg_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "secret.registration.v1beta1.Msg",
 	HandlerType: (*MsgServer)(nil),
@@ -411,6 +746,14 @@ var _Msg_serviceDesc = grpc.ServiceDesc{
 			MethodName: "RegisterAuth",
 			Handler:    _Msg_RegisterAuth_Handler,
 		},
+		{
+			MethodName: "Reattest",
+			Handler:    _Msg_Reattest_Handler,
+		},
+		{
+			MethodName: "UpdateParams",
+			Handler:    _Msg_UpdateParams_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "secret/registration/v1beta1/msg.proto",
@@ -497,7 +840,7 @@ func (m *RaAuthenticateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
-func (m *MasterKey) Marshal() (dAtA []byte, err error) {
+func (m *MsgReattest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -507,27 +850,34 @@ func (m *MasterKey) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *MasterKey) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReattest) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *MasterKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReattest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Bytes) > 0 {
-		i -= len(m.Bytes)
-		copy(dAtA[i:], m.Bytes)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Bytes)))
+	if len(m.Certificate) > 0 {
+		i -= len(m.Certificate)
+		copy(dAtA[i:], m.Certificate)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Certificate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Sender) > 0 {
+		i -= len(m.Sender)
+		copy(dAtA[i:], m.Sender)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Sender)))
 		i--
 		dAtA[i] = 0xa
 	}
 	return len(dAtA) - i, nil
 }
 
-func (m *Key) Marshal() (dAtA []byte, err error) {
+func (m *MsgReattestResponse) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
 	n, err := m.MarshalToSizedBuffer(dAtA[:size])
@@ -537,36 +887,152 @@ func (m *Key) Marshal() (dAtA []byte, err error) {
 	return dAtA[:n], nil
 }
 
-func (m *Key) MarshalTo(dAtA []byte) (int, error) {
+func (m *MsgReattestResponse) MarshalTo(dAtA []byte) (int, error) {
 	size := m.Size()
 	return m.MarshalToSizedBuffer(dAtA[:size])
 }
 
-func (m *Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+func (m *MsgReattestResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	i := len(dAtA)
 	_ = i
 	var l int
 	_ = l
-	if len(m.Key) > 0 {
-		i -= len(m.Key)
-		copy(dAtA[i:], m.Key)
-		i = encodeVarintMsg(dAtA, i, uint64(len(m.Key)))
-		i--
-		dAtA[i] = 0xa
-	}
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
-	offset -= sovMsg(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *MsgUpdateParams) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParams) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParams) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMsg(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Authority) > 0 {
+		i -= len(m.Authority)
+		copy(dAtA[i:], m.Authority)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Authority)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MsgUpdateParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MsgUpdateParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MsgUpdateParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *MasterKey) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MasterKey) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MasterKey) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Bytes) > 0 {
+		i -= len(m.Bytes)
+		copy(dAtA[i:], m.Bytes)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Bytes)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Key) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Key) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Key) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Key) > 0 {
+		i -= len(m.Key)
+		copy(dAtA[i:], m.Key)
+		i = encodeVarintMsg(dAtA, i, uint64(len(m.Key)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMsg(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMsg(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
 }
 func (m *RaAuthenticate) Size() (n int) {
 	if m == nil {
@@ -606,6 +1072,56 @@ func (m *RaAuthenticateResponse) Size() (n int) {
 	return n
 }
 
+func (m *MsgReattest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Sender)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = len(m.Certificate)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	return n
+}
+
+func (m *MsgReattestResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *MsgUpdateParams) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Authority)
+	if l > 0 {
+		n += 1 + l + sovMsg(uint64(l))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovMsg(uint64(l))
+	return n
+}
+
+func (m *MsgUpdateParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
 func (m *MasterKey) Size() (n int) {
 	if m == nil {
 		return 0
@@ -902,6 +1418,339 @@ func (m *RaAuthenticateResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *MsgReattest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReattest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReattest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Sender", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Sender = append(m.Sender[:0], dAtA[iNdEx:postIndex]...)
+			if m.Sender == nil {
+				m.Sender = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Certificate", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Certificate = append(m.Certificate[:0], dAtA[iNdEx:postIndex]...)
+			if m.Certificate == nil {
+				m.Certificate = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgReattestResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgReattestResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgReattestResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParams) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParams: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParams: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Authority", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Authority = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMsg
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMsg
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MsgUpdateParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMsg
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MsgUpdateParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMsg(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMsg
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func (m *MasterKey) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
@@ -0,0 +1,72 @@
+package types
+
+import (
+	"encoding/hex"
+	"fmt"
+	"slices"
+	"strings"
+
+	ra "github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation"
+)
+
+const cpuSvnLen = 16
+
+// DefaultParams returns a policy that accepts every attestation and never expires
+func DefaultParams() Params {
+	return Params{}
+}
+
+// Validate validates the set of params
+func (p Params) Validate() error {
+	for _, mrEnclave := range p.AcceptedMrEnclaves {
+		if err := validateMeasurement(mrEnclave); err != nil {
+			return fmt.Errorf("accepted mrenclave: %w", err)
+		}
+	}
+	for _, mrSigner := range p.AcceptedMrSigners {
+		if err := validateMeasurement(mrSigner); err != nil {
+			return fmt.Errorf("accepted mrsigner: %w", err)
+		}
+	}
+	if p.MinCpuSvn != "" {
+		svn, err := hex.DecodeString(p.MinCpuSvn)
+		if err != nil {
+			return fmt.Errorf("min cpu svn: %w", err)
+		}
+		if len(svn) != cpuSvnLen {
+			return fmt.Errorf("min cpu svn must be %d bytes, got %d", cpuSvnLen, len(svn))
+		}
+	}
+	if p.MaxAttestationAge < 0 {
+		return fmt.Errorf("max attestation age can't be negative: %d", p.MaxAttestationAge)
+	}
+	for _, status := range p.AcceptedTcbStatuses {
+		if !slices.Contains(ra.TcbStatuses, status) {
+			return fmt.Errorf("accepted tcb status %s must be one of %s", status, strings.Join(ra.TcbStatuses, ", "))
+		}
+	}
+	return nil
+}
+
+// AttestationPolicy returns the policy new attestations are checked against. The params must be valid
+func (p Params) AttestationPolicy() ra.AttestationPolicy {
+	minCpuSvn, _ := hex.DecodeString(p.MinCpuSvn)
+	return ra.AttestationPolicy{
+		MrEnclaves:  p.AcceptedMrEnclaves,
+		MrSigners:   p.AcceptedMrSigners,
+		MinCpuSvn:   minCpuSvn,
+		MinIsvSvn:   p.MinIsvSvn,
+		TcbStatuses: p.AcceptedTcbStatuses,
+	}
+}
+
+func validateMeasurement(measurement string) error {
+	bz, err := hex.DecodeString(measurement)
+	if err != nil {
+		return err
+	}
+	if len(bz) != 32 {
+		return fmt.Errorf("%s must be 32 bytes, got %d", measurement, len(bz))
+	}
+	return nil
+}
@@ -0,0 +1,590 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: secret/registration/v1beta1/params.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// Params defines the attestation policy nodes must comply with. Empty fields
+// accept anything
+type Params struct {
+	// MRENCLAVE values accepted from nodes, hex encoded
+	AcceptedMrEnclaves []string `protobuf:"bytes,1,rep,name=accepted_mr_enclaves,json=acceptedMrEnclaves,proto3" json:"accepted_mr_enclaves,omitempty"`
+	// MRSIGNER values accepted from nodes, hex encoded
+	AcceptedMrSigners []string `protobuf:"bytes,2,rep,name=accepted_mr_signers,json=acceptedMrSigners,proto3" json:"accepted_mr_signers,omitempty"`
+	// Minimum cpu svn of the quote, hex encoded. Every one of its 16 components
+	// must be met
+	MinCpuSvn string `protobuf:"bytes,3,opt,name=min_cpu_svn,json=minCpuSvn,proto3" json:"min_cpu_svn,omitempty"`
+	// Minimum isv svn of the enclave
+	MinIsvSvn uint32 `protobuf:"varint,4,opt,name=min_isv_svn,json=minIsvSvn,proto3" json:"min_isv_svn,omitempty"`
+	// Number of seconds after its attestation report was issued at that a node's
+	// attestation is stale and the node must re-attest. The report time is the
+	// IAS timestamp for EPID attestations, and the issue date of the TCB info in
+	// the collateral for DCAP quotes. Zero disables expiry
+	MaxAttestationAge int64 `protobuf:"varint,5,opt,name=max_attestation_age,json=maxAttestationAge,proto3" json:"max_attestation_age,omitempty"`
+	// Quote statuses accepted from EPID attestations, e.g. OK and
+	// SW_HARDENING_NEEDED to reject the OUT_OF_DATE ones. DCAP quotes are
+	// evaluated by the enclave against their collateral instead
+	AcceptedTcbStatuses []string `protobuf:"bytes,6,rep,name=accepted_tcb_statuses,json=acceptedTcbStatuses,proto3" json:"accepted_tcb_statuses,omitempty"`
+}
+
+func (m *Params) Reset()         { *m = Params{} }
+func (m *Params) String() string { return proto.CompactTextString(m) }
+func (*Params) ProtoMessage()    {}
+func (*Params) Descriptor() ([]byte, []int) {
+	return fileDescriptor_19c75792d39b095b, []int{0}
+}
+func (m *Params) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Params) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Params.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Params) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Params.Merge(m, src)
+}
+func (m *Params) XXX_Size() int {
+	return m.Size()
+}
+func (m *Params) XXX_DiscardUnknown() {
+	xxx_messageInfo_Params.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Params proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*Params)(nil), "secret.registration.v1beta1.Params")
+}
+
+func init() {
+	proto.RegisterFile("secret/registration/v1beta1/params.proto", fileDescriptor_19c75792d39b095b)
+}
+
+var fileDescriptor_19c75792d39b095b = []byte{
+	// 341 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x54, 0xd1, 0xbf, 0x4e, 0xeb, 0x30,
+	0x14, 0x06, 0xf0, 0xb8, 0xbd, 0xb7, 0x52, 0x73, 0x75, 0x87, 0xa6, 0x45, 0x8a, 0x40, 0xb2, 0x22,
+	0xa6, 0x4c, 0x31, 0x85, 0x07, 0x40, 0x05, 0x31, 0x30, 0x80, 0x50, 0xc3, 0xc4, 0x12, 0x39, 0xe6,
+	0x28, 0x58, 0x34, 0x4e, 0x64, 0x9f, 0x84, 0xf2, 0x12, 0x88, 0xc7, 0xe0, 0x51, 0x3a, 0x76, 0x64,
+	0x84, 0xf4, 0x45, 0x50, 0xdd, 0xbf, 0x6c, 0x96, 0x7e, 0xdf, 0x67, 0x1d, 0x1f, 0xbb, 0xa1, 0x01,
+	0xa1, 0x01, 0x99, 0x86, 0x4c, 0x1a, 0xd4, 0x1c, 0x65, 0xa1, 0x58, 0x3d, 0x4c, 0x01, 0xf9, 0x90,
+	0x95, 0x5c, 0xf3, 0xdc, 0x44, 0xa5, 0x2e, 0xb0, 0xf0, 0x8e, 0x56, 0xc9, 0x68, 0x3f, 0x19, 0xad,
+	0x93, 0x87, 0x83, 0xac, 0xc8, 0x0a, 0x9b, 0x63, 0xcb, 0xd3, 0xaa, 0x72, 0xfc, 0xd6, 0x72, 0x3b,
+	0x77, 0xf6, 0x0e, 0xef, 0xc4, 0x1d, 0x70, 0x21, 0xa0, 0x44, 0x78, 0x4c, 0x72, 0x9d, 0x80, 0x12,
+	0x13, 0x5e, 0x83, 0xf1, 0x49, 0xd0, 0x0e, 0xbb, 0x63, 0x6f, 0x63, 0x37, 0xfa, 0x6a, 0x2d, 0x5e,
+	0xe4, 0xf6, 0xf7, 0x1b, 0x46, 0x66, 0x0a, 0xb4, 0xf1, 0x5b, 0xb6, 0xd0, 0xdb, 0x15, 0xe2, 0x15,
+	0x78, 0xd4, 0xfd, 0x97, 0x4b, 0x95, 0x88, 0xb2, 0x4a, 0x4c, 0xad, 0xfc, 0x76, 0x40, 0xc2, 0xee,
+	0xb8, 0x9b, 0x4b, 0x75, 0x59, 0x56, 0x71, 0xad, 0x36, 0x2e, 0x4d, 0x6d, 0xfd, 0x4f, 0x40, 0xc2,
+	0xff, 0xd6, 0xaf, 0x4d, 0xbd, 0xf4, 0xc8, 0xed, 0xe7, 0x7c, 0x9a, 0x70, 0x44, 0x30, 0x68, 0x5f,
+	0x97, 0xf0, 0x0c, 0xfc, 0xbf, 0x01, 0x09, 0xdb, 0xe3, 0x5e, 0xce, 0xa7, 0xa3, 0x9d, 0x8c, 0x32,
+	0xf0, 0x4e, 0xdd, 0x83, 0xed, 0x7c, 0x28, 0xd2, 0x64, 0x49, 0x95, 0x01, 0xe3, 0x77, 0xec, 0x84,
+	0xdb, 0xe1, 0xef, 0x45, 0x1a, 0xaf, 0xe9, 0x82, 0xcf, 0xbe, 0xa9, 0xf3, 0xd1, 0x50, 0x32, 0x6b,
+	0x28, 0x99, 0x37, 0x94, 0x7c, 0x35, 0x94, 0xbc, 0x2f, 0xa8, 0x33, 0x5f, 0x50, 0xe7, 0x73, 0x41,
+	0x9d, 0x87, 0xf3, 0x4c, 0xe2, 0x53, 0x95, 0x46, 0xa2, 0xc8, 0x99, 0x11, 0x1a, 0x27, 0x3c, 0x35,
+	0x2c, 0xb6, 0x9b, 0xbf, 0x05, 0x7c, 0x29, 0xf4, 0x33, 0x9b, 0xfe, 0xfe, 0x2c, 0xa9, 0x10, 0xb4,
+	0xe2, 0x13, 0x86, 0xaf, 0x25, 0x98, 0xb4, 0x63, 0x57, 0x7f, 0xf6, 0x33, 0x00, 0x4d, 0x28, 0x7f,
+	0x15, 0xd9, 0x01, 0x00, 0x00,
+}
+
+func (this *Params) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Params)
+	if !ok {
+		that2, ok := that.(Params)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.AcceptedMrEnclaves) != len(that1.AcceptedMrEnclaves) {
+		return false
+	}
+	for i := range this.AcceptedMrEnclaves {
+		if this.AcceptedMrEnclaves[i] != that1.AcceptedMrEnclaves[i] {
+			return false
+		}
+	}
+	if len(this.AcceptedMrSigners) != len(that1.AcceptedMrSigners) {
+		return false
+	}
+	for i := range this.AcceptedMrSigners {
+		if this.AcceptedMrSigners[i] != that1.AcceptedMrSigners[i] {
+			return false
+		}
+	}
+	if this.MinCpuSvn != that1.MinCpuSvn {
+		return false
+	}
+	if this.MinIsvSvn != that1.MinIsvSvn {
+		return false
+	}
+	if this.MaxAttestationAge != that1.MaxAttestationAge {
+		return false
+	}
+	if len(this.AcceptedTcbStatuses) != len(that1.AcceptedTcbStatuses) {
+		return false
+	}
+	for i := range this.AcceptedTcbStatuses {
+		if this.AcceptedTcbStatuses[i] != that1.AcceptedTcbStatuses[i] {
+			return false
+		}
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.AcceptedTcbStatuses) > 0 {
+		for iNdEx := len(m.AcceptedTcbStatuses) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedTcbStatuses[iNdEx])
+			copy(dAtA[i:], m.AcceptedTcbStatuses[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AcceptedTcbStatuses[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.MaxAttestationAge != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxAttestationAge))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.MinIsvSvn != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MinIsvSvn))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.MinCpuSvn) > 0 {
+		i -= len(m.MinCpuSvn)
+		copy(dAtA[i:], m.MinCpuSvn)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.MinCpuSvn)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AcceptedMrSigners) > 0 {
+		for iNdEx := len(m.AcceptedMrSigners) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedMrSigners[iNdEx])
+			copy(dAtA[i:], m.AcceptedMrSigners[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AcceptedMrSigners[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if len(m.AcceptedMrEnclaves) > 0 {
+		for iNdEx := len(m.AcceptedMrEnclaves) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.AcceptedMrEnclaves[iNdEx])
+			copy(dAtA[i:], m.AcceptedMrEnclaves[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.AcceptedMrEnclaves[iNdEx])))
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.AcceptedMrEnclaves) > 0 {
+		for _, s := range m.AcceptedMrEnclaves {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if len(m.AcceptedMrSigners) > 0 {
+		for _, s := range m.AcceptedMrSigners {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	l = len(m.MinCpuSvn)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MinIsvSvn != 0 {
+		n += 1 + sovParams(uint64(m.MinIsvSvn))
+	}
+	if m.MaxAttestationAge != 0 {
+		n += 1 + sovParams(uint64(m.MaxAttestationAge))
+	}
+	if len(m.AcceptedTcbStatuses) > 0 {
+		for _, s := range m.AcceptedTcbStatuses {
+			l = len(s)
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedMrEnclaves", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedMrEnclaves = append(m.AcceptedMrEnclaves, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedMrSigners", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedMrSigners = append(m.AcceptedMrSigners, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinCpuSvn", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinCpuSvn = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinIsvSvn", wireType)
+			}
+			m.MinIsvSvn = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MinIsvSvn |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxAttestationAge", wireType)
+			}
+			m.MaxAttestationAge = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxAttestationAge |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AcceptedTcbStatuses", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AcceptedTcbStatuses = append(m.AcceptedTcbStatuses, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParams(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParams
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParams
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParams
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParams        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParams          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParams = fmt.Errorf("proto: unexpected end of group")
+)
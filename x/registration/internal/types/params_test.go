@@ -0,0 +1,29 @@
+package types
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+func TestParamsValidate(t *testing.T) {
+	measurement := strings.Repeat("ab", 32)
+
+	require.NoError(t, DefaultParams().Validate())
+	require.NoError(t, Params{
+		AcceptedMrEnclaves:  []string{measurement},
+		AcceptedMrSigners:   []string{measurement},
+		MinCpuSvn:           strings.Repeat("01", 16),
+		MinIsvSvn:           3,
+		MaxAttestationAge:   1000,
+		AcceptedTcbStatuses: []string{"OK", "SW_HARDENING_NEEDED"},
+	}.Validate())
+
+	require.Error(t, Params{AcceptedMrEnclaves: []string{"zz"}}.Validate())
+	require.Error(t, Params{AcceptedMrSigners: []string{"abcd"}}.Validate())
+	require.Error(t, Params{MinCpuSvn: "01"}.Validate())
+	require.Error(t, Params{MaxAttestationAge: -1}.Validate())
+	require.Error(t, Params{AcceptedTcbStatuses: []string{"OUT_OF_DATE"}}.Validate())
+	require.Error(t, Params{AcceptedTcbStatuses: []string{"ok"}}.Validate())
+}
@@ -7,23 +7,28 @@ import (
 	bytes "bytes"
 	context "context"
 	fmt "fmt"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	_ "google/protobuf"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -105,357 +110,3820 @@ func (m *QueryEncryptedSeedResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_QueryEncryptedSeedResponse proto.InternalMessageInfo
 
-func init() {
-	proto.RegisterType((*QueryEncryptedSeedRequest)(nil), "secret.registration.v1beta1.QueryEncryptedSeedRequest")
-	proto.RegisterType((*QueryEncryptedSeedResponse)(nil), "secret.registration.v1beta1.QueryEncryptedSeedResponse")
+type RegisteredNode struct {
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	// "epid" or "dcap"
+	AttestationType string `protobuf:"bytes,2,opt,name=attestation_type,json=attestationType,proto3" json:"attestation_type,omitempty"`
+	// hex encoded
+	MrEnclave string `protobuf:"bytes,3,opt,name=mr_enclave,json=mrEnclave,proto3" json:"mr_enclave,omitempty"`
+	// hex encoded
+	MrSigner string `protobuf:"bytes,4,opt,name=mr_signer,json=mrSigner,proto3" json:"mr_signer,omitempty"`
+	// the IAS quote status of EPID attestations, empty for DCAP attestations
+	// which are evaluated against their collateral by the enclave
+	TcbStatus string `protobuf:"bytes,5,opt,name=tcb_status,json=tcbStatus,proto3" json:"tcb_status,omitempty"`
+	// zero for nodes registered before the height was recorded
+	RegistrationHeight int64 `protobuf:"varint,6,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
 }
 
-func init() {
-	proto.RegisterFile("secret/registration/v1beta1/query.proto", fileDescriptor_7ee71413f073b37c)
+func (m *RegisteredNode) Reset()         { *m = RegisteredNode{} }
+func (m *RegisteredNode) String() string { return proto.CompactTextString(m) }
+func (*RegisteredNode) ProtoMessage()    {}
+func (*RegisteredNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{2}
 }
-
-var fileDescriptor_7ee71413f073b37c = []byte{
-	// 429 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x51, 0xcd, 0x8a, 0xd4, 0x40,
-	0x18, 0x4c, 0x94, 0x5d, 0xa1, 0x71, 0x15, 0x1a, 0xf1, 0x27, 0xbb, 0x34, 0xcb, 0xe0, 0xea, 0x7a,
-	0xd8, 0x6e, 0x57, 0x65, 0x3d, 0x0a, 0xca, 0x9e, 0x16, 0x04, 0x67, 0x3d, 0x79, 0x59, 0x92, 0xcc,
-	0x67, 0x1b, 0x66, 0xa6, 0xbb, 0xa7, 0xbb, 0xa3, 0x13, 0x06, 0x2f, 0x3e, 0x81, 0xe0, 0x4b, 0xcc,
-	0x23, 0xf8, 0x08, 0x73, 0x1c, 0xf0, 0xe2, 0x51, 0x33, 0x3e, 0x88, 0xa4, 0xd3, 0x23, 0x19, 0x88,
-	0x01, 0xf1, 0x96, 0xf4, 0x57, 0x5f, 0x55, 0x7d, 0x55, 0xe8, 0xbe, 0x81, 0x54, 0x83, 0x65, 0x1a,
-	0x78, 0x66, 0xac, 0x8e, 0x6d, 0x26, 0x05, 0x7b, 0x7f, 0x9c, 0x80, 0x8d, 0x8f, 0xd9, 0x24, 0x07,
-	0x5d, 0x50, 0xa5, 0xa5, 0x95, 0x78, 0xb7, 0x06, 0xd2, 0x26, 0x90, 0x7a, 0x60, 0x74, 0x83, 0x4b,
-	0x2e, 0x1d, 0x8e, 0x55, 0x5f, 0xf5, 0x4a, 0xb4, 0xcb, 0xa5, 0xe4, 0x23, 0x60, 0xee, 0x2f, 0xc9,
-	0xdf, 0x32, 0x18, 0x2b, 0xeb, 0xf9, 0xa2, 0x3d, 0x3f, 0x8c, 0x55, 0xc6, 0x62, 0x21, 0xa4, 0x75,
-	0x8c, 0xc6, 0x4f, 0x0f, 0xba, 0x6c, 0x8d, 0x0d, 0xf7, 0xb0, 0x07, 0x5d, 0x30, 0x0e, 0x02, 0x4c,
-	0xe6, 0x19, 0x7b, 0x4f, 0xd0, 0x9d, 0x57, 0xd5, 0x39, 0xa7, 0x22, 0xd5, 0x85, 0xb2, 0x30, 0x38,
-	0x07, 0x18, 0xf4, 0x61, 0x92, 0x83, 0xb1, 0xf8, 0x16, 0xba, 0xa2, 0xf2, 0xe4, 0x62, 0x08, 0xc5,
-	0xed, 0x70, 0x3f, 0x3c, 0xbc, 0xda, 0xdf, 0x56, 0x79, 0x72, 0x06, 0x45, 0xef, 0x05, 0x8a, 0xda,
-	0xb6, 0x8c, 0x92, 0xc2, 0x00, 0x3e, 0x40, 0xd7, 0x60, 0x3d, 0xb8, 0x30, 0x00, 0x03, 0xbf, 0xbd,
-	0x03, 0x4d, 0xf8, 0xa3, 0xf9, 0x65, 0xb4, 0xe5, 0x58, 0x30, 0x47, 0x5b, 0xaf, 0xa7, 0x67, 0x50,
-	0xe0, 0x9b, 0xb4, 0x3e, 0x9f, 0xae, 0xb3, 0xa1, 0xa7, 0x55, 0x36, 0xd1, 0x3e, 0xed, 0x88, 0x99,
-	0x56, 0x8e, 0xee, 0x7e, 0xfa, 0xf6, 0xeb, 0xcb, 0x25, 0x82, 0xf7, 0xda, 0x8f, 0xb6, 0xd3, 0xa3,
-	0x21, 0x14, 0x78, 0x86, 0xae, 0xf7, 0x1b, 0xe3, 0xff, 0x93, 0xa4, 0x4e, 0xf2, 0x10, 0xdf, 0x6b,
-	0x97, 0x6c, 0x3e, 0x3a, 0xf1, 0xaf, 0x21, 0xda, 0xd9, 0x08, 0x0c, 0x9f, 0x74, 0x6a, 0xfc, 0xb5,
-	0x97, 0xe8, 0xe9, 0x3f, 0xef, 0xd5, 0xcd, 0xf4, 0x4e, 0x9c, 0xe5, 0x87, 0x98, 0xb6, 0x5b, 0xfe,
-	0xd3, 0xcf, 0x51, 0xd5, 0x1a, 0x9b, 0xf9, 0xf2, 0x3f, 0x3e, 0x8f, 0x17, 0x3f, 0x49, 0x30, 0x2f,
-	0x49, 0xb8, 0x28, 0x49, 0xb8, 0x2c, 0x49, 0xf8, 0xa3, 0x24, 0xe1, 0xe7, 0x15, 0x09, 0x96, 0x2b,
-	0x12, 0x7c, 0x5f, 0x91, 0xe0, 0xcd, 0x33, 0x9e, 0xd9, 0x77, 0x79, 0x42, 0x53, 0x39, 0x66, 0x26,
-	0xd5, 0x76, 0x14, 0x27, 0x86, 0x9d, 0x3b, 0x97, 0x2f, 0xc1, 0x7e, 0x90, 0x7a, 0xc8, 0xa6, 0x9b,
-	0xa2, 0x99, 0xb0, 0xa0, 0x45, 0x3c, 0x62, 0xb6, 0x50, 0x60, 0x92, 0x6d, 0x97, 0xff, 0xe3, 0xdf,
-	0x01, 0x00, 0x00, 0xff, 0xff, 0x2c, 0x62, 0xa4, 0x1c, 0x7a, 0x03, 0x00, 0x00,
+func (m *RegisteredNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-
-func (this *QueryEncryptedSeedRequest) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
-
-	that1, ok := that.(*QueryEncryptedSeedRequest)
-	if !ok {
-		that2, ok := that.(QueryEncryptedSeedRequest)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+func (m *RegisteredNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RegisteredNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.PubKey, that1.PubKey) {
-		return false
-	}
-	return true
 }
-func (this *QueryEncryptedSeedResponse) Equal(that interface{}) bool {
-	if that == nil {
-		return this == nil
-	}
+func (m *RegisteredNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RegisteredNode.Merge(m, src)
+}
+func (m *RegisteredNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *RegisteredNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_RegisteredNode.DiscardUnknown(m)
+}
 
-	that1, ok := that.(*QueryEncryptedSeedResponse)
-	if !ok {
-		that2, ok := that.(QueryEncryptedSeedResponse)
-		if ok {
-			that1 = &that2
-		} else {
-			return false
+var xxx_messageInfo_RegisteredNode proto.InternalMessageInfo
+
+type QueryRegisteredNodesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryRegisteredNodesRequest) Reset()         { *m = QueryRegisteredNodesRequest{} }
+func (m *QueryRegisteredNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodesRequest) ProtoMessage()    {}
+func (*QueryRegisteredNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{3}
+}
+func (m *QueryRegisteredNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
 		}
+		return b[:n], nil
 	}
-	if that1 == nil {
-		return this == nil
-	} else if this == nil {
-		return false
-	}
-	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
-		return false
-	}
-	return true
+}
+func (m *QueryRegisteredNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodesRequest.Merge(m, src)
+}
+func (m *QueryRegisteredNodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodesRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
-
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+var xxx_messageInfo_QueryRegisteredNodesRequest proto.InternalMessageInfo
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Returns the key used for transactions
-	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the key used for registration
-	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
-	// Returns the encrypted seed for a registered node by public key
-	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
+type QueryRegisteredNodesResponse struct {
+	Nodes      []RegisteredNode    `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryRegisteredNodesResponse) Reset()         { *m = QueryRegisteredNodesResponse{} }
+func (m *QueryRegisteredNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryRegisteredNodesResponse) ProtoMessage()    {}
+func (*QueryRegisteredNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{4}
+}
+func (m *QueryRegisteredNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryRegisteredNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryRegisteredNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryRegisteredNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryRegisteredNodesResponse.Merge(m, src)
+}
+func (m *QueryRegisteredNodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryRegisteredNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryRegisteredNodesResponse.DiscardUnknown(m)
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+var xxx_messageInfo_QueryRegisteredNodesResponse proto.InternalMessageInfo
+
+type MachineSwap struct {
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded
+	Owner string `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	// the machine id of the registered node, hex encoded
+	MachineId string `protobuf:"bytes,3,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
+	// the machine id it replaced, hex encoded. Empty when the node registered
+	// on the same machine
+	ReplacedMachineId string `protobuf:"bytes,4,opt,name=replaced_machine_id,json=replacedMachineId,proto3" json:"replaced_machine_id,omitempty"`
 }
 
-func (c *queryClient) TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
-	out := new(Key)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/TxKey", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *MachineSwap) Reset()         { *m = MachineSwap{} }
+func (m *MachineSwap) String() string { return proto.CompactTextString(m) }
+func (*MachineSwap) ProtoMessage()    {}
+func (*MachineSwap) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{5}
+}
+func (m *MachineSwap) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MachineSwap) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MachineSwap.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
+}
+func (m *MachineSwap) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MachineSwap.Merge(m, src)
+}
+func (m *MachineSwap) XXX_Size() int {
+	return m.Size()
+}
+func (m *MachineSwap) XXX_DiscardUnknown() {
+	xxx_messageInfo_MachineSwap.DiscardUnknown(m)
 }
 
-func (c *queryClient) RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
-	out := new(Key)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegistrationKey", in, out, opts...)
-	if err != nil {
-		return nil, err
-	}
-	return out, nil
+var xxx_messageInfo_MachineSwap proto.InternalMessageInfo
+
+type QueryMachineSwapsRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
-	out := new(QueryEncryptedSeedResponse)
-	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeed", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMachineSwapsRequest) Reset()         { *m = QueryMachineSwapsRequest{} }
+func (m *QueryMachineSwapsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMachineSwapsRequest) ProtoMessage()    {}
+func (*QueryMachineSwapsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{6}
+}
+func (m *QueryMachineSwapsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMachineSwapsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMachineSwapsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Returns the key used for transactions
-	TxKey(context.Context, *emptypb.Empty) (*Key, error)
-	// Returns the key used for registration
-	RegistrationKey(context.Context, *emptypb.Empty) (*Key, error)
-	// Returns the encrypted seed for a registered node by public key
-	EncryptedSeed(context.Context, *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error)
+func (m *QueryMachineSwapsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMachineSwapsRequest.Merge(m, src)
+}
+func (m *QueryMachineSwapsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMachineSwapsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMachineSwapsRequest.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryMachineSwapsRequest proto.InternalMessageInfo
+
+type QueryMachineSwapsResponse struct {
+	Swaps      []MachineSwap       `protobuf:"bytes,1,rep,name=swaps,proto3" json:"swaps"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (*UnimplementedQueryServer) TxKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method TxKey not implemented")
+func (m *QueryMachineSwapsResponse) Reset()         { *m = QueryMachineSwapsResponse{} }
+func (m *QueryMachineSwapsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMachineSwapsResponse) ProtoMessage()    {}
+func (*QueryMachineSwapsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{7}
 }
-func (*UnimplementedQueryServer) RegistrationKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method RegistrationKey not implemented")
+func (m *QueryMachineSwapsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
 }
-func (*UnimplementedQueryServer) EncryptedSeed(ctx context.Context, req *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeed not implemented")
+func (m *QueryMachineSwapsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMachineSwapsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMachineSwapsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMachineSwapsResponse.Merge(m, src)
+}
+func (m *QueryMachineSwapsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMachineSwapsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMachineSwapsResponse.DiscardUnknown(m)
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+var xxx_messageInfo_QueryMachineSwapsResponse proto.InternalMessageInfo
+
+type WhitelistedMachine struct {
+	Index uint32 `protobuf:"varint,1,opt,name=index,proto3" json:"index,omitempty"`
+	// hex encoded
+	MachineId string `protobuf:"bytes,2,opt,name=machine_id,json=machineId,proto3" json:"machine_id,omitempty"`
 }
 
-func _Query_TxKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).TxKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Query/TxKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).TxKey(ctx, req.(*emptypb.Empty))
+func (m *WhitelistedMachine) Reset()         { *m = WhitelistedMachine{} }
+func (m *WhitelistedMachine) String() string { return proto.CompactTextString(m) }
+func (*WhitelistedMachine) ProtoMessage()    {}
+func (*WhitelistedMachine) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{8}
+}
+func (m *WhitelistedMachine) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *WhitelistedMachine) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_WhitelistedMachine.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *WhitelistedMachine) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WhitelistedMachine.Merge(m, src)
+}
+func (m *WhitelistedMachine) XXX_Size() int {
+	return m.Size()
+}
+func (m *WhitelistedMachine) XXX_DiscardUnknown() {
+	xxx_messageInfo_WhitelistedMachine.DiscardUnknown(m)
 }
 
-func _Query_RegistrationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(emptypb.Empty)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).RegistrationKey(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Query/RegistrationKey",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).RegistrationKey(ctx, req.(*emptypb.Empty))
-	}
-	return interceptor(ctx, in, info, handler)
+var xxx_messageInfo_WhitelistedMachine proto.InternalMessageInfo
+
+type QueryMachineWhitelistRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryEncryptedSeedRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).EncryptedSeed(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/secret.registration.v1beta1.Query/EncryptedSeed",
+func (m *QueryMachineWhitelistRequest) Reset()         { *m = QueryMachineWhitelistRequest{} }
+func (m *QueryMachineWhitelistRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMachineWhitelistRequest) ProtoMessage()    {}
+func (*QueryMachineWhitelistRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{9}
+}
+func (m *QueryMachineWhitelistRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMachineWhitelistRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMachineWhitelistRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).EncryptedSeed(ctx, req.(*QueryEncryptedSeedRequest))
+}
+func (m *QueryMachineWhitelistRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMachineWhitelistRequest.Merge(m, src)
+}
+func (m *QueryMachineWhitelistRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMachineWhitelistRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMachineWhitelistRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMachineWhitelistRequest proto.InternalMessageInfo
+
+type QueryMachineWhitelistResponse struct {
+	Machines   []WhitelistedMachine `protobuf:"bytes,1,rep,name=machines,proto3" json:"machines"`
+	Pagination *query.PageResponse  `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMachineWhitelistResponse) Reset()         { *m = QueryMachineWhitelistResponse{} }
+func (m *QueryMachineWhitelistResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMachineWhitelistResponse) ProtoMessage()    {}
+func (*QueryMachineWhitelistResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{10}
+}
+func (m *QueryMachineWhitelistResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMachineWhitelistResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMachineWhitelistResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return interceptor(ctx, in, info, handler)
+}
+func (m *QueryMachineWhitelistResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMachineWhitelistResponse.Merge(m, src)
+}
+func (m *QueryMachineWhitelistResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMachineWhitelistResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMachineWhitelistResponse.DiscardUnknown(m)
 }
 
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "secret.registration.v1beta1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "TxKey",
-			Handler:    _Query_TxKey_Handler,
-		},
-		{
-			MethodName: "RegistrationKey",
-			Handler:    _Query_RegistrationKey_Handler,
-		},
-		{
-			MethodName: "EncryptedSeed",
-			Handler:    _Query_EncryptedSeed_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "secret/registration/v1beta1/query.proto",
+var xxx_messageInfo_QueryMachineWhitelistResponse proto.InternalMessageInfo
+
+type QueryParamsRequest struct {
 }
 
-func (m *QueryEncryptedSeedRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryParamsRequest) Reset()         { *m = QueryParamsRequest{} }
+func (m *QueryParamsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsRequest) ProtoMessage()    {}
+func (*QueryParamsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{11}
+}
+func (m *QueryParamsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryParamsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsRequest.Merge(m, src)
+}
+func (m *QueryParamsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsRequest.DiscardUnknown(m)
 }
 
-func (m *QueryEncryptedSeedRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryParamsRequest proto.InternalMessageInfo
+
+type QueryParamsResponse struct {
+	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
 }
 
-func (m *QueryEncryptedSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.PubKey) > 0 {
-		i -= len(m.PubKey)
-		copy(dAtA[i:], m.PubKey)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryParamsResponse) Reset()         { *m = QueryParamsResponse{} }
+func (m *QueryParamsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryParamsResponse) ProtoMessage()    {}
+func (*QueryParamsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{12}
+}
+func (m *QueryParamsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryParamsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryParamsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryParamsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryParamsResponse.Merge(m, src)
+}
+func (m *QueryParamsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryParamsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryParamsResponse.DiscardUnknown(m)
 }
 
-func (m *QueryEncryptedSeedResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+var xxx_messageInfo_QueryParamsResponse proto.InternalMessageInfo
+
+type StaleNode struct {
+	PubKey            []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+	AttestationHeight int64  `protobuf:"varint,2,opt,name=attestation_height,json=attestationHeight,proto3" json:"attestation_height,omitempty"`
+	// why the node must re-attest
+	Reasons []string `protobuf:"bytes,3,rep,name=reasons,proto3" json:"reasons,omitempty"`
+	// the time the attestation report of the node was issued at, unset if the
+	// certificate has none
+	ReportTime *time.Time `protobuf:"bytes,4,opt,name=report_time,json=reportTime,proto3,stdtime" json:"report_time,omitempty"`
+}
+
+func (m *StaleNode) Reset()         { *m = StaleNode{} }
+func (m *StaleNode) String() string { return proto.CompactTextString(m) }
+func (*StaleNode) ProtoMessage()    {}
+func (*StaleNode) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{13}
+}
+func (m *StaleNode) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *StaleNode) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_StaleNode.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *StaleNode) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_StaleNode.Merge(m, src)
+}
+func (m *StaleNode) XXX_Size() int {
+	return m.Size()
+}
+func (m *StaleNode) XXX_DiscardUnknown() {
+	xxx_messageInfo_StaleNode.DiscardUnknown(m)
 }
 
-func (m *QueryEncryptedSeedResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_StaleNode proto.InternalMessageInfo
+
+type QueryStaleNodesRequest struct {
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (m *QueryEncryptedSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if len(m.EncryptedSeed) > 0 {
-		i -= len(m.EncryptedSeed)
-		copy(dAtA[i:], m.EncryptedSeed)
-		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncryptedSeed)))
-		i--
-		dAtA[i] = 0xa
+func (m *QueryStaleNodesRequest) Reset()         { *m = QueryStaleNodesRequest{} }
+func (m *QueryStaleNodesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleNodesRequest) ProtoMessage()    {}
+func (*QueryStaleNodesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{14}
+}
+func (m *QueryStaleNodesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleNodesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleNodesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return len(dAtA) - i, nil
+}
+func (m *QueryStaleNodesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleNodesRequest.Merge(m, src)
+}
+func (m *QueryStaleNodesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleNodesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleNodesRequest.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryStaleNodesRequest proto.InternalMessageInfo
+
+type QueryStaleNodesResponse struct {
+	Nodes      []StaleNode         `protobuf:"bytes,1,rep,name=nodes,proto3" json:"nodes"`
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryStaleNodesResponse) Reset()         { *m = QueryStaleNodesResponse{} }
+func (m *QueryStaleNodesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryStaleNodesResponse) ProtoMessage()    {}
+func (*QueryStaleNodesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7ee71413f073b37c, []int{15}
+}
+func (m *QueryStaleNodesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryStaleNodesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryStaleNodesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	dAtA[offset] = uint8(v)
-	return base
 }
-func (m *QueryEncryptedSeedRequest) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryStaleNodesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryStaleNodesResponse.Merge(m, src)
+}
+func (m *QueryStaleNodesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryStaleNodesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryStaleNodesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryStaleNodesResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*QueryEncryptedSeedRequest)(nil), "secret.registration.v1beta1.QueryEncryptedSeedRequest")
+	proto.RegisterType((*QueryEncryptedSeedResponse)(nil), "secret.registration.v1beta1.QueryEncryptedSeedResponse")
+	proto.RegisterType((*RegisteredNode)(nil), "secret.registration.v1beta1.RegisteredNode")
+	proto.RegisterType((*QueryRegisteredNodesRequest)(nil), "secret.registration.v1beta1.QueryRegisteredNodesRequest")
+	proto.RegisterType((*QueryRegisteredNodesResponse)(nil), "secret.registration.v1beta1.QueryRegisteredNodesResponse")
+	proto.RegisterType((*MachineSwap)(nil), "secret.registration.v1beta1.MachineSwap")
+	proto.RegisterType((*QueryMachineSwapsRequest)(nil), "secret.registration.v1beta1.QueryMachineSwapsRequest")
+	proto.RegisterType((*QueryMachineSwapsResponse)(nil), "secret.registration.v1beta1.QueryMachineSwapsResponse")
+	proto.RegisterType((*WhitelistedMachine)(nil), "secret.registration.v1beta1.WhitelistedMachine")
+	proto.RegisterType((*QueryMachineWhitelistRequest)(nil), "secret.registration.v1beta1.QueryMachineWhitelistRequest")
+	proto.RegisterType((*QueryMachineWhitelistResponse)(nil), "secret.registration.v1beta1.QueryMachineWhitelistResponse")
+	proto.RegisterType((*QueryParamsRequest)(nil), "secret.registration.v1beta1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "secret.registration.v1beta1.QueryParamsResponse")
+	proto.RegisterType((*StaleNode)(nil), "secret.registration.v1beta1.StaleNode")
+	proto.RegisterType((*QueryStaleNodesRequest)(nil), "secret.registration.v1beta1.QueryStaleNodesRequest")
+	proto.RegisterType((*QueryStaleNodesResponse)(nil), "secret.registration.v1beta1.QueryStaleNodesResponse")
+}
+
+func init() {
+	proto.RegisterFile("secret/registration/v1beta1/query.proto", fileDescriptor_7ee71413f073b37c)
+}
+
+var fileDescriptor_7ee71413f073b37c = []byte{
+	// 1139 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xb4, 0x57, 0x4f, 0x6f, 0x1b, 0x45,
+	0x14, 0xcf, 0x24, 0x71, 0xda, 0xbc, 0x34, 0x4d, 0x3b, 0x8d, 0x5a, 0x77, 0x93, 0x3a, 0xc6, 0x21,
+	0x89, 0x43, 0x95, 0xdd, 0x36, 0x2d, 0x81, 0x72, 0x41, 0x0d, 0x84, 0x52, 0xa1, 0x56, 0xad, 0x1d,
+	0x09, 0xc4, 0xc5, 0xec, 0xda, 0xaf, 0x9b, 0x55, 0xbc, 0x7f, 0xba, 0x33, 0x6e, 0x62, 0x55, 0x5c,
+	0x10, 0x07, 0x8e, 0x45, 0x7c, 0x03, 0x0e, 0x08, 0xf5, 0xc4, 0x09, 0x21, 0xf8, 0x02, 0x39, 0x56,
+	0xe2, 0xc2, 0x09, 0x4a, 0xc2, 0x07, 0x41, 0xf3, 0xc7, 0xee, 0x6e, 0xe2, 0xac, 0x93, 0x12, 0x6e,
+	0xde, 0xf7, 0xde, 0x6f, 0xde, 0x6f, 0x7e, 0x6f, 0xe6, 0xbd, 0x31, 0x2c, 0x30, 0xac, 0xc7, 0xc8,
+	0xad, 0x18, 0x5d, 0x8f, 0xf1, 0xd8, 0xe6, 0x5e, 0x18, 0x58, 0x4f, 0xae, 0x3b, 0xc8, 0xed, 0xeb,
+	0xd6, 0xe3, 0x16, 0xc6, 0x6d, 0x33, 0x8a, 0x43, 0x1e, 0xd2, 0x29, 0x15, 0x68, 0x26, 0x03, 0x4d,
+	0x1d, 0x68, 0x4c, 0xba, 0xa1, 0x1b, 0xca, 0x38, 0x4b, 0xfc, 0x52, 0x10, 0x63, 0xca, 0x0d, 0x43,
+	0xb7, 0x89, 0x96, 0xfc, 0x72, 0x5a, 0x8f, 0x2c, 0xf4, 0x23, 0xae, 0xd7, 0x33, 0x66, 0xf6, 0x3b,
+	0xb9, 0xe7, 0x23, 0xe3, 0xb6, 0x1f, 0xe9, 0x80, 0x69, 0x1d, 0x60, 0x47, 0x9e, 0x65, 0x07, 0x41,
+	0xc8, 0x65, 0x4a, 0xa6, 0xbd, 0x6f, 0xd5, 0x43, 0xe6, 0x87, 0xcc, 0x72, 0x6c, 0x86, 0x8a, 0x67,
+	0x97, 0x75, 0x64, 0xbb, 0x5e, 0xa0, 0xf8, 0xa9, 0xd8, 0xb9, 0xac, 0x3d, 0xfa, 0xcc, 0xd5, 0x61,
+	0x8b, 0x59, 0x61, 0x2e, 0x06, 0xc8, 0xbc, 0x4e, 0xf6, 0x72, 0x56, 0x68, 0x64, 0xc7, 0xb6, 0xaf,
+	0x23, 0x4b, 0x37, 0xe1, 0xf2, 0x43, 0xc1, 0x6e, 0x2d, 0xa8, 0xc7, 0xed, 0x88, 0x63, 0xa3, 0x8a,
+	0xd8, 0xa8, 0xe0, 0xe3, 0x16, 0x32, 0x4e, 0x2f, 0xc1, 0xa9, 0xa8, 0xe5, 0xd4, 0x36, 0xb1, 0x9d,
+	0x27, 0x45, 0x52, 0x3e, 0x53, 0x19, 0x89, 0x5a, 0xce, 0x27, 0xd8, 0x2e, 0x7d, 0x00, 0x46, 0x2f,
+	0x14, 0x8b, 0xc2, 0x80, 0x21, 0x9d, 0x83, 0xb3, 0xd8, 0x71, 0xd4, 0x18, 0x62, 0x43, 0xa3, 0xc7,
+	0x31, 0x19, 0x5e, 0x7a, 0x49, 0xe0, 0x6c, 0x45, 0x12, 0xc4, 0x18, 0x1b, 0xf7, 0xc3, 0x06, 0x1e,
+	0x9a, 0x90, 0x2e, 0xc2, 0x39, 0x9b, 0x73, 0xa1, 0xbf, 0xd8, 0x4a, 0x8d, 0xb7, 0x23, 0xcc, 0x0f,
+	0x16, 0x49, 0x79, 0xb4, 0x32, 0x91, 0xb0, 0xaf, 0xb7, 0x23, 0xa4, 0x57, 0x00, 0xfc, 0xb8, 0x86,
+	0x41, 0xbd, 0x69, 0x3f, 0xc1, 0xfc, 0x90, 0x0c, 0x1a, 0xf5, 0xe3, 0x35, 0x65, 0xa0, 0x53, 0x30,
+	0xea, 0xc7, 0x35, 0xe6, 0xb9, 0x01, 0xc6, 0xf9, 0x61, 0xe9, 0x3d, 0xed, 0xc7, 0x55, 0xf9, 0x2d,
+	0xb0, 0xbc, 0xee, 0xd4, 0xc4, 0x72, 0x2d, 0x96, 0xcf, 0x29, 0x2c, 0xaf, 0x3b, 0x55, 0x69, 0xa0,
+	0x16, 0x5c, 0x48, 0x2a, 0x5a, 0xdb, 0x40, 0xcf, 0xdd, 0xe0, 0xf9, 0x91, 0x22, 0x29, 0x0f, 0x55,
+	0x68, 0xd2, 0xf5, 0xb1, 0xf4, 0x94, 0x10, 0xa6, 0xa4, 0x4e, 0xe9, 0x6d, 0xb2, 0x8e, 0xbe, 0x1f,
+	0x01, 0xbc, 0x3a, 0x0c, 0x72, 0xc7, 0x63, 0xcb, 0xf3, 0xa6, 0x3a, 0x39, 0xa6, 0x38, 0x39, 0xa6,
+	0x3a, 0xe1, 0xba, 0x72, 0xe6, 0x03, 0xdb, 0x45, 0x8d, 0xad, 0x24, 0x90, 0xa5, 0x9f, 0x08, 0x4c,
+	0xf7, 0xce, 0xa3, 0x2b, 0x72, 0x07, 0x72, 0x81, 0x30, 0xe4, 0x49, 0x71, 0xa8, 0x3c, 0xb6, 0x7c,
+	0xd5, 0xcc, 0xb8, 0x2c, 0x66, 0x7a, 0x91, 0xd5, 0xe1, 0x9d, 0x3f, 0x67, 0x06, 0x2a, 0x0a, 0x4f,
+	0xef, 0xa4, 0x18, 0x0f, 0x4a, 0xc6, 0x0b, 0x7d, 0x19, 0x2b, 0x16, 0x29, 0xca, 0xdf, 0x10, 0x18,
+	0xbb, 0x67, 0xd7, 0x37, 0xbc, 0x00, 0xab, 0x5b, 0x76, 0x44, 0x27, 0x21, 0xe7, 0x05, 0x0d, 0xdc,
+	0x96, 0x2a, 0x8c, 0x57, 0xd4, 0x87, 0xb0, 0x86, 0x5b, 0xa2, 0x50, 0xaa, 0xd6, 0xea, 0x43, 0x56,
+	0x58, 0x41, 0x6b, 0x5e, 0xa3, 0x5b, 0x61, 0x65, 0xb9, 0xdb, 0xa0, 0xa6, 0xa8, 0x52, 0xd4, 0xb4,
+	0xeb, 0xd8, 0xa8, 0x25, 0xe2, 0x54, 0xad, 0xcf, 0x77, 0x5c, 0xf7, 0x3a, 0xf1, 0x25, 0x07, 0xf2,
+	0x52, 0xbc, 0x04, 0x9d, 0x13, 0xaf, 0xd0, 0x73, 0x02, 0x97, 0x7b, 0x24, 0xd1, 0xe5, 0xf9, 0x10,
+	0x72, 0x4c, 0x18, 0x74, 0x79, 0xca, 0x99, 0xe5, 0x49, 0xac, 0xd0, 0xa9, 0x8d, 0x04, 0x9f, 0x5c,
+	0x6d, 0xee, 0x02, 0xfd, 0x74, 0xc3, 0xe3, 0xd8, 0xf4, 0x18, 0xef, 0x0a, 0x75, 0x48, 0x85, 0xd2,
+	0xb5, 0x18, 0xdc, 0x57, 0x8b, 0xd2, 0x23, 0x7d, 0x30, 0xf5, 0x22, 0xdd, 0x65, 0x4f, 0x5a, 0xdf,
+	0xdf, 0x08, 0x5c, 0x39, 0x24, 0x91, 0xd6, 0xf8, 0x21, 0x9c, 0xd6, 0xb4, 0x3a, 0x32, 0x5b, 0x99,
+	0x32, 0x1f, 0x54, 0x40, 0xab, 0xdd, 0x5d, 0xe6, 0xe4, 0x04, 0x9f, 0x04, 0x2a, 0xc9, 0x3f, 0x90,
+	0x9d, 0x59, 0xef, 0xaf, 0xf4, 0x19, 0x5c, 0x48, 0x59, 0xf5, 0x46, 0x6e, 0xc3, 0x88, 0xea, 0xe0,
+	0x5a, 0xae, 0xd9, 0xcc, 0x6d, 0x28, 0xb0, 0xa6, 0xae, 0x81, 0xa2, 0x5f, 0x8c, 0x56, 0xb9, 0xdd,
+	0xc4, 0xec, 0xa6, 0xbb, 0x04, 0x34, 0xd9, 0x74, 0x75, 0xb7, 0x1b, 0x94, 0xdd, 0xee, 0x7c, 0xc2,
+	0xa3, 0x9a, 0x1d, 0xcd, 0xc3, 0xa9, 0x18, 0x6d, 0x16, 0x06, 0x2c, 0x3f, 0x54, 0x1c, 0x2a, 0x8f,
+	0x56, 0x3a, 0x9f, 0xf4, 0x36, 0x8c, 0xc5, 0x18, 0x85, 0x31, 0xaf, 0x89, 0x21, 0x2a, 0x6f, 0xe2,
+	0xd8, 0xb2, 0x61, 0xaa, 0x01, 0x6a, 0x76, 0x26, 0xac, 0xb9, 0xde, 0x99, 0xb0, 0xab, 0xc3, 0xcf,
+	0xfe, 0x9a, 0x21, 0x15, 0x50, 0x20, 0x61, 0x2e, 0x7d, 0x01, 0x17, 0xa5, 0x18, 0x5d, 0xda, 0x27,
+	0x7e, 0x45, 0x7f, 0x20, 0x70, 0xe9, 0x40, 0x0a, 0xad, 0xf9, 0x6a, 0xba, 0x7f, 0xce, 0x67, 0x4a,
+	0xde, 0xc5, 0xff, 0x3f, 0xad, 0x73, 0xf9, 0x6b, 0x80, 0x9c, 0x24, 0x4a, 0x5d, 0xc8, 0xad, 0x6f,
+	0x8b, 0x4a, 0x5d, 0x3c, 0xa0, 0xe5, 0x9a, 0x78, 0xca, 0x18, 0xc5, 0x4c, 0xa2, 0x62, 0x92, 0xbf,
+	0xf9, 0xd5, 0xef, 0xff, 0x7c, 0x37, 0x58, 0xa0, 0xd3, 0xbd, 0xdf, 0x0a, 0x7c, 0x7b, 0x69, 0x13,
+	0xdb, 0xf4, 0x29, 0x4c, 0x54, 0x12, 0xee, 0xff, 0x96, 0xd2, 0x94, 0x29, 0xcb, 0x74, 0xbe, 0x77,
+	0xca, 0xa4, 0x51, 0x26, 0xff, 0x85, 0xc0, 0x78, 0xea, 0xa1, 0x41, 0x57, 0x32, 0x73, 0x1c, 0xfa,
+	0x9e, 0x31, 0xde, 0x39, 0x36, 0x4e, 0xc9, 0x5f, 0x5a, 0x91, 0x94, 0xaf, 0x51, 0xb3, 0x37, 0xe5,
+	0xee, 0xbb, 0x66, 0x89, 0x21, 0x36, 0xac, 0xa7, 0xfa, 0x3a, 0x7d, 0x49, 0x7f, 0x26, 0x30, 0x91,
+	0x1e, 0xa7, 0x8c, 0xbe, 0xdb, 0x9f, 0x44, 0xef, 0xe7, 0x82, 0x71, 0xeb, 0x35, 0x90, 0x7a, 0x03,
+	0x47, 0xd2, 0x5c, 0xc0, 0x96, 0xd4, 0x61, 0x7d, 0x4e, 0xe0, 0x4c, 0x72, 0x54, 0xd1, 0xb7, 0xfb,
+	0xe7, 0xee, 0x31, 0x3f, 0x8d, 0x95, 0xe3, 0xc2, 0x34, 0xdf, 0xab, 0x92, 0xef, 0x1c, 0x9d, 0x3d,
+	0xe4, 0x51, 0xac, 0x30, 0x4b, 0x6a, 0xf0, 0xfd, 0x4a, 0xe0, 0xdc, 0xfe, 0xbe, 0x4f, 0x6f, 0x1d,
+	0x39, 0xf3, 0xfe, 0xa1, 0x64, 0xbc, 0xf7, 0x3a, 0x50, 0x4d, 0xdc, 0x92, 0xc4, 0x17, 0xe9, 0x42,
+	0x36, 0xf1, 0xad, 0x2e, 0xcf, 0x6f, 0x09, 0x8c, 0xa8, 0x26, 0x4d, 0xad, 0xfe, 0x79, 0x53, 0x13,
+	0xc2, 0xb8, 0x76, 0x74, 0x80, 0xa6, 0xd7, 0xe7, 0xba, 0xab, 0xf9, 0x40, 0xbf, 0x27, 0x00, 0xaf,
+	0xba, 0x20, 0xbd, 0xd1, 0x3f, 0xcd, 0x81, 0xb6, 0x6c, 0xdc, 0x3c, 0x1e, 0x48, 0xf3, 0x5b, 0x94,
+	0xfc, 0x66, 0xe9, 0x1b, 0xbd, 0xf9, 0x31, 0x81, 0x50, 0x47, 0x74, 0xd5, 0xde, 0xf9, 0xbb, 0x30,
+	0xf0, 0xe3, 0x6e, 0x81, 0xec, 0xec, 0x16, 0xc8, 0x8b, 0xdd, 0x02, 0x79, 0xb9, 0x5b, 0x20, 0xcf,
+	0xf6, 0x0a, 0x03, 0x2f, 0xf6, 0x0a, 0x03, 0x7f, 0xec, 0x15, 0x06, 0x3e, 0x7f, 0xdf, 0xf5, 0xf8,
+	0x46, 0xcb, 0x31, 0xeb, 0xa1, 0x6f, 0xb1, 0x7a, 0xcc, 0x9b, 0xb6, 0xc3, 0xac, 0xaa, 0x64, 0x75,
+	0x1f, 0xf9, 0x56, 0x18, 0x6f, 0x5a, 0xdb, 0xe9, 0x3c, 0x5e, 0xc0, 0x31, 0x0e, 0xec, 0xa6, 0x25,
+	0xfe, 0x60, 0x30, 0x67, 0x44, 0xf6, 0xb6, 0x1b, 0xff, 0x0e, 0x00, 0x06, 0xce, 0xe0, 0x1c, 0x85,
+	0x0e, 0x00, 0x00,
+}
+
+func (this *QueryEncryptedSeedRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
 	}
-	var l int
-	_ = l
-	l = len(m.PubKey)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+
+	that1, ok := that.(*QueryEncryptedSeedRequest)
+	if !ok {
+		that2, ok := that.(QueryEncryptedSeedRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	return true
+}
+func (this *QueryEncryptedSeedResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryEncryptedSeedResponse)
+	if !ok {
+		that2, ok := that.(QueryEncryptedSeedResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
+		return false
+	}
+	return true
+}
+func (this *RegisteredNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*RegisteredNode)
+	if !ok {
+		that2, ok := that.(RegisteredNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.AttestationType != that1.AttestationType {
+		return false
+	}
+	if this.MrEnclave != that1.MrEnclave {
+		return false
+	}
+	if this.MrSigner != that1.MrSigner {
+		return false
+	}
+	if this.TcbStatus != that1.TcbStatus {
+		return false
+	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	return true
+}
+func (this *QueryRegisteredNodesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryRegisteredNodesRequest)
+	if !ok {
+		that2, ok := that.(QueryRegisteredNodesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *QueryRegisteredNodesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryRegisteredNodesResponse)
+	if !ok {
+		that2, ok := that.(QueryRegisteredNodesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !this.Nodes[i].Equal(&that1.Nodes[i]) {
+			return false
+		}
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *MachineSwap) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*MachineSwap)
+	if !ok {
+		that2, ok := that.(MachineSwap)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.Owner != that1.Owner {
+		return false
+	}
+	if this.MachineId != that1.MachineId {
+		return false
+	}
+	if this.ReplacedMachineId != that1.ReplacedMachineId {
+		return false
+	}
+	return true
+}
+func (this *QueryMachineSwapsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryMachineSwapsRequest)
+	if !ok {
+		that2, ok := that.(QueryMachineSwapsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *QueryMachineSwapsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryMachineSwapsResponse)
+	if !ok {
+		that2, ok := that.(QueryMachineSwapsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Swaps) != len(that1.Swaps) {
+		return false
+	}
+	for i := range this.Swaps {
+		if !this.Swaps[i].Equal(&that1.Swaps[i]) {
+			return false
+		}
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *WhitelistedMachine) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*WhitelistedMachine)
+	if !ok {
+		that2, ok := that.(WhitelistedMachine)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Index != that1.Index {
+		return false
+	}
+	if this.MachineId != that1.MachineId {
+		return false
+	}
+	return true
+}
+func (this *QueryMachineWhitelistRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryMachineWhitelistRequest)
+	if !ok {
+		that2, ok := that.(QueryMachineWhitelistRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *QueryMachineWhitelistResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryMachineWhitelistResponse)
+	if !ok {
+		that2, ok := that.(QueryMachineWhitelistResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Machines) != len(that1.Machines) {
+		return false
+	}
+	for i := range this.Machines {
+		if !this.Machines[i].Equal(&that1.Machines[i]) {
+			return false
+		}
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *QueryParamsRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryParamsRequest)
+	if !ok {
+		that2, ok := that.(QueryParamsRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	return true
+}
+func (this *QueryParamsResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryParamsResponse)
+	if !ok {
+		that2, ok := that.(QueryParamsResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Params.Equal(&that1.Params) {
+		return false
+	}
+	return true
+}
+func (this *StaleNode) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*StaleNode)
+	if !ok {
+		that2, ok := that.(StaleNode)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !bytes.Equal(this.PubKey, that1.PubKey) {
+		return false
+	}
+	if this.AttestationHeight != that1.AttestationHeight {
+		return false
+	}
+	if len(this.Reasons) != len(that1.Reasons) {
+		return false
+	}
+	for i := range this.Reasons {
+		if this.Reasons[i] != that1.Reasons[i] {
+			return false
+		}
+	}
+	if that1.ReportTime == nil {
+		if this.ReportTime != nil {
+			return false
+		}
+	} else if !this.ReportTime.Equal(*that1.ReportTime) {
+		return false
+	}
+	return true
+}
+func (this *QueryStaleNodesRequest) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryStaleNodesRequest)
+	if !ok {
+		that2, ok := that.(QueryStaleNodesRequest)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+func (this *QueryStaleNodesResponse) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*QueryStaleNodesResponse)
+	if !ok {
+		that2, ok := that.(QueryStaleNodesResponse)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if len(this.Nodes) != len(that1.Nodes) {
+		return false
+	}
+	for i := range this.Nodes {
+		if !this.Nodes[i].Equal(&that1.Nodes[i]) {
+			return false
+		}
+	}
+	if !this.Pagination.Equal(that1.Pagination) {
+		return false
+	}
+	return true
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Returns the key used for transactions
+	TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the key used for registration
+	RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error)
+	// Returns the encrypted seed for a registered node by public key
+	EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error)
+	// Returns the registered nodes with their attestation fields
+	RegisteredNodes(ctx context.Context, in *QueryRegisteredNodesRequest, opts ...grpc.CallOption) (*QueryRegisteredNodesResponse, error)
+	// Returns the machine swaps recorded by node registrations
+	MachineSwaps(ctx context.Context, in *QueryMachineSwapsRequest, opts ...grpc.CallOption) (*QueryMachineSwapsResponse, error)
+	// Returns the machines added to the whitelist on-chain
+	MachineWhitelist(ctx context.Context, in *QueryMachineWhitelistRequest, opts ...grpc.CallOption) (*QueryMachineWhitelistResponse, error)
+	// Returns the attestation policy
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Returns the nodes whose attestation is stale or doesn't comply with the
+	// attestation policy
+	StaleNodes(ctx context.Context, in *QueryStaleNodesRequest, opts ...grpc.CallOption) (*QueryStaleNodesResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) TxKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
+	out := new(Key)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/TxKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegistrationKey(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*Key, error) {
+	out := new(Key)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegistrationKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) EncryptedSeed(ctx context.Context, in *QueryEncryptedSeedRequest, opts ...grpc.CallOption) (*QueryEncryptedSeedResponse, error) {
+	out := new(QueryEncryptedSeedResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/EncryptedSeed", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) RegisteredNodes(ctx context.Context, in *QueryRegisteredNodesRequest, opts ...grpc.CallOption) (*QueryRegisteredNodesResponse, error) {
+	out := new(QueryRegisteredNodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/RegisteredNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MachineSwaps(ctx context.Context, in *QueryMachineSwapsRequest, opts ...grpc.CallOption) (*QueryMachineSwapsResponse, error) {
+	out := new(QueryMachineSwapsResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/MachineSwaps", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MachineWhitelist(ctx context.Context, in *QueryMachineWhitelistRequest, opts ...grpc.CallOption) (*QueryMachineWhitelistResponse, error) {
+	out := new(QueryMachineWhitelistResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/MachineWhitelist", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) StaleNodes(ctx context.Context, in *QueryStaleNodesRequest, opts ...grpc.CallOption) (*QueryStaleNodesResponse, error) {
+	out := new(QueryStaleNodesResponse)
+	err := c.cc.Invoke(ctx, "/secret.registration.v1beta1.Query/StaleNodes", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Returns the key used for transactions
+	TxKey(context.Context, *emptypb.Empty) (*Key, error)
+	// Returns the key used for registration
+	RegistrationKey(context.Context, *emptypb.Empty) (*Key, error)
+	// Returns the encrypted seed for a registered node by public key
+	EncryptedSeed(context.Context, *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error)
+	// Returns the registered nodes with their attestation fields
+	RegisteredNodes(context.Context, *QueryRegisteredNodesRequest) (*QueryRegisteredNodesResponse, error)
+	// Returns the machine swaps recorded by node registrations
+	MachineSwaps(context.Context, *QueryMachineSwapsRequest) (*QueryMachineSwapsResponse, error)
+	// Returns the machines added to the whitelist on-chain
+	MachineWhitelist(context.Context, *QueryMachineWhitelistRequest) (*QueryMachineWhitelistResponse, error)
+	// Returns the attestation policy
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Returns the nodes whose attestation is stale or doesn't comply with the
+	// attestation policy
+	StaleNodes(context.Context, *QueryStaleNodesRequest) (*QueryStaleNodesResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) TxKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method TxKey not implemented")
+}
+func (*UnimplementedQueryServer) RegistrationKey(ctx context.Context, req *emptypb.Empty) (*Key, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegistrationKey not implemented")
+}
+func (*UnimplementedQueryServer) EncryptedSeed(ctx context.Context, req *QueryEncryptedSeedRequest) (*QueryEncryptedSeedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method EncryptedSeed not implemented")
+}
+func (*UnimplementedQueryServer) RegisteredNodes(ctx context.Context, req *QueryRegisteredNodesRequest) (*QueryRegisteredNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RegisteredNodes not implemented")
+}
+func (*UnimplementedQueryServer) MachineSwaps(ctx context.Context, req *QueryMachineSwapsRequest) (*QueryMachineSwapsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MachineSwaps not implemented")
+}
+func (*UnimplementedQueryServer) MachineWhitelist(ctx context.Context, req *QueryMachineWhitelistRequest) (*QueryMachineWhitelistResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MachineWhitelist not implemented")
+}
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) StaleNodes(ctx context.Context, req *QueryStaleNodesRequest) (*QueryStaleNodesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method StaleNodes not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_TxKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).TxKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/TxKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).TxKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegistrationKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(emptypb.Empty)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegistrationKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/RegistrationKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegistrationKey(ctx, req.(*emptypb.Empty))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_EncryptedSeed_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryEncryptedSeedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).EncryptedSeed(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/EncryptedSeed",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).EncryptedSeed(ctx, req.(*QueryEncryptedSeedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_RegisteredNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryRegisteredNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).RegisteredNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/RegisteredNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).RegisteredNodes(ctx, req.(*QueryRegisteredNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MachineSwaps_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMachineSwapsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MachineSwaps(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/MachineSwaps",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MachineSwaps(ctx, req.(*QueryMachineSwapsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MachineWhitelist_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMachineWhitelistRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MachineWhitelist(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/MachineWhitelist",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MachineWhitelist(ctx, req.(*QueryMachineWhitelistRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_StaleNodes_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryStaleNodesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).StaleNodes(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/secret.registration.v1beta1.Query/StaleNodes",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).StaleNodes(ctx, req.(*QueryStaleNodesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "secret.registration.v1beta1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "TxKey",
+			Handler:    _Query_TxKey_Handler,
+		},
+		{
+			MethodName: "RegistrationKey",
+			Handler:    _Query_RegistrationKey_Handler,
+		},
+		{
+			MethodName: "EncryptedSeed",
+			Handler:    _Query_EncryptedSeed_Handler,
+		},
+		{
+			MethodName: "RegisteredNodes",
+			Handler:    _Query_RegisteredNodes_Handler,
+		},
+		{
+			MethodName: "MachineSwaps",
+			Handler:    _Query_MachineSwaps_Handler,
+		},
+		{
+			MethodName: "MachineWhitelist",
+			Handler:    _Query_MachineWhitelist_Handler,
+		},
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "StaleNodes",
+			Handler:    _Query_StaleNodes_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "secret/registration/v1beta1/query.proto",
+}
+
+func (m *QueryEncryptedSeedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedSeedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedSeedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryEncryptedSeedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryEncryptedSeedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryEncryptedSeedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.EncryptedSeed) > 0 {
+		i -= len(m.EncryptedSeed)
+		copy(dAtA[i:], m.EncryptedSeed)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EncryptedSeed)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RegisteredNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RegisteredNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RegisteredNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.TcbStatus) > 0 {
+		i -= len(m.TcbStatus)
+		copy(dAtA[i:], m.TcbStatus)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.TcbStatus)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if len(m.MrSigner) > 0 {
+		i -= len(m.MrSigner)
+		copy(dAtA[i:], m.MrSigner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MrSigner)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MrEnclave) > 0 {
+		i -= len(m.MrEnclave)
+		copy(dAtA[i:], m.MrEnclave)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MrEnclave)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.AttestationType) > 0 {
+		i -= len(m.AttestationType)
+		copy(dAtA[i:], m.AttestationType)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.AttestationType)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryRegisteredNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryRegisteredNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryRegisteredNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MachineSwap) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MachineSwap) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MachineSwap) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.ReplacedMachineId) > 0 {
+		i -= len(m.ReplacedMachineId)
+		copy(dAtA[i:], m.ReplacedMachineId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.ReplacedMachineId)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.MachineId) > 0 {
+		i -= len(m.MachineId)
+		copy(dAtA[i:], m.MachineId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MachineId)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.Owner) > 0 {
+		i -= len(m.Owner)
+		copy(dAtA[i:], m.Owner)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Owner)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMachineSwapsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMachineSwapsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMachineSwapsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMachineSwapsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMachineSwapsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMachineSwapsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Swaps) > 0 {
+		for iNdEx := len(m.Swaps) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Swaps[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *WhitelistedMachine) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *WhitelistedMachine) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *WhitelistedMachine) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MachineId) > 0 {
+		i -= len(m.MachineId)
+		copy(dAtA[i:], m.MachineId)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.MachineId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Index != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Index))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMachineWhitelistRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMachineWhitelistRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMachineWhitelistRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMachineWhitelistResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMachineWhitelistResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMachineWhitelistResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Machines) > 0 {
+		for iNdEx := len(m.Machines) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Machines[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *StaleNode) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *StaleNode) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *StaleNode) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.ReportTime != nil {
+		n8, err8 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ReportTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReportTime):])
+		if err8 != nil {
+			return 0, err8
+		}
+		i -= n8
+		i = encodeVarintQuery(dAtA, i, uint64(n8))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.Reasons) > 0 {
+		for iNdEx := len(m.Reasons) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Reasons[iNdEx])
+			copy(dAtA[i:], m.Reasons[iNdEx])
+			i = encodeVarintQuery(dAtA, i, uint64(len(m.Reasons[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.AttestationHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.AttestationHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleNodesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleNodesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleNodesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryStaleNodesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryStaleNodesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryStaleNodesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Nodes) > 0 {
+		for iNdEx := len(m.Nodes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Nodes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryEncryptedSeedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryEncryptedSeedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.EncryptedSeed)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *RegisteredNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.AttestationType)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MrEnclave)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MrSigner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.TcbStatus)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.RegistrationHeight))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryRegisteredNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *MachineSwap) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.Owner)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.MachineId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.ReplacedMachineId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMachineSwapsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMachineSwapsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Swaps) > 0 {
+		for _, e := range m.Swaps {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *WhitelistedMachine) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Index != 0 {
+		n += 1 + sovQuery(uint64(m.Index))
+	}
+	l = len(m.MachineId)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMachineWhitelistRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMachineWhitelistResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Machines) > 0 {
+		for _, e := range m.Machines {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *StaleNode) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.AttestationHeight != 0 {
+		n += 1 + sovQuery(uint64(m.AttestationHeight))
+	}
+	if len(m.Reasons) > 0 {
+		for _, s := range m.Reasons {
+			l = len(s)
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.ReportTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ReportTime)
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleNodesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryStaleNodesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Nodes) > 0 {
+		for _, e := range m.Nodes {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
+			if m.EncryptedSeed == nil {
+				m.EncryptedSeed = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RegisteredNode) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RegisteredNode: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RegisteredNode: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationType", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AttestationType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrEnclave", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrEnclave = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MrSigner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MrSigner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TcbStatus", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TcbStatus = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryRegisteredNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryRegisteredNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryRegisteredNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, RegisteredNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MachineSwap) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MachineSwap: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MachineSwap: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Owner", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Owner = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplacedMachineId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReplacedMachineId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMachineSwapsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMachineSwapsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMachineSwapsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMachineSwapsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMachineSwapsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMachineSwapsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Swaps", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Swaps = append(m.Swaps, MachineSwap{})
+			if err := m.Swaps[len(m.Swaps)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *WhitelistedMachine) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: WhitelistedMachine: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: WhitelistedMachine: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Index", wireType)
+			}
+			m.Index = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Index |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MachineId", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MachineId = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMachineWhitelistRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMachineWhitelistRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMachineWhitelistRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMachineWhitelistResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMachineWhitelistResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMachineWhitelistResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Machines", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Machines = append(m.Machines, WhitelistedMachine{})
+			if err := m.Machines[len(m.Machines)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func (m *QueryEncryptedSeedResponse) Size() (n int) {
-	if m == nil {
-		return 0
-	}
-	var l int
-	_ = l
-	l = len(m.EncryptedSeed)
-	if l > 0 {
-		n += 1 + l + sovQuery(uint64(l))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
 	}
-	return n
-}
-
-func sovQuery(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	return nil
 }
-func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
+func (m *StaleNode) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -478,10 +3946,10 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: StaleNode: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: StaleNode: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -518,6 +3986,93 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 				m.PubKey = []byte{}
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHeight", wireType)
+			}
+			m.AttestationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reasons", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reasons = append(m.Reasons, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReportTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ReportTime == nil {
+				m.ReportTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ReportTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -539,7 +4094,7 @@ func (m *QueryEncryptedSeedRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryStaleNodesRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -562,17 +4117,17 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryStaleNodesRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryEncryptedSeedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryStaleNodesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field EncryptedSeed", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
 			}
-			var byteLen int
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowQuery
@@ -582,24 +4137,146 @@ func (m *QueryEncryptedSeedResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				byteLen |= int(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if byteLen < 0 {
+			if msglen < 0 {
 				return ErrInvalidLengthQuery
 			}
-			postIndex := iNdEx + byteLen
+			postIndex := iNdEx + msglen
 			if postIndex < 0 {
 				return ErrInvalidLengthQuery
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.EncryptedSeed = append(m.EncryptedSeed[:0], dAtA[iNdEx:postIndex]...)
-			if m.EncryptedSeed == nil {
-				m.EncryptedSeed = []byte{}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryStaleNodesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryStaleNodesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryStaleNodesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Nodes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Nodes = append(m.Nodes, StaleNode{})
+			if err := m.Nodes[len(m.Nodes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
 			}
 			iNdEx = postIndex
 		default:
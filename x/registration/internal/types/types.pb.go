@@ -105,6 +105,12 @@ var xxx_messageInfo_LegacySeedConfig proto.InternalMessageInfo
 type RegistrationNodeInfo struct {
 	Certificate   github_com_scrtlabs_SecretNetwork_x_registration_remote_attestation.Certificate `protobuf:"bytes,1,opt,name=certificate,proto3,casttype=github.com/scrtlabs/SecretNetwork/x/registration/remote_attestation.Certificate" json:"certificate,omitempty"`
 	EncryptedSeed []byte                                                                          `protobuf:"bytes,2,opt,name=encrypted_seed,json=encryptedSeed,proto3" json:"encrypted_seed,omitempty"`
+	// the height the node registered at, zero for nodes registered before it
+	// was recorded
+	RegistrationHeight int64 `protobuf:"varint,3,opt,name=registration_height,json=registrationHeight,proto3" json:"registration_height,omitempty"`
+	// the height of the node's latest attestation, either on registration or
+	// re-attestation
+	AttestationHeight int64 `protobuf:"varint,4,opt,name=attestation_height,json=attestationHeight,proto3" json:"attestation_height,omitempty"`
 }
 
 func (m *RegistrationNodeInfo) Reset()         { *m = RegistrationNodeInfo{} }
@@ -151,32 +157,34 @@ func init() {
 }
 
 var fileDescriptor_f3db05f1d182f4de = []byte{
-	// 395 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0x3d, 0x6e, 0xd4, 0x40,
-	0x14, 0x80, 0x3d, 0x89, 0xb4, 0x51, 0x66, 0x37, 0x08, 0x59, 0x29, 0x22, 0x90, 0xc6, 0xd1, 0x4a,
-	0x21, 0xa9, 0x3c, 0x0a, 0x1c, 0x00, 0xc9, 0x5b, 0xa1, 0xa0, 0x20, 0x79, 0x3b, 0x9a, 0x68, 0x3c,
-	0x7e, 0x6b, 0x46, 0xde, 0x9d, 0xb1, 0x66, 0x5e, 0x02, 0xbe, 0x03, 0x05, 0xc7, 0xe0, 0x00, 0x1c,
-	0x22, 0x65, 0x4a, 0x2a, 0x0b, 0xbc, 0x9d, 0x8f, 0x40, 0x85, 0xfc, 0x13, 0xd6, 0x94, 0xe9, 0x9e,
-	0xec, 0xef, 0xcd, 0xfb, 0xde, 0x0f, 0x3d, 0x77, 0x20, 0x2d, 0x20, 0xb7, 0x90, 0x29, 0x87, 0x56,
-	0xa0, 0x32, 0x9a, 0xdf, 0x5d, 0x26, 0x80, 0xe2, 0x92, 0x63, 0x59, 0x80, 0x0b, 0x0b, 0x6b, 0xd0,
-	0xf8, 0x2f, 0x7b, 0x30, 0x1c, 0x83, 0xe1, 0x00, 0xbe, 0x38, 0xce, 0x4c, 0x66, 0x3a, 0x8e, 0xb7,
-	0x51, 0x9f, 0x32, 0xff, 0x4a, 0x28, 0x5d, 0x02, 0xa4, 0x0b, 0xa3, 0x57, 0x2a, 0xf3, 0x5f, 0x51,
-	0xba, 0x11, 0x0e, 0xc1, 0xde, 0xe4, 0x50, 0x9e, 0x90, 0x53, 0x72, 0x71, 0x18, 0x1d, 0x34, 0x55,
-	0xb0, 0x5f, 0xe4, 0xaf, 0xe3, 0xc3, 0xfe, 0xd7, 0x15, 0x94, 0x3e, 0xa7, 0x47, 0xa0, 0xa5, 0x2d,
-	0x0b, 0x84, 0xb4, 0x43, 0xf7, 0x3a, 0x94, 0x36, 0x55, 0x30, 0x01, 0x2d, 0xaf, 0xa0, 0x8c, 0x67,
-	0xff, 0x80, 0x36, 0xe1, 0x8c, 0x1e, 0xdc, 0x81, 0x75, 0xca, 0xe8, 0x93, 0xfd, 0x53, 0x72, 0x71,
-	0x14, 0x4d, 0x9b, 0x2a, 0x78, 0xfc, 0x14, 0x3f, 0x06, 0xf3, 0x35, 0x7d, 0xfe, 0x1e, 0x32, 0x21,
-	0xcb, 0x91, 0xd3, 0x39, 0x9d, 0x0e, 0x4e, 0x12, 0x2c, 0x0e, 0x52, 0x93, 0xa6, 0x0a, 0xf6, 0x8a,
-	0x3c, 0x1e, 0x74, 0x17, 0x60, 0xf1, 0xc9, 0x52, 0xf3, 0x1f, 0x84, 0x1e, 0xc7, 0xa3, 0x59, 0x5d,
-	0x9b, 0x14, 0xde, 0xe9, 0x95, 0xf1, 0x6f, 0xe9, 0xb4, 0xad, 0xa5, 0x56, 0x4a, 0x0a, 0x84, 0xae,
-	0xe4, 0x2c, 0x5a, 0xfe, 0xa9, 0x82, 0x0f, 0x99, 0xc2, 0x4f, 0xb7, 0x49, 0x28, 0xcd, 0x86, 0x3b,
-	0x69, 0x71, 0x2d, 0x12, 0xc7, 0x97, 0xdd, 0xd4, 0xaf, 0x01, 0x3f, 0x1b, 0x9b, 0xf3, 0x2f, 0xff,
-	0xef, 0xc9, 0xc2, 0xc6, 0x20, 0xdc, 0x08, 0x44, 0x70, 0xd8, 0x6f, 0x64, 0xb1, 0x7b, 0x3a, 0x1e,
-	0xd7, 0xf1, 0xcf, 0xe8, 0xb3, 0x5d, 0x03, 0x0e, 0x20, 0xed, 0x3a, 0x98, 0xc5, 0xbb, 0xb6, 0xda,
-	0xb1, 0x44, 0xe2, 0xfe, 0x37, 0xf3, 0xbe, 0xd7, 0x8c, 0xdc, 0xd7, 0x8c, 0x3c, 0xd4, 0x8c, 0xfc,
-	0xaa, 0x19, 0xf9, 0xb6, 0x65, 0xde, 0xc3, 0x96, 0x79, 0x3f, 0xb7, 0xcc, 0xfb, 0xf8, 0xf6, 0xc9,
-	0x9a, 0x4a, 0x23, 0x58, 0x2d, 0xd6, 0xfd, 0x3d, 0x25, 0x93, 0xee, 0x3a, 0xde, 0xfc, 0x0d, 0x00,
-	0x00, 0xff, 0xff, 0xcd, 0xcc, 0xb9, 0x28, 0x7b, 0x02, 0x00, 0x00,
+	// 426 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x92, 0xb1, 0x6e, 0x13, 0x31,
+	0x18, 0xc7, 0x73, 0x09, 0x4a, 0x55, 0x27, 0x45, 0x60, 0x3a, 0x54, 0x20, 0xf9, 0xaa, 0x48, 0xa5,
+	0x5d, 0x88, 0x55, 0x78, 0x00, 0xa4, 0x64, 0x01, 0x15, 0x15, 0xc9, 0xd9, 0x58, 0x22, 0xc7, 0xf9,
+	0x72, 0xb1, 0x92, 0xd8, 0x27, 0xfb, 0x6b, 0xe1, 0xde, 0x81, 0x81, 0x81, 0x87, 0xe0, 0x51, 0x3a,
+	0x76, 0x64, 0x3a, 0x41, 0xb2, 0xe5, 0x11, 0x98, 0x50, 0x7c, 0x09, 0x31, 0x63, 0x37, 0xeb, 0xfe,
+	0x3f, 0xdf, 0xff, 0xff, 0xfd, 0xfd, 0x91, 0x73, 0x0f, 0xca, 0x01, 0x72, 0x07, 0x99, 0xf6, 0xe8,
+	0x24, 0x6a, 0x6b, 0xf8, 0xed, 0xe5, 0x08, 0x50, 0x5e, 0x72, 0x2c, 0x72, 0xf0, 0xdd, 0xdc, 0x59,
+	0xb4, 0xf4, 0x45, 0x05, 0x76, 0x63, 0xb0, 0xbb, 0x05, 0x9f, 0x1f, 0x67, 0x36, 0xb3, 0x81, 0xe3,
+	0x9b, 0x53, 0x75, 0xa5, 0xf3, 0x35, 0x21, 0x64, 0x00, 0x30, 0xee, 0x5b, 0x33, 0xd1, 0x19, 0x7d,
+	0x49, 0xc8, 0x42, 0x7a, 0x04, 0x37, 0x9c, 0x41, 0x71, 0x92, 0x9c, 0x26, 0x17, 0x87, 0xbd, 0x83,
+	0x75, 0x99, 0x36, 0xf2, 0xd9, 0x6b, 0x71, 0x58, 0x49, 0x57, 0x50, 0x50, 0x4e, 0x8e, 0xc0, 0x28,
+	0x57, 0xe4, 0x08, 0xe3, 0x80, 0xd6, 0x03, 0x4a, 0xd6, 0x65, 0xda, 0x04, 0xa3, 0xae, 0xa0, 0x10,
+	0xed, 0x7f, 0xc0, 0xe6, 0xc2, 0x19, 0x39, 0xb8, 0x05, 0xe7, 0xb5, 0x35, 0x27, 0x8d, 0xd3, 0xe4,
+	0xe2, 0xa8, 0xd7, 0x5a, 0x97, 0xe9, 0xee, 0x93, 0xd8, 0x1d, 0x3a, 0x73, 0xf2, 0xe4, 0x03, 0x64,
+	0x52, 0x15, 0x51, 0xa6, 0x73, 0xd2, 0xda, 0x66, 0x52, 0xe0, 0x70, 0x1b, 0xaa, 0xb9, 0x2e, 0xd3,
+	0x7a, 0x3e, 0x13, 0xdb, 0xb8, 0x7d, 0x70, 0xf8, 0xe0, 0x50, 0x9d, 0xef, 0x75, 0x72, 0x2c, 0xa2,
+	0xae, 0xae, 0xed, 0x18, 0xde, 0x9b, 0x89, 0xa5, 0x37, 0xa4, 0xb5, 0xf1, 0xd2, 0x13, 0xad, 0x24,
+	0x42, 0xb0, 0x6c, 0xf7, 0x06, 0x7f, 0xca, 0xf4, 0x63, 0xa6, 0x71, 0x7a, 0x33, 0xea, 0x2a, 0xbb,
+	0xe0, 0x5e, 0x39, 0x9c, 0xcb, 0x91, 0xe7, 0x83, 0xd0, 0xfa, 0x35, 0xe0, 0x67, 0xeb, 0x66, 0xfc,
+	0xcb, 0xff, 0xef, 0xe4, 0x60, 0x61, 0x11, 0x86, 0x12, 0x11, 0x3c, 0x56, 0x2f, 0xd2, 0xdf, 0xff,
+	0x5a, 0xc4, 0x3e, 0xf4, 0x8c, 0x3c, 0xde, 0x0f, 0xe0, 0x01, 0xc6, 0x61, 0x82, 0xb6, 0xd8, 0x8f,
+	0xb5, 0xa9, 0x85, 0x72, 0xf2, 0x2c, 0xb6, 0x18, 0x4e, 0x41, 0x67, 0x53, 0x0c, 0xbd, 0x36, 0x04,
+	0x8d, 0xa5, 0x77, 0x41, 0xa1, 0xaf, 0x08, 0x8d, 0xfc, 0x77, 0xfc, 0xa3, 0xc0, 0x3f, 0x8d, 0x94,
+	0x0a, 0xef, 0xc9, 0xbb, 0xdf, 0xac, 0xf6, 0x63, 0xc9, 0x92, 0xbb, 0x25, 0x4b, 0xee, 0x97, 0x2c,
+	0xf9, 0xb5, 0x64, 0xc9, 0xb7, 0x15, 0xab, 0xdd, 0xaf, 0x58, 0xed, 0xe7, 0x8a, 0xd5, 0x3e, 0xbd,
+	0x7d, 0x70, 0x0d, 0xda, 0x20, 0x38, 0x23, 0xe7, 0xd5, 0xbe, 0x8e, 0x9a, 0x61, 0xfb, 0xde, 0xfc,
+	0x1d, 0x00, 0x1e, 0x79, 0x78, 0x91, 0xdb, 0x02, 0x00, 0x00,
 }
 
 func (this *SeedConfig) Equal(that interface{}) bool {
@@ -261,6 +269,12 @@ func (this *RegistrationNodeInfo) Equal(that interface{}) bool {
 	if !bytes.Equal(this.EncryptedSeed, that1.EncryptedSeed) {
 		return false
 	}
+	if this.RegistrationHeight != that1.RegistrationHeight {
+		return false
+	}
+	if this.AttestationHeight != that1.AttestationHeight {
+		return false
+	}
 	return true
 }
 func (m *SeedConfig) Marshal() (dAtA []byte, err error) {
@@ -362,6 +376,16 @@ func (m *RegistrationNodeInfo) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.AttestationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.AttestationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.RegistrationHeight != 0 {
+		i = encodeVarintTypes(dAtA, i, uint64(m.RegistrationHeight))
+		i--
+		dAtA[i] = 0x18
+	}
 	if len(m.EncryptedSeed) > 0 {
 		i -= len(m.EncryptedSeed)
 		copy(dAtA[i:], m.EncryptedSeed)
@@ -441,6 +465,12 @@ func (m *RegistrationNodeInfo) Size() (n int) {
 	if l > 0 {
 		n += 1 + l + sovTypes(uint64(l))
 	}
+	if m.RegistrationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.RegistrationHeight))
+	}
+	if m.AttestationHeight != 0 {
+		n += 1 + sovTypes(uint64(m.AttestationHeight))
+	}
 	return n
 }
 
@@ -794,6 +824,44 @@ func (m *RegistrationNodeInfo) Unmarshal(dAtA []byte) error {
 				m.EncryptedSeed = []byte{}
 			}
 			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RegistrationHeight", wireType)
+			}
+			m.RegistrationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RegistrationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AttestationHeight", wireType)
+			}
+			m.AttestationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTypes
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AttestationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTypes(dAtA[iNdEx:])
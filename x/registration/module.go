@@ -53,6 +53,7 @@ func (AppModuleBasic) DefaultGenesis(cdc codec.JSONCodec) json.RawMessage {
 	return cdc.MustMarshalJSON(&GenesisState{
 		NodeExchMasterKey: &MasterKey{},
 		IoMasterKey:       &MasterKey{},
+		Params:            DefaultParams(),
 	})
 }
 
@@ -0,0 +1,172 @@
+package remote_attestation
+
+import (
+	"bytes"
+	"encoding/base64"
+	"encoding/binary"
+	"encoding/hex"
+	"encoding/json"
+	"time"
+
+	"github.com/pkg/errors"
+)
+
+const (
+	AttestationTypeEPID = "epid"
+	AttestationTypeDCAP = "dcap"
+)
+
+// offsets in an sgx quote, which starts with a 48 bytes header followed by the report body
+const (
+	cpuSvnOffset = 48
+	cpuSvnLen    = 16
+	isvSvnOffset = 48 + 258
+	// minQuoteLen is the length of an sgx quote up to the end of the report data in its report body
+	minQuoteLen = 432
+)
+
+// the collateral of a dcap quote starts with its tee type and the sizes of its 7 sections, which follow in order
+const (
+	collateralHdrLen     = 8 * 4
+	collateralTcbInfoIdx = 5
+)
+
+// iasTimestampLayout is the format of the timestamp of IAS reports, which are in UTC
+const iasTimestampLayout = "2006-01-02T15:04:05.999999"
+
+// AttestationInfo holds the fields of a node certificate that are interesting to operators and auditors
+type AttestationInfo struct {
+	Type      string
+	MrEnclave string
+	MrSigner  string
+	CpuSvn    []byte
+	IsvSvn    uint32
+	// PubKey is the node's public key, from the report data of the quote
+	PubKey []byte
+	// TcbStatus is the quote status reported by IAS for EPID attestations. DCAP quotes are evaluated by the
+	// enclave against their collateral, so their status isn't part of the certificate and is left empty
+	TcbStatus string
+	// ReportTime is the time the attestation report was issued at. For EPID attestations that's the time IAS issued
+	// the report at, DCAP quotes carry no time so it's the issue date of the TCB info in their collateral, which the
+	// enclave verifies the quote against. It's zero if the certificate doesn't have one, e.g. in software mode
+	ReportTime time.Time
+
+	// the fields below are only set for EPID attestations
+
+	AdvisoryIDs      []string
+	PlatformInfoBlob string
+	// ReportSignatureValid is set if the report is signed by IAS with a certificate chained to Intel's root
+	ReportSignatureValid bool
+}
+
+// ParseCombinedCert extracts the attestation fields of a combined certificate.
+// It doesn't verify the certificate, which must have been verified when the node was registered
+func ParseCombinedCert(blob []byte) (*AttestationInfo, error) {
+	epidCert, dcapQuote, dcapCollateral, err := splitCombinedCert(blob)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(epidCert) > 0 {
+		return ParseRaCert(epidCert)
+	}
+
+	if len(dcapQuote) > 0 {
+		info, err := parseQuote(dcapQuote)
+		if err != nil {
+			return nil, err
+		}
+		info.Type = AttestationTypeDCAP
+		info.ReportTime = collateralIssueDate(dcapCollateral)
+		return info, nil
+	}
+
+	return nil, errors.New("No valid attestation found")
+}
+
+// ParseRaCert extracts the attestation fields of an EPID certificate, which is what combined certificates replaced
+func ParseRaCert(rawCert []byte) (*AttestationInfo, error) {
+	_, payload, err := unmarshalCert(rawCert)
+	if err != nil {
+		return nil, err
+	}
+
+	var signedReport EndorsedAttestationReport
+	if err := json.Unmarshal(payload, &signedReport); err != nil {
+		// software mode certificates carry the raw public key instead of a report
+		return &AttestationInfo{Type: AttestationTypeEPID}, nil
+	}
+
+	var qr QuoteReport
+	if err := json.Unmarshal(signedReport.Report, &qr); err != nil {
+		return nil, err
+	}
+
+	qb, err := base64.StdEncoding.DecodeString(qr.IsvEnclaveQuoteBody)
+	if err != nil {
+		return nil, err
+	}
+
+	info, err := parseQuote(qb)
+	if err != nil {
+		return nil, err
+	}
+	info.Type = AttestationTypeEPID
+	info.TcbStatus = qr.IsvEnclaveQuoteStatus
+	info.AdvisoryIDs = qr.AdvisoryIDs
+	info.PlatformInfoBlob = qr.PlatformInfoBlob
+	// a report with a malformed timestamp is treated like one without
+	info.ReportTime, _ = time.ParseInLocation(iasTimestampLayout, qr.Timestamp, time.UTC)
+	_, err = verifyCert(payload)
+	info.ReportSignatureValid = err == nil
+	return info, nil
+}
+
+func parseQuote(quote []byte) (*AttestationInfo, error) {
+	if len(quote) < minQuoteLen {
+		return nil, errors.New("quote too small")
+	}
+
+	qrData := parseReport(quote, hex.EncodeToString(quote))
+	// the report data is the public key, padded with zeros or followed by the owner of the machine
+	pubKey, err := hex.DecodeString(qrData.ReportBody.ReportData[:64])
+	if err != nil {
+		return nil, err
+	}
+	return &AttestationInfo{
+		MrEnclave: qrData.ReportBody.MrEnclave,
+		MrSigner:  qrData.ReportBody.MrSigner,
+		CpuSvn:    append([]byte(nil), quote[cpuSvnOffset:cpuSvnOffset+cpuSvnLen]...),
+		IsvSvn:    uint32(binary.LittleEndian.Uint16(quote[isvSvnOffset:])),
+		PubKey:    pubKey,
+	}, nil
+}
+
+// collateralIssueDate returns the issue date of the TCB info in the collateral of a dcap quote, or the zero time if it
+// doesn't have one
+func collateralIssueDate(collateral []byte) time.Time {
+	if len(collateral) < collateralHdrLen {
+		return time.Time{}
+	}
+
+	// the sizes follow the tee type
+	start := uint64(collateralHdrLen)
+	for i := 1; i < collateralTcbInfoIdx; i++ {
+		start += uint64(binary.LittleEndian.Uint32(collateral[4*i:]))
+	}
+	end := start + uint64(binary.LittleEndian.Uint32(collateral[4*collateralTcbInfoIdx:]))
+	if end > uint64(len(collateral)) {
+		return time.Time{}
+	}
+
+	var tcbInfo struct {
+		TcbInfo struct {
+			IssueDate time.Time `json:"issueDate"`
+		} `json:"tcbInfo"`
+	}
+	// the TCB info is a null terminated json document
+	if err := json.Unmarshal(bytes.TrimRight(collateral[start:end], "\x00"), &tcbInfo); err != nil {
+		return time.Time{}
+	}
+	return tcbInfo.TcbInfo.IssueDate.UTC()
+}
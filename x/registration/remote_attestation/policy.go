@@ -0,0 +1,102 @@
+package remote_attestation
+
+import (
+	"encoding/hex"
+	"fmt"
+	"strings"
+
+	"github.com/pkg/errors"
+)
+
+// TcbStatuses are the quote statuses that verifyAttReport accepts from IAS, from the most to the least up to date
+var TcbStatuses = []string{
+	"OK",
+	"SW_HARDENING_NEEDED",
+	"CONFIGURATION_NEEDED",
+	"CONFIGURATION_AND_SW_HARDENING_NEEDED",
+	"GROUP_OUT_OF_DATE",
+	"GROUP_REVOKED",
+}
+
+// AttestationPolicy restricts the attestations accepted from nodes. Empty fields accept anything
+type AttestationPolicy struct {
+	// hex encoded
+	MrEnclaves []string
+	// hex encoded
+	MrSigners []string
+	// every component of the cpu svn must be at least the matching component of MinCpuSvn
+	MinCpuSvn []byte
+	MinIsvSvn uint32
+	// quote statuses accepted from EPID attestations, see TcbStatuses. DCAP quotes are evaluated by the enclave
+	// against their collateral, their status isn't part of the certificate
+	TcbStatuses []string
+}
+
+// IsEmpty returns true if the policy accepts every attestation
+func (p AttestationPolicy) IsEmpty() bool {
+	return len(p.MrEnclaves) == 0 && len(p.MrSigners) == 0 && len(p.MinCpuSvn) == 0 && p.MinIsvSvn == 0 &&
+		len(p.TcbStatuses) == 0
+}
+
+// Violations returns the reasons the attestation doesn't comply with the policy
+func (p AttestationPolicy) Violations(info *AttestationInfo) []string {
+	var violations []string
+
+	if len(p.MrEnclaves) > 0 && !containsFold(p.MrEnclaves, info.MrEnclave) {
+		violations = append(violations, fmt.Sprintf("mrenclave %s is not accepted", info.MrEnclave))
+	}
+	if len(p.MrSigners) > 0 && !containsFold(p.MrSigners, info.MrSigner) {
+		violations = append(violations, fmt.Sprintf("mrsigner %s is not accepted", info.MrSigner))
+	}
+	if len(p.MinCpuSvn) > 0 && !svnAtLeast(info.CpuSvn, p.MinCpuSvn) {
+		violations = append(violations, fmt.Sprintf("cpu svn %s is below %s", hex.EncodeToString(info.CpuSvn), hex.EncodeToString(p.MinCpuSvn)))
+	}
+	if info.IsvSvn < p.MinIsvSvn {
+		violations = append(violations, fmt.Sprintf("isv svn %d is below %d", info.IsvSvn, p.MinIsvSvn))
+	}
+	if len(p.TcbStatuses) > 0 && info.TcbStatus != "" && !containsFold(p.TcbStatuses, info.TcbStatus) {
+		violations = append(violations, fmt.Sprintf("tcb status %s is not accepted", info.TcbStatus))
+	}
+
+	return violations
+}
+
+// VerifyCombinedCertWithPolicy verifies the certificate like VerifyCombinedCert, and then checks it against the policy
+func VerifyCombinedCertWithPolicy(blob []byte, policy AttestationPolicy) ([]byte, error) {
+	pubKey, err := VerifyCombinedCert(blob)
+	if err != nil || policy.IsEmpty() {
+		return pubKey, err
+	}
+
+	info, err := ParseCombinedCert(blob)
+	if err != nil {
+		return nil, err
+	}
+	if violations := policy.Violations(info); len(violations) > 0 {
+		return nil, errors.New("attestation policy violated: " + strings.Join(violations, ", "))
+	}
+
+	return pubKey, nil
+}
+
+func containsFold(list []string, value string) bool {
+	for _, item := range list {
+		if strings.EqualFold(item, value) {
+			return true
+		}
+	}
+	return false
+}
+
+// svnAtLeast compares the svns component by component, the way TCB levels are compared
+func svnAtLeast(svn, minimum []byte) bool {
+	if len(svn) < len(minimum) {
+		return false
+	}
+	for i := range minimum {
+		if svn[i] < minimum[i] {
+			return false
+		}
+	}
+	return true
+}
@@ -1,8 +1,10 @@
 package remote_attestation
 
 import (
+	"encoding/binary"
 	"os"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/require"
 )
@@ -71,3 +73,58 @@ func Test_FuzzCrashers(t *testing.T) {
 		_, _ = VerifyRaCert(f)
 	}
 }
+
+func Test_ParseRaCert(t *testing.T) {
+	cert, err := os.ReadFile("../testdata/attestation_cert_hw_v2")
+	require.NoError(t, err)
+	t.Setenv("SGX_MODE", "HW")
+	pubKey, err := VerifyRaCert(cert)
+	require.NoError(t, err)
+
+	info, err := ParseRaCert(cert)
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeEPID, info.Type)
+	require.Equal(t, pubKey, info.PubKey)
+	require.Len(t, info.MrEnclave, 64)
+	require.Len(t, info.MrSigner, 64)
+	require.NotEmpty(t, info.TcbStatus)
+	require.False(t, info.ReportTime.IsZero())
+	require.True(t, info.ReportSignatureValid)
+
+	require.Empty(t, AttestationPolicy{MrEnclaves: []string{info.MrEnclave}, MinIsvSvn: info.IsvSvn}.Violations(info))
+	require.Len(t, AttestationPolicy{MrSigners: []string{info.MrEnclave}, MinIsvSvn: info.IsvSvn + 1}.Violations(info), 2)
+
+	// the tcb status of the report must be accepted
+	require.Empty(t, AttestationPolicy{TcbStatuses: []string{info.TcbStatus}}.Violations(info))
+	require.Len(t, AttestationPolicy{TcbStatuses: []string{"none"}}.Violations(info), 1)
+	info.TcbStatus = "GROUP_OUT_OF_DATE"
+	require.Len(t, AttestationPolicy{TcbStatuses: []string{"OK", "SW_HARDENING_NEEDED"}}.Violations(info), 1)
+
+	// dcap quotes have no status in the certificate
+	info.TcbStatus = ""
+	require.Empty(t, AttestationPolicy{TcbStatuses: []string{"OK"}}.Violations(info))
+}
+
+func Test_ParseCombinedCertDCAP(t *testing.T) {
+	quote, err := os.ReadFile("../testdata/attestation_dcap.quote")
+	require.NoError(t, err)
+	collateral, err := os.ReadFile("../testdata/attestation_dcap.collateral")
+	require.NoError(t, err)
+
+	// a combined certificate without an EPID certificate, in the legacy format
+	cert := make([]byte, 12)
+	binary.LittleEndian.PutUint32(cert[4:], uint32(len(quote)))
+	binary.LittleEndian.PutUint32(cert[8:], uint32(len(collateral)))
+	cert = append(append(cert, quote...), collateral...)
+
+	info, err := ParseCombinedCert(cert)
+	require.NoError(t, err)
+	require.Equal(t, AttestationTypeDCAP, info.Type)
+	require.Equal(t, time.Date(2021, 3, 31, 8, 46, 22, 0, time.UTC), info.ReportTime)
+
+	// without its collateral the quote has no report time
+	binary.LittleEndian.PutUint32(cert[8:], 0)
+	info, err = ParseCombinedCert(cert[:12+len(quote)])
+	require.NoError(t, err)
+	require.True(t, info.ReportTime.IsZero())
+}
@@ -40,12 +40,15 @@ func VerifyCertDCAP(blob []byte, pos0 uintptr, pos1 uintptr) ([]byte, error) {
 	return quote.M_PubKey[:], nil
 }
 
-func VerifyCombinedCert(blob []byte) ([]byte, error) {
+// splitCombinedCert returns the EPID certificate, the DCAP quote and the collateral of the quote carried by a combined
+// certificate, any of which may be empty
+func splitCombinedCert(blob []byte) ([]byte, []byte, []byte, error) {
 	var hdr CombinedHdr
 
 	if (len(blob) > 0) && (blob[0] != 0) {
 		// try the newer format
 		pos := 0
+		var dcapQuote, dcapCollateral []byte
 
 		for pos+5 < len(blob) {
 
@@ -61,24 +64,30 @@ func VerifyCombinedCert(blob []byte) ([]byte, error) {
 
 			pos1 := pos + int(block_size)
 
-			if (block_tag == 2) && (block_size > 0) {
-				return VerifyCertDCAP(blob, uintptr(pos), uintptr(pos1))
+			if (block_tag == 2) && (block_size > 0) && (dcapQuote == nil) {
+				dcapQuote = blob[pos:pos1]
+			}
+			if (block_tag == 3) && (dcapCollateral == nil) {
+				dcapCollateral = blob[pos:pos1]
 			}
 
 			pos = pos1
 		}
 
+		if dcapQuote != nil {
+			return nil, dcapQuote, dcapCollateral, nil
+		}
 	}
 
 	if uintptr(len(blob)) < unsafe.Sizeof(hdr) {
-		return nil, errors.New("Combined hdr too small")
+		return nil, nil, nil, errors.New("Combined hdr too small")
 	}
 
 	{
 		buf := bytes.NewReader(blob)
 		err := binary.Read(buf, binary.LittleEndian, &hdr)
 		if err != nil {
-			return nil, err
+			return nil, nil, nil, err
 		}
 	}
 
@@ -88,19 +97,28 @@ func VerifyCombinedCert(blob []byte) ([]byte, error) {
 	idx3 := idx2 + uintptr(hdr.M_CombinedSizes[2])
 
 	if uintptr(len(blob)) < idx3 {
-		return nil, errors.New("combined hdr invalid")
+		return nil, nil, nil, errors.New("combined hdr invalid")
+	}
+
+	return blob[idx0:idx1], blob[idx1:idx2], blob[idx2:idx3], nil
+}
+
+func VerifyCombinedCert(blob []byte) ([]byte, error) {
+	epidCert, dcapQuote, _, err := splitCombinedCert(blob)
+	if err != nil {
+		return nil, err
 	}
 
-	if idx1 > idx0 {
-		ret_pk, ret_err := VerifyRaCert(blob[idx0:idx1])
+	if len(epidCert) > 0 {
+		ret_pk, ret_err := VerifyRaCert(epidCert)
 		if ret_pk != nil {
 			fmt.Println("EPID quote Extracted pk: ", hex.EncodeToString(ret_pk))
 		}
 		return ret_pk, ret_err
 	}
 
-	if idx2 > idx1 {
-		return VerifyCertDCAP(blob, idx1, idx2)
+	if len(dcapQuote) > 0 {
+		return VerifyCertDCAP(dcapQuote, 0, uintptr(len(dcapQuote)))
 	}
 
 	return nil, errors.New("No valid attestatoin found")